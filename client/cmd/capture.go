@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var captureCmd = &cobra.Command{
+	Use:   "capture",
+	Short: "Capture decrypted packets of the WireGuard interface",
+	Long: `Records the decrypted packets passing through the userspace packet filter into a pcapng file that can be opened with Wireshark or tcpdump.
+The capture runs until the duration or size limit is reached or it is interrupted with Ctrl+C.
+Filters of different kinds are combined with AND, multiple values of the same kind with OR.
+Packet capture requires the userspace WireGuard device. On Linux it is not available with the WireGuard kernel module, set NB_WG_KERNEL_DISABLED=true to use the userspace device.`,
+	Example: `
+  netbird debug capture --duration 1m
+  netbird debug capture --peer peer-a.netbird.cloud -p tcp --port 443
+  netbird debug capture --host 100.64.0.10 --host 10.0.0.0/8 -p icmp --max-size 10`,
+	Args: cobra.NoArgs,
+	RunE: runCapture,
+}
+
+func init() {
+	debugCmd.AddCommand(captureCmd)
+
+	captureCmd.Flags().StringSlice("peer", nil, "Capture packets from and to the peer, by FQDN, hostname, NetBird IP or public key")
+	captureCmd.Flags().StringSlice("host", nil, "Capture packets from and to the IP address or network")
+	captureCmd.Flags().StringSliceP("protocol", "p", nil, "Capture packets of the protocol (tcp/udp/icmp)")
+	captureCmd.Flags().UintSlice("port", nil, "Capture TCP and UDP packets from and to the port")
+	captureCmd.Flags().Duration("duration", 0, "Stop the capture after the duration, e.g. 30s or 5m (default until interrupted)")
+	captureCmd.Flags().Int64("max-size", 0, "Stop the capture after the given amount of packet data in MB (default unlimited)")
+	captureCmd.Flags().Uint32("snaplen", 0, "Store at most the given number of bytes per packet (default 65535)")
+}
+
+func runCapture(cmd *cobra.Command, _ []string) error {
+	req, err := captureRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Errorf(errCloseConnection, err)
+		}
+	}()
+
+	client := proto.NewDaemonServiceClient(conn)
+	resp, err := client.StartCapture(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to start capture: %v", status.Convert(err).Message())
+	}
+
+	if resp.GetFilter() != "" {
+		cmd.Printf("Capturing packets matching %q, press Ctrl+C to stop\n", resp.GetFilter())
+	} else {
+		cmd.Println("Capturing all packets, press Ctrl+C to stop")
+	}
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	waitForCapture(ctx, cmd, client)
+
+	stopResp, err := client.StopCapture(context.Background(), &proto.StopCaptureRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to stop capture: %v", status.Convert(err).Message())
+	}
+
+	stats := stopResp.GetStats()
+	cmd.Printf("\nCapture %s: %d packets, %d bytes\n", stats.GetStopReason(), stats.GetPackets(), stats.GetBytes())
+	cmd.Println(stats.GetPath())
+
+	return nil
+}
+
+func captureRequest(cmd *cobra.Command) (*proto.StartCaptureRequest, error) {
+	peers, _ := cmd.Flags().GetStringSlice("peer")
+	hosts, _ := cmd.Flags().GetStringSlice("host")
+	protocols, _ := cmd.Flags().GetStringSlice("protocol")
+	ports, _ := cmd.Flags().GetUintSlice("port")
+	duration, _ := cmd.Flags().GetDuration("duration")
+	maxSize, _ := cmd.Flags().GetInt64("max-size")
+	snapLen, _ := cmd.Flags().GetUint32("snaplen")
+
+	for _, protocol := range protocols {
+		if protocol != "tcp" && protocol != "udp" && protocol != "icmp" {
+			return nil, fmt.Errorf("invalid protocol %s: use tcp/udp/icmp", protocol)
+		}
+	}
+
+	req := &proto.StartCaptureRequest{
+		Peers:     peers,
+		Prefixes:  hosts,
+		Protocols: protocols,
+		MaxBytes:  maxSize * 1024 * 1024,
+		SnapLen:   snapLen,
+	}
+
+	for _, port := range ports {
+		if port == 0 || port > 65535 {
+			return nil, fmt.Errorf("invalid port %d", port)
+		}
+		req.Ports = append(req.Ports, uint32(port))
+	}
+
+	if duration > 0 {
+		req.Duration = durationpb.New(duration)
+	}
+
+	return req, nil
+}
+
+// waitForCapture prints the capture progress until the capture stops by itself or the context is canceled
+func waitForCapture(ctx context.Context, cmd *cobra.Command, client proto.DaemonServiceClient) {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			resp, err := client.GetCaptureStatus(ctx, &proto.GetCaptureStatusRequest{})
+			if err != nil {
+				cmd.PrintErrf("\nFailed to get capture status: %v\n", status.Convert(err).Message())
+				return
+			}
+			stats := resp.GetStats()
+			cmd.Printf("\rCaptured %d packets, %d bytes", stats.GetPackets(), stats.GetBytes())
+			if !resp.GetRunning() {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var exitNodeCmd = &cobra.Command{
+	Use:   "exit-node",
+	Short: "Manage exit nodes",
+	Long: `Commands to list, use, or clear exit nodes. An exit node routes all internet traffic of this peer.
+With the --exit-node-kill-switch flag of the up command, the traffic bypassing NetBird is blocked while an exit node is selected.`,
+}
+
+var exitNodeListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List exit nodes",
+	Long:    "List all available exit nodes, the selected exit node is marked with an asterisk.",
+	Example: "  netbird exit-node list",
+	RunE:    exitNodeList,
+}
+
+var exitNodeUseCmd = &cobra.Command{
+	Use:     "use <id>",
+	Short:   "Use an exit node",
+	Long:    "Route the internet traffic through the given exit node. Other exit nodes are deselected, the selection of the other networks is kept.",
+	Example: "  netbird exit-node use exit-node-eu",
+	Args:    cobra.ExactArgs(1),
+	RunE:    exitNodeUse,
+}
+
+var exitNodeClearCmd = &cobra.Command{
+	Use:     "clear",
+	Short:   "Stop using an exit node",
+	Long:    "Deselect all exit nodes, the internet traffic is not routed through NetBird anymore.",
+	Example: "  netbird exit-node clear",
+	RunE:    exitNodeClear,
+}
+
+func init() {
+	rootCmd.AddCommand(exitNodeCmd)
+	exitNodeCmd.AddCommand(exitNodeListCmd, exitNodeUseCmd, exitNodeClearCmd)
+}
+
+func exitNodeList(cmd *cobra.Command, _ []string) error {
+	client, closeConn, err := getProfileClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	resp, err := client.ListExitNodes(cmd.Context(), &proto.ListExitNodesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list exit nodes: %v", status.Convert(err).Message())
+	}
+
+	if len(resp.GetExitNodes()) == 0 {
+		cmd.Println("No exit nodes available.")
+	}
+
+	for _, exitNode := range resp.GetExitNodes() {
+		marker := " "
+		state := ""
+		if exitNode.GetSelected() {
+			marker = "*"
+			state = "disconnected"
+			if exitNode.GetConnected() {
+				state = "connected"
+			}
+		}
+		cmd.Printf("%s %s\t%s\t%s\n", marker, exitNode.GetId(), exitNode.GetNetwork(), state)
+	}
+
+	killSwitch := "disabled"
+	if resp.GetKillSwitch() {
+		killSwitch = "enabled"
+		if resp.GetKillSwitchAllowLan() {
+			killSwitch = "enabled, local networks allowed"
+		}
+	}
+	cmd.Printf("\nKill-switch: %s\n", killSwitch)
+
+	return nil
+}
+
+func exitNodeUse(cmd *cobra.Command, args []string) error {
+	client, closeConn, err := getProfileClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	if _, err := client.SelectExitNode(cmd.Context(), &proto.SelectExitNodeRequest{Id: args[0]}); err != nil {
+		return fmt.Errorf("failed to select exit node: %v", status.Convert(err).Message())
+	}
+
+	cmd.Printf("Using exit node %s\n", args[0])
+	return nil
+}
+
+func exitNodeClear(cmd *cobra.Command, _ []string) error {
+	client, closeConn, err := getProfileClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	if _, err := client.ClearExitNode(cmd.Context(), &proto.ClearExitNodeRequest{}); err != nil {
+		return fmt.Errorf("failed to clear exit node: %v", status.Convert(err).Message())
+	}
+
+	cmd.Println("Exit node cleared")
+	return nil
+}
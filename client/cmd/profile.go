@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage profiles",
+	Long: `Commands to manage the profiles of the daemon. Every profile has its own management URL, keys and settings,
+which allows using accounts of several NetBird networks from one client.`,
+}
+
+var profileListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List profiles",
+	Long:    "List all profiles, the active profile is marked with an asterisk.",
+	Example: "  netbird profile list",
+	RunE:    profileList,
+}
+
+var profileAddCmd = &cobra.Command{
+	Use:     "add <name>",
+	Short:   "Add a profile",
+	Long:    "Add a new profile with its own keys. The management URL of the profile is set with the --management-url flag.",
+	Example: "  netbird profile add work --management-url https://netbird.example.com",
+	Args:    cobra.ExactArgs(1),
+	RunE:    profileAdd,
+}
+
+var profileRemoveCmd = &cobra.Command{
+	Use:     "remove <name>",
+	Aliases: []string{"rm"},
+	Short:   "Remove a profile",
+	Long:    "Remove a profile and its config. The default and the active profile can't be removed.",
+	Example: "  netbird profile remove work",
+	Args:    cobra.ExactArgs(1),
+	RunE:    profileRemove,
+}
+
+var profileSwitchCmd = &cobra.Command{
+	Use:     "switch <name>",
+	Short:   "Switch the active profile",
+	Long:    "Disconnect the active profile and activate the given one. Run the up command afterwards to connect the profile.",
+	Example: "  netbird profile switch work\n  netbird up",
+	Args:    cobra.ExactArgs(1),
+	RunE:    profileSwitch,
+}
+
+func init() {
+	rootCmd.AddCommand(profileCmd)
+	profileCmd.AddCommand(profileListCmd, profileAddCmd, profileRemoveCmd, profileSwitchCmd)
+}
+
+func profileList(cmd *cobra.Command, _ []string) error {
+	client, closeConn, err := getProfileClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	resp, err := client.ListProfiles(cmd.Context(), &proto.ListProfilesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list profiles: %v", status.Convert(err).Message())
+	}
+
+	for _, profile := range resp.GetProfiles() {
+		marker := " "
+		if profile.GetActive() {
+			marker = "*"
+		}
+		cmd.Printf("%s %s\t%s\n", marker, profile.GetName(), profile.GetManagementUrl())
+	}
+
+	return nil
+}
+
+func profileAdd(cmd *cobra.Command, args []string) error {
+	client, closeConn, err := getProfileClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	if _, err := client.AddProfile(cmd.Context(), &proto.AddProfileRequest{
+		Name:          args[0],
+		ManagementUrl: managementURL,
+	}); err != nil {
+		return fmt.Errorf("failed to add profile: %v", status.Convert(err).Message())
+	}
+
+	cmd.Printf("Profile %s added\n", args[0])
+	return nil
+}
+
+func profileRemove(cmd *cobra.Command, args []string) error {
+	client, closeConn, err := getProfileClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	if _, err := client.RemoveProfile(cmd.Context(), &proto.RemoveProfileRequest{Name: args[0]}); err != nil {
+		return fmt.Errorf("failed to remove profile: %v", status.Convert(err).Message())
+	}
+
+	cmd.Printf("Profile %s removed\n", args[0])
+	return nil
+}
+
+func profileSwitch(cmd *cobra.Command, args []string) error {
+	client, closeConn, err := getProfileClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	if _, err := client.SwitchProfile(cmd.Context(), &proto.SwitchProfileRequest{Name: args[0]}); err != nil {
+		return fmt.Errorf("failed to switch profile: %v", status.Convert(err).Message())
+	}
+
+	cmd.Printf("Switched to profile %s, run \"netbird up\" to connect\n", args[0])
+	return nil
+}
+
+func getProfileClient(cmd *cobra.Command) (proto.DaemonServiceClient, func(), error) {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closeConn := func() {
+		if err := conn.Close(); err != nil {
+			log.Errorf(errCloseConnection, err)
+		}
+	}
+	return proto.NewDaemonServiceClient(conn), closeConn, nil
+}
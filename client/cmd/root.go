@@ -39,6 +39,9 @@ const (
 	dnsRouteIntervalFlag    = "dns-router-interval"
 	systemInfoFlag          = "system-info"
 	blockLANAccessFlag      = "block-lan-access"
+	exitNodeKillSwitchFlag  = "exit-node-kill-switch"
+	exitNodeAllowLANFlag    = "exit-node-allow-lan"
+	captureFlag             = "capture"
 )
 
 var (
@@ -54,6 +57,7 @@ var (
 	oldDefaultLogFile       string
 	logFile                 string
 	daemonAddr              string
+	metricsAddr             string
 	managementURL           string
 	adminURL                string
 	setupKey                string
@@ -73,8 +77,11 @@ var (
 	extraIFaceBlackList     []string
 	anonymizeFlag           bool
 	debugSystemInfoFlag     bool
+	debugCaptureFlag        bool
 	dnsRouteInterval        time.Duration
 	blockLANAccess          bool
+	exitNodeKillSwitch      bool
+	exitNodeAllowLAN        bool
 
 	rootCmd = &cobra.Command{
 		Use:          "netbird",
@@ -124,6 +131,7 @@ func init() {
 	}
 
 	rootCmd.PersistentFlags().StringVar(&daemonAddr, "daemon-addr", defaultDaemonAddr, "Daemon service address to serve CLI requests [unix|tcp]://[path|host:port]")
+	rootCmd.PersistentFlags().StringVar(&metricsAddr, "metrics-addr", "", "Address the daemon service exposes Prometheus metrics on, e.g. 127.0.0.1:9091. Metrics are disabled if empty")
 	rootCmd.PersistentFlags().StringVarP(&managementURL, "management-url", "m", "", fmt.Sprintf("Management Service URL [http|https]://[host]:[port] (default \"%s\")", internal.DefaultManagementURL))
 	rootCmd.PersistentFlags().StringVar(&adminURL, "admin-url", "", fmt.Sprintf("Admin Panel URL [http|https]://[host]:[port] (default \"%s\")", internal.DefaultAdminURL))
 	rootCmd.PersistentFlags().StringVarP(&serviceName, "service", "s", defaultServiceName, "Netbird system service name")
@@ -178,6 +186,8 @@ func init() {
 	upCmd.PersistentFlags().BoolVar(&autoConnectDisabled, disableAutoConnectFlag, false, "Disables auto-connect feature. If enabled, then the client won't connect automatically when the service starts.")
 
 	debugCmd.PersistentFlags().BoolVarP(&debugSystemInfoFlag, systemInfoFlag, "S", false, "Adds system information to the debug bundle")
+	debugBundleCmd.Flags().BoolVar(&debugCaptureFlag, captureFlag, false, "Adds the last packet capture to the debug bundle")
+	forCmd.Flags().BoolVar(&debugCaptureFlag, captureFlag, false, "Captures all packets for the duration and adds the capture to the debug bundle")
 }
 
 // SetupCloseHandler handles SIGTERM signal and exits with success
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/netbirdio/netbird/client/internal"
+	"github.com/netbirdio/netbird/client/internal/metrics"
 	"github.com/netbirdio/netbird/client/server"
 )
 
@@ -19,6 +20,7 @@ type program struct {
 	serv             *grpc.Server
 	serverInstance   *server.Server
 	serverInstanceMu sync.Mutex
+	metricsServer    *metrics.Metrics
 }
 
 func newProgram(ctx context.Context, cancel context.CancelFunc) *program {
@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"github.com/netbirdio/netbird/client/internal/metrics"
 	"github.com/netbirdio/netbird/client/proto"
 	"github.com/netbirdio/netbird/client/server"
 	"github.com/netbirdio/netbird/util"
@@ -65,6 +68,10 @@ func (p *program) Start(svc service.Service) error {
 		p.serverInstance = serverInstance
 		p.serverInstanceMu.Unlock()
 
+		if metricsAddr != "" {
+			p.startMetrics(serverInstance)
+		}
+
 		log.Printf("started daemon server: %v", split[1])
 		if err := p.serv.Serve(listen); err != nil {
 			log.Errorf("failed to serve daemon requests: %v", err)
@@ -90,11 +97,53 @@ func (p *program) Stop(srv service.Service) error {
 		p.serv.Stop()
 	}
 
+	p.stopMetrics()
+
 	time.Sleep(time.Second * 2)
 	log.Info("stopped Netbird service") //nolint
 	return nil
 }
 
+// startMetrics serves the client metrics, the daemon keeps running if the metrics server fails
+func (p *program) startMetrics(serverInstance *server.Server) {
+	metricsServer, err := metrics.NewServer(metricsAddr)
+	if err != nil {
+		log.Errorf("failed to create metrics server: %v", err)
+		return
+	}
+
+	if err := serverInstance.RegisterMetrics(metricsServer.Meter); err != nil {
+		log.Errorf("failed to register metrics: %v", err)
+		return
+	}
+
+	p.serverInstanceMu.Lock()
+	p.metricsServer = metricsServer
+	p.serverInstanceMu.Unlock()
+
+	go func() {
+		log.Infof("running metrics server: %s%s", metricsServer.Addr, metricsServer.Endpoint)
+		if err := metricsServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("failed to serve metrics: %v", err)
+		}
+	}()
+}
+
+func (p *program) stopMetrics() {
+	p.serverInstanceMu.Lock()
+	metricsServer := p.metricsServer
+	p.serverInstanceMu.Unlock()
+	if metricsServer == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := metricsServer.Shutdown(ctx); err != nil {
+		log.Errorf("failed to stop metrics server: %v", err)
+	}
+}
+
 var runCmd = &cobra.Command{
 	Use:   "run",
 	Short: "runs Netbird as service",
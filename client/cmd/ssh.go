@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"runtime"
 	"strings"
 	"syscall"
 
@@ -17,9 +18,13 @@ import (
 )
 
 var (
-	port int
-	user = "root"
-	host string
+	port           int
+	user           = "root"
+	host           string
+	localForwards  []string
+	remoteForwards []string
+	noShell        bool
+	copyRecursive  bool
 )
 
 var sshCmd = &cobra.Command{
@@ -29,72 +34,178 @@ var sshCmd = &cobra.Command{
 			return errors.New("requires a host argument")
 		}
 
-		split := strings.Split(args[0], "@")
-		if len(split) == 2 {
-			user = split[0]
-			host = split[1]
-		} else {
-			host = args[0]
-		}
+		user, host = parseUserHost(args[0])
 
 		return nil
 	},
 	Short: "connect to a remote SSH server",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		SetFlagsFromEnvVars(rootCmd)
-		SetFlagsFromEnvVars(cmd)
+		forwards, err := parseForwards(localForwards, remoteForwards)
+		if err != nil {
+			return err
+		}
 
-		cmd.SetOut(cmd.OutOrStdout())
+		return runSSHCommand(cmd, func(ctx context.Context, pemKey []byte) error {
+			return runSSH(ctx, host, pemKey, forwards, cmd)
+		})
+	},
+}
 
-		err := util.InitLog(logLevel, "console")
-		if err != nil {
-			return fmt.Errorf("failed initializing log %v", err)
+var sshCopyCmd = &cobra.Command{
+	Use:   "cp [-r] source target",
+	Short: "copy files from or to a remote peer over SFTP",
+	Long: "Copies files between the local machine and a remote peer over SFTP. " +
+		"Exactly one of the source and target must be a remote path in the [user@]host:path format, " +
+		"relative remote paths start from the home directory of the user.",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		source, sourceRemote := parseCopyPath(args[0])
+		target, targetRemote := parseCopyPath(args[1])
+		if sourceRemote == targetRemote {
+			return errors.New("exactly one of the source and target must be a remote path in the [user@]host:path format")
 		}
 
-		if !util.IsAdmin() {
-			cmd.Printf("error: you must have Administrator privileges to run this command\n")
-			return nil
+		remote := target
+		if sourceRemote {
+			remote = source
 		}
+		user, host = parseUserHost(remote.host)
 
-		ctx := internal.CtxInitState(cmd.Context())
+		return runSSHCommand(cmd, func(_ context.Context, pemKey []byte) error {
+			c, err := dialSSH(host, pemKey, cmd)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = c.Close()
+			}()
 
-		config, err := internal.UpdateConfig(internal.ConfigInput{
-			ConfigPath: configPath,
+			if sourceRemote {
+				return c.Download(source.path, target.path, copyRecursive)
+			}
+			return c.Upload(source.path, target.path, copyRecursive)
 		})
+	},
+}
+
+var sshSFTPServerCmd = &cobra.Command{
+	Use:    "sftp-server",
+	Short:  "serve SFTP on the standard input and output, started by the embedded SSH server",
+	Hidden: true,
+	Args:   cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workDir, err := os.Getwd()
 		if err != nil {
 			return err
 		}
 
-		sig := make(chan os.Signal, 1)
-		signal.Notify(sig, syscall.SIGTERM, syscall.SIGINT)
-		sshctx, cancel := context.WithCancel(ctx)
+		return nbssh.ServeSFTP(stdio{}, workDir)
+	},
+}
 
-		go func() {
-			// blocking
-			if err := runSSH(sshctx, host, []byte(config.SSHKey), cmd); err != nil {
-				cmd.Printf("Error: %v\n", err)
-				os.Exit(1)
-			}
-			cancel()
-		}()
+// stdio is the standard input and output of the process
+type stdio struct{}
 
-		select {
-		case <-sig:
-			cancel()
-		case <-sshctx.Done():
-		}
+func (stdio) Read(p []byte) (int, error) {
+	return os.Stdin.Read(p)
+}
+
+func (stdio) Write(p []byte) (int, error) {
+	return os.Stdout.Write(p)
+}
+
+func (stdio) Close() error {
+	return errors.Join(os.Stdin.Close(), os.Stdout.Close())
+}
+
+// runSSHCommand loads the SSH key of the peer and runs fn until it returns or the command is interrupted
+func runSSHCommand(cmd *cobra.Command, fn func(ctx context.Context, pemKey []byte) error) error {
+	SetFlagsFromEnvVars(rootCmd)
+	SetFlagsFromEnvVars(cmd)
 
+	cmd.SetOut(cmd.OutOrStdout())
+
+	err := util.InitLog(logLevel, "console")
+	if err != nil {
+		return fmt.Errorf("failed initializing log %v", err)
+	}
+
+	if !util.IsAdmin() {
+		cmd.Printf("error: you must have Administrator privileges to run this command\n")
 		return nil
-	},
+	}
+
+	ctx := internal.CtxInitState(cmd.Context())
+
+	config, err := internal.UpdateConfig(internal.ConfigInput{
+		ConfigPath: configPath,
+	})
+	if err != nil {
+		return err
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGTERM, syscall.SIGINT)
+	sshctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		// blocking
+		if err := fn(sshctx, []byte(config.SSHKey)); err != nil {
+			cmd.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		cancel()
+	}()
+
+	select {
+	case <-sig:
+		cancel()
+	case <-sshctx.Done():
+	}
+
+	return nil
 }
 
-func runSSH(ctx context.Context, addr string, pemKey []byte, cmd *cobra.Command) error {
+func dialSSH(addr string, pemKey []byte, cmd *cobra.Command) (*nbssh.Client, error) {
 	c, err := nbssh.DialWithKey(fmt.Sprintf("%s:%d", addr, port), user, pemKey)
 	if err != nil {
 		cmd.Printf("Error: %v\n", err)
 		cmd.Printf("Couldn't connect. Please check the connection status or if the ssh server is enabled on the other peer" +
 			"\nYou can verify the connection by running:\n\n" +
 			" netbird status\n\n")
+		return nil, err
+	}
+	return c, nil
+}
+
+// sshForwards are the parsed -L and -R port forwardings
+type sshForwards struct {
+	local  []nbssh.Forward
+	remote []nbssh.Forward
+}
+
+func parseForwards(local, remote []string) (sshForwards, error) {
+	var forwards sshForwards
+	for _, spec := range local {
+		forward, err := nbssh.ParseForward(spec)
+		if err != nil {
+			return sshForwards{}, err
+		}
+		forwards.local = append(forwards.local, forward)
+	}
+	for _, spec := range remote {
+		forward, err := nbssh.ParseForward(spec)
+		if err != nil {
+			return sshForwards{}, err
+		}
+		forwards.remote = append(forwards.remote, forward)
+	}
+	return forwards, nil
+}
+
+func runSSH(ctx context.Context, addr string, pemKey []byte, forwards sshForwards, cmd *cobra.Command) error {
+	c, err := dialSSH(addr, pemKey, cmd)
+	if err != nil {
 		return err
 	}
 	go func() {
@@ -105,6 +216,27 @@ func runSSH(ctx context.Context, addr string, pemKey []byte, cmd *cobra.Command)
 		}
 	}()
 
+	for _, forward := range forwards.local {
+		go func() {
+			if err := c.LocalForward(ctx, forward); err != nil {
+				cmd.Printf("Error: local port forwarding %s -> %s: %v\n", forward.BindAddress, forward.TargetAddress, err)
+			}
+		}()
+	}
+	for _, forward := range forwards.remote {
+		go func() {
+			if err := c.RemoteForward(ctx, forward); err != nil {
+				cmd.Printf("Error: remote port forwarding %s -> %s: %v\n", forward.BindAddress, forward.TargetAddress, err)
+			}
+		}()
+	}
+
+	if noShell {
+		// the connection is only used for port forwarding
+		_ = c.Wait()
+		return nil
+	}
+
 	err = c.OpenTerminal()
 	if err != nil {
 		return err
@@ -113,6 +245,43 @@ func runSSH(ctx context.Context, addr string, pemKey []byte, cmd *cobra.Command)
 	return nil
 }
 
+func parseUserHost(arg string) (string, string) {
+	split := strings.Split(arg, "@")
+	if len(split) == 2 {
+		return split[0], split[1]
+	}
+	return user, arg
+}
+
+// copyPath is a local path or a remote path of the cp command
+type copyPath struct {
+	host string
+	path string
+}
+
+// parseCopyPath parses a path of the cp command, remote paths have the [user@]host:path format
+func parseCopyPath(arg string) (copyPath, bool) {
+	i := strings.Index(arg, ":")
+	if i <= 0 || strings.ContainsAny(arg[:i], `/\`) {
+		return copyPath{path: arg}, false
+	}
+
+	// C:\path is a local path on windows
+	if runtime.GOOS == "windows" && i == 1 {
+		return copyPath{path: arg}, false
+	}
+
+	return copyPath{host: arg[:i], path: arg[i+1:]}, true
+}
+
 func init() {
 	sshCmd.PersistentFlags().IntVarP(&port, "port", "p", nbssh.DefaultSSHPort, "Sets remote SSH port. Defaults to "+fmt.Sprint(nbssh.DefaultSSHPort))
+	sshCmd.Flags().StringArrayVarP(&localForwards, "local-forward", "L", nil,
+		"Forwards connections to the local [bind_address:]port to host:hostport through the remote peer. Format: [bind_address:]port:host:hostport")
+	sshCmd.Flags().StringArrayVarP(&remoteForwards, "remote-forward", "R", nil,
+		"Forwards connections to the [bind_address:]port of the remote peer to host:hostport from this machine. Format: [bind_address:]port:host:hostport")
+	sshCmd.Flags().BoolVarP(&noShell, "no-shell", "N", false, "Doesn't open a terminal, useful to only forward ports")
+	sshCopyCmd.Flags().BoolVarP(&copyRecursive, "recursive", "r", false, "Copies directories recursively")
+
+	sshCmd.AddCommand(sshCopyCmd, sshSFTPServerCmd)
 }
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/proto"
+	nbssh "github.com/netbirdio/netbird/client/ssh"
+)
+
+var sshRecordingOutput string
+
+var sshRecordingsCmd = &cobra.Command{
+	Use:   "recordings",
+	Short: "Manage the terminal sessions recorded by the SSH server",
+	Long: `Provides commands for inspecting the terminal sessions recorded by the NetBird SSH server of this peer.
+Sessions are recorded in the asciinema v2 format when session recording is enabled for the peer in the management service.`,
+}
+
+var sshRecordingsListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the recorded terminal sessions",
+	Example: "  netbird ssh recordings list",
+	Args:    cobra.NoArgs,
+	RunE:    sshRecordingsList,
+}
+
+var sshRecordingsGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get the asciinema v2 file of a recorded terminal session",
+	Long: `Gets the asciinema v2 file of a recorded terminal session from the recording directory of the daemon.
+The recordings are only readable by root.`,
+	Example: `  netbird ssh recordings get 20240101T120000Z-1a2b3c4d -o session.cast
+  netbird ssh recordings get 20240101T120000Z-1a2b3c4d | asciinema play -`,
+	Args: cobra.ExactArgs(1),
+	RunE: sshRecordingsGet,
+}
+
+func init() {
+	sshRecordingsGetCmd.Flags().StringVarP(&sshRecordingOutput, "output", "o", "", "Writes the recording to the file instead of stdout")
+
+	sshRecordingsCmd.AddCommand(sshRecordingsListCmd, sshRecordingsGetCmd)
+	sshCmd.AddCommand(sshRecordingsCmd)
+}
+
+func sshRecordingsList(cmd *cobra.Command, _ []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Errorf(errCloseConnection, err)
+		}
+	}()
+
+	client := proto.NewDaemonServiceClient(conn)
+	resp, err := client.ListSSHRecordings(cmd.Context(), &proto.ListSSHRecordingsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list SSH recordings: %v", status.Convert(err).Message())
+	}
+
+	if len(resp.GetRecordings()) == 0 {
+		cmd.Println("No recorded SSH sessions")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tLOCAL USER\tREMOTE ADDRESS\tSTARTED\tDURATION\tSIZE")
+	for _, recording := range resp.GetRecordings() {
+		duration := "running"
+		if recording.GetEndedAt() != nil {
+			duration = recording.GetEndedAt().AsTime().Sub(recording.GetStartedAt().AsTime()).Round(time.Second).String()
+		}
+		size := fmt.Sprintf("%d", recording.GetSize())
+		if recording.GetTruncated() {
+			size += " (truncated)"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			recording.GetId(),
+			recording.GetLocalUser(),
+			recording.GetRemoteAddr(),
+			recording.GetStartedAt().AsTime().Local().Format(time.DateTime),
+			duration,
+			size,
+		)
+	}
+	return w.Flush()
+}
+
+func sshRecordingsGet(cmd *cobra.Command, args []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Errorf(errCloseConnection, err)
+		}
+	}()
+
+	client := proto.NewDaemonServiceClient(conn)
+	resp, err := client.ListSSHRecordings(cmd.Context(), &proto.ListSSHRecordingsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get SSH recording: %v", status.Convert(err).Message())
+	}
+
+	recording, err := nbssh.OpenRecording(resp.GetDir(), args[0])
+	if errors.Is(err, os.ErrPermission) {
+		return fmt.Errorf("failed to open SSH recording: the recordings are only readable by root")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open SSH recording: %w", err)
+	}
+	defer func() {
+		if err := recording.Close(); err != nil {
+			log.Errorf("failed to close SSH recording: %v", err)
+		}
+	}()
+
+	out := cmd.OutOrStdout()
+	if sshRecordingOutput != "" {
+		file, err := os.OpenFile(sshRecordingOutput, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				log.Errorf("failed to close output file: %v", err)
+			}
+		}()
+		out = file
+	}
+
+	if _, err := io.Copy(out, recording); err != nil {
+		return fmt.Errorf("failed to write SSH recording: %w", err)
+	}
+
+	if sshRecordingOutput != "" {
+		cmd.PrintErrf("SSH recording %s written to %s\n", args[0], sshRecordingOutput)
+	}
+	return nil
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"os"
+	"os/signal"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/status"
@@ -24,10 +27,15 @@ var (
 	ipsFilter            []string
 	prefixNamesFilter    []string
 	statusFilter         string
+	watchFlag            bool
+	historyFlag          string
 	ipsFilterMap         map[string]struct{}
 	prefixNamesFilterMap map[string]struct{}
 )
 
+// statusWatchInterval is the refresh interval of the status output in watch mode
+const statusWatchInterval = 2 * time.Second
+
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "status of the Netbird Service",
@@ -41,7 +49,12 @@ func init() {
 	statusCmd.PersistentFlags().BoolVar(&jsonFlag, "json", false, "display detailed status information in json format")
 	statusCmd.PersistentFlags().BoolVar(&yamlFlag, "yaml", false, "display detailed status information in yaml format")
 	statusCmd.PersistentFlags().BoolVar(&ipv4Flag, "ipv4", false, "display only NetBird IPv4 of this peer, e.g., --ipv4 will output 100.64.0.33")
+	statusCmd.PersistentFlags().BoolVarP(&watchFlag, "watch", "w", false, "refresh the human-readable status output every 2 seconds until interrupted")
+	statusCmd.PersistentFlags().StringVar(&historyFlag, "history", "", "display the recorded connection history of a peer by public key, IP, FQDN or hostname, e.g., --history peer-a")
 	statusCmd.MarkFlagsMutuallyExclusive("detail", "json", "yaml", "ipv4")
+	statusCmd.MarkFlagsMutuallyExclusive("watch", "json", "yaml", "ipv4")
+	statusCmd.MarkFlagsMutuallyExclusive("watch", "history")
+	statusCmd.MarkFlagsMutuallyExclusive("history", "detail", "ipv4")
 	statusCmd.PersistentFlags().StringSliceVar(&ipsFilter, "filter-by-ips", []string{}, "filters the detailed output by a list of one or more IPs, e.g., --filter-by-ips 100.64.0.100,100.64.0.200")
 	statusCmd.PersistentFlags().StringSliceVar(&prefixNamesFilter, "filter-by-names", []string{}, "filters the detailed output by a list of one or more peer FQDN or hostnames, e.g., --filter-by-names peer-a,peer-b.netbird.cloud")
 	statusCmd.PersistentFlags().StringVar(&statusFilter, "filter-by-status", "", "filters the detailed output by connection status(connected|disconnected), e.g., --filter-by-status connected")
@@ -64,12 +77,20 @@ func statusFunc(cmd *cobra.Command, args []string) error {
 
 	ctx := internal.CtxInitState(cmd.Context())
 
+	if historyFlag != "" {
+		return peerHistoryFunc(ctx, cmd)
+	}
+
+	if watchFlag {
+		return watchStatus(ctx, cmd)
+	}
+
 	resp, err := getStatus(ctx)
 	if err != nil {
 		return err
 	}
 
-	if resp.GetStatus() == string(internal.StatusNeedsLogin) || resp.GetStatus() == string(internal.StatusLoginFailed) {
+	if needsLogin(resp) {
 		cmd.Printf("Daemon status: %s\n\n"+
 			"Run UP command to log in with SSO (interactive login):\n\n"+
 			" netbird up \n\n"+
@@ -86,25 +107,100 @@ func statusFunc(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	statusOutputString, err := parseStatusOutput(resp)
+	if err != nil {
+		return err
+	}
+
+	cmd.Print(statusOutputString)
+
+	return nil
+}
+
+func needsLogin(resp *proto.StatusResponse) bool {
+	return resp.GetStatus() == string(internal.StatusNeedsLogin) || resp.GetStatus() == string(internal.StatusLoginFailed)
+}
+
+func parseStatusOutput(resp *proto.StatusResponse) (string, error) {
 	var outputInformationHolder = nbstatus.ConvertToStatusOutputOverview(resp, anonymizeFlag, statusFilter, prefixNamesFilter, prefixNamesFilterMap, ipsFilterMap)
-	var statusOutputString string
 	switch {
 	case detailFlag:
-		statusOutputString = nbstatus.ParseToFullDetailSummary(outputInformationHolder)
+		return nbstatus.ParseToFullDetailSummary(outputInformationHolder), nil
 	case jsonFlag:
-		statusOutputString, err = nbstatus.ParseToJSON(outputInformationHolder)
+		return nbstatus.ParseToJSON(outputInformationHolder)
 	case yamlFlag:
-		statusOutputString, err = nbstatus.ParseToYAML(outputInformationHolder)
+		return nbstatus.ParseToYAML(outputInformationHolder)
 	default:
-		statusOutputString = nbstatus.ParseGeneralSummary(outputInformationHolder, false, false, false)
+		return nbstatus.ParseGeneralSummary(outputInformationHolder, false, false, false), nil
+	}
+}
+
+// watchStatus redraws the status output on every interval until the command is interrupted
+func watchStatus(ctx context.Context, cmd *cobra.Command) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	ticker := time.NewTicker(statusWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := getStatus(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		var statusOutputString string
+		if needsLogin(resp) {
+			statusOutputString = fmt.Sprintf("Daemon status: %s\n\nRun UP command to log in: netbird up\n", resp.GetStatus())
+		} else if statusOutputString, err = parseStatusOutput(resp); err != nil {
+			return err
+		}
+
+		// move the cursor home and clear the screen before redrawing
+		cmd.Print("\033[H\033[2J")
+		cmd.Print(statusOutputString)
+		cmd.Printf("\nRefreshing every %s, press Ctrl+C to exit\n", statusWatchInterval)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
 	}
+}
 
+func peerHistoryFunc(ctx context.Context, cmd *cobra.Command) error {
+	conn, err := DialClientGRPCServer(ctx, daemonAddr)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to connect to daemon error: %v\n"+
+			"If the daemon is not running please run: "+
+			"\nnetbird service install \nnetbird service start\n", err)
 	}
+	defer conn.Close()
 
-	cmd.Print(statusOutputString)
+	resp, err := proto.NewDaemonServiceClient(conn).GetPeerHistory(ctx, &proto.GetPeerHistoryRequest{Peer: historyFlag})
+	if err != nil {
+		return fmt.Errorf("get peer history failed: %v", status.Convert(err).Message())
+	}
+
+	histories := nbstatus.ConvertToPeerHistoriesOutput(resp, anonymizeFlag)
+	var historyOutputString string
+	switch {
+	case jsonFlag:
+		historyOutputString, err = nbstatus.ParsePeerHistoriesToJSON(histories)
+	case yamlFlag:
+		historyOutputString, err = nbstatus.ParsePeerHistoriesToYAML(histories)
+	default:
+		historyOutputString = nbstatus.ParsePeerHistories(histories)
+	}
+	if err != nil {
+		return err
+	}
 
+	cmd.Print(historyOutputString)
 	return nil
 }
 
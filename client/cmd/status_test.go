@@ -1,9 +1,18 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/netbirdio/netbird/client/proto"
+	nbstatus "github.com/netbirdio/netbird/client/status"
 )
 
 func TestParsingOfIP(t *testing.T) {
@@ -13,3 +22,58 @@ func TestParsingOfIP(t *testing.T) {
 
 	assert.Equal(t, "192.168.178.123\n", parsedIP)
 }
+
+type peerHistoryDaemon struct {
+	proto.UnimplementedDaemonServiceServer
+	requested string
+}
+
+func (d *peerHistoryDaemon) GetPeerHistory(_ context.Context, req *proto.GetPeerHistoryRequest) (*proto.GetPeerHistoryResponse, error) {
+	d.requested = req.GetPeer()
+	return &proto.GetPeerHistoryResponse{
+		Peers: []*proto.PeerHistory{{PubKey: "key-a", Fqdn: "peer-a.netbird.cloud", Ip: "100.64.0.10"}},
+	}, nil
+}
+
+func TestStatusHistoryJSON(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	daemon := &peerHistoryDaemon{}
+	s := grpc.NewServer()
+	proto.RegisterDaemonServiceServer(s, daemon)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	t.Cleanup(func() {
+		historyFlag = ""
+		jsonFlag = false
+		for _, name := range []string{"history", "json"} {
+			statusCmd.PersistentFlags().Lookup(name).Changed = false
+		}
+		rootCmd.SetOut(nil)
+	})
+
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	// the help flag stays set after the command tests, keep it for the tests that follow
+	if help := statusCmd.Flags().Lookup("help"); help != nil {
+		previous := help.Value.String()
+		require.NoError(t, help.Value.Set("false"))
+		t.Cleanup(func() {
+			_ = help.Value.Set(previous)
+		})
+	}
+
+	rootCmd.SetArgs([]string{"status", "--history", "peer-a", "--json", "--daemon-addr", "tcp://" + lis.Addr().String()})
+	require.NoError(t, rootCmd.Execute())
+
+	assert.Equal(t, "peer-a", daemon.requested)
+
+	var histories nbstatus.PeerHistoriesOutput
+	require.NoError(t, json.Unmarshal(out.Bytes(), &histories), "the output should be json: %s", out.String())
+	require.Len(t, histories.Peers, 1)
+	assert.Equal(t, "peer-a.netbird.cloud", histories.Peers[0].FQDN)
+	assert.Equal(t, "100.64.0.10", histories.Peers[0].IP)
+}
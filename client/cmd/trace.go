@@ -18,7 +18,8 @@ var traceCmd = &cobra.Command{
   netbird debug trace in 192.168.1.10 10.10.0.2 -p tcp --sport 12345 --dport 443 --syn --ack
   netbird debug trace out 10.10.0.1 8.8.8.8 -p udp  --dport 53
   netbird debug trace in 10.10.0.2 10.10.0.1 -p icmp --type 8 --code 0
-  netbird debug trace in 100.64.1.1 self -p tcp --dport 80`,
+  netbird debug trace in 100.64.1.1 self -p tcp --dport 80
+  netbird debug trace in fd00::2 2001:db8::10 -p icmp --icmp-type 128`,
 	Args: cobra.ExactArgs(3),
 	RunE: tracePacket,
 }
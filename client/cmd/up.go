@@ -57,6 +57,8 @@ func init() {
 	upCmd.PersistentFlags().StringSliceVar(&extraIFaceBlackList, extraIFaceBlackListFlag, nil, "Extra list of default interfaces to ignore for listening")
 	upCmd.PersistentFlags().DurationVar(&dnsRouteInterval, dnsRouteIntervalFlag, time.Minute, "DNS route update interval")
 	upCmd.PersistentFlags().BoolVar(&blockLANAccess, blockLANAccessFlag, false, "Block access to local networks (LAN) when using this peer as a router or exit node")
+	upCmd.PersistentFlags().BoolVar(&exitNodeKillSwitch, exitNodeKillSwitchFlag, false, "Block the internet traffic bypassing NetBird while an exit node is selected. Requires advanced routing on Linux")
+	upCmd.PersistentFlags().BoolVar(&exitNodeAllowLAN, exitNodeAllowLANFlag, false, "Allow access to local networks (LAN) while the exit node kill-switch is active")
 
 	upCmd.PersistentFlags().StringSliceVar(&dnsLabels, dnsLabelsFlag, nil,
 		`Sets DNS labels`+
@@ -187,6 +189,14 @@ func runInForegroundMode(ctx context.Context, cmd *cobra.Command) error {
 		ic.BlockLANAccess = &blockLANAccess
 	}
 
+	if cmd.Flag(exitNodeKillSwitchFlag).Changed {
+		ic.ExitNodeKillSwitch = &exitNodeKillSwitch
+	}
+
+	if cmd.Flag(exitNodeAllowLANFlag).Changed {
+		ic.ExitNodeAllowLAN = &exitNodeAllowLAN
+	}
+
 	providedSetupKey, err := getSetupKey()
 	if err != nil {
 		return err
@@ -323,6 +333,14 @@ func runInDaemonMode(ctx context.Context, cmd *cobra.Command) error {
 		loginRequest.BlockLanAccess = &blockLANAccess
 	}
 
+	if cmd.Flag(exitNodeKillSwitchFlag).Changed {
+		loginRequest.ExitNodeKillSwitch = &exitNodeKillSwitch
+	}
+
+	if cmd.Flag(exitNodeAllowLANFlag).Changed {
+		loginRequest.ExitNodeAllowLan = &exitNodeAllowLAN
+	}
+
 	var loginErr error
 
 	var loginResp *proto.LoginResponse
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"slices"
+	"strings"
 
 	"github.com/coreos/go-iptables/iptables"
 	"github.com/google/uuid"
@@ -20,6 +21,9 @@ const (
 
 	// rules chains contains the effective ACL rules
 	chainNameInputRules = "NETBIRD-ACL-INPUT"
+
+	// ipsetSuffixIPv6 keeps the names of the IPv6 sets apart from the IPv4 ones, ipsets share one namespace
+	ipsetSuffixIPv6 = "-v6"
 )
 
 type aclEntries map[string][][]string
@@ -33,6 +37,7 @@ type aclManager struct {
 	iptablesClient     *iptables.IPTables
 	wgIface            iFaceMapper
 	routingFwChainName string
+	ipv6               bool
 
 	entries         aclEntries
 	optionalEntries map[string][]entry
@@ -46,6 +51,7 @@ func newAclManager(iptablesClient *iptables.IPTables, wgIface iFaceMapper, routi
 		iptablesClient:     iptablesClient,
 		wgIface:            wgIface,
 		routingFwChainName: routingFwChainName,
+		ipv6:               iptablesClient.Proto() == iptables.ProtocolIPv6,
 
 		entries:         make(map[string][][]string),
 		optionalEntries: make(map[string][]entry),
@@ -89,7 +95,10 @@ func (m *aclManager) AddPeerFiltering(
 	chain := chainNameInputRules
 
 	ipsetName = transformIPsetName(ipsetName, sPort, dPort)
-	specs := filterRuleSpecs(ip, string(protocol), sPort, dPort, action, ipsetName)
+	if ipsetName != "" && m.ipv6 {
+		ipsetName += ipsetSuffixIPv6
+	}
+	specs := filterRuleSpecs(ip, protocolToStr(protocol, m.ipv6), sPort, dPort, action, ipsetName)
 
 	mangleSpecs := slices.Clone(specs)
 	mangleSpecs = append(mangleSpecs,
@@ -119,7 +128,7 @@ func (m *aclManager) AddPeerFiltering(
 		if err := ipset.Flush(ipsetName); err != nil {
 			log.Errorf("flush ipset %s before use it: %s", ipsetName, err)
 		}
-		if err := ipset.Create(ipsetName); err != nil {
+		if err := ipset.Create(ipsetName, m.ipsetOptions()...); err != nil {
 			return nil, fmt.Errorf("failed to create ipset: %w", err)
 		}
 		if err := ipset.Add(ipsetName, ip.String()); err != nil {
@@ -350,8 +359,13 @@ func (m *aclManager) updateState() {
 	currentState.Lock()
 	defer currentState.Unlock()
 
-	currentState.ACLEntries = m.entries
-	currentState.ACLIPsetStore = m.ipsetStore
+	if m.ipv6 {
+		currentState.ACLEntries6 = m.entries
+		currentState.ACLIPsetStore6 = m.ipsetStore
+	} else {
+		currentState.ACLEntries = m.entries
+		currentState.ACLIPsetStore = m.ipsetStore
+	}
 
 	if err := m.stateManager.UpdateState(currentState); err != nil {
 		log.Errorf("failed to update state: %v", err)
@@ -361,8 +375,8 @@ func (m *aclManager) updateState() {
 // filterRuleSpecs returns the specs of a filtering rule
 func filterRuleSpecs(ip net.IP, protocol string, sPort, dPort *firewall.Port, action firewall.Action, ipsetName string) (specs []string) {
 	matchByIP := true
-	// don't use IP matching if IP is ip 0.0.0.0
-	if ip.String() == "0.0.0.0" {
+	// don't use IP matching if IP is ip 0.0.0.0 or ::
+	if ip.IsUnspecified() {
 		matchByIP = false
 	}
 
@@ -381,6 +395,22 @@ func filterRuleSpecs(ip net.IP, protocol string, sPort, dPort *firewall.Port, ac
 	return specs
 }
 
+// ipsetOptions returns the options to create the sets of the manager's address family
+func (m *aclManager) ipsetOptions() []ipset.Option {
+	if m.ipv6 {
+		return []ipset.Option{ipset.OptIPv6()}
+	}
+	return nil
+}
+
+// protocolToStr returns the protocol name for iptables, ip6tables names ICMP ipv6-icmp
+func protocolToStr(protocol firewall.Protocol, ipv6 bool) string {
+	if ipv6 && protocol == firewall.ProtocolICMP {
+		return "ipv6-icmp"
+	}
+	return strings.ToLower(string(protocol))
+}
+
 func actionToStr(action firewall.Action) string {
 	if action == firewall.ActionAccept {
 		return "ACCEPT"
@@ -0,0 +1,139 @@
+package iptables
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/coreos/go-iptables/iptables"
+	"github.com/hashicorp/go-multierror"
+	log "github.com/sirupsen/logrus"
+
+	nberrors "github.com/netbirdio/netbird/client/errors"
+	firewall "github.com/netbirdio/netbird/client/firewall/manager"
+	nbnet "github.com/netbirdio/netbird/util/net"
+)
+
+const (
+	chainKillSwitch  = "NETBIRD-KILLSWITCH"
+	chainOutput      = "OUTPUT"
+	dhcpV4ServerPort = "67"
+	dhcpV6ServerPort = "547"
+)
+
+// killSwitch blocks the egress traffic of the host that bypasses the NetBird interface
+type killSwitch struct {
+	wgIface iFaceMapper
+	clients map[iptables.Protocol]*iptables.IPTables
+}
+
+func newKillSwitch(ipv4Client *iptables.IPTables, wgIface iFaceMapper) *killSwitch {
+	clients := map[iptables.Protocol]*iptables.IPTables{
+		iptables.ProtocolIPv4: ipv4Client,
+	}
+
+	// the IPv6 egress must be blocked as well, otherwise the traffic leaks over IPv6
+	ipv6Client, err := iptables.NewWithProtocol(iptables.ProtocolIPv6)
+	if err != nil {
+		log.Warnf("ip6tables is not available, the kill-switch won't block IPv6 traffic: %v", err)
+	} else {
+		clients[iptables.ProtocolIPv6] = ipv6Client
+	}
+
+	return &killSwitch{
+		wgIface: wgIface,
+		clients: clients,
+	}
+}
+
+func (k *killSwitch) enable(allowedNetworks []netip.Prefix) error {
+	// NetBird's own connections are recognized by the fwmark, which is only set with advanced routing
+	if !nbnet.AdvancedRouting() {
+		return firewall.ErrKillSwitchNotSupported
+	}
+
+	var merr *multierror.Error
+	for proto, client := range k.clients {
+		if err := k.enableForProtocol(client, proto, allowedNetworks); err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("enable kill-switch for %s: %w", protoName(proto), err))
+		}
+	}
+
+	if merr != nil {
+		if err := k.disable(); err != nil {
+			log.Errorf("failed to roll back kill-switch: %v", err)
+		}
+	}
+
+	return nberrors.FormatErrorOrNil(merr)
+}
+
+func (k *killSwitch) enableForProtocol(client *iptables.IPTables, proto iptables.Protocol, allowedNetworks []netip.Prefix) error {
+	// ClearChain creates the chain if it doesn't exist
+	if err := client.ClearChain(tableFilter, chainKillSwitch); err != nil {
+		return fmt.Errorf("create chain: %w", err)
+	}
+
+	dhcpPort := dhcpV4ServerPort
+	if proto == iptables.ProtocolIPv6 {
+		dhcpPort = dhcpV6ServerPort
+	}
+
+	rules := [][]string{
+		{"-o", "lo", "-j", "ACCEPT"},
+		{"-o", k.wgIface.Name(), "-j", "ACCEPT"},
+		{"-m", "mark", "--mark", fmt.Sprintf("%#x", nbnet.NetbirdFwmark), "-j", "ACCEPT"},
+		{"-p", "udp", "--dport", dhcpPort, "-j", "ACCEPT"},
+	}
+	for _, network := range allowedNetworks {
+		if network.Addr().Is4() != (proto == iptables.ProtocolIPv4) {
+			continue
+		}
+		rules = append(rules, []string{"-d", network.String(), "-j", "ACCEPT"})
+	}
+	rules = append(rules, []string{"-j", "DROP"})
+
+	for _, rule := range rules {
+		if err := client.Append(tableFilter, chainKillSwitch, rule...); err != nil {
+			return fmt.Errorf("add rule %v: %w", rule, err)
+		}
+	}
+
+	if err := client.InsertUnique(tableFilter, chainOutput, 1, "-j", chainKillSwitch); err != nil {
+		return fmt.Errorf("add jump rule: %w", err)
+	}
+
+	return nil
+}
+
+func (k *killSwitch) disable() error {
+	var merr *multierror.Error
+	for proto, client := range k.clients {
+		// the jump rule can't be checked if the chain doesn't exist
+		exists, err := client.ChainExists(tableFilter, chainKillSwitch)
+		if err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("check chain for %s: %w", protoName(proto), err))
+			continue
+		}
+		if !exists {
+			continue
+		}
+
+		if err := client.DeleteIfExists(tableFilter, chainOutput, "-j", chainKillSwitch); err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("remove jump rule for %s: %w", protoName(proto), err))
+			continue
+		}
+
+		if err := client.ClearAndDeleteChain(tableFilter, chainKillSwitch); err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("remove chain for %s: %w", protoName(proto), err))
+		}
+	}
+
+	return nberrors.FormatErrorOrNil(merr)
+}
+
+func protoName(proto iptables.Protocol) string {
+	if proto == iptables.ProtocolIPv6 {
+		return "IPv6"
+	}
+	return "IPv4"
+}
@@ -26,6 +26,12 @@ type Manager struct {
 	ipv4Client *iptables.IPTables
 	aclMgr     *aclManager
 	router     *router
+	killSwitch *killSwitch
+
+	// ipv6Client, aclMgr6 and router6 filter the IPv6 traffic, they are nil if ip6tables isn't usable
+	ipv6Client *iptables.IPTables
+	aclMgr6    *aclManager
+	router6    *router
 }
 
 // iFaceMapper defines subset methods of interface required for manager
@@ -57,9 +63,39 @@ func Create(wgIface iFaceMapper) (*Manager, error) {
 		return nil, fmt.Errorf("create acl manager: %w", err)
 	}
 
+	m.killSwitch = newKillSwitch(iptablesClient, wgIface)
+
+	if err := m.createIPv6(); err != nil {
+		log.Warnf("IPv6 traffic can't be filtered: %v", err)
+	}
+
 	return m, nil
 }
 
+// createIPv6 creates the managers of the ip6tables rules
+func (m *Manager) createIPv6() error {
+	client, err := iptables.NewWithProtocol(iptables.ProtocolIPv6)
+	if err != nil {
+		return fmt.Errorf("init ip6tables: %w", err)
+	}
+
+	router, err := newRouter(client, m.wgIface)
+	if err != nil {
+		return fmt.Errorf("create router: %w", err)
+	}
+
+	aclMgr, err := newAclManager(client, m.wgIface, chainRTFWD)
+	if err != nil {
+		return fmt.Errorf("create acl manager: %w", err)
+	}
+
+	m.ipv6Client = client
+	m.router6 = router
+	m.aclMgr6 = aclMgr
+
+	return nil
+}
+
 func (m *Manager) Init(stateManager *statemanager.Manager) error {
 	state := &ShutdownState{
 		InterfaceState: &InterfaceState{
@@ -82,6 +118,13 @@ func (m *Manager) Init(stateManager *statemanager.Manager) error {
 		return fmt.Errorf("acl manager init: %w", err)
 	}
 
+	if err := m.initIPv6(stateManager); err != nil {
+		log.Warnf("IPv6 traffic can't be filtered, the IPv6 overlay stays disabled: %v", err)
+		m.ipv6Client = nil
+		m.router6 = nil
+		m.aclMgr6 = nil
+	}
+
 	// persist early to ensure cleanup of chains
 	go func() {
 		if err := stateManager.PersistState(context.Background()); err != nil {
@@ -92,6 +135,23 @@ func (m *Manager) Init(stateManager *statemanager.Manager) error {
 	return nil
 }
 
+// initIPv6 creates the ip6tables chains with the same rules as the iptables ones
+func (m *Manager) initIPv6(stateManager *statemanager.Manager) error {
+	if m.ipv6Client == nil {
+		return fmt.Errorf("ip6tables not available")
+	}
+
+	if err := m.router6.init(stateManager); err != nil {
+		return fmt.Errorf("router init: %w", err)
+	}
+
+	if err := m.aclMgr6.init(stateManager); err != nil {
+		return fmt.Errorf("acl manager init: %w", err)
+	}
+
+	return nil
+}
+
 // AddPeerFiltering adds a rule to the firewall
 //
 // Comment will be ignored because some system this feature is not supported
@@ -107,6 +167,13 @@ func (m *Manager) AddPeerFiltering(
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if ip.To4() == nil {
+		if m.aclMgr6 == nil {
+			return nil, fmt.Errorf("unsupported IP version: %s", ip.String())
+		}
+		return m.aclMgr6.AddPeerFiltering(ip, protocol, sPort, dPort, action, ipsetName)
+	}
+
 	return m.aclMgr.AddPeerFiltering(ip, protocol, sPort, dPort, action, ipsetName)
 }
 
@@ -121,11 +188,18 @@ func (m *Manager) AddRouteFiltering(
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	if !destination.Addr().Is4() {
-		return nil, fmt.Errorf("unsupported IP version: %s", destination.Addr().String())
+	r, err := m.routerFor(destination.Addr())
+	if err != nil {
+		return nil, err
 	}
 
-	return m.router.AddRouteFiltering(sources, destination, proto, sPort, dPort, action)
+	// the sources of the other address family can't match the traffic to the destination
+	sources = firewall.FilterPrefixesByFamily(sources, destination.Addr().Is6())
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no sources of the address family of %s", destination)
+	}
+
+	return r.AddRouteFiltering(sources, destination, proto, sPort, dPort, action)
 }
 
 // DeletePeerRule from the firewall by rule definition
@@ -133,6 +207,12 @@ func (m *Manager) DeletePeerRule(rule firewall.Rule) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if r, ok := rule.(*Rule); ok && m.aclMgr6 != nil {
+		if ip := net.ParseIP(r.ip); ip != nil && ip.To4() == nil {
+			return m.aclMgr6.DeletePeerRule(rule)
+		}
+	}
+
 	return m.aclMgr.DeletePeerRule(rule)
 }
 
@@ -140,6 +220,10 @@ func (m *Manager) DeleteRouteRule(rule firewall.Rule) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if m.router6 != nil && m.router6.hasRule(rule.GetRuleID()) {
+		return m.router6.DeleteRouteRule(rule)
+	}
+
 	return m.router.DeleteRouteRule(rule)
 }
 
@@ -147,21 +231,55 @@ func (m *Manager) IsServerRouteSupported() bool {
 	return true
 }
 
+// IsIPv6Supported returns true if the rules for the IPv6 traffic are installed with ip6tables
+func (m *Manager) IsIPv6Supported() bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.aclMgr6 != nil
+}
+
 func (m *Manager) AddNatRule(pair firewall.RouterPair) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	return m.router.AddNatRule(pair)
+	r, err := m.routerFor(pair.Destination.Addr())
+	if err != nil {
+		return err
+	}
+
+	return r.AddNatRule(pair)
 }
 
 func (m *Manager) RemoveNatRule(pair firewall.RouterPair) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	return m.router.RemoveNatRule(pair)
+	r, err := m.routerFor(pair.Destination.Addr())
+	if err != nil {
+		return err
+	}
+
+	return r.RemoveNatRule(pair)
+}
+
+// routerFor returns the router of the address family of addr
+func (m *Manager) routerFor(addr netip.Addr) (*router, error) {
+	if !addr.Is6() {
+		return m.router, nil
+	}
+	if m.router6 == nil {
+		return nil, fmt.Errorf("unsupported IP version: %s", addr)
+	}
+	return m.router6, nil
 }
 
 func (m *Manager) SetLegacyManagement(isLegacy bool) error {
+	if m.router6 != nil {
+		if err := firewall.SetLegacyManagement(m.router6, isLegacy); err != nil {
+			return err
+		}
+	}
 	return firewall.SetLegacyManagement(m.router, isLegacy)
 }
 
@@ -178,6 +296,19 @@ func (m *Manager) Close(stateManager *statemanager.Manager) error {
 	if err := m.router.Reset(); err != nil {
 		merr = multierror.Append(merr, fmt.Errorf("reset router: %w", err))
 	}
+	if m.aclMgr6 != nil {
+		if err := m.aclMgr6.Reset(); err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("reset IPv6 acl manager: %w", err))
+		}
+	}
+	if m.router6 != nil {
+		if err := m.router6.Reset(); err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("reset IPv6 router: %w", err))
+		}
+	}
+	if err := m.killSwitch.disable(); err != nil {
+		merr = multierror.Append(merr, fmt.Errorf("disable kill-switch: %w", err))
+	}
 
 	// attempt to delete state only if all other operations succeeded
 	if merr == nil {
@@ -207,6 +338,14 @@ func (m *Manager) AllowNetbird() error {
 	if err != nil {
 		return fmt.Errorf("allow netbird interface traffic: %w", err)
 	}
+
+	if !m.IsIPv6Supported() {
+		return nil
+	}
+
+	if _, err := m.AddPeerFiltering(net.IPv6zero, "all", nil, nil, firewall.ActionAccept, "", ""); err != nil {
+		return fmt.Errorf("allow netbird interface IPv6 traffic: %w", err)
+	}
 	return nil
 }
 
@@ -226,6 +365,22 @@ func (m *Manager) DisableRouting() error {
 	return nil
 }
 
+// EnableKillSwitch drops all egress traffic that doesn't belong to NetBird or goes through the NetBird interface
+func (m *Manager) EnableKillSwitch(allowedNetworks []netip.Prefix) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.killSwitch.enable(allowedNetworks)
+}
+
+// DisableKillSwitch removes the egress block of the kill-switch
+func (m *Manager) DisableKillSwitch() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.killSwitch.disable()
+}
+
 func getConntrackEstablished() []string {
 	return []string{"-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"}
 }
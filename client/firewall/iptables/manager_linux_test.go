@@ -3,6 +3,8 @@ package iptables
 import (
 	"fmt"
 	"net"
+	"net/netip"
+	"os/exec"
 	"testing"
 	"time"
 
@@ -112,6 +114,45 @@ func TestIptablesManager(t *testing.T) {
 	})
 }
 
+func TestIptablesManagerIPv6(t *testing.T) {
+	if _, err := exec.LookPath("ip6tables"); err != nil {
+		t.Skip("ip6tables not available")
+	}
+
+	ipv6Client, err := iptables.NewWithProtocol(iptables.ProtocolIPv6)
+	require.NoError(t, err)
+
+	manager, err := Create(ifaceMock)
+	require.NoError(t, err)
+	require.NoError(t, manager.Init(nil))
+
+	defer func() {
+		require.NoError(t, manager.Close(nil), "clear the manager state")
+	}()
+
+	require.True(t, manager.IsIPv6Supported(), "ip6tables rules should be installed")
+
+	rules, err := manager.AddPeerFiltering(net.ParseIP("fd00:1234::3"), fw.ProtocolICMP, nil, nil, fw.ActionAccept, "nb0000001", "")
+	require.NoError(t, err, "failed to add IPv6 rule")
+
+	for _, r := range rules {
+		rr := r.(*Rule)
+		require.Equal(t, "nb0000001"+ipsetSuffixIPv6, rr.ipsetName, "IPv6 ipset name must not clash with the IPv4 one")
+		checkRuleSpecs(t, ipv6Client, rr.chain, true, rr.specs...)
+	}
+
+	sources := []netip.Prefix{netip.MustParsePrefix("10.20.0.3/32"), netip.MustParsePrefix("fd00:1234::3/128")}
+	routeRule, err := manager.AddRouteFiltering(sources, netip.MustParsePrefix("2001:db8::/64"), fw.ProtocolTCP, nil, nil, fw.ActionAccept)
+	require.NoError(t, err, "failed to add IPv6 route rule")
+	require.True(t, manager.router6.hasRule(routeRule.GetRuleID()), "route rule should be added by the IPv6 router")
+
+	require.NoError(t, manager.DeleteRouteRule(routeRule), "failed to delete route rule")
+	for _, r := range rules {
+		require.NoError(t, manager.DeletePeerRule(r), "failed to delete rule")
+	}
+	require.Empty(t, manager.aclMgr6.ipsetStore.ipsets, "IPv6 ipsets must be removed with the last rule")
+}
+
 func TestIptablesManagerIPSet(t *testing.T) {
 	mock := &iFaceMock{
 		NameFunc: func() string {
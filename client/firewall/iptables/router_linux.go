@@ -56,6 +56,7 @@ type ipsetCounter = refcounter.Counter[string, []netip.Prefix, struct{}]
 
 type router struct {
 	iptablesClient   *iptables.IPTables
+	ipv6             bool
 	rules            routeRules
 	ipsetCounter     *ipsetCounter
 	wgIface          iFaceMapper
@@ -67,6 +68,7 @@ type router struct {
 func newRouter(iptablesClient *iptables.IPTables, wgIface iFaceMapper) (*router, error) {
 	r := &router{
 		iptablesClient: iptablesClient,
+		ipv6:           iptablesClient.Proto() == iptables.ProtocolIPv6,
 		rules:          make(map[string][]string),
 		wgIface:        wgIface,
 	}
@@ -180,6 +182,12 @@ func (r *router) DeleteRouteRule(rule firewall.Rule) error {
 	return nil
 }
 
+// hasRule reports if the route rule was added by this router
+func (r *router) hasRule(ruleKey string) bool {
+	_, ok := r.rules[ruleKey]
+	return ok
+}
+
 func (r *router) findSetNameInRule(rule []string) string {
 	for i, arg := range rule {
 		if arg == "-m" && i+3 < len(rule) && rule[i+1] == "set" && rule[i+2] == matchSet {
@@ -190,7 +198,12 @@ func (r *router) findSetNameInRule(rule []string) string {
 }
 
 func (r *router) createIpSet(setName string, sources []netip.Prefix) error {
-	if err := ipset.Create(setName, ipset.OptTimeout(0)); err != nil {
+	opts := []ipset.Option{ipset.OptTimeout(0)}
+	if r.ipv6 {
+		opts = append(opts, ipset.OptIPv6())
+	}
+
+	if err := ipset.Create(setName, opts...); err != nil {
 		return fmt.Errorf("create set %s: %w", setName, err)
 	}
 
@@ -556,8 +569,13 @@ func (r *router) updateState() {
 	currentState.Lock()
 	defer currentState.Unlock()
 
-	currentState.RouteRules = r.rules
-	currentState.RouteIPsetCounter = r.ipsetCounter
+	if r.ipv6 {
+		currentState.RouteRules6 = r.rules
+		currentState.RouteIPsetCounter6 = r.ipsetCounter
+	} else {
+		currentState.RouteRules = r.rules
+		currentState.RouteIPsetCounter = r.ipsetCounter
+	}
 
 	if err := r.stateManager.UpdateState(currentState); err != nil {
 		log.Errorf("failed to update state: %v", err)
@@ -577,7 +595,7 @@ func genRouteFilteringRuleSpec(params routeFilteringRuleParams) []string {
 	rule = append(rule, "-d", params.Destination.String())
 
 	if params.Proto != firewall.ProtocolALL {
-		rule = append(rule, "-p", strings.ToLower(string(params.Proto)))
+		rule = append(rule, "-p", protocolToStr(params.Proto, params.Destination.Addr().Is6()))
 		rule = append(rule, applyPort("--sport", params.SPort)...)
 		rule = append(rule, applyPort("--dport", params.DPort)...)
 	}
@@ -4,6 +4,7 @@ package iptables
 
 import (
 	"fmt"
+	"net"
 	"net/netip"
 	"os/exec"
 	"testing"
@@ -374,3 +375,22 @@ func TestRouter_AddRouteFiltering(t *testing.T) {
 		})
 	}
 }
+
+func TestGenRouteFilteringRuleSpecIPv6(t *testing.T) {
+	rule := genRouteFilteringRuleSpec(routeFilteringRuleParams{
+		Sources:     []netip.Prefix{netip.MustParsePrefix("fd00:1234::1/128")},
+		Destination: netip.MustParsePrefix("2001:db8::/64"),
+		Proto:       firewall.ProtocolICMP,
+		Action:      firewall.ActionAccept,
+	})
+
+	assert.Equal(t, []string{"-s", "fd00:1234::1/128", "-d", "2001:db8::/64", "-p", "ipv6-icmp", "-j", "ACCEPT"}, rule)
+}
+
+func TestFilterRuleSpecsIPv6(t *testing.T) {
+	specs := filterRuleSpecs(net.IPv6zero, protocolToStr(firewall.ProtocolTCP, true), nil, &firewall.Port{Values: []uint16{22}}, firewall.ActionAccept, "")
+	assert.Equal(t, []string{"-p", "tcp", "--dport", "22"}, specs, "the unspecified address must match any source")
+
+	specs = filterRuleSpecs(net.ParseIP("fd00:1234::1"), protocolToStr(firewall.ProtocolICMP, true), nil, nil, firewall.ActionAccept, "")
+	assert.Equal(t, []string{"-s", "fd00:1234::1", "-p", "ipv6-icmp"}, specs)
+}
@@ -3,6 +3,7 @@ package manager
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net"
 	"net/netip"
@@ -14,6 +15,9 @@ import (
 	"github.com/netbirdio/netbird/client/internal/statemanager"
 )
 
+// ErrKillSwitchNotSupported is returned if the firewall can't block the egress traffic of the host
+var ErrKillSwitchNotSupported = errors.New("kill-switch is not supported on this system")
+
 const (
 	ForwardingFormatPrefix = "netbird-fwd-"
 	ForwardingFormat       = "netbird-fwd-%s-%t"
@@ -80,6 +84,9 @@ type Manager interface {
 	// IsServerRouteSupported returns true if the firewall supports server side routing operations
 	IsServerRouteSupported() bool
 
+	// IsIPv6Supported returns true if the firewall can filter IPv6 peer traffic
+	IsIPv6Supported() bool
+
 	AddRouteFiltering(source []netip.Prefix, destination netip.Prefix, proto Protocol, sPort *Port, dPort *Port, action Action) (Rule, error)
 
 	// DeleteRouteRule deletes a routing rule
@@ -105,6 +112,13 @@ type Manager interface {
 	EnableRouting() error
 
 	DisableRouting() error
+
+	// EnableKillSwitch drops all egress traffic except the traffic of NetBird itself,
+	// the traffic through the NetBird interface and the traffic to the allowed networks
+	EnableKillSwitch(allowedNetworks []netip.Prefix) error
+
+	// DisableKillSwitch removes the egress block of the kill-switch
+	DisableKillSwitch() error
 }
 
 func GenKey(format string, pair RouterPair) string {
@@ -193,3 +207,14 @@ func SortPrefixes(prefixes []netip.Prefix) {
 		return prefixes[i].Bits() > prefixes[j].Bits()
 	})
 }
+
+// FilterPrefixesByFamily returns the prefixes of the IPv6 family if ipv6 is set, of the IPv4 family otherwise
+func FilterPrefixesByFamily(prefixes []netip.Prefix, ipv6 bool) []netip.Prefix {
+	var filtered []netip.Prefix
+	for _, prefix := range prefixes {
+		if prefix.Addr().Is6() == ipv6 {
+			filtered = append(filtered, prefix)
+		}
+	}
+	return filtered
+}
@@ -190,3 +190,23 @@ func TestMergeIPRanges(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterPrefixesByFamily(t *testing.T) {
+	prefixes := []netip.Prefix{
+		netip.MustParsePrefix("100.64.0.1/32"),
+		netip.MustParsePrefix("fd00:1234::1/128"),
+		netip.MustParsePrefix("10.0.0.0/8"),
+	}
+
+	ipv4 := manager.FilterPrefixesByFamily(prefixes, false)
+	expected4 := []netip.Prefix{netip.MustParsePrefix("100.64.0.1/32"), netip.MustParsePrefix("10.0.0.0/8")}
+	if !reflect.DeepEqual(ipv4, expected4) {
+		t.Errorf("Expected IPv4 prefixes %v, got %v", expected4, ipv4)
+	}
+
+	ipv6 := manager.FilterPrefixesByFamily(prefixes, true)
+	expected6 := []netip.Prefix{netip.MustParsePrefix("fd00:1234::1/128")}
+	if !reflect.DeepEqual(ipv6, expected6) {
+		t.Errorf("Expected IPv6 prefixes %v, got %v", expected6, ipv6)
+	}
+}
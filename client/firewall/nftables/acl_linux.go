@@ -146,7 +146,7 @@ func (m *AclManager) DeletePeerRule(rule firewall.Rule) error {
 	}
 
 	if _, ok := ips[r.ip.String()]; ok {
-		err := m.sConn.SetDeleteElements(r.nftSet, []nftables.SetElement{{Key: r.ip.To4()}})
+		err := m.sConn.SetDeleteElements(r.nftSet, []nftables.SetElement{{Key: m.rawIP(r.ip)}})
 		if err != nil {
 			log.Errorf("delete elements for set %q: %v", r.nftSet.Name, err)
 		}
@@ -193,25 +193,26 @@ func (m *AclManager) DeletePeerRule(rule firewall.Rule) error {
 
 // createDefaultAllowRules creates default allow rules for the input and output chains
 func (m *AclManager) createDefaultAllowRules() error {
+	srcOffset, addrLen := m.srcAddrPayload()
 	expIn := []expr.Any{
 		&expr.Payload{
 			DestRegister: 1,
 			Base:         expr.PayloadBaseNetworkHeader,
-			Offset:       12,
-			Len:          4,
+			Offset:       srcOffset,
+			Len:          addrLen,
 		},
 		// mask
 		&expr.Bitwise{
 			SourceRegister: 1,
 			DestRegister:   1,
-			Len:            4,
-			Mask:           []byte{0, 0, 0, 0},
-			Xor:            []byte{0, 0, 0, 0},
+			Len:            addrLen,
+			Mask:           make([]byte, addrLen),
+			Xor:            make([]byte, addrLen),
 		},
 		// net address
 		&expr.Cmp{
 			Register: 1,
-			Data:     []byte{0, 0, 0, 0},
+			Data:     make([]byte, addrLen),
 		},
 		&expr.Verdict{
 			Kind: expr.VerdictAccept,
@@ -272,14 +273,19 @@ func (m *AclManager) addIOFiltering(
 	var expressions []expr.Any
 
 	if proto != firewall.ProtocolALL {
-		expressions = append(expressions, &expr.Payload{
-			DestRegister: 1,
-			Base:         expr.PayloadBaseNetworkHeader,
-			Offset:       uint32(9),
-			Len:          uint32(1),
-		})
+		if m.isIPv6() {
+			// the IPv6 next header can be an extension header, the meta key resolves the transport protocol
+			expressions = append(expressions, &expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1})
+		} else {
+			expressions = append(expressions, &expr.Payload{
+				DestRegister: 1,
+				Base:         expr.PayloadBaseNetworkHeader,
+				Offset:       uint32(9),
+				Len:          uint32(1),
+			})
+		}
 
-		protoData, err := protoToInt(proto)
+		protoData, err := protoToIntFamily(proto, m.isIPv6())
 		if err != nil {
 			return nil, fmt.Errorf("convert protocol to number: %v", err)
 		}
@@ -291,19 +297,18 @@ func (m *AclManager) addIOFiltering(
 		})
 	}
 
-	rawIP := ip.To4()
-	// check if rawIP contains zeroed IPv4 0.0.0.0 value
+	rawIP := m.rawIP(ip)
+	// check if rawIP contains zeroed 0.0.0.0 or :: value
 	// in that case not add IP match expression into the rule definition
 	if !bytes.HasPrefix(anyIP, rawIP) {
-		// source address position
-		addrOffset := uint32(12)
+		addrOffset, addrLen := m.srcAddrPayload()
 
 		expressions = append(expressions,
 			&expr.Payload{
 				DestRegister: 1,
 				Base:         expr.PayloadBaseNetworkHeader,
 				Offset:       addrOffset,
-				Len:          4,
+				Len:          addrLen,
 			},
 		)
 		// add individual IP for match if no ipset defined
@@ -590,7 +595,7 @@ func (m *AclManager) addJumpRule(chain *nftables.Chain, to string, ifaceKey expr
 
 func (m *AclManager) addIpToSet(ipsetName string, ip net.IP) (*nftables.Set, error) {
 	ipset, err := m.rConn.GetSetByName(m.workTable, ipsetName)
-	rawIP := ip.To4()
+	rawIP := m.rawIP(ip)
 	if err != nil {
 		if ipset, err = m.createSet(m.workTable, ipsetName); err != nil {
 			return nil, fmt.Errorf("get set name: %v", err)
@@ -618,11 +623,16 @@ func (m *AclManager) addIpToSet(ipsetName string, ip net.IP) (*nftables.Set, err
 
 // createSet in given table by name
 func (m *AclManager) createSet(table *nftables.Table, name string) (*nftables.Set, error) {
+	keyType := nftables.TypeIPAddr
+	if isIPv6Table(table) {
+		keyType = nftables.TypeIP6Addr
+	}
+
 	ipset := &nftables.Set{
 		Name:    name,
 		Table:   table,
 		Dynamic: true,
-		KeyType: nftables.TypeIPAddr,
+		KeyType: keyType,
 	}
 
 	if err := m.rConn.AddSet(ipset, nil); err != nil {
@@ -687,6 +697,27 @@ func (m *AclManager) refreshRuleHandles(chain *nftables.Chain, mangle bool) erro
 	return nil
 }
 
+// isIPv6 reports if the manager filters the IPv6 traffic of the peers
+func (m *AclManager) isIPv6() bool {
+	return isIPv6Table(m.workTable)
+}
+
+// rawIP returns the address in the length matched by the rules of the manager
+func (m *AclManager) rawIP(ip net.IP) net.IP {
+	if m.isIPv6() {
+		return ip.To16()
+	}
+	return ip.To4()
+}
+
+// srcAddrPayload returns the offset and length of the source address in the network header
+func (m *AclManager) srcAddrPayload() (uint32, uint32) {
+	if m.isIPv6() {
+		return 8, 16
+	}
+	return 12, 4
+}
+
 func generatePeerRuleId(ip net.IP, sPort *firewall.Port, dPort *firewall.Port, action firewall.Action, ipset *nftables.Set) string {
 	rulesetID := ":"
 	if sPort != nil {
@@ -722,3 +753,16 @@ func protoToInt(protocol firewall.Protocol) (uint8, error) {
 
 	return 0, fmt.Errorf("unsupported protocol: %s", protocol)
 }
+
+// protoToIntFamily is protoToInt for the given address family, ICMP is ICMPv6 for IPv6 traffic
+func protoToIntFamily(protocol firewall.Protocol, ipv6 bool) (uint8, error) {
+	if ipv6 && protocol == firewall.ProtocolICMP {
+		return unix.IPPROTO_ICMPV6, nil
+	}
+	return protoToInt(protocol)
+}
+
+// isIPv6Table reports if the table holds the rules for the IPv6 traffic
+func isIPv6Table(table *nftables.Table) bool {
+	return table != nil && table.Family == nftables.TableFamilyIPv6
+}
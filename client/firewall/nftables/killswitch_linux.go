@@ -0,0 +1,204 @@
+package nftables
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"golang.org/x/sys/unix"
+
+	firewall "github.com/netbirdio/netbird/client/firewall/manager"
+	nbnet "github.com/netbirdio/netbird/util/net"
+)
+
+const (
+	// tableNameKillSwitch is the name of the table that blocks the egress traffic bypassing NetBird.
+	// It is an inet table to block IPv4 and IPv6 traffic alike
+	tableNameKillSwitch = "netbird-killswitch"
+	chainNameKillSwitch = "output"
+
+	dhcpV4ServerPort = 67
+	dhcpV6ServerPort = 547
+)
+
+// killSwitch blocks the egress traffic of the host that bypasses the NetBird interface
+type killSwitch struct {
+	rConn   *nftables.Conn
+	wgIface iFaceMapper
+}
+
+func newKillSwitch(rConn *nftables.Conn, wgIface iFaceMapper) *killSwitch {
+	return &killSwitch{
+		rConn:   rConn,
+		wgIface: wgIface,
+	}
+}
+
+func (k *killSwitch) enable(allowedNetworks []netip.Prefix) error {
+	// NetBird's own connections are recognized by the fwmark, which is only set with advanced routing
+	if !nbnet.AdvancedRouting() {
+		return firewall.ErrKillSwitchNotSupported
+	}
+
+	if err := k.deleteTable(); err != nil {
+		return err
+	}
+
+	table := k.rConn.AddTable(&nftables.Table{Name: tableNameKillSwitch, Family: nftables.TableFamilyINet})
+
+	polAccept := nftables.ChainPolicyAccept
+	chain := k.rConn.AddChain(&nftables.Chain{
+		Name:     chainNameKillSwitch,
+		Table:    table,
+		Hooknum:  nftables.ChainHookOutput,
+		Priority: nftables.ChainPriorityFilter,
+		Type:     nftables.ChainTypeFilter,
+		Policy:   &polAccept,
+	})
+
+	rules := [][]expr.Any{
+		oifnameExprs("lo"),
+		oifnameExprs(k.wgIface.Name()),
+		{
+			&expr.Meta{Key: expr.MetaKeyMARK, Register: 1},
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     binaryutil.NativeEndian.PutUint32(nbnet.NetbirdFwmark),
+			},
+		},
+		dhcpExprs(unix.NFPROTO_IPV4, dhcpV4ServerPort),
+		dhcpExprs(unix.NFPROTO_IPV6, dhcpV6ServerPort),
+	}
+	for _, network := range allowedNetworks {
+		rules = append(rules, destinationExprs(network))
+	}
+
+	for _, exprs := range rules {
+		k.rConn.AddRule(&nftables.Rule{
+			Table: table,
+			Chain: chain,
+			Exprs: append(exprs, &expr.Verdict{Kind: expr.VerdictAccept}),
+		})
+	}
+
+	k.rConn.AddRule(&nftables.Rule{
+		Table: table,
+		Chain: chain,
+		Exprs: []expr.Any{&expr.Verdict{Kind: expr.VerdictDrop}},
+	})
+
+	if err := k.rConn.Flush(); err != nil {
+		return fmt.Errorf(flushError, err)
+	}
+
+	return nil
+}
+
+func (k *killSwitch) disable() error {
+	if err := k.deleteTable(); err != nil {
+		return err
+	}
+
+	if err := k.rConn.Flush(); err != nil {
+		return fmt.Errorf(flushError, err)
+	}
+
+	return nil
+}
+
+func (k *killSwitch) deleteTable() error {
+	tables, err := k.rConn.ListTablesOfFamily(nftables.TableFamilyINet)
+	if err != nil {
+		return fmt.Errorf("list of tables: %w", err)
+	}
+
+	for _, t := range tables {
+		if t.Name == tableNameKillSwitch {
+			k.rConn.DelTable(t)
+		}
+	}
+
+	return nil
+}
+
+func oifnameExprs(name string) []expr.Any {
+	return []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: 1},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     ifname(name),
+		},
+	}
+}
+
+func dhcpExprs(nfproto byte, port uint16) []expr.Any {
+	return []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     []byte{nfproto},
+		},
+		&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     []byte{unix.IPPROTO_UDP},
+		},
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseTransportHeader,
+			Offset:       2,
+			Len:          2,
+		},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     binaryutil.BigEndian.PutUint16(port),
+		},
+	}
+}
+
+// destinationExprs matches the destination address of IPv4 and IPv6 packets against the network
+func destinationExprs(network netip.Prefix) []expr.Any {
+	nfproto := byte(unix.NFPROTO_IPV4)
+	offset := uint32(16)
+	addrLen := uint32(4)
+	if network.Addr().Is6() {
+		nfproto = unix.NFPROTO_IPV6
+		offset = 24
+		addrLen = 16
+	}
+
+	return []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     []byte{nfproto},
+		},
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseNetworkHeader,
+			Offset:       offset,
+			Len:          addrLen,
+		},
+		&expr.Bitwise{
+			DestRegister:   1,
+			SourceRegister: 1,
+			Len:            addrLen,
+			Mask:           net.CIDRMask(network.Bits(), int(addrLen)*8),
+			Xor:            make([]byte, addrLen),
+		},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     network.Masked().Addr().AsSlice(),
+		},
+	}
+}
@@ -41,6 +41,11 @@ type Manager struct {
 
 	router     *router
 	aclManager *AclManager
+	killSwitch *killSwitch
+
+	// router6 and aclManager6 filter the IPv6 traffic, they are nil if the system can't filter IPv6
+	router6     *router
+	aclManager6 *AclManager
 }
 
 // Create nftables firewall manager
@@ -63,12 +68,22 @@ func Create(wgIface iFaceMapper) (*Manager, error) {
 		return nil, fmt.Errorf("create acl manager: %w", err)
 	}
 
+	workTable6 := &nftables.Table{Name: tableNameNetbird, Family: nftables.TableFamilyIPv6}
+	if m.router6, err = newRouter(workTable6, wgIface); err != nil {
+		log.Warnf("IPv6 traffic can't be filtered, create router: %v", err)
+	} else if m.aclManager6, err = newAclManager(workTable6, wgIface, chainNameRoutingFw); err != nil {
+		log.Warnf("IPv6 traffic can't be filtered, create acl manager: %v", err)
+		m.router6 = nil
+	}
+
+	m.killSwitch = newKillSwitch(m.rConn, wgIface)
+
 	return m, nil
 }
 
 // Init nftables firewall manager
 func (m *Manager) Init(stateManager *statemanager.Manager) error {
-	workTable, err := m.createWorkTable()
+	workTable, err := m.createWorkTable(nftables.TableFamilyIPv4)
 	if err != nil {
 		return fmt.Errorf("create work table: %w", err)
 	}
@@ -82,6 +97,12 @@ func (m *Manager) Init(stateManager *statemanager.Manager) error {
 		return fmt.Errorf("acl manager init: %w", err)
 	}
 
+	if err := m.initIPv6(); err != nil {
+		log.Warnf("IPv6 traffic can't be filtered, the IPv6 overlay stays disabled: %v", err)
+		m.router6 = nil
+		m.aclManager6 = nil
+	}
+
 	stateManager.RegisterState(&ShutdownState{})
 
 	// We only need to record minimal interface state for potential recreation.
@@ -108,6 +129,28 @@ func (m *Manager) Init(stateManager *statemanager.Manager) error {
 	return nil
 }
 
+// initIPv6 creates the IPv6 table with the same chains as the IPv4 one
+func (m *Manager) initIPv6() error {
+	if m.router6 == nil || m.aclManager6 == nil {
+		return fmt.Errorf("IPv6 managers not created")
+	}
+
+	workTable, err := m.createWorkTable(nftables.TableFamilyIPv6)
+	if err != nil {
+		return fmt.Errorf("create work table: %w", err)
+	}
+
+	if err := m.router6.init(workTable); err != nil {
+		return fmt.Errorf("router init: %w", err)
+	}
+
+	if err := m.aclManager6.init(workTable); err != nil {
+		return fmt.Errorf("acl manager init: %w", err)
+	}
+
+	return nil
+}
+
 // AddPeerFiltering rule to the firewall
 //
 // If comment argument is empty firewall manager should set
@@ -124,9 +167,11 @@ func (m *Manager) AddPeerFiltering(
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	rawIP := ip.To4()
-	if rawIP == nil {
-		return nil, fmt.Errorf("unsupported IP version: %s", ip.String())
+	if ip.To4() == nil {
+		if m.aclManager6 == nil {
+			return nil, fmt.Errorf("unsupported IP version: %s", ip.String())
+		}
+		return m.aclManager6.AddPeerFiltering(ip, proto, sPort, dPort, action, ipsetName, comment)
 	}
 
 	return m.aclManager.AddPeerFiltering(ip, proto, sPort, dPort, action, ipsetName, comment)
@@ -143,11 +188,18 @@ func (m *Manager) AddRouteFiltering(
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	if !destination.Addr().Is4() {
-		return nil, fmt.Errorf("unsupported IP version: %s", destination.Addr().String())
+	r, err := m.routerFor(destination.Addr())
+	if err != nil {
+		return nil, err
+	}
+
+	// the sources of the other address family can't match the traffic to the destination
+	sources = firewall.FilterPrefixesByFamily(sources, destination.Addr().Is6())
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no sources of the address family of %s", destination)
 	}
 
-	return m.router.AddRouteFiltering(sources, destination, proto, sPort, dPort, action)
+	return r.AddRouteFiltering(sources, destination, proto, sPort, dPort, action)
 }
 
 // DeletePeerRule from the firewall by rule definition
@@ -155,6 +207,10 @@ func (m *Manager) DeletePeerRule(rule firewall.Rule) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if r, ok := rule.(*Rule); ok && r.ip.To4() == nil && m.aclManager6 != nil {
+		return m.aclManager6.DeletePeerRule(rule)
+	}
+
 	return m.aclManager.DeletePeerRule(rule)
 }
 
@@ -163,6 +219,10 @@ func (m *Manager) DeleteRouteRule(rule firewall.Rule) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if m.router6 != nil && m.router6.hasRule(rule.GetRuleID()) {
+		return m.router6.DeleteRouteRule(rule)
+	}
+
 	return m.router.DeleteRouteRule(rule)
 }
 
@@ -170,18 +230,47 @@ func (m *Manager) IsServerRouteSupported() bool {
 	return true
 }
 
+// IsIPv6Supported returns true if the rules for the IPv6 traffic are installed in the ip6 table
+func (m *Manager) IsIPv6Supported() bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.aclManager6 != nil
+}
+
 func (m *Manager) AddNatRule(pair firewall.RouterPair) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	return m.router.AddNatRule(pair)
+	r, err := m.routerFor(pair.Destination.Addr())
+	if err != nil {
+		return err
+	}
+
+	return r.AddNatRule(pair)
 }
 
 func (m *Manager) RemoveNatRule(pair firewall.RouterPair) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	return m.router.RemoveNatRule(pair)
+	r, err := m.routerFor(pair.Destination.Addr())
+	if err != nil {
+		return err
+	}
+
+	return r.RemoveNatRule(pair)
+}
+
+// routerFor returns the router of the address family of addr
+func (m *Manager) routerFor(addr netip.Addr) (*router, error) {
+	if !addr.Is6() {
+		return m.router, nil
+	}
+	if m.router6 == nil {
+		return nil, fmt.Errorf("unsupported IP version: %s", addr)
+	}
+	return m.router6, nil
 }
 
 // AllowNetbird allows netbird interface traffic
@@ -198,7 +287,24 @@ func (m *Manager) AllowNetbird() error {
 		return fmt.Errorf("failed to create default allow rules: %v", err)
 	}
 
-	chains, err := m.rConn.ListChainsOfTableFamily(nftables.TableFamilyIPv4)
+	if err := m.allowNetbirdInput(nftables.TableFamilyIPv4); err != nil {
+		return err
+	}
+
+	if m.aclManager6 == nil {
+		return nil
+	}
+
+	if err := m.aclManager6.createDefaultAllowRules(); err != nil {
+		return fmt.Errorf("failed to create default IPv6 allow rules: %v", err)
+	}
+
+	return m.allowNetbirdInput(nftables.TableFamilyIPv6)
+}
+
+// allowNetbirdInput accepts the netbird interface traffic in the INPUT chain of the filter table of the family
+func (m *Manager) allowNetbirdInput(family nftables.TableFamily) error {
+	chains, err := m.rConn.ListChainsOfTableFamily(family)
 	if err != nil {
 		return fmt.Errorf("list of chains: %w", err)
 	}
@@ -228,8 +334,7 @@ func (m *Manager) AllowNetbird() error {
 
 	m.applyAllowNetbirdRules(chain)
 
-	err = m.rConn.Flush()
-	if err != nil {
+	if err := m.rConn.Flush(); err != nil {
 		return fmt.Errorf("failed to flush allow input netbird rules: %v", err)
 	}
 
@@ -238,6 +343,11 @@ func (m *Manager) AllowNetbird() error {
 
 // SetLegacyManagement sets the route manager to use legacy management
 func (m *Manager) SetLegacyManagement(isLegacy bool) error {
+	if m.router6 != nil {
+		if err := firewall.SetLegacyManagement(m.router6, isLegacy); err != nil {
+			return err
+		}
+	}
 	return firewall.SetLegacyManagement(m.router, isLegacy)
 }
 
@@ -254,10 +364,20 @@ func (m *Manager) Close(stateManager *statemanager.Manager) error {
 		return fmt.Errorf("reset router: %v", err)
 	}
 
+	if m.router6 != nil {
+		if err := m.router6.Reset(); err != nil {
+			return fmt.Errorf("reset IPv6 router: %v", err)
+		}
+	}
+
 	if err := m.cleanupNetbirdTables(); err != nil {
 		return fmt.Errorf("cleanup netbird tables: %v", err)
 	}
 
+	if err := m.killSwitch.deleteTable(); err != nil {
+		return fmt.Errorf("cleanup kill-switch table: %v", err)
+	}
+
 	if err := m.rConn.Flush(); err != nil {
 		return fmt.Errorf(flushError, err)
 	}
@@ -331,6 +451,22 @@ func (m *Manager) DisableRouting() error {
 	return nil
 }
 
+// EnableKillSwitch drops all egress traffic that doesn't belong to NetBird or goes through the NetBird interface
+func (m *Manager) EnableKillSwitch(allowedNetworks []netip.Prefix) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.killSwitch.enable(allowedNetworks)
+}
+
+// DisableKillSwitch removes the egress block of the kill-switch
+func (m *Manager) DisableKillSwitch() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.killSwitch.disable()
+}
+
 // Flush rule/chain/set operations from the buffer
 //
 // Method also get all rules after flush and refreshes handle values in the rulesets
@@ -339,11 +475,17 @@ func (m *Manager) Flush() error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if m.aclManager6 != nil {
+		if err := m.aclManager6.Flush(); err != nil {
+			return err
+		}
+	}
+
 	return m.aclManager.Flush()
 }
 
-func (m *Manager) createWorkTable() (*nftables.Table, error) {
-	tables, err := m.rConn.ListTablesOfFamily(nftables.TableFamilyIPv4)
+func (m *Manager) createWorkTable(family nftables.TableFamily) (*nftables.Table, error) {
+	tables, err := m.rConn.ListTablesOfFamily(family)
 	if err != nil {
 		return nil, fmt.Errorf("list of tables: %w", err)
 	}
@@ -354,7 +496,7 @@ func (m *Manager) createWorkTable() (*nftables.Table, error) {
 		}
 	}
 
-	table := m.rConn.AddTable(&nftables.Table{Name: tableNameNetbird, Family: nftables.TableFamilyIPv4})
+	table := m.rConn.AddTable(&nftables.Table{Name: tableNameNetbird, Family: family})
 	err = m.rConn.Flush()
 	return table, err
 }
@@ -166,6 +166,74 @@ func TestNftablesManager(t *testing.T) {
 	require.NoError(t, err, "failed to reset")
 }
 
+func TestNftablesManagerIPv6(t *testing.T) {
+	manager, err := Create(ifaceMock)
+	require.NoError(t, err)
+	require.NoError(t, manager.Init(nil))
+
+	defer func() {
+		require.NoError(t, manager.Close(nil), "failed to reset")
+	}()
+
+	require.True(t, manager.IsIPv6Supported(), "IPv6 table should be created")
+
+	testClient := &nftables.Conn{}
+
+	ip := net.ParseIP("fd00:1234::1")
+	peerRules, err := manager.AddPeerFiltering(ip, fw.ProtocolICMP, nil, nil, fw.ActionAccept, "nb0000001", "")
+	require.NoError(t, err, "failed to add IPv6 peer rule")
+	require.NoError(t, manager.Flush(), "failed to flush")
+
+	rules, err := testClient.GetRules(manager.aclManager6.workTable, manager.aclManager6.chainInputRules)
+	require.NoError(t, err, "failed to get rules")
+	require.Len(t, rules, 2, "expected the established and the peer rule")
+
+	expectedExprs := []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+		&expr.Cmp{
+			Register: 1,
+			Op:       expr.CmpOpEq,
+			Data:     []byte{unix.IPPROTO_ICMPV6},
+		},
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseNetworkHeader,
+			Offset:       8,
+			Len:          16,
+		},
+	}
+	require.Equal(t, expectedExprs, rules[1].Exprs[:3], "expected an IPv6 source match")
+
+	v4Rules, err := testClient.GetRules(manager.aclManager.workTable, manager.aclManager.chainInputRules)
+	require.NoError(t, err, "failed to get rules")
+	require.Len(t, v4Rules, 1, "IPv6 peer rule must not be added to the IPv4 table")
+
+	sources := []netip.Prefix{
+		netip.MustParsePrefix("100.96.0.2/32"),
+		netip.MustParsePrefix("fd00:1234::2/128"),
+		netip.MustParsePrefix("fd00:1234::3/128"),
+	}
+	routeRule, err := manager.AddRouteFiltering(sources, netip.MustParsePrefix("2001:db8::/64"), fw.ProtocolTCP, nil, &fw.Port{Values: []uint16{443}}, fw.ActionAccept)
+	require.NoError(t, err, "failed to add IPv6 route rule")
+	require.True(t, manager.router6.hasRule(routeRule.GetRuleID()), "route rule should be added to the IPv6 router")
+	require.False(t, manager.router.hasRule(routeRule.GetRuleID()), "route rule must not be added to the IPv4 router")
+
+	_, err = manager.AddRouteFiltering(sources[:1], netip.MustParsePrefix("2001:db8::/64"), fw.ProtocolALL, nil, nil, fw.ActionAccept)
+	require.Error(t, err, "IPv4 sources can't match IPv6 destinations")
+
+	require.NoError(t, manager.DeleteRouteRule(routeRule), "failed to delete route rule")
+	require.False(t, manager.router6.hasRule(routeRule.GetRuleID()), "route rule should be deleted")
+
+	for _, r := range peerRules {
+		require.NoError(t, manager.DeletePeerRule(r), "failed to delete peer rule")
+	}
+	require.NoError(t, manager.Flush(), "failed to flush")
+
+	rules, err = testClient.GetRules(manager.aclManager6.workTable, manager.aclManager6.chainInputRules)
+	require.NoError(t, err, "failed to get rules")
+	require.Len(t, rules, 1, "expected only the established rule after deletion")
+}
+
 func TestNFtablesCreatePerformance(t *testing.T) {
 	mock := &iFaceMock{
 		NameFunc: func() string {
@@ -2,7 +2,6 @@ package nftables
 
 import (
 	"bytes"
-	"encoding/binary"
 	"errors"
 	"fmt"
 	"net"
@@ -102,7 +101,7 @@ func (r *router) Reset() error {
 }
 
 func (r *router) loadFilterTable() (*nftables.Table, error) {
-	tables, err := r.conn.ListTablesOfFamily(nftables.TableFamilyIPv4)
+	tables, err := r.conn.ListTablesOfFamily(r.workTable.Family)
 	if err != nil {
 		return nil, fmt.Errorf("nftables: unable to list tables: %v", err)
 	}
@@ -201,7 +200,7 @@ func (r *router) AddRouteFiltering(
 
 	// Handle protocol
 	if proto != firewall.ProtocolALL {
-		protoNum, err := protoToInt(proto)
+		protoNum, err := protoToIntFamily(proto, destination.Addr().Is6())
 		if err != nil {
 			return nil, fmt.Errorf("convert protocol to number: %w", err)
 		}
@@ -260,12 +259,17 @@ func (r *router) getIpSetExprs(sources []netip.Prefix, exprs []expr.Any) ([]expr
 		return nil, fmt.Errorf("create or get ipset for sources: %w", err)
 	}
 
+	offset, addrLen := uint32(12), uint32(4)
+	if isIPv6Table(r.workTable) {
+		offset, addrLen = 8, 16
+	}
+
 	exprs = append(exprs,
 		&expr.Payload{
 			DestRegister: 1,
 			Base:         expr.PayloadBaseNetworkHeader,
-			Offset:       12,
-			Len:          4,
+			Offset:       offset,
+			Len:          addrLen,
 		},
 		&expr.Lookup{
 			SourceRegister: 1,
@@ -315,19 +319,24 @@ func (r *router) createIpSet(setName string, sources []netip.Prefix) (*nftables.
 	// overlapping prefixes will result in an error, so we need to merge them
 	sources = firewall.MergeIPRanges(sources)
 
+	ipv6 := isIPv6Table(r.workTable)
+	keyType := nftables.TypeIPAddr
+	if ipv6 {
+		keyType = nftables.TypeIP6Addr
+	}
+
 	set := &nftables.Set{
 		Name:  setName,
 		Table: r.workTable,
 		// required for prefixes
 		Interval: true,
-		KeyType:  nftables.TypeIPAddr,
+		KeyType:  keyType,
 	}
 
 	var elements []nftables.SetElement
 	for _, prefix := range sources {
-		// TODO: Implement IPv6 support
-		if prefix.Addr().Is6() {
-			log.Printf("Skipping IPv6 prefix %s: IPv6 support not yet implemented", prefix)
+		if prefix.Addr().Is6() != ipv6 {
+			log.Debugf("Skipping prefix %s of the other address family for ipset %s", prefix, setName)
 			continue
 		}
 
@@ -359,23 +368,19 @@ func (r *router) createIpSet(setName string, sources []netip.Prefix) (*nftables.
 
 // calculateLastIP determines the last IP in a given prefix.
 func calculateLastIP(prefix netip.Prefix) netip.Addr {
-	hostMask := ^uint32(0) >> prefix.Masked().Bits()
-	lastIP := uint32FromNetipAddr(prefix.Addr()) | hostMask
-
-	return netip.AddrFrom4(uint32ToBytes(lastIP))
-}
+	b := prefix.Masked().Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 1 << (7 - i%8)
+	}
 
-// Utility function to convert netip.Addr to uint32.
-func uint32FromNetipAddr(addr netip.Addr) uint32 {
-	b := addr.As4()
-	return binary.BigEndian.Uint32(b[:])
+	lastIP, _ := netip.AddrFromSlice(b)
+	return lastIP
 }
 
-// Utility function to convert uint32 to a netip-compatible byte slice.
-func uint32ToBytes(ip uint32) [4]byte {
-	var b [4]byte
-	binary.BigEndian.PutUint32(b[:], ip)
-	return b
+// hasRule reports if the route rule was added by this router
+func (r *router) hasRule(ruleKey string) bool {
+	_, ok := r.rules[ruleKey]
+	return ok
 }
 
 func (r *router) deleteIpSet(setName string, set *nftables.Set) error {
@@ -687,7 +692,7 @@ func (r *router) acceptForwardRules() error {
 	}()
 
 	// Try iptables first and fallback to nftables if iptables is not available
-	ipt, err := iptables.New()
+	ipt, err := iptables.NewWithProtocol(r.iptablesProtocol())
 	if err != nil {
 		// filter table exists but iptables is not
 		log.Warnf("Will use nftables to manipulate the filter table because iptables is not available: %v", err)
@@ -699,6 +704,14 @@ func (r *router) acceptForwardRules() error {
 	return r.acceptForwardRulesIptables(ipt)
 }
 
+// iptablesProtocol returns the iptables variant managing the filter table of the router's address family
+func (r *router) iptablesProtocol() iptables.Protocol {
+	if isIPv6Table(r.workTable) {
+		return iptables.ProtocolIPv6
+	}
+	return iptables.ProtocolIPv4
+}
+
 func (r *router) acceptForwardRulesIptables(ipt *iptables.IPTables) error {
 	var merr *multierror.Error
 	for _, rule := range r.getAcceptForwardRules() {
@@ -781,7 +794,7 @@ func (r *router) removeAcceptForwardRules() error {
 	}
 
 	// Try iptables first and fallback to nftables if iptables is not available
-	ipt, err := iptables.New()
+	ipt, err := iptables.NewWithProtocol(r.iptablesProtocol())
 	if err != nil {
 		log.Warnf("Will use nftables to manipulate the filter table because iptables is not available: %v", err)
 		return r.removeAcceptForwardRulesNftables()
@@ -791,7 +804,7 @@ func (r *router) removeAcceptForwardRules() error {
 }
 
 func (r *router) removeAcceptForwardRulesNftables() error {
-	chains, err := r.conn.ListChainsOfTableFamily(nftables.TableFamilyIPv4)
+	chains, err := r.conn.ListChainsOfTableFamily(r.filterTable.Family)
 	if err != nil {
 		return fmt.Errorf("list chains: %v", err)
 	}
@@ -899,34 +912,40 @@ func (r *router) refreshRulesMap() error {
 // generateCIDRMatcherExpressions generates nftables expressions that matches a CIDR
 func generateCIDRMatcherExpressions(source bool, prefix netip.Prefix) []expr.Any {
 	var offset uint32
-	if source {
+	addrLen := uint32(4)
+	switch {
+	case prefix.Addr().Is6() && source:
+		offset, addrLen = 8, 16
+	case prefix.Addr().Is6():
+		offset, addrLen = 24, 16
+	case source:
 		offset = 12 // src offset
-	} else {
+	default:
 		offset = 16 // dst offset
 	}
 
 	ones := prefix.Bits()
-	// 0.0.0.0/0 doesn't need extra expressions
+	// 0.0.0.0/0 and ::/0 don't need extra expressions
 	if ones == 0 {
 		return nil
 	}
 
-	mask := net.CIDRMask(ones, 32)
+	mask := net.CIDRMask(ones, int(addrLen)*8)
 
 	return []expr.Any{
 		&expr.Payload{
 			DestRegister: 1,
 			Base:         expr.PayloadBaseNetworkHeader,
 			Offset:       offset,
-			Len:          4,
+			Len:          addrLen,
 		},
 		// netmask
 		&expr.Bitwise{
 			DestRegister:   1,
 			SourceRegister: 1,
-			Len:            4,
+			Len:            addrLen,
 			Mask:           mask,
-			Xor:            []byte{0, 0, 0, 0},
+			Xor:            make([]byte, addrLen),
 		},
 		// net address
 		&expr.Cmp{
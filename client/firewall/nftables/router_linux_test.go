@@ -714,3 +714,23 @@ func deleteWorkTable() {
 		}
 	}
 }
+
+func TestCalculateLastIP(t *testing.T) {
+	tests := []struct {
+		prefix   string
+		expected string
+	}{
+		{prefix: "10.0.0.0/24", expected: "10.0.0.255"},
+		{prefix: "10.1.1.1/32", expected: "10.1.1.1"},
+		{prefix: "192.168.0.0/15", expected: "192.169.255.255"},
+		{prefix: "fd00:1234::/64", expected: "fd00:1234::ffff:ffff:ffff:ffff"},
+		{prefix: "fd00:1234::1/128", expected: "fd00:1234::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			lastIP := calculateLastIP(netip.MustParsePrefix(tt.prefix))
+			assert.Equal(t, netip.MustParseAddr(tt.expected), lastIP)
+		})
+	}
+}
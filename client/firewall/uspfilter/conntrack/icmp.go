@@ -93,9 +93,9 @@ func (t *ICMPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, id uint16, seq u
 	conn.UpdateLastSeen()
 }
 
-// IsValidInbound checks if an inbound ICMP Echo Reply matches a tracked request
+// IsValidInbound checks if an inbound ICMP or ICMPv6 Echo Reply matches a tracked request
 func (t *ICMPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, id uint16, seq uint16, icmpType uint8) bool {
-	if icmpType != uint8(layers.ICMPv4TypeEchoReply) {
+	if icmpType != echoReplyType(srcIP) {
 		return false
 	}
 
@@ -167,3 +167,11 @@ func makeICMPKey(srcIP net.IP, dstIP net.IP, id uint16, seq uint16) ICMPConnKey
 		Sequence: seq,
 	}
 }
+
+// echoReplyType returns the echo reply type of the ICMP version matching the address family
+func echoReplyType(ip net.IP) uint8 {
+	if ip.To4() != nil {
+		return uint8(layers.ICMPv4TypeEchoReply)
+	}
+	return uint8(layers.ICMPv6TypeEchoReply)
+}
@@ -3,8 +3,48 @@ package conntrack
 import (
 	"net"
 	"testing"
+
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestICMPTracker_IsValidInbound(t *testing.T) {
+	tests := []struct {
+		name      string
+		srcIP     net.IP
+		dstIP     net.IP
+		replyType uint8
+	}{
+		{
+			name:      "ICMP",
+			srcIP:     net.ParseIP("100.64.0.1"),
+			dstIP:     net.ParseIP("100.64.0.2"),
+			replyType: layers.ICMPv4TypeEchoReply,
+		},
+		{
+			name:      "ICMPv6",
+			srcIP:     net.ParseIP("fd00::1"),
+			dstIP:     net.ParseIP("fd00::2"),
+			replyType: layers.ICMPv6TypeEchoReply,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewICMPTracker(DefaultICMPTimeout, logger)
+			defer tracker.Close()
+
+			tracker.TrackOutbound(tt.srcIP, tt.dstIP, 1, 1)
+
+			assert.True(t, tracker.IsValidInbound(tt.dstIP, tt.srcIP, 1, 1, tt.replyType), "echo reply")
+			assert.False(t, tracker.IsValidInbound(tt.dstIP, tt.srcIP, 1, 2, tt.replyType), "wrong sequence")
+			assert.False(t, tracker.IsValidInbound(tt.dstIP, tt.srcIP, 2, 1, tt.replyType), "wrong identifier")
+			assert.False(t, tracker.IsValidInbound(tt.srcIP, tt.dstIP, 1, 1, tt.replyType), "wrong direction")
+			assert.False(t, tracker.IsValidInbound(tt.dstIP, tt.srcIP, 1, 1, tt.replyType+1), "not an echo reply")
+		})
+	}
+}
+
 func BenchmarkICMPTracker(b *testing.B) {
 	b.Run("TrackOutbound", func(b *testing.B) {
 		tracker := NewICMPTracker(DefaultICMPTimeout, logger)
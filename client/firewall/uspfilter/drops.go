@@ -0,0 +1,36 @@
+package uspfilter
+
+import "sync/atomic"
+
+const (
+	// DropReasonInvalid counts packets that could not be decoded
+	DropReasonInvalid = "invalid"
+	// DropReasonPeerACL counts packets to the local peer denied by the access control rules
+	DropReasonPeerACL = "peer_acl"
+	// DropReasonRoutingDisabled counts packets to other hosts received while routing is disabled
+	DropReasonRoutingDisabled = "routing_disabled"
+	// DropReasonRouteACL counts routed packets denied by the route access control rules
+	DropReasonRouteACL = "route_acl"
+	// DropReasonNoForwarder counts local packets received before the netstack forwarder was initialized
+	DropReasonNoForwarder = "no_forwarder"
+)
+
+// dropCounters counts the incoming packets dropped by the filter by reason
+type dropCounters struct {
+	invalid         atomic.Uint64
+	peerACL         atomic.Uint64
+	routingDisabled atomic.Uint64
+	routeACL        atomic.Uint64
+	noForwarder     atomic.Uint64
+}
+
+// DroppedPackets returns the number of incoming packets dropped since the filter was created, keyed by drop reason
+func (m *Manager) DroppedPackets() map[string]uint64 {
+	return map[string]uint64{
+		DropReasonInvalid:         m.drops.invalid.Load(),
+		DropReasonPeerACL:         m.drops.peerACL.Load(),
+		DropReasonRoutingDisabled: m.drops.routingDisabled.Load(),
+		DropReasonRouteACL:        m.drops.routeACL.Load(),
+		DropReasonNoForwarder:     m.drops.noForwarder.Load(),
+	}
+}
@@ -44,15 +44,22 @@ func (e *endpoint) LinkAddress() tcpip.LinkAddress {
 func (e *endpoint) WritePackets(pkts stack.PacketBufferList) (int, tcpip.Error) {
 	var written int
 	for _, pkt := range pkts.AsSlice() {
-		netHeader := header.IPv4(pkt.NetworkHeader().View().AsSlice())
+		netHeader := pkt.NetworkHeader().View().AsSlice()
 
 		data := stack.PayloadSince(pkt.NetworkHeader())
 		if data == nil {
 			continue
 		}
 
+		var address tcpip.Address
+		switch pkt.NetworkProtocolNumber {
+		case header.IPv6ProtocolNumber:
+			address = header.IPv6(netHeader).DestinationAddress()
+		default:
+			address = header.IPv4(netHeader).DestinationAddress()
+		}
+
 		// Send the packet through WireGuard
-		address := netHeader.DestinationAddress()
 		err := e.device.CreateOutboundPacket(data.AsSlice(), address.AsSlice())
 		if err != nil {
 			e.logger.Error("CreateOutboundPacket: %v", err)
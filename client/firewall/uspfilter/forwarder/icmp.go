@@ -107,3 +107,102 @@ func (f *Forwarder) handleEchoResponse(icmpHdr header.ICMPv4, payload []byte, ds
 	f.logger.Trace("Forwarded ICMP echo reply for %v", id)
 	return true
 }
+
+// isICMPv6EchoRequest returns true if the IPv6 packet carries an ICMPv6 echo request without extension headers
+func isICMPv6EchoRequest(packet []byte) bool {
+	ipHdr := header.IPv6(packet)
+	if ipHdr.NextHeader() != uint8(header.ICMPv6ProtocolNumber) {
+		return false
+	}
+
+	payload := ipHdr.Payload()
+	if len(payload) < header.ICMPv6EchoMinimumSize {
+		return false
+	}
+	return header.ICMPv6(payload).Type() == header.ICMPv6EchoRequest
+}
+
+// handleICMPv6Echo forwards an ICMPv6 echo request and sends the reply back through WireGuard
+func (f *Forwarder) handleICMPv6Echo(packet []byte) {
+	ipHdr := header.IPv6(packet)
+	srcAddr, dstAddr := ipHdr.SourceAddress(), ipHdr.DestinationAddress()
+	request := header.ICMPv6(ipHdr.Payload())
+
+	ctx, cancel := context.WithTimeout(f.ctx, 5*time.Second)
+	defer cancel()
+
+	lc := net.ListenConfig{}
+	// TODO: support non-root
+	conn, err := lc.ListenPacket(ctx, "ip6:ipv6-icmp", "::")
+	if err != nil {
+		f.logger.Error("Failed to create ICMPv6 socket for %s -> %s: %v", srcAddr, dstAddr, err)
+		return
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			f.logger.Debug("Failed to close ICMPv6 socket: %v", err)
+		}
+	}()
+
+	// the kernel calculates the checksum of ICMPv6 messages sent through raw sockets
+	dst := &net.IPAddr{IP: f.determineDialAddr(dstAddr)}
+	if _, err := conn.WriteTo(request, dst); err != nil {
+		f.logger.Error("Failed to write ICMPv6 packet for %s -> %s: %v", srcAddr, dstAddr, err)
+		return
+	}
+
+	f.logger.Trace("Forwarded ICMPv6 echo request %s -> %s id=%d seq=%d",
+		srcAddr, dstAddr, request.Ident(), request.Sequence())
+
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		f.logger.Error("Failed to set read deadline for ICMPv6 response: %v", err)
+		return
+	}
+
+	response := make([]byte, f.endpoint.mtu)
+	var n int
+	// the raw socket receives all ICMPv6 messages of the host, wait for the matching reply
+	for {
+		n, _, err = conn.ReadFrom(response)
+		if err != nil {
+			if !isTimeout(err) {
+				f.logger.Error("Failed to read ICMPv6 response: %v", err)
+			}
+			return
+		}
+
+		reply := header.ICMPv6(response[:n])
+		if n >= header.ICMPv6EchoMinimumSize &&
+			reply.Type() == header.ICMPv6EchoReply &&
+			reply.Ident() == request.Ident() &&
+			reply.Sequence() == request.Sequence() {
+			break
+		}
+	}
+
+	fullPacket := make([]byte, header.IPv6MinimumSize+n)
+	ip := header.IPv6(fullPacket)
+	ip.Encode(&header.IPv6Fields{
+		PayloadLength:     uint16(n),
+		TransportProtocol: header.ICMPv6ProtocolNumber,
+		HopLimit:          64,
+		SrcAddr:           dstAddr,
+		DstAddr:           srcAddr,
+	})
+
+	// the checksum covers the pseudo header, which changes with the addresses
+	reply := header.ICMPv6(fullPacket[header.IPv6MinimumSize:])
+	copy(reply, response[:n])
+	reply.SetChecksum(header.ICMPv6Checksum(header.ICMPv6ChecksumParams{
+		Header: reply,
+		Src:    dstAddr,
+		Dst:    srcAddr,
+	}))
+
+	if err := f.endpoint.device.CreateOutboundPacket(fullPacket, srcAddr.AsSlice()); err != nil {
+		f.logger.Error("Failed to send ICMPv6 echo reply: %v", err)
+		return
+	}
+
+	f.logger.Trace("Forwarded ICMPv6 echo reply for %s -> %s", srcAddr, dstAddr)
+}
@@ -3,6 +3,7 @@ package uspfilter
 import (
 	"fmt"
 	"net"
+	"net/netip"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
@@ -15,6 +16,9 @@ type localIPManager struct {
 
 	// Use bitmap for IPv4 (32 bits * 2^16 = 256KB memory)
 	ipv4Bitmap [1 << 16]uint32
+
+	// IPv6 hosts have only a few addresses, a set is sufficient
+	ipv6Set map[netip.Addr]struct{}
 }
 
 func newLocalIPManager() *localIPManager {
@@ -41,7 +45,7 @@ func (m *localIPManager) checkBitmapBit(ip net.IP) bool {
 	return (m.ipv4Bitmap[high] & (1 << (low % 32))) != 0
 }
 
-func (m *localIPManager) processIP(ip net.IP, newIPv4Bitmap *[1 << 16]uint32, ipv4Set map[string]struct{}, ipv4Addresses *[]string) error {
+func (m *localIPManager) processIP(ip net.IP, newIPv4Bitmap *[1 << 16]uint32, newIPv6Set map[netip.Addr]struct{}, ipSet map[string]struct{}, addresses *[]string) error {
 	if ipv4 := ip.To4(); ipv4 != nil {
 		high := (uint16(ipv4[0]) << 8) | uint16(ipv4[1])
 		low := (uint16(ipv4[2]) << 8) | uint16(ipv4[3])
@@ -49,16 +53,28 @@ func (m *localIPManager) processIP(ip net.IP, newIPv4Bitmap *[1 << 16]uint32, ip
 			return fmt.Errorf("invalid IPv4 address: %s", ip)
 		}
 		ipStr := ip.String()
-		if _, exists := ipv4Set[ipStr]; !exists {
-			ipv4Set[ipStr] = struct{}{}
-			*ipv4Addresses = append(*ipv4Addresses, ipStr)
+		if _, exists := ipSet[ipStr]; !exists {
+			ipSet[ipStr] = struct{}{}
+			*addresses = append(*addresses, ipStr)
 			newIPv4Bitmap[high] |= 1 << (low % 32)
 		}
+		return nil
+	}
+
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return nil
+	}
+	ipStr := addr.String()
+	if _, exists := ipSet[ipStr]; !exists {
+		ipSet[ipStr] = struct{}{}
+		*addresses = append(*addresses, ipStr)
+		newIPv6Set[addr] = struct{}{}
 	}
 	return nil
 }
 
-func (m *localIPManager) processInterface(iface net.Interface, newIPv4Bitmap *[1 << 16]uint32, ipv4Set map[string]struct{}, ipv4Addresses *[]string) {
+func (m *localIPManager) processInterface(iface net.Interface, newIPv4Bitmap *[1 << 16]uint32, newIPv6Set map[netip.Addr]struct{}, ipSet map[string]struct{}, addresses *[]string) {
 	addrs, err := iface.Addrs()
 	if err != nil {
 		log.Debugf("get addresses for interface %s failed: %v", iface.Name, err)
@@ -76,7 +92,7 @@ func (m *localIPManager) processInterface(iface net.Interface, newIPv4Bitmap *[1
 			continue
 		}
 
-		if err := m.processIP(ip, newIPv4Bitmap, ipv4Set, ipv4Addresses); err != nil {
+		if err := m.processIP(ip, newIPv4Bitmap, newIPv6Set, ipSet, addresses); err != nil {
 			log.Debugf("process IP failed: %v", err)
 		}
 	}
@@ -90,8 +106,11 @@ func (m *localIPManager) UpdateLocalIPs(iface common.IFaceMapper) (err error) {
 	}()
 
 	var newIPv4Bitmap [1 << 16]uint32
-	ipv4Set := make(map[string]struct{})
-	var ipv4Addresses []string
+	newIPv6Set := map[netip.Addr]struct{}{
+		netip.IPv6Loopback(): {},
+	}
+	ipSet := make(map[string]struct{})
+	var addresses []string
 
 	// 127.0.0.0/8
 	high := uint16(127) << 8
@@ -100,9 +119,14 @@ func (m *localIPManager) UpdateLocalIPs(iface common.IFaceMapper) (err error) {
 	}
 
 	if iface != nil {
-		if err := m.processIP(iface.Address().IP, &newIPv4Bitmap, ipv4Set, &ipv4Addresses); err != nil {
+		if err := m.processIP(iface.Address().IP, &newIPv4Bitmap, newIPv6Set, ipSet, &addresses); err != nil {
 			return err
 		}
+		if ipv6 := iface.Address().IPv6; ipv6 != nil {
+			if err := m.processIP(ipv6, &newIPv4Bitmap, newIPv6Set, ipSet, &addresses); err != nil {
+				return err
+			}
+		}
 	}
 
 	interfaces, err := net.Interfaces()
@@ -110,15 +134,16 @@ func (m *localIPManager) UpdateLocalIPs(iface common.IFaceMapper) (err error) {
 		log.Warnf("failed to get interfaces: %v", err)
 	} else {
 		for _, intf := range interfaces {
-			m.processInterface(intf, &newIPv4Bitmap, ipv4Set, &ipv4Addresses)
+			m.processInterface(intf, &newIPv4Bitmap, newIPv6Set, ipSet, &addresses)
 		}
 	}
 
 	m.mu.Lock()
 	m.ipv4Bitmap = newIPv4Bitmap
+	m.ipv6Set = newIPv6Set
 	m.mu.Unlock()
 
-	log.Debugf("Local IPv4 addresses: %v", ipv4Addresses)
+	log.Debugf("Local IP addresses: %v", addresses)
 	return nil
 }
 
@@ -130,5 +155,10 @@ func (m *localIPManager) IsLocalIP(ip net.IP) bool {
 		return m.checkBitmapBit(ipv4)
 	}
 
-	return false
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	_, exists := m.ipv6Set[addr]
+	return exists
 }
@@ -86,8 +86,32 @@ func TestLocalIPManager(t *testing.T) {
 				},
 			},
 			testIP:   net.ParseIP("fe80::1"),
+			expected: true,
+		},
+		{
+			name: "IPv6 address doesn't match",
+			setupAddr: iface.WGAddress{
+				IP: net.ParseIP("fe80::1"),
+				Network: &net.IPNet{
+					IP:   net.ParseIP("fe80::"),
+					Mask: net.CIDRMask(64, 128),
+				},
+			},
+			testIP:   net.ParseIP("fe80::2"),
 			expected: false,
 		},
+		{
+			name: "IPv6 loopback",
+			setupAddr: iface.WGAddress{
+				IP: net.ParseIP("192.168.1.1"),
+				Network: &net.IPNet{
+					IP:   net.ParseIP("192.168.1.0"),
+					Mask: net.CIDRMask(24, 32),
+				},
+			},
+			testIP:   net.ParseIP("::1"),
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
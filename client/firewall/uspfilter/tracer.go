@@ -3,6 +3,7 @@ package uspfilter
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"time"
 
 	"github.com/google/gopacket"
@@ -104,7 +105,10 @@ func (t *PacketTrace) AddResultWithForwarder(stage PacketStage, message string,
 }
 
 func (p *PacketBuilder) Build() ([]byte, error) {
-	ip := p.buildIPLayer()
+	ip, err := p.buildIPLayer()
+	if err != nil {
+		return nil, err
+	}
 	pktLayers := []gopacket.SerializableLayer{ip}
 
 	transportLayer, err := p.buildTransportLayer(ip)
@@ -121,30 +125,57 @@ func (p *PacketBuilder) Build() ([]byte, error) {
 	return serializePacket(pktLayers)
 }
 
-func (p *PacketBuilder) buildIPLayer() *layers.IPv4 {
+// networkLayer is the IPv4 or IPv6 layer of a built packet
+type networkLayer interface {
+	gopacket.SerializableLayer
+	gopacket.NetworkLayer
+}
+
+func (p *PacketBuilder) isIPv6() bool {
+	return p.SrcIP.To4() == nil
+}
+
+func (p *PacketBuilder) buildIPLayer() (networkLayer, error) {
+	if (p.SrcIP.To4() == nil) != (p.DstIP.To4() == nil) {
+		return nil, fmt.Errorf("source %s and destination %s have different address families", p.SrcIP, p.DstIP)
+	}
+
+	if p.isIPv6() {
+		return &layers.IPv6{
+			Version:    6,
+			HopLimit:   64,
+			NextHeader: layers.IPProtocol(getIPProtocolNumber(p.Protocol, true)),
+			SrcIP:      p.SrcIP,
+			DstIP:      p.DstIP,
+		}, nil
+	}
+
 	return &layers.IPv4{
 		Version:  4,
 		TTL:      64,
-		Protocol: layers.IPProtocol(getIPProtocolNumber(p.Protocol)),
+		Protocol: layers.IPProtocol(getIPProtocolNumber(p.Protocol, false)),
 		SrcIP:    p.SrcIP,
 		DstIP:    p.DstIP,
-	}
+	}, nil
 }
 
-func (p *PacketBuilder) buildTransportLayer(ip *layers.IPv4) ([]gopacket.SerializableLayer, error) {
+func (p *PacketBuilder) buildTransportLayer(ip networkLayer) ([]gopacket.SerializableLayer, error) {
 	switch p.Protocol {
 	case "tcp":
 		return p.buildTCPLayer(ip)
 	case "udp":
 		return p.buildUDPLayer(ip)
 	case "icmp":
+		if p.isIPv6() {
+			return p.buildICMPv6Layer(ip)
+		}
 		return p.buildICMPLayer()
 	default:
 		return nil, fmt.Errorf("unsupported protocol: %s", p.Protocol)
 	}
 }
 
-func (p *PacketBuilder) buildTCPLayer(ip *layers.IPv4) ([]gopacket.SerializableLayer, error) {
+func (p *PacketBuilder) buildTCPLayer(ip networkLayer) ([]gopacket.SerializableLayer, error) {
 	tcp := &layers.TCP{
 		SrcPort: layers.TCPPort(p.SrcPort),
 		DstPort: layers.TCPPort(p.DstPort),
@@ -162,7 +193,7 @@ func (p *PacketBuilder) buildTCPLayer(ip *layers.IPv4) ([]gopacket.SerializableL
 	return []gopacket.SerializableLayer{tcp}, nil
 }
 
-func (p *PacketBuilder) buildUDPLayer(ip *layers.IPv4) ([]gopacket.SerializableLayer, error) {
+func (p *PacketBuilder) buildUDPLayer(ip networkLayer) ([]gopacket.SerializableLayer, error) {
 	udp := &layers.UDP{
 		SrcPort: layers.UDPPort(p.SrcPort),
 		DstPort: layers.UDPPort(p.DstPort),
@@ -184,6 +215,24 @@ func (p *PacketBuilder) buildICMPLayer() ([]gopacket.SerializableLayer, error) {
 	return []gopacket.SerializableLayer{icmp}, nil
 }
 
+func (p *PacketBuilder) buildICMPv6Layer(ip networkLayer) ([]gopacket.SerializableLayer, error) {
+	icmp := &layers.ICMPv6{
+		TypeCode: layers.CreateICMPv6TypeCode(p.ICMPType, p.ICMPCode),
+	}
+	if err := icmp.SetNetworkLayerForChecksum(ip); err != nil {
+		return nil, fmt.Errorf("set network layer for ICMPv6 checksum: %w", err)
+	}
+
+	if p.ICMPType == layers.ICMPv6TypeEchoRequest || p.ICMPType == layers.ICMPv6TypeEchoReply {
+		echo := &layers.ICMPv6Echo{
+			Identifier: 1,
+			SeqNumber:  1,
+		}
+		return []gopacket.SerializableLayer{icmp, echo}, nil
+	}
+	return []gopacket.SerializableLayer{icmp}, nil
+}
+
 func serializePacket(layers []gopacket.SerializableLayer) ([]byte, error) {
 	buf := gopacket.NewSerializeBuffer()
 	opts := gopacket.SerializeOptions{
@@ -196,13 +245,16 @@ func serializePacket(layers []gopacket.SerializableLayer) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func getIPProtocolNumber(protocol fw.Protocol) int {
+func getIPProtocolNumber(protocol fw.Protocol, ipv6 bool) int {
 	switch protocol {
 	case fw.ProtocolTCP:
 		return int(layers.IPProtocolTCP)
 	case fw.ProtocolUDP:
 		return int(layers.IPProtocolUDP)
 	case fw.ProtocolICMP:
+		if ipv6 {
+			return int(layers.IPProtocolICMPv6)
+		}
 		return int(layers.IPProtocolICMPv4)
 	default:
 		return 0
@@ -226,7 +278,7 @@ func (m *Manager) TracePacket(packetData []byte, direction fw.RuleDirection) *Pa
 	trace := &PacketTrace{Direction: direction}
 
 	// Initial packet decoding
-	if err := d.parser.DecodeLayers(packetData, &d.decoded); err != nil {
+	if err := d.decodePacket(packetData); err != nil {
 		trace.AddResult(StageReceived, fmt.Sprintf("Failed to decode packet: %v", err), false)
 		return trace
 	}
@@ -246,7 +298,7 @@ func (m *Manager) TracePacket(packetData []byte, direction fw.RuleDirection) *Pa
 		trace.Protocol = "UDP"
 		trace.SourcePort = uint16(d.udp.SrcPort)
 		trace.DestinationPort = uint16(d.udp.DstPort)
-	case layers.LayerTypeICMPv4:
+	case layers.LayerTypeICMPv4, layers.LayerTypeICMPv6:
 		trace.Protocol = "ICMP"
 	}
 
@@ -303,8 +355,9 @@ func (m *Manager) buildConntrackStateMessage(d *decoder) string {
 			flags&conntrack.TCPAck != 0,
 			flags&conntrack.TCPRst != 0,
 			flags&conntrack.TCPFin != 0)
-	case layers.LayerTypeICMPv4:
-		msg += fmt.Sprintf(" (ICMP ID=%d, Seq=%d)", d.icmp4.Id, d.icmp4.Seq)
+	case layers.LayerTypeICMPv4, layers.LayerTypeICMPv6:
+		id, seq := d.icmpEcho()
+		msg += fmt.Sprintf(" (ICMP ID=%d, Seq=%d)", id, seq)
 	}
 	return msg
 }
@@ -362,7 +415,7 @@ func (m *Manager) handleRouteACLs(trace *PacketTrace, d *decoder, srcIP, dstIP n
 	trace.AddResult(StageRouteACL, msg, allowed)
 
 	if allowed && m.forwarder != nil {
-		m.addForwardingResult(trace, "proxy-remote", fmt.Sprintf("%s:%d", dstIP, dstPort), true)
+		m.addForwardingResult(trace, "proxy-remote", net.JoinHostPort(dstIP.String(), strconv.Itoa(int(dstPort))), true)
 	}
 
 	trace.AddResult(StageCompleted, msgProcessingCompleted, allowed)
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
@@ -94,19 +95,75 @@ type Manager struct {
 	tcpTracker  *conntrack.TCPTracker
 	forwarder   *forwarder.Forwarder
 	logger      *nblog.Logger
+
+	capture atomic.Pointer[PacketCapture]
+	drops   dropCounters
+}
+
+// PacketCapture receives the decrypted packets passing the filter hooks
+type PacketCapture interface {
+	CapturePacket(packet []byte, outbound bool)
 }
 
 // decoder for packages
 type decoder struct {
-	eth     layers.Ethernet
-	ip4     layers.IPv4
-	ip6     layers.IPv6
-	tcp     layers.TCP
-	udp     layers.UDP
-	icmp4   layers.ICMPv4
-	icmp6   layers.ICMPv6
-	decoded []gopacket.LayerType
-	parser  *gopacket.DecodingLayerParser
+	eth       layers.Ethernet
+	ip4       layers.IPv4
+	ip6       layers.IPv6
+	tcp       layers.TCP
+	udp       layers.UDP
+	icmp4     layers.ICMPv4
+	icmp6     layers.ICMPv6
+	icmp6echo layers.ICMPv6Echo
+	decoded   []gopacket.LayerType
+	parser4   *gopacket.DecodingLayerParser
+	parser6   *gopacket.DecodingLayerParser
+}
+
+func newDecoder() *decoder {
+	d := &decoder{
+		decoded: []gopacket.LayerType{},
+	}
+	d.parser4 = gopacket.NewDecodingLayerParser(
+		layers.LayerTypeIPv4,
+		&d.eth, &d.ip4, &d.icmp4, &d.tcp, &d.udp,
+	)
+	d.parser4.IgnoreUnsupported = true
+
+	d.parser6 = gopacket.NewDecodingLayerParser(
+		layers.LayerTypeIPv6,
+		&d.eth, &d.ip6, &d.icmp6, &d.icmp6echo, &d.tcp, &d.udp,
+	)
+	d.parser6.IgnoreUnsupported = true
+
+	return d
+}
+
+// decodePacket decodes the packet with the parser matching the IP version of the packet
+func (d *decoder) decodePacket(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("empty packet")
+	}
+
+	switch version := data[0] >> 4; version {
+	case 4:
+		return d.parser4.DecodeLayers(data, &d.decoded)
+	case 6:
+		return d.parser6.DecodeLayers(data, &d.decoded)
+	default:
+		return fmt.Errorf("unknown IP version %d", version)
+	}
+}
+
+// icmpEcho returns the identifier and sequence number of ICMP and ICMPv6 echo messages
+func (d *decoder) icmpEcho() (id uint16, seq uint16) {
+	if d.decoded[1] == layers.LayerTypeICMPv4 {
+		return d.icmp4.Id, d.icmp4.Seq
+	}
+	if len(d.decoded) > 2 && d.decoded[2] == layers.LayerTypeICMPv6Echo {
+		return d.icmp6echo.Identifier, d.icmp6echo.SeqNumber
+	}
+	return 0, 0
 }
 
 // Create userspace firewall manager constructor
@@ -152,15 +209,7 @@ func create(iface common.IFaceMapper, nativeFirewall firewall.Manager, disableSe
 	m := &Manager{
 		decoders: sync.Pool{
 			New: func() any {
-				d := &decoder{
-					decoded: []gopacket.LayerType{},
-				}
-				d.parser = gopacket.NewDecodingLayerParser(
-					layers.LayerTypeIPv4,
-					&d.eth, &d.ip4, &d.ip6, &d.icmp4, &d.icmp6, &d.tcp, &d.udp,
-				)
-				d.parser.IgnoreUnsupported = true
-				return d
+				return newDecoder()
 			},
 		},
 		nativeFirewall:      nativeFirewall,
@@ -323,6 +372,11 @@ func (m *Manager) IsServerRouteSupported() bool {
 	return true
 }
 
+// IsIPv6Supported returns true, peer traffic is filtered in userspace for both address families
+func (m *Manager) IsIPv6Supported() bool {
+	return true
+}
+
 func (m *Manager) AddNatRule(pair firewall.RouterPair) error {
 	if m.nativeRouter && m.nativeFirewall != nil {
 		return m.nativeFirewall.AddNatRule(pair)
@@ -480,14 +534,39 @@ func (m *Manager) Flush() error { return nil }
 
 // DropOutgoing filter outgoing packets
 func (m *Manager) DropOutgoing(packetData []byte) bool {
+	if c := m.capture.Load(); c != nil {
+		(*c).CapturePacket(packetData, true)
+	}
 	return m.processOutgoingHooks(packetData)
 }
 
 // DropIncoming filter incoming packets
 func (m *Manager) DropIncoming(packetData []byte) bool {
+	if c := m.capture.Load(); c != nil {
+		(*c).CapturePacket(packetData, false)
+	}
 	return m.dropFilter(packetData)
 }
 
+// SetPacketCapture sets the capture receiving all packets passing the filter, nil removes it
+func (m *Manager) SetPacketCapture(capture PacketCapture) {
+	if capture == nil {
+		m.capture.Store(nil)
+		return
+	}
+	m.capture.Store(&capture)
+}
+
+// RemovePacketCapture removes the capture only if it is still the one set, so a capture replaced in the meantime
+// keeps receiving packets. It reports whether the capture was removed
+func (m *Manager) RemovePacketCapture(capture PacketCapture) bool {
+	current := m.capture.Load()
+	if current == nil || *current != capture {
+		return false
+	}
+	return m.capture.CompareAndSwap(current, nil)
+}
+
 // UpdateLocalIPs updates the list of local IPs
 func (m *Manager) UpdateLocalIPs() error {
 	return m.localipmanager.UpdateLocalIPs(m.wgIface)
@@ -500,7 +579,7 @@ func (m *Manager) processOutgoingHooks(packetData []byte) bool {
 	d := m.decoders.Get().(*decoder)
 	defer m.decoders.Put(d)
 
-	if err := d.parser.DecodeLayers(packetData, &d.decoded); err != nil {
+	if err := d.decodePacket(packetData); err != nil {
 		return false
 	}
 
@@ -520,7 +599,7 @@ func (m *Manager) processOutgoingHooks(packetData []byte) bool {
 			m.trackUDPOutbound(d, srcIP, dstIP)
 		case layers.LayerTypeTCP:
 			m.trackTCPOutbound(d, srcIP, dstIP)
-		case layers.LayerTypeICMPv4:
+		case layers.LayerTypeICMPv4, layers.LayerTypeICMPv6:
 			m.trackICMPOutbound(d, srcIP, dstIP)
 		}
 	}
@@ -601,13 +680,27 @@ func (m *Manager) checkUDPHooks(d *decoder, dstIP net.IP, packetData []byte) boo
 }
 
 func (m *Manager) trackICMPOutbound(d *decoder, srcIP, dstIP net.IP) {
-	if d.icmp4.TypeCode.Type() == layers.ICMPv4TypeEchoRequest {
-		m.icmpTracker.TrackOutbound(
-			srcIP,
-			dstIP,
-			d.icmp4.Id,
-			d.icmp4.Seq,
-		)
+	if !isICMPEchoRequest(d) {
+		return
+	}
+
+	id, seq := d.icmpEcho()
+	m.icmpTracker.TrackOutbound(
+		srcIP,
+		dstIP,
+		id,
+		seq,
+	)
+}
+
+func isICMPEchoRequest(d *decoder) bool {
+	switch d.decoded[1] {
+	case layers.LayerTypeICMPv4:
+		return d.icmp4.TypeCode.Type() == layers.ICMPv4TypeEchoRequest
+	case layers.LayerTypeICMPv6:
+		return d.icmp6.TypeCode.Type() == layers.ICMPv6TypeEchoRequest
+	default:
+		return false
 	}
 }
 
@@ -621,12 +714,14 @@ func (m *Manager) dropFilter(packetData []byte) bool {
 	defer m.decoders.Put(d)
 
 	if !m.isValidPacket(d, packetData) {
+		m.drops.invalid.Add(1)
 		return true
 	}
 
 	srcIP, dstIP := m.extractIPs(d)
 	if srcIP == nil {
 		m.logger.Error("Unknown network layer: %v", d.decoded[0])
+		m.drops.invalid.Add(1)
 		return true
 	}
 
@@ -649,6 +744,7 @@ func (m *Manager) handleLocalTraffic(d *decoder, srcIP, dstIP net.IP, packetData
 	if m.peerACLsBlock(srcIP, packetData, m.incomingRules, d) {
 		m.logger.Trace("Dropping local packet (ACL denied): src=%s dst=%s",
 			srcIP, dstIP)
+		m.drops.peerACL.Add(1)
 		return true
 	}
 
@@ -668,6 +764,7 @@ func (m *Manager) handleNetstackLocalTraffic(packetData []byte) bool {
 
 	if m.forwarder == nil {
 		m.logger.Trace("Dropping local packet (forwarder not initialized)")
+		m.drops.noForwarder.Add(1)
 		return true
 	}
 
@@ -686,6 +783,7 @@ func (m *Manager) handleRoutedTraffic(d *decoder, srcIP, dstIP net.IP, packetDat
 	if !m.routingEnabled {
 		m.logger.Trace("Dropping routed packet (routing disabled): src=%s dst=%s",
 			srcIP, dstIP)
+		m.drops.routingDisabled.Add(1)
 		return true
 	}
 
@@ -700,6 +798,7 @@ func (m *Manager) handleRoutedTraffic(d *decoder, srcIP, dstIP net.IP, packetDat
 	if !m.routeACLsPass(srcIP, dstIP, proto, srcPort, dstPort) {
 		m.logger.Trace("Dropping routed packet (ACL denied): src=%s:%d dst=%s:%d proto=%v",
 			srcIP, srcPort, dstIP, dstPort, proto)
+		m.drops.routeACL.Add(1)
 		return true
 	}
 
@@ -737,7 +836,7 @@ func getPortsFromPacket(d *decoder) (srcPort, dstPort uint16) {
 }
 
 func (m *Manager) isValidPacket(d *decoder, packetData []byte) bool {
-	if err := d.parser.DecodeLayers(packetData, &d.decoded); err != nil {
+	if err := d.decodePacket(packetData); err != nil {
 		m.logger.Trace("couldn't decode packet, err: %s", err)
 		return false
 	}
@@ -777,7 +876,15 @@ func (m *Manager) isValidTrackedConnection(d *decoder, srcIP, dstIP net.IP) bool
 			d.icmp4.TypeCode.Type(),
 		)
 
-		// TODO: ICMPv6
+	case layers.LayerTypeICMPv6:
+		id, seq := d.icmpEcho()
+		return m.icmpTracker.IsValidInbound(
+			srcIP,
+			dstIP,
+			id,
+			seq,
+			d.icmp6.TypeCode.Type(),
+		)
 	}
 
 	return false
@@ -785,13 +892,20 @@ func (m *Manager) isValidTrackedConnection(d *decoder, srcIP, dstIP net.IP) bool
 
 // isSpecialICMP returns true if the packet is a special ICMP packet that should be allowed
 func (m *Manager) isSpecialICMP(d *decoder) bool {
-	if d.decoded[1] != layers.LayerTypeICMPv4 {
+	switch d.decoded[1] {
+	case layers.LayerTypeICMPv4:
+		icmpType := d.icmp4.TypeCode.Type()
+		return icmpType == layers.ICMPv4TypeDestinationUnreachable ||
+			icmpType == layers.ICMPv4TypeTimeExceeded
+	case layers.LayerTypeICMPv6:
+		icmpType := d.icmp6.TypeCode.Type()
+		return icmpType == layers.ICMPv6TypeDestinationUnreachable ||
+			icmpType == layers.ICMPv6TypePacketTooBig ||
+			icmpType == layers.ICMPv6TypeTimeExceeded ||
+			icmpType == layers.ICMPv6TypeParameterProblem
+	default:
 		return false
 	}
-
-	icmpType := d.icmp4.TypeCode.Type()
-	return icmpType == layers.ICMPv4TypeDestinationUnreachable ||
-		icmpType == layers.ICMPv4TypeTimeExceeded
 }
 
 func (m *Manager) peerACLsBlock(srcIP net.IP, packetData []byte, rules map[string]RuleSet, d *decoder) bool {
@@ -843,7 +957,7 @@ func validateRule(ip net.IP, packetData []byte, rules map[string]PeerRule, d *de
 			return rule.drop, true
 		}
 
-		if payloadLayer != rule.protoLayer {
+		if !protoLayerMatches(rule.protoLayer, payloadLayer) {
 			continue
 		}
 
@@ -869,13 +983,33 @@ func validateRule(ip net.IP, packetData []byte, rules map[string]PeerRule, d *de
 	return false, false
 }
 
+// protoLayerMatches returns true if the packet layer matches the rule layer.
+// ICMP and ICMPv6 are treated as the same protocol, the address family is matched by the rule IP.
+func protoLayerMatches(ruleLayer, payloadLayer gopacket.LayerType) bool {
+	if ruleLayer == payloadLayer {
+		return true
+	}
+	return isICMPLayer(ruleLayer) && isICMPLayer(payloadLayer)
+}
+
+func isICMPLayer(layer gopacket.LayerType) bool {
+	return layer == layers.LayerTypeICMPv4 || layer == layers.LayerTypeICMPv6
+}
+
 // routeACLsPass returns treu if the packet is allowed by the route ACLs
 func (m *Manager) routeACLsPass(srcIP, dstIP net.IP, proto firewall.Protocol, srcPort, dstPort uint16) bool {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
 
-	srcAddr := netip.AddrFrom4([4]byte(srcIP.To4()))
-	dstAddr := netip.AddrFrom4([4]byte(dstIP.To4()))
+	srcAddr, ok := netip.AddrFromSlice(srcIP)
+	if !ok {
+		return false
+	}
+	dstAddr, ok := netip.AddrFromSlice(dstIP)
+	if !ok {
+		return false
+	}
+	srcAddr, dstAddr = srcAddr.Unmap(), dstAddr.Unmap()
 
 	for _, rule := range m.routeRules {
 		if m.ruleMatches(rule, srcAddr, dstAddr, proto, srcPort, dstPort) {
@@ -995,6 +1129,23 @@ func (m *Manager) EnableRouting() error {
 	return m.determineRouting()
 }
 
+// EnableKillSwitch blocks the egress traffic of the host with the native firewall, the userspace filter
+// only sees the traffic of the NetBird interface
+func (m *Manager) EnableKillSwitch(allowedNetworks []netip.Prefix) error {
+	if m.netstack || m.nativeFirewall == nil {
+		return firewall.ErrKillSwitchNotSupported
+	}
+	return m.nativeFirewall.EnableKillSwitch(allowedNetworks)
+}
+
+// DisableKillSwitch removes the egress block of the kill-switch
+func (m *Manager) DisableKillSwitch() error {
+	if m.netstack || m.nativeFirewall == nil {
+		return nil
+	}
+	return m.nativeFirewall.DisableKillSwitch()
+}
+
 func (m *Manager) DisableRouting() error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
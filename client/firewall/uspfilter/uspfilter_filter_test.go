@@ -188,6 +188,55 @@ func TestPeerACLFiltering(t *testing.T) {
 			ruleAction:      fw.ActionAccept,
 			shouldBeBlocked: true,
 		},
+		{
+			name:            "Allow IPv6 TCP traffic from peer",
+			srcIP:           "fd00::1",
+			dstIP:           "::1",
+			proto:           fw.ProtocolTCP,
+			srcPort:         12345,
+			dstPort:         443,
+			ruleIP:          "fd00::1",
+			ruleProto:       fw.ProtocolTCP,
+			ruleDstPort:     &fw.Port{Values: []uint16{443}},
+			ruleAction:      fw.ActionAccept,
+			shouldBeBlocked: false,
+		},
+		{
+			name:            "Block IPv6 TCP traffic from other peer",
+			srcIP:           "fd00::2",
+			dstIP:           "::1",
+			proto:           fw.ProtocolTCP,
+			srcPort:         12345,
+			dstPort:         443,
+			ruleIP:          "fd00::1",
+			ruleProto:       fw.ProtocolTCP,
+			ruleDstPort:     &fw.Port{Values: []uint16{443}},
+			ruleAction:      fw.ActionAccept,
+			shouldBeBlocked: true,
+		},
+		{
+			name:            "Allow IPv6 UDP traffic from all peers",
+			srcIP:           "fd00::1",
+			dstIP:           "::1",
+			proto:           fw.ProtocolUDP,
+			srcPort:         12345,
+			dstPort:         53,
+			ruleIP:          "::",
+			ruleProto:       fw.ProtocolUDP,
+			ruleDstPort:     &fw.Port{Values: []uint16{53}},
+			ruleAction:      fw.ActionAccept,
+			shouldBeBlocked: false,
+		},
+		{
+			name:            "Allow ICMPv6 by ICMP rule for all peers",
+			srcIP:           "fd00::1",
+			dstIP:           "::1",
+			proto:           fw.ProtocolICMP,
+			ruleIP:          "0.0.0.0",
+			ruleProto:       fw.ProtocolICMP,
+			ruleAction:      fw.ActionAccept,
+			shouldBeBlocked: false,
+		},
 	}
 
 	t.Run("Implicit DROP (no rules)", func(t *testing.T) {
@@ -232,6 +281,10 @@ func createTestPacket(t *testing.T, srcIP, dstIP string, proto fw.Protocol, srcP
 		FixLengths:       true,
 	}
 
+	if net.ParseIP(srcIP).To4() == nil {
+		return createTestPacketV6(t, srcIP, dstIP, proto, srcPort, dstPort)
+	}
+
 	ipLayer := &layers.IPv4{
 		Version: 4,
 		TTL:     64,
@@ -276,6 +329,62 @@ func createTestPacket(t *testing.T, srcIP, dstIP string, proto fw.Protocol, srcP
 	return buf.Bytes()
 }
 
+func createTestPacketV6(t *testing.T, srcIP, dstIP string, proto fw.Protocol, srcPort, dstPort uint16) []byte {
+	t.Helper()
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{
+		ComputeChecksums: true,
+		FixLengths:       true,
+	}
+
+	ipLayer := &layers.IPv6{
+		Version:  6,
+		HopLimit: 64,
+		SrcIP:    net.ParseIP(srcIP),
+		DstIP:    net.ParseIP(dstIP),
+	}
+
+	var err error
+	switch proto {
+	case fw.ProtocolTCP:
+		ipLayer.NextHeader = layers.IPProtocolTCP
+		tcp := &layers.TCP{
+			SrcPort: layers.TCPPort(srcPort),
+			DstPort: layers.TCPPort(dstPort),
+		}
+		err = tcp.SetNetworkLayerForChecksum(ipLayer)
+		require.NoError(t, err)
+		err = gopacket.SerializeLayers(buf, opts, ipLayer, tcp)
+
+	case fw.ProtocolUDP:
+		ipLayer.NextHeader = layers.IPProtocolUDP
+		udp := &layers.UDP{
+			SrcPort: layers.UDPPort(srcPort),
+			DstPort: layers.UDPPort(dstPort),
+		}
+		err = udp.SetNetworkLayerForChecksum(ipLayer)
+		require.NoError(t, err)
+		err = gopacket.SerializeLayers(buf, opts, ipLayer, udp)
+
+	case fw.ProtocolICMP:
+		ipLayer.NextHeader = layers.IPProtocolICMPv6
+		icmp := &layers.ICMPv6{
+			TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeEchoRequest, 0),
+		}
+		err = icmp.SetNetworkLayerForChecksum(ipLayer)
+		require.NoError(t, err)
+		err = gopacket.SerializeLayers(buf, opts, ipLayer, icmp, &layers.ICMPv6Echo{Identifier: 1, SeqNumber: 1})
+
+	default:
+		ipLayer.NextHeader = layers.IPProtocolNoNextHeader
+		err = gopacket.SerializeLayers(buf, opts, ipLayer)
+	}
+
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
 func setupRoutedManager(tb testing.TB, network string) *Manager {
 	tb.Helper()
 
@@ -798,6 +907,66 @@ func TestRouteACLFiltering(t *testing.T) {
 			},
 			shouldPass: false,
 		},
+		{
+			name:    "Allow IPv6 TCP to routed IPv6 network",
+			srcIP:   "fd00::1",
+			dstIP:   "2001:db8::10",
+			proto:   fw.ProtocolTCP,
+			srcPort: 12345,
+			dstPort: 443,
+			rule: rule{
+				sources: []netip.Prefix{netip.MustParsePrefix("fd00::/64")},
+				dest:    netip.MustParsePrefix("2001:db8::/64"),
+				proto:   fw.ProtocolTCP,
+				dstPort: &fw.Port{Values: []uint16{443}},
+				action:  fw.ActionAccept,
+			},
+			shouldPass: true,
+		},
+		{
+			name:    "IPv6 source outside allowed prefix",
+			srcIP:   "fd01::1",
+			dstIP:   "2001:db8::10",
+			proto:   fw.ProtocolTCP,
+			srcPort: 12345,
+			dstPort: 443,
+			rule: rule{
+				sources: []netip.Prefix{netip.MustParsePrefix("fd00::/64")},
+				dest:    netip.MustParsePrefix("2001:db8::/64"),
+				proto:   fw.ProtocolTCP,
+				dstPort: &fw.Port{Values: []uint16{443}},
+				action:  fw.ActionAccept,
+			},
+			shouldPass: false,
+		},
+		{
+			name:  "Allow ICMPv6 to routed IPv6 network",
+			srcIP: "fd00::1",
+			dstIP: "2001:db8::10",
+			proto: fw.ProtocolICMP,
+			rule: rule{
+				sources: []netip.Prefix{netip.MustParsePrefix("fd00::/64")},
+				dest:    netip.MustParsePrefix("2001:db8::/64"),
+				proto:   fw.ProtocolICMP,
+				action:  fw.ActionAccept,
+			},
+			shouldPass: true,
+		},
+		{
+			name:    "IPv4 rule doesn't match IPv6 traffic",
+			srcIP:   "fd00::1",
+			dstIP:   "2001:db8::10",
+			proto:   fw.ProtocolTCP,
+			srcPort: 12345,
+			dstPort: 443,
+			rule: rule{
+				sources: []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")},
+				dest:    netip.MustParsePrefix("0.0.0.0/0"),
+				proto:   fw.ProtocolALL,
+				action:  fw.ActionAccept,
+			},
+			shouldPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
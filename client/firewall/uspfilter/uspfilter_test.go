@@ -408,15 +408,7 @@ func TestProcessOutgoingHooks(t *testing.T) {
 
 	manager.decoders = sync.Pool{
 		New: func() any {
-			d := &decoder{
-				decoded: []gopacket.LayerType{},
-			}
-			d.parser = gopacket.NewDecodingLayerParser(
-				layers.LayerTypeIPv4,
-				&d.eth, &d.ip4, &d.ip6, &d.icmp4, &d.icmp6, &d.tcp, &d.udp,
-			)
-			d.parser.IgnoreUnsupported = true
-			return d
+			return newDecoder()
 		},
 	}
 
@@ -518,15 +510,7 @@ func TestStatefulFirewall_UDPTracking(t *testing.T) {
 	manager.udpTracker = conntrack.NewUDPTracker(200*time.Millisecond, logger)
 	manager.decoders = sync.Pool{
 		New: func() any {
-			d := &decoder{
-				decoded: []gopacket.LayerType{},
-			}
-			d.parser = gopacket.NewDecodingLayerParser(
-				layers.LayerTypeIPv4,
-				&d.eth, &d.ip4, &d.ip6, &d.icmp4, &d.icmp6, &d.tcp, &d.udp,
-			)
-			d.parser.IgnoreUnsupported = true
-			return d
+			return newDecoder()
 		},
 	}
 	defer func() {
@@ -712,3 +696,175 @@ func TestStatefulFirewall_UDPTracking(t *testing.T) {
 		})
 	}
 }
+
+func TestStatefulFirewall_ICMPv6Tracking(t *testing.T) {
+	manager, err := Create(&IFaceMock{
+		SetFilterFunc: func(device.PacketFilter) error { return nil },
+	}, false)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, manager.Close(nil))
+	}()
+
+	srcIP := net.ParseIP("fd00::1")
+	dstIP := net.ParseIP("fd00::2")
+
+	icmpPacket := func(src, dst net.IP, typ uint8, seq uint16) []byte {
+		ip := &layers.IPv6{
+			Version:    6,
+			HopLimit:   64,
+			NextHeader: layers.IPProtocolICMPv6,
+			SrcIP:      src,
+			DstIP:      dst,
+		}
+		icmp := &layers.ICMPv6{
+			TypeCode: layers.CreateICMPv6TypeCode(typ, 0),
+		}
+		require.NoError(t, icmp.SetNetworkLayerForChecksum(ip))
+
+		buf := gopacket.NewSerializeBuffer()
+		opts := gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true}
+		require.NoError(t, gopacket.SerializeLayers(buf, opts, ip, icmp, &layers.ICMPv6Echo{Identifier: 42, SeqNumber: seq}))
+		return buf.Bytes()
+	}
+
+	drop := manager.processOutgoingHooks(icmpPacket(srcIP, dstIP, layers.ICMPv6TypeEchoRequest, 1))
+	require.False(t, drop, "outbound echo request should not be dropped")
+
+	drop = manager.dropFilter(icmpPacket(dstIP, srcIP, layers.ICMPv6TypeEchoReply, 1))
+	require.False(t, drop, "echo reply to a tracked request should be allowed")
+
+	drop = manager.dropFilter(icmpPacket(dstIP, srcIP, layers.ICMPv6TypeEchoReply, 2))
+	require.True(t, drop, "echo reply with a different sequence number should be dropped")
+
+	drop = manager.dropFilter(icmpPacket(dstIP, srcIP, layers.ICMPv6TypeEchoRequest, 1))
+	require.True(t, drop, "untracked echo request should be dropped")
+}
+
+func TestTracePacketIPv6(t *testing.T) {
+	manager, err := Create(&IFaceMock{
+		SetFilterFunc: func(device.PacketFilter) error { return nil },
+	}, false)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, manager.Close(nil))
+	}()
+
+	manager.localForwarding = true
+
+	_, err = manager.AddPeerFiltering(net.ParseIP("fd00::1"), fw.ProtocolTCP, nil, &fw.Port{Values: []uint16{22}}, fw.ActionAccept, "", "")
+	require.NoError(t, err)
+
+	trace, err := manager.TracePacketFromBuilder(&PacketBuilder{
+		SrcIP:     net.ParseIP("fd00::1"),
+		DstIP:     net.ParseIP("::1"),
+		Protocol:  fw.ProtocolTCP,
+		SrcPort:   12345,
+		DstPort:   22,
+		Direction: fw.RuleDirectionIN,
+		TCPState:  &TCPState{SYN: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "TCP", trace.Protocol)
+	require.True(t, trace.DestinationIP.Equal(net.ParseIP("::1")))
+	require.NotEmpty(t, trace.Results)
+	require.True(t, trace.Results[len(trace.Results)-1].Allowed, "packet should be allowed by the peer ACL")
+
+	trace, err = manager.TracePacketFromBuilder(&PacketBuilder{
+		SrcIP:     net.ParseIP("fd00::1"),
+		DstIP:     net.ParseIP("::1"),
+		Protocol:  fw.ProtocolICMP,
+		ICMPType:  layers.ICMPv6TypeEchoRequest,
+		Direction: fw.RuleDirectionIN,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ICMP", trace.Protocol)
+	require.False(t, trace.Results[len(trace.Results)-1].Allowed, "ICMPv6 isn't allowed by the peer ACL")
+
+	_, err = manager.TracePacketFromBuilder(&PacketBuilder{
+		SrcIP:    net.ParseIP("fd00::1"),
+		DstIP:    net.ParseIP("100.64.0.1"),
+		Protocol: fw.ProtocolTCP,
+	})
+	require.Error(t, err, "mixed address families")
+}
+
+type captureMock struct {
+	inbound, outbound int
+}
+
+func (c *captureMock) CapturePacket(_ []byte, outbound bool) {
+	if outbound {
+		c.outbound++
+		return
+	}
+	c.inbound++
+}
+
+func TestPacketCapture(t *testing.T) {
+	manager, err := Create(&IFaceMock{
+		SetFilterFunc: func(device.PacketFilter) error { return nil },
+	}, false)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, manager.Close(nil))
+	}()
+
+	packet := createTestPacket(t, "100.10.0.1", "100.10.0.100", fw.ProtocolTCP, 12345, 443)
+
+	capture := &captureMock{}
+	manager.SetPacketCapture(capture)
+
+	manager.DropOutgoing(packet)
+	manager.DropIncoming(packet)
+	manager.DropIncoming(packet)
+	require.Equal(t, 1, capture.outbound)
+	require.Equal(t, 2, capture.inbound, "dropped packets are captured as well")
+
+	manager.SetPacketCapture(nil)
+	manager.DropIncoming(packet)
+	require.Equal(t, 2, capture.inbound)
+
+	newCapture := &captureMock{}
+	manager.SetPacketCapture(newCapture)
+	require.False(t, manager.RemovePacketCapture(capture), "a replaced capture mustn't remove the new one")
+	manager.DropIncoming(packet)
+	require.Equal(t, 1, newCapture.inbound)
+
+	require.True(t, manager.RemovePacketCapture(newCapture))
+	manager.DropIncoming(packet)
+	require.Equal(t, 1, newCapture.inbound)
+}
+
+func TestDroppedPackets(t *testing.T) {
+	manager, err := Create(&IFaceMock{
+		SetFilterFunc: func(device.PacketFilter) error { return nil },
+		AddressFunc: func() iface.WGAddress {
+			return iface.WGAddress{
+				IP: net.ParseIP("100.10.0.100"),
+				Network: &net.IPNet{
+					IP:   net.ParseIP("100.10.0.0"),
+					Mask: net.CIDRMask(16, 32),
+				},
+			}
+		},
+	}, false)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, manager.Close(nil))
+	}()
+	manager.routingEnabled = false
+
+	require.True(t, manager.DropIncoming([]byte{0x45, 0x00}))
+	require.True(t, manager.DropIncoming(createTestPacket(t, "100.10.0.1", "100.10.0.100", fw.ProtocolTCP, 12345, 443)))
+	require.True(t, manager.DropIncoming(createTestPacket(t, "100.10.0.1", "100.10.0.100", fw.ProtocolUDP, 12345, 53)))
+	require.True(t, manager.DropIncoming(createTestPacket(t, "100.10.0.1", "192.168.1.10", fw.ProtocolTCP, 12345, 443)))
+
+	require.Equal(t, map[string]uint64{
+		DropReasonInvalid:         1,
+		DropReasonPeerACL:         2,
+		DropReasonRoutingDisabled: 1,
+		DropReasonRouteACL:        0,
+		DropReasonNoForwarder:     0,
+	}, manager.DroppedPackets())
+}
@@ -3,27 +3,65 @@ package device
 import (
 	"fmt"
 	"net"
+	"strings"
 )
 
 // WGAddress WireGuard parsed address
 type WGAddress struct {
 	IP      net.IP
 	Network *net.IPNet
+	// IPv6 is the optional IPv6 address of the interface, nil if the interface has an IPv4 address only
+	IPv6      net.IP
+	NetworkV6 *net.IPNet
 }
 
-// ParseWGAddress parse a string ("1.2.3.4/24") address to WG Address
+// ParseWGAddress parse a string ("1.2.3.4/24") address to WG Address.
+// The IPv4 address can be followed by a comma separated IPv6 address ("1.2.3.4/24,fd00::1/64")
 func ParseWGAddress(address string) (WGAddress, error) {
-	ip, network, err := net.ParseCIDR(address)
+	addressV4, addressV6, dualStack := strings.Cut(address, ",")
+
+	ip, network, err := net.ParseCIDR(addressV4)
 	if err != nil {
 		return WGAddress{}, err
 	}
-	return WGAddress{
+	wgAddress := WGAddress{
 		IP:      ip,
 		Network: network,
-	}, nil
+	}
+
+	if !dualStack {
+		return wgAddress, nil
+	}
+
+	ipv6, networkV6, err := net.ParseCIDR(addressV6)
+	if err != nil {
+		return WGAddress{}, err
+	}
+	if ipv6.To4() != nil {
+		return WGAddress{}, fmt.Errorf("%s is not an IPv6 address", addressV6)
+	}
+	wgAddress.IPv6 = ipv6
+	wgAddress.NetworkV6 = networkV6
+
+	return wgAddress, nil
+}
+
+// HasIPv6 checks if the address includes an IPv6 address
+func (addr WGAddress) HasIPv6() bool {
+	return addr.IPv6 != nil && addr.NetworkV6 != nil
 }
 
+// String returns the IPv4 address in CIDR notation
 func (addr WGAddress) String() string {
 	maskSize, _ := addr.Network.Mask.Size()
 	return fmt.Sprintf("%s/%d", addr.IP.String(), maskSize)
 }
+
+// IPv6String returns the IPv6 address in CIDR notation or an empty string if there is no IPv6 address
+func (addr WGAddress) IPv6String() string {
+	if !addr.HasIPv6() {
+		return ""
+	}
+	maskSize, _ := addr.NetworkV6.Mask.Size()
+	return fmt.Sprintf("%s/%d", addr.IPv6.String(), maskSize)
+}
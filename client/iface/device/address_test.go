@@ -0,0 +1,61 @@
+package device
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWGAddress(t *testing.T) {
+	tests := []struct {
+		name         string
+		address      string
+		expectedV4   string
+		expectedV6   string
+		expectedIPv6 bool
+		expectErr    bool
+	}{
+		{
+			name:       "IPv4 only",
+			address:    "100.64.0.1/10",
+			expectedV4: "100.64.0.1/10",
+		},
+		{
+			name:         "dual stack",
+			address:      "100.64.0.1/10,fd12:3456:789a:1::6440:1/64",
+			expectedV4:   "100.64.0.1/10",
+			expectedV6:   "fd12:3456:789a:1::6440:1/64",
+			expectedIPv6: true,
+		},
+		{
+			name:      "invalid IPv4 address",
+			address:   "100.64.0.1",
+			expectErr: true,
+		},
+		{
+			name:      "invalid IPv6 address",
+			address:   "100.64.0.1/10,fd12::1",
+			expectErr: true,
+		},
+		{
+			name:      "second address is not IPv6",
+			address:   "100.64.0.1/10,100.64.0.2/10",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := ParseWGAddress(tt.address)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedV4, addr.String())
+			assert.Equal(t, tt.expectedV6, addr.IPv6String())
+			assert.Equal(t, tt.expectedIPv6, addr.HasIPv6())
+		})
+	}
+}
@@ -5,6 +5,7 @@ package device
 import (
 	"fmt"
 	"os/exec"
+	"strconv"
 
 	log "github.com/sirupsen/logrus"
 	"golang.zx2c4.com/wireguard/device"
@@ -142,6 +143,27 @@ func (t *TunDevice) assignAddr() error {
 		log.Errorf("adding route command '%v' failed with output: %s", routeCmd.String(), out)
 		return err
 	}
+
+	if t.address.HasIPv6() {
+		return t.assignAddrV6()
+	}
+	return nil
+}
+
+// assignAddrV6 adds the IPv6 address to the tunnel interface and the route to the IPv6 network
+func (t *TunDevice) assignAddrV6() error {
+	maskSize, _ := t.address.NetworkV6.Mask.Size()
+	cmd := exec.Command("ifconfig", t.name, "inet6", t.address.IPv6.String(), "prefixlen", strconv.Itoa(maskSize), "alias")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		log.Errorf("adding address command '%v' failed with output: %s", cmd.String(), out)
+		return err
+	}
+
+	routeCmd := exec.Command("route", "add", "-inet6", "-net", t.address.NetworkV6.String(), "-interface", t.name)
+	if out, err := routeCmd.CombinedOutput(); err != nil {
+		log.Errorf("adding route command '%v' failed with output: %s", routeCmd.String(), out)
+		return err
+	}
 	return nil
 }
 
@@ -173,7 +173,13 @@ func (t *TunDevice) GetInterfaceGUIDString() (string, error) {
 func (t *TunDevice) assignAddr() error {
 	luid := winipcfg.LUID(t.nativeTunDevice.LUID())
 	log.Debugf("adding address %s to interface: %s", t.address.IP, t.name)
-	return luid.SetIPAddresses([]netip.Prefix{netip.MustParsePrefix(t.address.String())})
+
+	prefixes := []netip.Prefix{netip.MustParsePrefix(t.address.String())}
+	if t.address.HasIPv6() {
+		log.Debugf("adding address %s to interface: %s", t.address.IPv6, t.name)
+		prefixes = append(prefixes, netip.MustParsePrefix(t.address.IPv6String()))
+	}
+	return luid.SetIPAddresses(prefixes)
 }
 
 func (t *TunDevice) GetNet() *netstack.Net {
@@ -124,6 +124,23 @@ func (l *wgLink) assignAddr(address WGAddress) error {
 		return fmt.Errorf("add addr: %w", err)
 	}
 
+	if address.HasIPv6() {
+		addrV6Str := address.IPv6String()
+		log.Debugf("adding address %s to interface: %s", addrV6Str, name)
+
+		addrV6, err := netlink.ParseAddr(addrV6Str)
+		if err != nil {
+			return fmt.Errorf("parse IPv6 addr: %w", err)
+		}
+
+		err = netlink.AddrAdd(l, addrV6)
+		if os.IsExist(err) {
+			log.Infof("interface %s already has the address: %s", name, addrV6Str)
+		} else if err != nil {
+			return fmt.Errorf("add IPv6 addr: %w", err)
+		}
+	}
+
 	// On linux, the link must be brought up
 	if err := netlink.LinkSetUp(l); err != nil {
 		return fmt.Errorf("link setup: %w", err)
@@ -110,6 +110,15 @@ func (d *DefaultManager) applyPeerACLs(networkMap *mgmProto.NetworkMap) {
 			Protocol:  mgmProto.RuleProtocol_TCP,
 			Port:      strconv.Itoa(ssh.DefaultSSHPort),
 		})
+		if hasIPv6Peers(networkMap) {
+			rules = append(rules, &mgmProto.FirewallRule{
+				PeerIP:    "::",
+				Direction: mgmProto.RuleDirection_IN,
+				Action:    mgmProto.RuleAction_ACCEPT,
+				Protocol:  mgmProto.RuleProtocol_TCP,
+				Port:      strconv.Itoa(ssh.DefaultSSHPort),
+			})
+		}
 	}
 
 	// if we got empty rules list but management not set networkMap.FirewallRulesIsEmpty flag
@@ -135,7 +144,14 @@ func (d *DefaultManager) applyPeerACLs(networkMap *mgmProto.NetworkMap) {
 	newRulePairs := make(map[id.RuleID][]firewall.Rule)
 	ipsetByRuleSelectors := make(map[string]string)
 
+	ipv6Supported := d.firewall.IsIPv6Supported()
 	for _, r := range rules {
+		// peers with IPv6 overlay addresses get a rule per address family,
+		// the IPv6 one is skipped if the firewall can't filter IPv6 traffic
+		if !ipv6Supported && isIPv6Rule(r) {
+			continue
+		}
+
 		// if this rule is member of rule selection with more than DefaultIPsCountForSet
 		// it's IP address can be used in the ipset for firewall manager which supports it
 		selector := d.getRuleGroupingSelector(r)
@@ -388,6 +404,7 @@ func (d *DefaultManager) squashAcceptRules(
 			totalIPs++
 		}
 	}
+	hasIPv6 := hasIPv6Peers(networkMap)
 
 	type protoMatch map[mgmProto.RuleProtocol]map[string]int
 
@@ -418,7 +435,7 @@ func (d *DefaultManager) squashAcceptRules(
 		// special case, when we receive this all network IP address
 		// it means that rules for that protocol was already optimized on the
 		// management side
-		if r.PeerIP == "0.0.0.0" {
+		if r.PeerIP == "0.0.0.0" || r.PeerIP == "::" {
 			squashedRules = append(squashedRules, r)
 			squashedProtocols[r.Protocol] = struct{}{}
 			return
@@ -466,6 +483,15 @@ func (d *DefaultManager) squashAcceptRules(
 				Action:    mgmProto.RuleAction_ACCEPT,
 				Protocol:  protocol,
 			})
+			// the IPv6 addresses of the peers were squashed too, they need their own rule
+			if hasIPv6 {
+				squashedRules = append(squashedRules, &mgmProto.FirewallRule{
+					PeerIP:    "::",
+					Direction: direction,
+					Action:    mgmProto.RuleAction_ACCEPT,
+					Protocol:  protocol,
+				})
+			}
 			squashedProtocols[protocol] = struct{}{}
 
 			if protocol == mgmProto.RuleProtocol_ALL {
@@ -505,6 +531,24 @@ func (d *DefaultManager) squashAcceptRules(
 	return append(rules, squashedRules...), squashedProtocols
 }
 
+// hasIPv6Peers checks if any peer of the network map has an IPv6 overlay address
+func hasIPv6Peers(networkMap *mgmProto.NetworkMap) bool {
+	for _, p := range append(networkMap.RemotePeers, networkMap.OfflinePeers...) {
+		for _, allowedIP := range p.AllowedIps {
+			if prefix, err := netip.ParsePrefix(allowedIP); err == nil && prefix.Addr().Is6() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isIPv6Rule checks if the rule targets an IPv6 peer address
+func isIPv6Rule(r *mgmProto.FirewallRule) bool {
+	ip := net.ParseIP(r.PeerIP)
+	return ip != nil && ip.To4() == nil
+}
+
 // getRuleGroupingSelector takes all rule properties except IP address to build selector
 func (d *DefaultManager) getRuleGroupingSelector(rule *mgmProto.FirewallRule) string {
 	return fmt.Sprintf("%v:%v:%v:%s:%v", strconv.Itoa(int(rule.Direction)), rule.Action, rule.Protocol, rule.Port, rule.PortInfo)
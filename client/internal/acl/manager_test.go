@@ -227,6 +227,33 @@ func TestDefaultManagerSquashRules(t *testing.T) {
 	}
 }
 
+func TestDefaultManagerSquashRulesIPv6(t *testing.T) {
+	networkMap := &mgmProto.NetworkMap{
+		RemotePeers: []*mgmProto.RemotePeerConfig{
+			{AllowedIps: []string{"10.93.0.1/32", "fd00:1234::1/128"}},
+			{AllowedIps: []string{"10.93.0.2/32"}},
+		},
+	}
+	for _, ip := range []string{"10.93.0.1", "fd00:1234::1", "10.93.0.2"} {
+		networkMap.FirewallRules = append(networkMap.FirewallRules, &mgmProto.FirewallRule{
+			PeerIP:    ip,
+			Direction: mgmProto.RuleDirection_IN,
+			Action:    mgmProto.RuleAction_ACCEPT,
+			Protocol:  mgmProto.RuleProtocol_ALL,
+		})
+	}
+
+	manager := &DefaultManager{}
+	rules, _ := manager.squashAcceptRules(networkMap)
+	if len(rules) != 2 {
+		t.Fatalf("rules should contain an IPv4 and an IPv6 squashed rule, got: %v", rules)
+	}
+
+	if rules[0].PeerIP != "0.0.0.0" || rules[1].PeerIP != "::" {
+		t.Errorf("expected the squashed rules for 0.0.0.0 and ::, got: %s and %s", rules[0].PeerIP, rules[1].PeerIP)
+	}
+}
+
 func TestDefaultManagerSquashRulesNoAffect(t *testing.T) {
 	networkMap := &mgmProto.NetworkMap{
 		RemotePeers: []*mgmProto.RemotePeerConfig{
@@ -0,0 +1,203 @@
+// Package capture records decrypted packets of the WireGuard interface into pcapng files.
+package capture
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultSnapLen is the maximum number of bytes stored per packet if no snap length is set
+const DefaultSnapLen = 65535
+
+// Options configure a capture
+type Options struct {
+	// InterfaceName is stored in the capture file
+	InterfaceName string
+	Filter        Filter
+	// Duration stops the capture after the given time, 0 means no limit
+	Duration time.Duration
+	// MaxBytes stops the capture when the captured packet data reaches the given size, 0 means no limit
+	MaxBytes int64
+	// SnapLen truncates the stored packets, 0 means DefaultSnapLen
+	SnapLen uint32
+}
+
+// Stats of a capture
+type Stats struct {
+	Packets int64
+	Bytes   int64
+	// Reason the capture stopped, empty while it is running
+	Reason string
+}
+
+// Capture writes the packets matching the filter to a pcapng stream.
+// Inbound and outbound packets are stored as two interfaces of the capture file.
+type Capture struct {
+	mu       sync.Mutex
+	writer   *pcapgo.NgWriter
+	options  Options
+	inbound  int
+	outbound int
+	stats    Stats
+	stopped  bool
+	timer    *time.Timer
+	done     chan struct{}
+}
+
+// New writes the pcapng header to w and returns a running capture
+func New(w io.Writer, options Options) (*Capture, error) {
+	if err := options.Filter.Validate(); err != nil {
+		return nil, err
+	}
+	if options.SnapLen == 0 {
+		options.SnapLen = DefaultSnapLen
+	}
+
+	intf := pcapgo.NgInterface{
+		Name:                options.InterfaceName + "-in",
+		Description:         "Inbound packets of " + options.InterfaceName,
+		Filter:              options.Filter.String(),
+		OS:                  runtime.GOOS,
+		LinkType:            layers.LinkTypeRaw,
+		SnapLength:          options.SnapLen,
+		TimestampResolution: 9,
+	}
+	writer, err := pcapgo.NewNgWriterInterface(w, intf, pcapgo.NgWriterOptions{
+		SectionInfo: pcapgo.NgSectionInfo{
+			Hardware:    runtime.GOARCH,
+			OS:          runtime.GOOS,
+			Application: "NetBird",
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("write pcapng header: %w", err)
+	}
+
+	intf.Name = options.InterfaceName + "-out"
+	intf.Description = "Outbound packets of " + options.InterfaceName
+	outbound, err := writer.AddInterface(intf)
+	if err != nil {
+		return nil, fmt.Errorf("add pcapng interface: %w", err)
+	}
+
+	c := &Capture{
+		writer:   writer,
+		options:  options,
+		inbound:  0,
+		outbound: outbound,
+		done:     make(chan struct{}),
+	}
+
+	if options.Duration > 0 {
+		c.timer = time.AfterFunc(options.Duration, func() {
+			c.stop("duration reached")
+		})
+	}
+
+	return c, nil
+}
+
+// CapturePacket stores the packet if it matches the filter
+func (c *Capture) CapturePacket(packet []byte, outbound bool) {
+	if !c.options.Filter.Match(packet) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopped {
+		return
+	}
+
+	data := packet
+	if uint32(len(data)) > c.options.SnapLen {
+		data = data[:c.options.SnapLen]
+	}
+
+	intf := c.inbound
+	if outbound {
+		intf = c.outbound
+	}
+
+	ci := gopacket.CaptureInfo{
+		Timestamp:      time.Now(),
+		CaptureLength:  len(data),
+		Length:         len(packet),
+		InterfaceIndex: intf,
+	}
+	if err := c.writer.WritePacket(ci, data); err != nil {
+		log.Errorf("failed to write captured packet: %v", err)
+		if err := c.stopLocked("write error"); err != nil {
+			log.Debugf("failed to stop capture: %v", err)
+		}
+		return
+	}
+
+	c.stats.Packets++
+	c.stats.Bytes += int64(len(data))
+
+	if c.options.MaxBytes > 0 && c.stats.Bytes >= c.options.MaxBytes {
+		if err := c.stopLocked("size limit reached"); err != nil {
+			log.Errorf("failed to stop capture: %v", err)
+		}
+	}
+}
+
+// Stop stops the capture and flushes the written packets. Stopping a stopped capture is a no-op.
+func (c *Capture) Stop() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopped {
+		return nil
+	}
+	return c.stopLocked("stopped")
+}
+
+// Done is closed when the capture stops
+func (c *Capture) Done() <-chan struct{} {
+	return c.done
+}
+
+// Stats returns the number of captured packets and bytes
+func (c *Capture) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+func (c *Capture) stop(reason string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopped {
+		return
+	}
+	if err := c.stopLocked(reason); err != nil {
+		log.Errorf("failed to stop capture: %v", err)
+	}
+}
+
+func (c *Capture) stopLocked(reason string) error {
+	c.stopped = true
+	c.stats.Reason = reason
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	defer close(c.done)
+
+	if err := c.writer.Flush(); err != nil {
+		return fmt.Errorf("flush capture: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package capture
+
+import (
+	"bytes"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPacket(t *testing.T, src, dst string, protocol layers.IPProtocol, srcPort, dstPort uint16) []byte {
+	t.Helper()
+
+	var network gopacket.NetworkLayer
+	var ipLayer gopacket.SerializableLayer
+	if net.ParseIP(src).To4() != nil {
+		ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: protocol, SrcIP: net.ParseIP(src), DstIP: net.ParseIP(dst)}
+		network, ipLayer = ip, ip
+	} else {
+		ip := &layers.IPv6{Version: 6, HopLimit: 64, NextHeader: protocol, SrcIP: net.ParseIP(src), DstIP: net.ParseIP(dst)}
+		network, ipLayer = ip, ip
+	}
+
+	var transport gopacket.SerializableLayer
+	switch protocol {
+	case layers.IPProtocolTCP:
+		tcp := &layers.TCP{SrcPort: layers.TCPPort(srcPort), DstPort: layers.TCPPort(dstPort)}
+		require.NoError(t, tcp.SetNetworkLayerForChecksum(network))
+		transport = tcp
+	case layers.IPProtocolUDP:
+		udp := &layers.UDP{SrcPort: layers.UDPPort(srcPort), DstPort: layers.UDPPort(dstPort)}
+		require.NoError(t, udp.SetNetworkLayerForChecksum(network))
+		transport = udp
+	case layers.IPProtocolICMPv4:
+		transport = &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0)}
+	case layers.IPProtocolICMPv6:
+		icmp := &layers.ICMPv6{TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeEchoRequest, 0)}
+		require.NoError(t, icmp.SetNetworkLayerForChecksum(network))
+		transport = icmp
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true}
+	require.NoError(t, gopacket.SerializeLayers(buf, opts, ipLayer, transport, gopacket.Payload("payload")))
+	return buf.Bytes()
+}
+
+func TestFilter_Match(t *testing.T) {
+	tcp4 := testPacket(t, "100.64.0.1", "100.64.0.2", layers.IPProtocolTCP, 40000, 443)
+	udp4 := testPacket(t, "100.64.0.1", "10.0.0.1", layers.IPProtocolUDP, 40000, 53)
+	icmp4 := testPacket(t, "100.64.0.3", "100.64.0.1", layers.IPProtocolICMPv4, 0, 0)
+	tcp6 := testPacket(t, "fd00::1", "fd00::2", layers.IPProtocolTCP, 40000, 22)
+	icmp6 := testPacket(t, "fd00::1", "fd00::2", layers.IPProtocolICMPv6, 0, 0)
+
+	tests := []struct {
+		name    string
+		filter  Filter
+		matches [][]byte
+		misses  [][]byte
+	}{
+		{
+			name:    "empty filter",
+			matches: [][]byte{tcp4, udp4, icmp4, tcp6, icmp6, {0x00}},
+		},
+		{
+			name:    "host",
+			filter:  Filter{Prefixes: []netip.Prefix{netip.MustParsePrefix("100.64.0.2/32")}},
+			matches: [][]byte{tcp4},
+			misses:  [][]byte{udp4, icmp4, tcp6, {0x00}},
+		},
+		{
+			name:    "network",
+			filter:  Filter{Prefixes: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/64")}},
+			matches: [][]byte{udp4, tcp6, icmp6},
+			misses:  [][]byte{tcp4, icmp4},
+		},
+		{
+			name:    "protocol",
+			filter:  Filter{Protocols: []string{"icmp"}},
+			matches: [][]byte{icmp4, icmp6},
+			misses:  [][]byte{tcp4, udp4, tcp6},
+		},
+		{
+			name:    "port",
+			filter:  Filter{Ports: []uint16{53, 22}},
+			matches: [][]byte{udp4, tcp6},
+			misses:  [][]byte{tcp4, icmp4, icmp6},
+		},
+		{
+			name:    "combined",
+			filter:  Filter{Prefixes: []netip.Prefix{netip.MustParsePrefix("100.64.0.0/10")}, Protocols: []string{"tcp"}, Ports: []uint16{443}},
+			matches: [][]byte{tcp4},
+			misses:  [][]byte{udp4, icmp4, tcp6},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, packet := range tt.matches {
+				assert.True(t, tt.filter.Match(packet), "packet should match %q", tt.filter.String())
+			}
+			for _, packet := range tt.misses {
+				assert.False(t, tt.filter.Match(packet), "packet shouldn't match %q", tt.filter.String())
+			}
+		})
+	}
+}
+
+func TestFilter_String(t *testing.T) {
+	filter := Filter{
+		Prefixes:  []netip.Prefix{netip.MustParsePrefix("100.64.0.1/32"), netip.MustParsePrefix("10.0.0.0/8")},
+		Protocols: []string{"tcp"},
+		Ports:     []uint16{443},
+	}
+	assert.Equal(t, "(host 100.64.0.1 or net 10.0.0.0/8) and tcp and port 443", filter.String())
+
+	assert.Error(t, (&Filter{Protocols: []string{"sctp"}}).Validate())
+}
+
+func TestCapture(t *testing.T) {
+	var buf bytes.Buffer
+	c, err := New(&buf, Options{
+		InterfaceName: "wt0",
+		Filter:        Filter{Protocols: []string{"tcp"}},
+		SnapLen:       32,
+	})
+	require.NoError(t, err)
+
+	tcp := testPacket(t, "100.64.0.1", "100.64.0.2", layers.IPProtocolTCP, 40000, 443)
+	c.CapturePacket(tcp, true)
+	c.CapturePacket(testPacket(t, "100.64.0.1", "100.64.0.2", layers.IPProtocolUDP, 40000, 53), true)
+	c.CapturePacket(testPacket(t, "fd00::2", "fd00::1", layers.IPProtocolTCP, 443, 40000), false)
+
+	require.NoError(t, c.Stop())
+	require.NoError(t, c.Stop(), "stopping twice is a no-op")
+	c.CapturePacket(tcp, true)
+
+	stats := c.Stats()
+	assert.Equal(t, int64(2), stats.Packets)
+	assert.Equal(t, "stopped", stats.Reason)
+
+	reader, err := pcapgo.NewNgReader(&buf, pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+
+	data, ci, err := reader.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, tcp[:32], data, "packet is truncated to the snap length")
+	assert.Equal(t, len(tcp), ci.Length)
+	assert.Equal(t, 1, ci.InterfaceIndex, "outbound interface")
+
+	_, ci, err = reader.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, 0, ci.InterfaceIndex, "inbound interface")
+	intf, err := reader.Interface(0)
+	require.NoError(t, err)
+	assert.Equal(t, "wt0-in", intf.Name)
+
+	_, _, err = reader.ReadPacketData()
+	assert.Error(t, err, "only two packets were captured")
+}
+
+func TestCapture_Limits(t *testing.T) {
+	packet := testPacket(t, "100.64.0.1", "100.64.0.2", layers.IPProtocolUDP, 40000, 53)
+
+	t.Run("size", func(t *testing.T) {
+		c, err := New(&bytes.Buffer{}, Options{InterfaceName: "wt0", MaxBytes: int64(2 * len(packet))})
+		require.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			c.CapturePacket(packet, false)
+		}
+
+		select {
+		case <-c.Done():
+		default:
+			t.Fatal("capture should stop at the size limit")
+		}
+		assert.Equal(t, int64(2), c.Stats().Packets)
+		assert.Equal(t, "size limit reached", c.Stats().Reason)
+	})
+
+	t.Run("duration", func(t *testing.T) {
+		c, err := New(&bytes.Buffer{}, Options{InterfaceName: "wt0", Duration: 50 * time.Millisecond})
+		require.NoError(t, err)
+
+		select {
+		case <-c.Done():
+		case <-time.After(time.Second):
+			t.Fatal("capture should stop after the duration")
+		}
+		assert.Equal(t, "duration reached", c.Stats().Reason)
+	})
+}
@@ -0,0 +1,167 @@
+package capture
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+const (
+	protocolICMP   = 1
+	protocolTCP    = 6
+	protocolUDP    = 17
+	protocolICMPv6 = 58
+
+	ipv4MinHeaderLen = 20
+	ipv6HeaderLen    = 40
+)
+
+// Filter selects the packets to capture. Empty fields match all packets,
+// the fields are combined with AND and the values of a field with OR.
+type Filter struct {
+	// Prefixes match the source or destination address of a packet
+	Prefixes []netip.Prefix
+	// Protocols match the transport protocol of a packet: tcp, udp or icmp
+	Protocols []string
+	// Ports match the source or destination port of TCP and UDP packets
+	Ports []uint16
+}
+
+// Validate returns an error if the filter contains unknown protocols
+func (f *Filter) Validate() error {
+	for _, protocol := range f.Protocols {
+		if _, ok := protocolNumbers(protocol); !ok {
+			return fmt.Errorf("unknown protocol %q, use tcp, udp or icmp", protocol)
+		}
+	}
+	return nil
+}
+
+// String returns the filter in a BPF-like notation, it is stored in the capture file
+func (f *Filter) String() string {
+	var parts []string
+	if len(f.Prefixes) > 0 {
+		var hosts []string
+		for _, prefix := range f.Prefixes {
+			if prefix.IsSingleIP() {
+				hosts = append(hosts, "host "+prefix.Addr().String())
+				continue
+			}
+			hosts = append(hosts, "net "+prefix.String())
+		}
+		parts = append(parts, group(hosts))
+	}
+	if len(f.Protocols) > 0 {
+		parts = append(parts, group(f.Protocols))
+	}
+	if len(f.Ports) > 0 {
+		var ports []string
+		for _, port := range f.Ports {
+			ports = append(ports, fmt.Sprintf("port %d", port))
+		}
+		parts = append(parts, group(ports))
+	}
+	return strings.Join(parts, " and ")
+}
+
+func group(values []string) string {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return "(" + strings.Join(values, " or ") + ")"
+}
+
+// Match returns true if the raw IP packet matches the filter
+func (f *Filter) Match(packet []byte) bool {
+	src, dst, protocol, payload, ok := parseIPHeader(packet)
+	if !ok {
+		// capture everything that can't be parsed only if there is nothing to filter on
+		return len(f.Prefixes) == 0 && len(f.Protocols) == 0 && len(f.Ports) == 0
+	}
+
+	if len(f.Prefixes) > 0 && !f.matchPrefixes(src, dst) {
+		return false
+	}
+
+	if len(f.Protocols) > 0 && !f.matchProtocol(protocol) {
+		return false
+	}
+
+	if len(f.Ports) > 0 {
+		if protocol != protocolTCP && protocol != protocolUDP || len(payload) < 4 {
+			return false
+		}
+		srcPort := binary.BigEndian.Uint16(payload[0:2])
+		dstPort := binary.BigEndian.Uint16(payload[2:4])
+		if !slices.Contains(f.Ports, srcPort) && !slices.Contains(f.Ports, dstPort) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *Filter) matchPrefixes(src, dst netip.Addr) bool {
+	for _, prefix := range f.Prefixes {
+		if prefix.Contains(src) || prefix.Contains(dst) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *Filter) matchProtocol(protocol uint8) bool {
+	for _, name := range f.Protocols {
+		numbers, _ := protocolNumbers(name)
+		if slices.Contains(numbers, protocol) {
+			return true
+		}
+	}
+	return false
+}
+
+func protocolNumbers(name string) ([]uint8, bool) {
+	switch strings.ToLower(name) {
+	case "tcp":
+		return []uint8{protocolTCP}, true
+	case "udp":
+		return []uint8{protocolUDP}, true
+	case "icmp":
+		return []uint8{protocolICMP, protocolICMPv6}, true
+	default:
+		return nil, false
+	}
+}
+
+// parseIPHeader returns the addresses, the transport protocol and the transport payload of an IPv4 or IPv6 packet.
+// IPv6 extension headers are not followed.
+func parseIPHeader(packet []byte) (src, dst netip.Addr, protocol uint8, payload []byte, ok bool) {
+	if len(packet) == 0 {
+		return src, dst, 0, nil, false
+	}
+
+	switch packet[0] >> 4 {
+	case 4:
+		if len(packet) < ipv4MinHeaderLen {
+			return src, dst, 0, nil, false
+		}
+		headerLen := int(packet[0]&0x0f) * 4
+		if headerLen < ipv4MinHeaderLen || len(packet) < headerLen {
+			return src, dst, 0, nil, false
+		}
+		src = netip.AddrFrom4([4]byte(packet[12:16]))
+		dst = netip.AddrFrom4([4]byte(packet[16:20]))
+		return src, dst, packet[9], packet[headerLen:], true
+	case 6:
+		if len(packet) < ipv6HeaderLen {
+			return src, dst, 0, nil, false
+		}
+		src = netip.AddrFrom16([16]byte(packet[8:24]))
+		dst = netip.AddrFrom16([16]byte(packet[24:40]))
+		return src, dst, packet[6], packet[ipv6HeaderLen:], true
+	default:
+		return src, dst, 0, nil, false
+	}
+}
@@ -71,6 +71,9 @@ type ConfigInput struct {
 
 	BlockLANAccess *bool
 
+	ExitNodeKillSwitch *bool
+	ExitNodeAllowLAN   *bool
+
 	DisableNotifications *bool
 
 	DNSLabels domain.List
@@ -99,6 +102,11 @@ type Config struct {
 
 	BlockLANAccess bool
 
+	// ExitNodeKillSwitch blocks the traffic bypassing NetBird while an exit node is selected
+	ExitNodeKillSwitch bool
+	// ExitNodeAllowLAN allows the traffic to the local networks while the kill-switch is active
+	ExitNodeAllowLAN bool
+
 	DisableNotifications *bool
 
 	DNSLabels domain.List
@@ -479,6 +487,26 @@ func (config *Config) apply(input ConfigInput) (updated bool, err error) {
 		updated = true
 	}
 
+	if input.ExitNodeKillSwitch != nil && *input.ExitNodeKillSwitch != config.ExitNodeKillSwitch {
+		if *input.ExitNodeKillSwitch {
+			log.Infof("enabling exit node kill-switch")
+		} else {
+			log.Infof("disabling exit node kill-switch")
+		}
+		config.ExitNodeKillSwitch = *input.ExitNodeKillSwitch
+		updated = true
+	}
+
+	if input.ExitNodeAllowLAN != nil && *input.ExitNodeAllowLAN != config.ExitNodeAllowLAN {
+		if *input.ExitNodeAllowLAN {
+			log.Infof("allowing LAN access while the exit node kill-switch is active")
+		} else {
+			log.Infof("blocking LAN access while the exit node kill-switch is active")
+		}
+		config.ExitNodeAllowLAN = *input.ExitNodeAllowLAN
+		updated = true
+	}
+
 	if input.DisableNotifications != nil && input.DisableNotifications != config.DisableNotifications {
 		if *input.DisableNotifications {
 			log.Infof("disabling notifications")
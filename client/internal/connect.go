@@ -44,6 +44,7 @@ type ConnectClient struct {
 	engineMutex    sync.Mutex
 
 	persistNetworkMap bool
+	stateFilePath     string
 }
 
 func NewConnectClient(
@@ -266,6 +267,7 @@ func (c *ConnectClient) run(mobileDependency MobileDependency, runningChan chan
 			log.Error(err)
 			return wrapErr(err)
 		}
+		engineConfig.StateFilePath = c.stateFilePath
 
 		checks := loginResp.GetChecks()
 
@@ -331,8 +333,10 @@ func parseRelayInfo(loginResp *mgmProto.LoginResponse) ([]string, *hmac.Token) {
 	}
 
 	token := &hmac.Token{
-		Payload:   relayCfg.GetTokenPayload(),
-		Signature: relayCfg.GetTokenSignature(),
+		Payload:         relayCfg.GetTokenPayload(),
+		Signature:       relayCfg.GetTokenSignature(),
+		LimitsPayload:   relayCfg.GetLimitsTokenPayload(),
+		LimitsSignature: relayCfg.GetLimitsTokenSignature(),
 	}
 
 	return relayCfg.GetUrls(), token
@@ -388,6 +392,14 @@ func (c *ConnectClient) isContextCancelled() bool {
 	}
 }
 
+// SetStateFilePath sets the file the engine persists its state to, the default state file is used when empty.
+// It has to be set before the client runs.
+func (c *ConnectClient) SetStateFilePath(path string) {
+	c.engineMutex.Lock()
+	defer c.engineMutex.Unlock()
+	c.stateFilePath = path
+}
+
 // SetNetworkMapPersistence enables or disables network map persistence.
 // When enabled, the last received network map will be stored and can be retrieved
 // through the Engine's getLatestNetworkMap method. When disabled, any stored
@@ -431,6 +443,9 @@ func createEngineConfig(key wgtypes.Key, config *Config, peerConfig *mgmProto.Pe
 		DisableFirewall:     config.DisableFirewall,
 
 		BlockLANAccess: config.BlockLANAccess,
+
+		ExitNodeKillSwitch: config.ExitNodeKillSwitch,
+		ExitNodeAllowLAN:   config.ExitNodeAllowLAN,
 	}
 
 	if config.PreSharedKey != "" {
@@ -1,7 +1,6 @@
 package internal
 
 import (
-	"fmt"
 	"net"
 	"slices"
 	"strings"
@@ -35,27 +34,6 @@ func createPTRRecord(aRecord nbdns.SimpleRecord, ipNet *net.IPNet) (nbdns.Simple
 	}, true
 }
 
-// generateReverseZoneName creates the reverse DNS zone name for a given network
-func generateReverseZoneName(ipNet *net.IPNet) (string, error) {
-	networkIP := ipNet.IP.Mask(ipNet.Mask)
-	maskOnes, _ := ipNet.Mask.Size()
-
-	// round up to nearest byte
-	octetsToUse := (maskOnes + 7) / 8
-
-	octets := strings.Split(networkIP.String(), ".")
-	if octetsToUse > len(octets) {
-		return "", fmt.Errorf("invalid network mask size for reverse DNS: %d", maskOnes)
-	}
-
-	reverseOctets := make([]string, octetsToUse)
-	for i := 0; i < octetsToUse; i++ {
-		reverseOctets[octetsToUse-1-i] = octets[i]
-	}
-
-	return dns.Fqdn(strings.Join(reverseOctets, ".") + ".in-addr.arpa"), nil
-}
-
 // zoneExists checks if a zone with the given name already exists in the configuration
 func zoneExists(config *nbdns.Config, zoneName string) bool {
 	for _, zone := range config.CustomZones {
@@ -88,7 +66,7 @@ func collectPTRRecords(config *nbdns.Config, ipNet *net.IPNet) []nbdns.SimpleRec
 
 // addReverseZone adds a reverse DNS zone to the configuration for the given network
 func addReverseZone(config *nbdns.Config, ipNet *net.IPNet) {
-	zoneName, err := generateReverseZoneName(ipNet)
+	zoneName, err := nbdns.GetReverseZoneName(ipNet)
 	if err != nil {
 		log.Warn(err)
 		return
@@ -61,14 +61,40 @@ func (d *localResolver) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 }
 
 // lookupRecords fetches *all* DNS records matching the first question in r.
+// If there are no records for the name, the records of the closest wildcard name are returned with the queried name.
 func (d *localResolver) lookupRecords(r *dns.Msg) []dns.RR {
 	if len(r.Question) == 0 {
 		return nil
 	}
 	question := r.Question[0]
 	question.Name = strings.ToLower(question.Name)
-	key := buildRecordKey(question.Name, question.Qclass, question.Qtype)
 
+	if records := d.loadRecords(buildRecordKey(question.Name, question.Qclass, question.Qtype)); len(records) > 0 {
+		return records
+	}
+
+	labels := dns.SplitDomainName(question.Name)
+	for i := 1; i < len(labels); i++ {
+		wildcard := "*." + strings.Join(labels[i:], ".")
+		records := d.loadRecords(buildRecordKey(wildcard, question.Qclass, question.Qtype))
+		if len(records) == 0 {
+			continue
+		}
+
+		synthesized := make([]dns.RR, 0, len(records))
+		for _, record := range records {
+			rr := dns.Copy(record)
+			rr.Header().Name = question.Name
+			synthesized = append(synthesized, rr)
+		}
+		return synthesized
+	}
+
+	return nil
+}
+
+// loadRecords returns the records stored under the key and rotates them (round-robin)
+func (d *localResolver) loadRecords(key string) []dns.RR {
 	value, found := d.records.Load(key)
 	if !found {
 		return nil
@@ -1,10 +1,13 @@
 package dns
 
 import (
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	nbdns "github.com/netbirdio/netbird/dns"
 )
@@ -86,3 +89,87 @@ func TestLocalResolver_ServeDNS(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalResolver_RecordTypes(t *testing.T) {
+	records := []nbdns.SimpleRecord{
+		{Name: "internal.example.com.", Type: int(dns.TypeTXT), Class: nbdns.DefaultClass, TTL: 300, RData: `v=spf1 "quoted" -all`},
+		{Name: "internal.example.com.", Type: int(dns.TypeMX), Class: nbdns.DefaultClass, TTL: 300, RData: "10 mail.internal.example.com."},
+		{Name: "_https._tcp.internal.example.com.", Type: int(dns.TypeSRV), Class: nbdns.DefaultClass, TTL: 300, RData: "10 5 443 web.internal.example.com."},
+		{Name: "10.0.0.10.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: 300, RData: "web.internal.example.com."},
+		{Name: "*.apps.internal.example.com.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "10.0.0.10"},
+	}
+
+	resolver := &localResolver{registeredMap: make(registrationMap)}
+	for _, record := range records {
+		_, err := resolver.registerRecord(record)
+		require.NoError(t, err)
+	}
+
+	lookup := func(name string, qtype uint16) []dns.RR {
+		return resolver.lookupRecords(new(dns.Msg).SetQuestion(name, qtype))
+	}
+
+	answers := lookup("internal.example.com.", dns.TypeTXT)
+	require.Len(t, answers, 1)
+	assert.Equal(t, []string{`v=spf1 "quoted" -all`}, unpackTXT(t, answers[0]))
+
+	answers = lookup("internal.example.com.", dns.TypeMX)
+	require.Len(t, answers, 1)
+	assert.Equal(t, "mail.internal.example.com.", answers[0].(*dns.MX).Mx)
+
+	answers = lookup("_https._tcp.internal.example.com.", dns.TypeSRV)
+	require.Len(t, answers, 1)
+	assert.Equal(t, uint16(443), answers[0].(*dns.SRV).Port)
+
+	answers = lookup("10.0.0.10.in-addr.arpa.", dns.TypePTR)
+	require.Len(t, answers, 1)
+	assert.Equal(t, "web.internal.example.com.", answers[0].(*dns.PTR).Ptr)
+
+	answers = lookup("Web.Apps.internal.example.com.", dns.TypeA)
+	require.Len(t, answers, 1, "wildcard records should match subdomains")
+	assert.Equal(t, "web.apps.internal.example.com.", answers[0].Header().Name)
+	assert.Equal(t, "10.0.0.10", answers[0].(*dns.A).A.String())
+
+	answers = lookup("a.b.apps.internal.example.com.", dns.TypeA)
+	require.Len(t, answers, 1, "wildcard records should match nested subdomains")
+	assert.Equal(t, "a.b.apps.internal.example.com.", answers[0].Header().Name)
+
+	assert.Empty(t, lookup("apps.internal.example.com.", dns.TypeA), "wildcard records should not match the parent name")
+	assert.Empty(t, lookup("web.apps.internal.example.com.", dns.TypeAAAA))
+}
+
+func TestLocalResolver_LongTXTRecord(t *testing.T) {
+	text := strings.Repeat("a", 300)
+	resolver := &localResolver{registeredMap: make(registrationMap)}
+	_, err := resolver.registerRecord(nbdns.SimpleRecord{Name: "internal.example.com.", Type: int(dns.TypeTXT), Class: nbdns.DefaultClass, TTL: 300, RData: text})
+	require.NoError(t, err)
+
+	answers := resolver.lookupRecords(new(dns.Msg).SetQuestion("internal.example.com.", dns.TypeTXT))
+	require.Len(t, answers, 1)
+	txt := unpackTXT(t, answers[0])
+	require.Len(t, txt, 2, "text longer than 255 bytes should be split")
+	assert.Equal(t, text, strings.Join(txt, ""))
+}
+
+// unpackTXT returns the strings of the TXT record as they are sent on the wire
+func unpackTXT(t *testing.T, rr dns.RR) []string {
+	t.Helper()
+
+	m := new(dns.Msg).SetQuestion(rr.Header().Name, dns.TypeTXT)
+	m.Answer = []dns.RR{rr}
+	packed, err := m.Pack()
+	require.NoError(t, err)
+
+	unpacked := new(dns.Msg)
+	require.NoError(t, unpacked.Unpack(packed))
+	require.Len(t, unpacked.Answer, 1)
+
+	var txt []string
+	for _, s := range unpacked.Answer[0].(*dns.TXT).Txt {
+		// the unpacked strings are in presentation format
+		unquoted, err := strconv.Unquote(`"` + s + `"`)
+		require.NoError(t, err)
+		txt = append(txt, unquoted)
+	}
+	return txt
+}
@@ -560,12 +560,18 @@ func (s *DefaultServer) createHandlersForDomainGroup(domainGroup nsGroupsByDomai
 		}
 
 		for _, ns := range nsGroup.NameServers {
-			if ns.NSType != nbdns.UDPNameServerType {
-				log.Warnf("skipping nameserver %s with type %s, this peer supports only %s",
-					ns.IP.String(), ns.NSType.String(), nbdns.UDPNameServerType.String())
-				continue
+			switch {
+			case ns.NSType == nbdns.UDPNameServerType:
+				handler.upstreamServers = append(handler.upstreamServers, getNSHostPort(ns))
+			case ns.IsEncrypted():
+				if err := handler.addEncryptedUpstream(ns); err != nil {
+					log.Warnf("skipping nameserver %s: %v", ns.URL(), err)
+				}
+			default:
+				log.Warnf("skipping nameserver %s with type %s, this peer supports only %s, %s and %s",
+					ns.IP.String(), ns.NSType.String(), nbdns.UDPNameServerType.String(),
+					nbdns.TLSNameServerType.String(), nbdns.HTTPSNameServerType.String())
 			}
-			handler.upstreamServers = append(handler.upstreamServers, getNSHostPort(ns))
 		}
 
 		if len(handler.upstreamServers) == 0 {
@@ -771,6 +777,10 @@ func (s *DefaultServer) updateNSGroupStates(groups []*nbdns.NameServerGroup) {
 	for _, group := range groups {
 		var servers []string
 		for _, ns := range group.NameServers {
+			if ns.IsEncrypted() {
+				servers = append(servers, ns.URL())
+				continue
+			}
 			servers = append(servers, fmt.Sprintf("%s:%d", ns.IP, ns.Port))
 		}
 
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"slices"
 	"strings"
 	"sync"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/netbirdio/netbird/client/internal/peer"
 	"github.com/netbirdio/netbird/client/proto"
+	nbdns "github.com/netbirdio/netbird/dns"
 )
 
 const (
@@ -33,6 +35,8 @@ const testRecord = "com."
 
 type upstreamClient interface {
 	exchange(ctx context.Context, upstream string, r *dns.Msg) (*dns.Msg, time.Duration, error)
+	// dialer returns the platform specific dialer the encrypted clients use to connect to the upstream server
+	dialer(upstream netip.AddrPort) (*net.Dialer, error)
 }
 
 type UpstreamResolver interface {
@@ -45,6 +49,7 @@ type upstreamResolverBase struct {
 	cancel           context.CancelFunc
 	upstreamClient   upstreamClient
 	upstreamServers  []string
+	encryptedClients map[string]encryptedClient
 	domain           string
 	disabled         bool
 	failsCount       atomic.Int32
@@ -96,6 +101,34 @@ func (u *upstreamResolverBase) MatchSubdomains() bool {
 func (u *upstreamResolverBase) stop() {
 	log.Debugf("stopping serving DNS for upstreams %s", u.upstreamServers)
 	u.cancel()
+
+	for _, client := range u.encryptedClients {
+		client.close()
+	}
+}
+
+// addEncryptedUpstream adds a DNS-over-TLS or DNS-over-HTTPS nameserver to the upstream servers
+func (u *upstreamResolverBase) addEncryptedUpstream(ns nbdns.NameServer) error {
+	client, err := newEncryptedClient(ns, u.upstreamClient.dialer)
+	if err != nil {
+		return err
+	}
+
+	if u.encryptedClients == nil {
+		u.encryptedClients = make(map[string]encryptedClient)
+	}
+	upstream := ns.URL()
+	u.encryptedClients[upstream] = client
+	u.upstreamServers = append(u.upstreamServers, upstream)
+	return nil
+}
+
+// exchangeUpstream sends the query to the upstream server with its encrypted client or the platform specific client
+func (u *upstreamResolverBase) exchangeUpstream(ctx context.Context, upstream string, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	if client, ok := u.encryptedClients[upstream]; ok {
+		return client.exchange(ctx, r)
+	}
+	return u.upstreamClient.exchange(ctx, upstream, r)
 }
 
 // ServeDNS handles a DNS request
@@ -126,20 +159,23 @@ func (u *upstreamResolverBase) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		func() {
 			ctx, cancel := context.WithTimeout(u.ctx, u.upstreamTimeout)
 			defer cancel()
-			rm, t, err = u.upstreamClient.exchange(ctx, upstream, r)
+			rm, t, err = u.exchangeUpstream(ctx, upstream, r)
 		}()
 
 		if err != nil {
 			if errors.Is(err, context.DeadlineExceeded) || isTimeout(err) {
 				log.Warnf("upstream %s timed out for question domain=%s", upstream, r.Question[0].Name)
+				u.recordUpstreamFailure(upstream)
 				continue
 			}
 			log.Warnf("failed to query upstream %s for question domain=%s: %s", upstream, r.Question[0].Name, err)
+			u.recordUpstreamFailure(upstream)
 			continue
 		}
 
 		if rm == nil || !rm.Response {
 			log.Warnf("no response from upstream %s for question domain=%s", upstream, r.Question[0].Name)
+			u.recordUpstreamFailure(upstream)
 			continue
 		}
 
@@ -163,6 +199,14 @@ func (u *upstreamResolverBase) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	}
 }
 
+// recordUpstreamFailure counts the failed query for the metrics of the upstream
+func (u *upstreamResolverBase) recordUpstreamFailure(upstream string) {
+	if u.statusRecorder == nil {
+		return
+	}
+	u.statusRecorder.RecordDNSUpstreamFailure(upstream)
+}
+
 // checkUpstreamFails counts fails and disables or enables upstream resolving
 //
 // If fails count is greater that failsTillDeact, upstream resolving
@@ -333,6 +377,6 @@ func (u *upstreamResolverBase) testNameserver(server string, timeout time.Durati
 
 	r := new(dns.Msg).SetQuestion(testRecord, dns.TypeSOA)
 
-	_, _, err := u.upstreamClient.exchange(ctx, server, r)
+	_, _, err := u.exchangeUpstream(ctx, server, r)
 	return err
 }
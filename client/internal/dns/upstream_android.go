@@ -3,6 +3,7 @@ package dns
 import (
 	"context"
 	"net"
+	"net/netip"
 	"syscall"
 	"time"
 
@@ -77,6 +78,21 @@ func (u *upstreamResolver) exchangeWithoutVPN(ctx context.Context, upstream stri
 	return upstreamExchangeClient.Exchange(r, upstream)
 }
 
+// dialer protects the sockets of the local resolvers, the same way as exchangeWithoutVPN does, so the encrypted
+// connections to them don't go through the VPN
+func (u *upstreamResolver) dialer(upstream netip.AddrPort) (*net.Dialer, error) {
+	if !u.isLocalResolver(upstream.String()) {
+		return &net.Dialer{}, nil
+	}
+
+	nbDialer := nbnet.NewDialer()
+	return &net.Dialer{
+		Control: func(network, address string, c syscall.RawConn) error {
+			return nbDialer.Control(network, address, c)
+		},
+	}, nil
+}
+
 func (u *upstreamResolver) isLocalResolver(upstream string) bool {
 	if u.hostsDNSHolder.isContain(upstream) {
 		return true
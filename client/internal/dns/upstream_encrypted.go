@@ -0,0 +1,245 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/miekg/dns"
+	log "github.com/sirupsen/logrus"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+const (
+	encryptedDialTimeout  = 5 * time.Second
+	encryptedIdleTimeout  = 30 * time.Second
+	maxIdleEncryptedConns = 4
+
+	dohMediaType = "application/dns-message"
+)
+
+// dialerFunc returns the dialer used to connect to the nameserver
+type dialerFunc func(upstream netip.AddrPort) (*net.Dialer, error)
+
+// encryptedClient exchanges queries with a DNS-over-TLS or DNS-over-HTTPS nameserver and reuses its connections
+type encryptedClient interface {
+	exchange(ctx context.Context, r *dns.Msg) (*dns.Msg, time.Duration, error)
+	close()
+}
+
+func newEncryptedClient(ns nbdns.NameServer, newDialer dialerFunc) (encryptedClient, error) {
+	switch ns.NSType {
+	case nbdns.TLSNameServerType:
+		return newTLSClient(ns, newDialer), nil
+	case nbdns.HTTPSNameServerType:
+		return newHTTPSClient(ns, newDialer), nil
+	default:
+		return nil, fmt.Errorf("nameserver type %s is not encrypted", ns.NSType)
+	}
+}
+
+// newEncryptedDialer returns the platform specific dialer for the nameserver with the dial timeout of the encrypted
+// clients
+func newEncryptedDialer(newDialer dialerFunc, address netip.AddrPort) (*net.Dialer, error) {
+	dialer, err := newDialer(address)
+	if err != nil {
+		return nil, fmt.Errorf("create dialer for %s: %w", address, err)
+	}
+	dialer.Timeout = encryptedDialTimeout
+	return dialer, nil
+}
+
+// newTLSConfig verifies the certificate of the nameserver against its hostname or, if it has none, its IP address
+func newTLSConfig(ns nbdns.NameServer) *tls.Config {
+	return &tls.Config{
+		ServerName:         ns.ServerName(),
+		MinVersion:         tls.VersionTLS12,
+		ClientSessionCache: tls.NewLRUClientSessionCache(0),
+	}
+}
+
+// tlsClient sends queries over DNS-over-TLS connections, idle connections are kept for the next queries
+type tlsClient struct {
+	address   netip.AddrPort
+	newDialer dialerFunc
+	tlsConfig *tls.Config
+	idle      chan *dns.Conn
+}
+
+func newTLSClient(ns nbdns.NameServer, newDialer dialerFunc) *tlsClient {
+	return &tlsClient{
+		address:   netip.AddrPortFrom(ns.IP, uint16(ns.Port)),
+		newDialer: newDialer,
+		tlsConfig: newTLSConfig(ns),
+		idle:      make(chan *dns.Conn, maxIdleEncryptedConns),
+	}
+}
+
+func (c *tlsClient) exchange(ctx context.Context, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	conn, reused, err := c.getConn(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	client := &dns.Client{Net: "tcp-tls"}
+	rm, t, err := client.ExchangeWithConnContext(ctx, r, conn)
+	if err != nil && reused && ctx.Err() == nil {
+		// the server might have closed the idle connection, retry once with a new one
+		c.closeConn(conn)
+		if conn, err = c.dial(ctx); err != nil {
+			return nil, 0, err
+		}
+		rm, t, err = client.ExchangeWithConnContext(ctx, r, conn)
+	}
+	if err != nil {
+		c.closeConn(conn)
+		return nil, t, err
+	}
+
+	c.putConn(conn)
+	return rm, t, nil
+}
+
+func (c *tlsClient) getConn(ctx context.Context) (conn *dns.Conn, reused bool, err error) {
+	select {
+	case conn := <-c.idle:
+		return conn, true, nil
+	default:
+	}
+
+	conn, err = c.dial(ctx)
+	return conn, false, err
+}
+
+func (c *tlsClient) dial(ctx context.Context) (*dns.Conn, error) {
+	netDialer, err := newEncryptedDialer(c.newDialer, c.address)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &tls.Dialer{
+		NetDialer: netDialer,
+		Config:    c.tlsConfig,
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", c.address.String())
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", c.address, err)
+	}
+	return &dns.Conn{Conn: conn}, nil
+}
+
+func (c *tlsClient) putConn(conn *dns.Conn) {
+	select {
+	case c.idle <- conn:
+	default:
+		c.closeConn(conn)
+	}
+}
+
+func (c *tlsClient) closeConn(conn *dns.Conn) {
+	if err := conn.Close(); err != nil {
+		log.Tracef("failed to close connection to %s: %v", c.address, err)
+	}
+}
+
+func (c *tlsClient) close() {
+	for {
+		select {
+		case conn := <-c.idle:
+			c.closeConn(conn)
+		default:
+			return
+		}
+	}
+}
+
+// httpsClient sends queries as DNS-over-HTTPS POST requests, the HTTP transport keeps the connections alive
+type httpsClient struct {
+	url       string
+	transport *http.Transport
+	client    *http.Client
+}
+
+func newHTTPSClient(ns nbdns.NameServer, newDialer dialerFunc) *httpsClient {
+	address := netip.AddrPortFrom(ns.IP, uint16(ns.Port))
+
+	transport := &http.Transport{
+		// always connect to the nameserver IP, the hostname is only used to verify the certificate
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			dialer, err := newEncryptedDialer(newDialer, address)
+			if err != nil {
+				return nil, err
+			}
+			return dialer.DialContext(ctx, network, address.String())
+		},
+		TLSClientConfig:     newTLSConfig(ns),
+		TLSHandshakeTimeout: encryptedDialTimeout,
+		ForceAttemptHTTP2:   true,
+		MaxIdleConnsPerHost: maxIdleEncryptedConns,
+		IdleConnTimeout:     encryptedIdleTimeout,
+	}
+
+	return &httpsClient{
+		url:       "https://" + net.JoinHostPort(ns.ServerName(), strconv.Itoa(ns.Port)) + ns.DoHPath(),
+		transport: transport,
+		client:    &http.Client{Transport: transport},
+	}
+}
+
+func (c *httpsClient) exchange(ctx context.Context, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	start := time.Now()
+
+	// RFC 8484 recommends the ID 0 to make responses cacheable
+	query := r.Copy()
+	query.Id = 0
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, 0, fmt.Errorf("pack query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(packed))
+	if err != nil {
+		return nil, 0, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", dohMediaType)
+	req.Header.Set("Accept", dohMediaType)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, time.Since(start), err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Tracef("failed to close response body of %s: %v", c.url, err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, time.Since(start), fmt.Errorf("unexpected response status %s from %s", resp.Status, c.url)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dns.MaxMsgSize))
+	if err != nil {
+		return nil, time.Since(start), fmt.Errorf("read response: %w", err)
+	}
+
+	rm := new(dns.Msg)
+	if err := rm.Unpack(body); err != nil {
+		return nil, time.Since(start), fmt.Errorf("unpack response: %w", err)
+	}
+	rm.Id = r.Id
+
+	return rm, time.Since(start), nil
+}
+
+func (c *httpsClient) close() {
+	c.transport.CloseIdleConnections()
+}
@@ -0,0 +1,240 @@
+package dns
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+func plainDialer(netip.AddrPort) (*net.Dialer, error) {
+	return &net.Dialer{}, nil
+}
+
+func answerA(t *testing.T, r *dns.Msg) *dns.Msg {
+	t.Helper()
+
+	m := new(dns.Msg)
+	m.SetReply(r)
+	rr, err := dns.NewRR(r.Question[0].Name + " 60 IN A 192.0.2.1")
+	require.NoError(t, err)
+	m.Answer = append(m.Answer, rr)
+	return m
+}
+
+// testCertificate returns the certificate of the httptest package, valid for 127.0.0.1 and example.com
+func testCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ts.Certificate())
+	return ts.TLS.Certificates[0], pool
+}
+
+func startDoTServer(t *testing.T, cert tls.Certificate) (netip.AddrPort, *sync.Map) {
+	t.Helper()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	require.NoError(t, err)
+
+	var clients sync.Map
+	server := &dns.Server{
+		Listener: listener,
+		Net:      "tcp-tls",
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			clients.Store(w.RemoteAddr().String(), struct{}{})
+			assert.NoError(t, w.WriteMsg(answerA(t, r)))
+		}),
+	}
+	go func() {
+		_ = server.ActivateAndServe()
+	}()
+	t.Cleanup(func() {
+		_ = server.Shutdown()
+	})
+
+	return netip.MustParseAddrPort(listener.Addr().String()), &clients
+}
+
+func TestTLSClient_Exchange(t *testing.T) {
+	cert, pool := testCertificate(t)
+	address, clients := startDoTServer(t, cert)
+
+	ns := nbdns.NameServer{IP: address.Addr(), NSType: nbdns.TLSNameServerType, Port: int(address.Port())}
+	client := newTLSClient(ns, plainDialer)
+	client.tlsConfig.RootCAs = pool
+	defer client.close()
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		r := new(dns.Msg).SetQuestion("netbird.io.", dns.TypeA)
+		rm, _, err := client.exchange(ctx, r)
+		cancel()
+
+		require.NoError(t, err)
+		require.Len(t, rm.Answer, 1)
+		assert.Equal(t, "192.0.2.1", rm.Answer[0].(*dns.A).A.String())
+		assert.Equal(t, r.Id, rm.Id)
+	}
+
+	connections := 0
+	clients.Range(func(_, _ any) bool {
+		connections++
+		return true
+	})
+	assert.Equal(t, 1, connections, "the connection should be reused")
+}
+
+func TestTLSClient_VerifiesHostname(t *testing.T) {
+	cert, pool := testCertificate(t)
+	address, _ := startDoTServer(t, cert)
+
+	tests := []struct {
+		hostname string
+		wantErr  bool
+	}{
+		{hostname: "example.com"},
+		{hostname: "wrong.example.org", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.hostname, func(t *testing.T) {
+			ns := nbdns.NameServer{IP: address.Addr(), NSType: nbdns.TLSNameServerType, Port: int(address.Port()), Hostname: tt.hostname}
+			client := newTLSClient(ns, plainDialer)
+			client.tlsConfig.RootCAs = pool
+			defer client.close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_, _, err := client.exchange(ctx, new(dns.Msg).SetQuestion("netbird.io.", dns.TypeA))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestHTTPSClient_Exchange(t *testing.T) {
+	var paths sync.Map
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		paths.Store(req.URL.Path, req.Host)
+		if req.Method != http.MethodPost || req.Header.Get("Content-Type") != dohMediaType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		r := new(dns.Msg)
+		require.NoError(t, r.Unpack(body))
+		assert.Equal(t, uint16(0), r.Id, "DoH queries should use the ID 0")
+
+		packed, err := answerA(t, r).Pack()
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", dohMediaType)
+		_, err = w.Write(packed)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	host, port, err := net.SplitHostPort(ts.Listener.Addr().String())
+	require.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	ns := nbdns.NameServer{
+		IP:       netip.MustParseAddr(host),
+		NSType:   nbdns.HTTPSNameServerType,
+		Port:     portNumber,
+		Hostname: "example.com",
+		Path:     "/custom-query",
+	}
+	client := newHTTPSClient(ns, plainDialer)
+	client.transport.TLSClientConfig.RootCAs = ts.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs
+	defer client.close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	r := new(dns.Msg).SetQuestion("netbird.io.", dns.TypeA)
+	rm, _, err := client.exchange(ctx, r)
+	require.NoError(t, err)
+	require.Len(t, rm.Answer, 1)
+	assert.Equal(t, "192.0.2.1", rm.Answer[0].(*dns.A).A.String())
+	assert.Equal(t, r.Id, rm.Id, "the ID of the query should be restored")
+
+	requestHost, ok := paths.Load("/custom-query")
+	require.True(t, ok, "the query should be sent to the configured path")
+	assert.Equal(t, net.JoinHostPort("example.com", port), requestHost)
+}
+
+func TestUpstreamResolver_EncryptedUpstream(t *testing.T) {
+	cert, pool := testCertificate(t)
+	address, _ := startDoTServer(t, cert)
+
+	resolver, err := newUpstreamResolver(context.Background(), "", net.IP{}, &net.IPNet{}, nil, nil, ".")
+	require.NoError(t, err)
+	defer resolver.stop()
+
+	ns := nbdns.NameServer{IP: address.Addr(), NSType: nbdns.TLSNameServerType, Port: int(address.Port())}
+	require.NoError(t, resolver.addEncryptedUpstream(ns))
+	require.Equal(t, []string{ns.URL()}, resolver.upstreamServers)
+	resolver.encryptedClients[ns.URL()].(*tlsClient).tlsConfig.RootCAs = pool
+
+	responseWriter := &mockResponseWriter{
+		WriteMsgFunc: func(m *dns.Msg) error {
+			require.Len(t, m.Answer, 1)
+			assert.Equal(t, "192.0.2.1", m.Answer[0].(*dns.A).A.String())
+			return nil
+		},
+	}
+	resolver.ServeDNS(responseWriter, new(dns.Msg).SetQuestion("netbird.io.", dns.TypeA))
+	assert.Equal(t, int32(0), resolver.failsCount.Load())
+	assert.Equal(t, int32(1), resolver.successCount.Load())
+}
+
+func TestEncryptedClient_Dialer(t *testing.T) {
+	cert, pool := testCertificate(t)
+	address, _ := startDoTServer(t, cert)
+	ns := nbdns.NameServer{IP: address.Addr(), NSType: nbdns.TLSNameServerType, Port: int(address.Port())}
+
+	var dialed []netip.AddrPort
+	client := newTLSClient(ns, func(upstream netip.AddrPort) (*net.Dialer, error) {
+		dialed = append(dialed, upstream)
+		return &net.Dialer{}, nil
+	})
+	client.tlsConfig.RootCAs = pool
+	defer client.close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, _, err := client.exchange(ctx, new(dns.Msg).SetQuestion("netbird.io.", dns.TypeA))
+	require.NoError(t, err)
+	assert.Equal(t, []netip.AddrPort{address}, dialed, "the connection should use the platform dialer")
+
+	failing := newTLSClient(ns, func(netip.AddrPort) (*net.Dialer, error) {
+		return nil, assert.AnError
+	})
+	defer failing.close()
+	_, _, err = failing.exchange(ctx, new(dns.Msg).SetQuestion("netbird.io.", dns.TypeA))
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -5,6 +5,7 @@ package dns
 import (
 	"context"
 	"net"
+	"net/netip"
 	"time"
 
 	"github.com/miekg/dns"
@@ -37,3 +38,7 @@ func (u *upstreamResolver) exchange(ctx context.Context, upstream string, r *dns
 	upstreamExchangeClient := &dns.Client{}
 	return upstreamExchangeClient.ExchangeContext(ctx, r, upstream)
 }
+
+func (u *upstreamResolver) dialer(netip.AddrPort) (*net.Dialer, error) {
+	return &net.Dialer{}, nil
+}
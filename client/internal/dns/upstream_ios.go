@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/netip"
 	"syscall"
 	"time"
 
@@ -71,9 +72,38 @@ func (u *upstreamResolverIOS) exchange(ctx context.Context, upstream string, r *
 	return client.Exchange(r, upstream)
 }
 
+// dialer binds the encrypted connections to private upstream servers to the Netbird interface, the same way as
+// exchange does for the plain DNS queries
+func (u *upstreamResolverIOS) dialer(upstream netip.AddrPort) (*net.Dialer, error) {
+	upstreamIP := net.IP(upstream.Addr().Unmap().AsSlice())
+	if !u.lNet.Contains(upstreamIP) && !upstreamIP.IsPrivate() {
+		return &net.Dialer{}, nil
+	}
+
+	log.Debugf("using private dialer to connect to upstream: %s", upstream)
+	// Let the OS pick a free port
+	return privateDialer(&net.TCPAddr{IP: u.lIP}, u.interfaceName, 0)
+}
+
 // GetClientPrivate returns a new DNS client bound to the local IP address of the Netbird interface
 // This method is needed for iOS
 func GetClientPrivate(ip net.IP, interfaceName string, dialTimeout time.Duration) (*dns.Client, error) {
+	dialer, err := privateDialer(&net.UDPAddr{
+		IP:   ip,
+		Port: 0, // Let the OS pick a free port
+	}, interfaceName, dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &dns.Client{
+		Dialer: dialer,
+	}
+	return client, nil
+}
+
+// privateDialer returns a dialer bound to the local address and the interface
+func privateDialer(localAddr net.Addr, interfaceName string, dialTimeout time.Duration) (*net.Dialer, error) {
 	index, err := getInterfaceIndex(interfaceName)
 	if err != nil {
 		log.Debugf("unable to get interface index for %s: %s", interfaceName, err)
@@ -81,11 +111,8 @@ func GetClientPrivate(ip net.IP, interfaceName string, dialTimeout time.Duration
 	}
 
 	dialer := &net.Dialer{
-		LocalAddr: &net.UDPAddr{
-			IP:   ip,
-			Port: 0, // Let the OS pick a free port
-		},
-		Timeout: dialTimeout,
+		LocalAddr: localAddr,
+		Timeout:   dialTimeout,
 		Control: func(network, address string, c syscall.RawConn) error {
 			var operr error
 			fn := func(s uintptr) {
@@ -103,10 +130,7 @@ func GetClientPrivate(ip net.IP, interfaceName string, dialTimeout time.Duration
 			return operr
 		},
 	}
-	client := &dns.Client{
-		Dialer: dialer,
-	}
-	return client, nil
+	return dialer, nil
 }
 
 func getInterfaceIndex(interfaceName string) (int, error) {
@@ -3,6 +3,7 @@ package dns
 import (
 	"context"
 	"net"
+	"net/netip"
 	"strings"
 	"testing"
 	"time"
@@ -114,6 +115,10 @@ func (c mockUpstreamResolver) exchange(_ context.Context, _ string, _ *dns.Msg)
 	return c.r, c.rtt, c.err
 }
 
+func (c mockUpstreamResolver) dialer(netip.AddrPort) (*net.Dialer, error) {
+	return &net.Dialer{}, nil
+}
+
 func TestUpstreamResolver_DeactivationReactivation(t *testing.T) {
 	resolver := &upstreamResolverBase{
 		ctx: context.TODO(),
@@ -22,6 +22,7 @@ import (
 	"golang.zx2c4.com/wireguard/tun/netstack"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	nberrors "github.com/netbirdio/netbird/client/errors"
 	"github.com/netbirdio/netbird/client/firewall"
@@ -69,6 +70,13 @@ const (
 	PeerConnectionTimeoutMax = 45000 // ms
 	PeerConnectionTimeoutMin = 30000 // ms
 	connInitLimit            = 200
+
+	// sshLoginsQueueSize is the number of SSH logins kept until they are reported to the management service
+	sshLoginsQueueSize = 100
+	// sshSessionsQueueSize is the number of recorded SSH sessions kept until they are reported to the management service
+	sshSessionsQueueSize = 100
+	// sshLoginsReportInterval is how often the queued SSH logins and sessions are reported to the management service
+	sshLoginsReportInterval = 5 * time.Second
 )
 
 var ErrResetConnection = fmt.Errorf("reset connection")
@@ -119,6 +127,15 @@ type EngineConfig struct {
 	DisableFirewall     bool
 
 	BlockLANAccess bool
+
+	// ExitNodeKillSwitch blocks the traffic bypassing NetBird while an exit node is selected
+	ExitNodeKillSwitch bool
+	// ExitNodeAllowLAN allows the traffic to the local networks while the kill-switch is active
+	ExitNodeAllowLAN bool
+
+	// StateFilePath is the file the state to undo after an unclean shutdown is persisted to.
+	// The default state file is used when empty
+	StateFilePath string
 }
 
 // Engine is a mechanism responsible for reacting on Signal and Management stream events and managing connections to the remote peers.
@@ -166,6 +183,10 @@ type Engine struct {
 
 	sshServerFunc func(hostKeyPEM []byte, addr string) (nbssh.Server, error)
 	sshServer     nbssh.Server
+	// sshLogins queues the login attempts to the SSH server until they are reported to the management service
+	sshLogins chan *mgmProto.SSHLogin
+	// sshSessions queues the recorded SSH sessions until they are reported to the management service
+	sshSessions chan *mgmProto.SSHSession
 
 	statusRecorder *peer.Status
 
@@ -176,6 +197,11 @@ type Engine struct {
 
 	dnsServer dns.Server
 
+	// killSwitchMu guards the kill-switch state, it is updated by network map updates and exit node selections
+	killSwitchMu          sync.Mutex
+	killSwitchActive      bool
+	killSwitchUnsupported bool
+
 	// checks are the client-applied posture checks that need to be evaluated on the client
 	checks []*mgmProto.Checks
 
@@ -226,6 +252,8 @@ func NewEngine(
 		TURNs:          []*stun.URI{},
 		networkSerial:  0,
 		sshServerFunc:  nbssh.DefaultSSHServer,
+		sshLogins:      make(chan *mgmProto.SSHLogin, sshLoginsQueueSize),
+		sshSessions:    make(chan *mgmProto.SSHSession, sshSessionsQueueSize),
 		statusRecorder: statusRecorder,
 		checks:         checks,
 		connSemaphore:  semaphoregroup.NewSemaphoreGroup(connInitLimit),
@@ -241,7 +269,11 @@ func NewEngine(
 
 		engine.stateManager = statemanager.New(mobileDep.StateFilePath)
 	}
-	if path := statemanager.GetDefaultStatePath(); path != "" {
+	path := config.StateFilePath
+	if path == "" {
+		path = statemanager.GetDefaultStatePath()
+	}
+	if path != "" {
 		engine.stateManager = statemanager.New(path)
 	}
 
@@ -326,6 +358,9 @@ func (e *Engine) Start() error {
 	}
 	e.ctx, e.cancel = context.WithCancel(e.clientCtx)
 
+	go e.reportSSHLogins(e.ctx)
+	go e.reportSSHSessions(e.ctx)
+
 	wgIface, err := e.newWgIface()
 	if err != nil {
 		log.Errorf("failed creating wireguard interface instance %s: [%s]", e.config.WgIfaceName, err)
@@ -536,6 +571,11 @@ func (e *Engine) modifyPeers(peersUpdate []*mgmProto.RemotePeerConfig) error {
 			continue
 		}
 
+		if conn, ok := e.peerStore.PeerConn(peerPubKey); ok && conn.Strategy() != toConnStrategy(p.GetConnectionStrategy()) {
+			modified = append(modified, p)
+			continue
+		}
+
 		err := e.statusRecorder.UpdatePeerFQDN(peerPubKey, p.GetFqdn())
 		if err != nil {
 			log.Warnf("error updating peer's %s fqdn in the status recorder, got error: %v", peerPubKey, err)
@@ -637,8 +677,10 @@ func (e *Engine) handleSync(update *mgmProto.SyncResponse) error {
 		if relayMsg != nil {
 			// when we receive token we expect valid address list too
 			c := &auth.Token{
-				Payload:   relayMsg.GetTokenPayload(),
-				Signature: relayMsg.GetTokenSignature(),
+				Payload:         relayMsg.GetTokenPayload(),
+				Signature:       relayMsg.GetTokenSignature(),
+				LimitsPayload:   relayMsg.GetLimitsTokenPayload(),
+				LimitsSignature: relayMsg.GetLimitsTokenSignature(),
 			}
 			if err := e.relayManager.UpdateToken(c); err != nil {
 				log.Errorf("failed to update relay token: %v", err)
@@ -704,6 +746,7 @@ func (e *Engine) updateChecksIfNew(checks []*mgmProto.Checks) error {
 		e.config.DisableDNS,
 		e.config.DisableFirewall,
 	)
+	info.IPv6Supported = e.isIPv6Supported()
 
 	if err := e.mgmClient.SyncMeta(info); err != nil {
 		log.Errorf("could not sync meta: error %s", err)
@@ -741,6 +784,9 @@ func (e *Engine) updateSSH(sshConf *mgmProto.SSHConfig) error {
 				if err != nil {
 					return fmt.Errorf("create ssh server: %w", err)
 				}
+				e.sshServer.SetFeatures(toSSHFeatures(sshConf))
+				e.sshServer.SetLoginListener(e.queueSSHLogin)
+				e.sshServer.SetRecordingListener(e.queueSSHSession)
 				go func() {
 					// blocking
 					err = e.sshServer.Start()
@@ -755,6 +801,7 @@ func (e *Engine) updateSSH(sshConf *mgmProto.SSHConfig) error {
 				}()
 			} else {
 				log.Debugf("SSH server is already running")
+				e.sshServer.SetFeatures(toSSHFeatures(sshConf))
 			}
 		} else if !isNil(e.sshServer) {
 			// Disable SSH server request, so stop it if it was running
@@ -769,20 +816,132 @@ func (e *Engine) updateSSH(sshConf *mgmProto.SSHConfig) error {
 	}
 }
 
+func toSSHFeatures(sshConf *mgmProto.SSHConfig) nbssh.Features {
+	return nbssh.Features{
+		SFTP:                 sshConf.GetSftpEnabled(),
+		LocalPortForwarding:  sshConf.GetLocalPortForwardingEnabled(),
+		RemotePortForwarding: sshConf.GetRemotePortForwardingEnabled(),
+		SessionRecording:     sshConf.GetSessionRecordingEnabled(),
+	}
+}
+
+// queueSSHLogin queues a login attempt to the SSH server to be reported to the management service.
+// The login is dropped if the queue is full
+func (e *Engine) queueSSHLogin(event nbssh.LoginEvent) {
+	login := &mgmProto.SSHLogin{
+		RemotePeerPubKey: event.PeerKey,
+		LocalUser:        event.LocalUser,
+		Allowed:          event.Allowed,
+		Reason:           event.Reason,
+		Timestamp:        timestamppb.New(event.Timestamp),
+	}
+
+	select {
+	case e.sshLogins <- login:
+	default:
+		log.Warnf("dropping SSH login report of peer %s, user %s: queue is full", event.PeerKey, event.LocalUser)
+	}
+}
+
+// reportSSHLogins reports the queued SSH logins to the management service in batches until the context is done
+func (e *Engine) reportSSHLogins(ctx context.Context) {
+	ticker := time.NewTicker(sshLoginsReportInterval)
+	defer ticker.Stop()
+
+	var logins []*mgmProto.SSHLogin
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case login := <-e.sshLogins:
+			logins = append(logins, login)
+		case <-ticker.C:
+			if len(logins) == 0 {
+				continue
+			}
+			if err := e.mgmClient.ReportSSHLogins(logins); err != nil {
+				log.Warnf("failed to report %d SSH logins: %v", len(logins), err)
+				// keep the logins for the next attempt unless they pile up
+				if len(logins) < sshLoginsQueueSize {
+					continue
+				}
+			}
+			logins = nil
+		}
+	}
+}
+
+// queueSSHSession queues a recorded SSH session to be reported to the management service.
+// The session is dropped if the queue is full, the recording is kept on the peer regardless
+func (e *Engine) queueSSHSession(recording nbssh.Recording) {
+	session := &mgmProto.SSHSession{
+		RemotePeerPubKey: recording.PeerKey,
+		LocalUser:        recording.LocalUser,
+		StartedAt:        timestamppb.New(recording.StartedAt),
+		EndedAt:          timestamppb.New(recording.EndedAt),
+		RecordingID:      recording.ID,
+		Truncated:        recording.Truncated,
+	}
+
+	select {
+	case e.sshSessions <- session:
+	default:
+		log.Warnf("dropping SSH session report of recording %s: queue is full", recording.ID)
+	}
+}
+
+// reportSSHSessions reports the queued SSH sessions to the management service in batches until the context is done
+func (e *Engine) reportSSHSessions(ctx context.Context) {
+	ticker := time.NewTicker(sshLoginsReportInterval)
+	defer ticker.Stop()
+
+	var sessions []*mgmProto.SSHSession
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case session := <-e.sshSessions:
+			sessions = append(sessions, session)
+		case <-ticker.C:
+			if len(sessions) == 0 {
+				continue
+			}
+			if err := e.mgmClient.ReportSSHSessions(sessions); err != nil {
+				log.Warnf("failed to report %d SSH sessions: %v", len(sessions), err)
+				// keep the sessions for the next attempt unless they pile up
+				if len(sessions) < sshSessionsQueueSize {
+					continue
+				}
+			}
+			sessions = nil
+		}
+	}
+}
+
 func (e *Engine) updateConfig(conf *mgmProto.PeerConfig) error {
 	if e.wgInterface == nil {
 		return errors.New("wireguard interface is not initialized")
 	}
 
-	if e.wgInterface.Address().String() != conf.Address {
+	addressV6 := conf.GetAddressV6()
+	if addressV6 != "" && !e.isIPv6Supported() {
+		log.Debugf("IPv6 is not supported on this peer, skipping IPv6 address %s", addressV6)
+		addressV6 = ""
+	}
+
+	if e.wgInterface.Address().String() != conf.Address || e.wgInterface.Address().IPv6String() != addressV6 {
 		oldAddr := e.wgInterface.Address().String()
-		log.Debugf("updating peer address from %s to %s", oldAddr, conf.Address)
-		err := e.wgInterface.UpdateAddr(conf.Address)
+		newAddr := conf.Address
+		if addressV6 != "" {
+			newAddr = fmt.Sprintf("%s,%s", conf.Address, addressV6)
+		}
+		log.Debugf("updating peer address from %s to %s", oldAddr, newAddr)
+		err := e.wgInterface.UpdateAddr(newAddr)
 		if err != nil {
 			return err
 		}
 		e.config.WgAddr = conf.Address
-		log.Infof("updated peer address from %s to %s", oldAddr, conf.Address)
+		log.Infof("updated peer address from %s to %s", oldAddr, newAddr)
 	}
 
 	if conf.GetSshConfig() != nil {
@@ -803,6 +962,25 @@ func (e *Engine) updateConfig(conf *mgmProto.PeerConfig) error {
 	return nil
 }
 
+// isIPv6Supported checks if the IPv6 overlay address can be assigned to the interface.
+// The address is only configured if the firewall can filter IPv6 traffic,
+// otherwise the peer would be reachable over IPv6 without any ACL applied.
+// Without a firewall, e.g. if it is disabled or failed to start, IPv6 stays off.
+// The result is reported to the management, the other peers get the IPv6 address only if it is configured
+func (e *Engine) isIPv6Supported() bool {
+	switch runtime.GOOS {
+	case "linux", "darwin", "windows":
+	default:
+		return false
+	}
+
+	if nbnetstack.IsEnabled() {
+		return false
+	}
+
+	return e.firewall != nil && e.firewall.IsIPv6Supported()
+}
+
 // receiveManagementEvents connects to the Management Service event stream to receive updates from the management service
 // E.g. when a new peer has been registered and we are allowed to connect to it.
 func (e *Engine) receiveManagementEvents() {
@@ -821,6 +999,7 @@ func (e *Engine) receiveManagementEvents() {
 			e.config.DisableDNS,
 			e.config.DisableFirewall,
 		)
+		info.IPv6Supported = e.isIPv6Supported()
 
 		// err = e.mgmClient.Sync(info, e.handleSync)
 		err = e.mgmClient.Sync(e.ctx, info, e.handleSync)
@@ -911,6 +1090,7 @@ func (e *Engine) updateNetworkMap(networkMap *mgmProto.NetworkMap) error {
 	if err := e.routeManager.UpdateRoutes(serial, routes, dnsRouteFeatureFlag); err != nil {
 		log.Errorf("failed to update clientRoutes, err: %v", err)
 	}
+	e.UpdateKillSwitch()
 
 	log.Debugf("got peers update from Management Service, total peers to connect to = %d", len(networkMap.GetRemotePeers()))
 
@@ -941,8 +1121,12 @@ func (e *Engine) updateNetworkMap(networkMap *mgmProto.NetworkMap) error {
 
 		e.statusRecorder.FinishPeerListModifications()
 
-		// update SSHServer by adding remote peer SSH keys
+		// update SSHServer by adding remote peer SSH keys and the local users they may log in as
 		if !isNil(e.sshServer) {
+			var authorizedUsers map[string][]string
+			if networkMap.GetPeerConfig().GetSshConfig().GetAuthorizedUsersEnforced() {
+				authorizedUsers = make(map[string][]string)
+			}
 			for _, config := range networkMap.GetRemotePeers() {
 				if config.GetSshConfig() != nil && config.GetSshConfig().GetSshPubKey() != nil {
 					err := e.sshServer.AddAuthorizedKey(config.WgPubKey, string(config.GetSshConfig().GetSshPubKey()))
@@ -950,7 +1134,11 @@ func (e *Engine) updateNetworkMap(networkMap *mgmProto.NetworkMap) error {
 						log.Warnf("failed adding authorized key to SSH DefaultServer %v", err)
 					}
 				}
+				if authorizedUsers != nil {
+					authorizedUsers[config.WgPubKey] = config.GetSshConfig().GetAuthorizedUsers()
+				}
 			}
+			e.sshServer.SetAuthorizedUsers(authorizedUsers)
 		}
 	}
 
@@ -1005,12 +1193,27 @@ func toRoutes(protoRoutes []*mgmProto.Route) []*route.Route {
 			Metric:      int(protoRoute.Metric),
 			Masquerade:  protoRoute.Masquerade,
 			KeepRoute:   protoRoute.KeepRoute,
+			HealthCheck: toRouteHealthCheck(protoRoute.GetHealthCheck()),
 		}
 		routes = append(routes, convertedRoute)
 	}
 	return routes
 }
 
+func toRouteHealthCheck(protoHealthCheck *mgmProto.RouteHealthCheck) *route.HealthCheck {
+	if protoHealthCheck == nil {
+		return nil
+	}
+
+	return &route.HealthCheck{
+		Type:             route.HealthCheckType(protoHealthCheck.GetType()),
+		Target:           protoHealthCheck.GetTarget(),
+		Interval:         protoHealthCheck.GetInterval().AsDuration(),
+		Timeout:          protoHealthCheck.GetTimeout().AsDuration(),
+		FailureThreshold: int(protoHealthCheck.GetFailureThreshold()),
+	}
+}
+
 func toRouteDomains(myPubKey string, protoRoutes []*mgmProto.Route) []string {
 	if protoRoutes == nil {
 		protoRoutes = []*mgmProto.Route{}
@@ -1037,7 +1240,8 @@ func toDNSConfig(protoDNSConfig *mgmProto.DNSConfig, network *net.IPNet) nbdns.C
 
 	for _, zone := range protoDNSConfig.GetCustomZones() {
 		dnsZone := nbdns.CustomZone{
-			Domain: zone.GetDomain(),
+			Domain:               zone.GetDomain(),
+			SearchDomainDisabled: zone.GetSearchDomainDisabled(),
 		}
 		for _, record := range zone.Records {
 			dnsRecord := nbdns.SimpleRecord{
@@ -1060,9 +1264,11 @@ func toDNSConfig(protoDNSConfig *mgmProto.DNSConfig, network *net.IPNet) nbdns.C
 		}
 		for _, ns := range nsGroup.GetNameServers() {
 			dnsNS := nbdns.NameServer{
-				IP:     netip.MustParseAddr(ns.GetIP()),
-				NSType: nbdns.NameServerType(ns.GetNSType()),
-				Port:   int(ns.GetPort()),
+				IP:       netip.MustParseAddr(ns.GetIP()),
+				NSType:   nbdns.NameServerType(ns.GetNSType()),
+				Port:     int(ns.GetPort()),
+				Hostname: ns.GetHostname(),
+				Path:     ns.GetPath(),
 			}
 			dnsNSGroup.NameServers = append(dnsNSGroup.NameServers, dnsNS)
 		}
@@ -1120,7 +1326,7 @@ func (e *Engine) addNewPeer(peerConfig *mgmProto.RemotePeerConfig) error {
 		peerIPs = append(peerIPs, allowedNetIP)
 	}
 
-	conn, err := e.createPeerConn(peerKey, peerIPs)
+	conn, err := e.createPeerConn(peerKey, peerIPs, toConnStrategy(peerConfig.GetConnectionStrategy()))
 	if err != nil {
 		return fmt.Errorf("create peer connection: %w", err)
 	}
@@ -1144,7 +1350,7 @@ func (e *Engine) addNewPeer(peerConfig *mgmProto.RemotePeerConfig) error {
 	return nil
 }
 
-func (e *Engine) createPeerConn(pubKey string, allowedIPs []netip.Prefix) (*peer.Conn, error) {
+func (e *Engine) createPeerConn(pubKey string, allowedIPs []netip.Prefix, strategy peer.ConnStrategy) (*peer.Conn, error) {
 	log.Debugf("creating peer connection %s", pubKey)
 
 	wgConfig := peer.WgConfig{
@@ -1193,6 +1399,7 @@ func (e *Engine) createPeerConn(pubKey string, allowedIPs []netip.Prefix) (*peer
 			UDPMuxSrflx:          e.udpMux,
 			NATExternalIPs:       e.parseNATExternalIPMappings(),
 		},
+		Strategy: strategy,
 	}
 
 	peerConn, err := peer.NewConn(e.ctx, config, e.statusRecorder, e.signaler, e.mobileDep.IFaceDiscover, e.relayManager, e.srWatcher, e.connSemaphore)
@@ -1208,6 +1415,21 @@ func (e *Engine) createPeerConn(pubKey string, allowedIPs []netip.Prefix) (*peer
 	return peerConn, nil
 }
 
+func toConnStrategy(strategy mgmProto.RemotePeerConfig_ConnectionStrategy) peer.ConnStrategy {
+	switch strategy {
+	case mgmProto.RemotePeerConfig_RELAY_ONLY:
+		return peer.ConnStrategyRelayOnly
+	case mgmProto.RemotePeerConfig_DIRECT_ONLY:
+		return peer.ConnStrategyDirectOnly
+	case mgmProto.RemotePeerConfig_PREFER_RELAY:
+		return peer.ConnStrategyPreferRelay
+	case mgmProto.RemotePeerConfig_LAZY:
+		return peer.ConnStrategyLazy
+	default:
+		return peer.ConnStrategyDefault
+	}
+}
+
 // receiveSignalEvents connects to the Signal Service event stream to negotiate connection with remote peers
 func (e *Engine) receiveSignalEvents() {
 	go func() {
@@ -1542,6 +1764,15 @@ func (e *Engine) RunHealthProbes() bool {
 	}
 	log.Debugf("relay health check: healthy=%t", relayHealthy)
 
+	e.UpdateWireGuardStats()
+
+	allHealthy := signalHealthy && managementHealthy && relayHealthy
+	log.Debugf("all health checks completed: healthy=%t", allHealthy)
+	return allHealthy
+}
+
+// UpdateWireGuardStats refreshes the handshake and transfer stats of the peers in the status recorder
+func (e *Engine) UpdateWireGuardStats() {
 	for _, key := range e.peerStore.PeersPubKey() {
 		wgStats, err := e.wgInterface.GetStats(key)
 		if err != nil {
@@ -1553,10 +1784,6 @@ func (e *Engine) RunHealthProbes() bool {
 			log.Debugf("failed to update wg stats for peer %s: %s", key, err)
 		}
 	}
-
-	allHealthy := signalHealthy && managementHealthy && relayHealthy
-	log.Debugf("all health checks completed: healthy=%t", allHealthy)
-	return allHealthy
 }
 
 func (e *Engine) probeSTUNs() []relay.ProbeResult {
@@ -1438,8 +1438,9 @@ func startManagement(t *testing.T, dataDir, testFile string) (*grpc.Server, stri
 		return nil, "", err
 	}
 
-	secretsManager := server.NewTimeBasedAuthSecretsManager(peersUpdateManager, config.TURNConfig, config.Relay)
-	mgmtServer, err := server.NewServer(context.Background(), config, accountManager, settings.NewManager(store), peersUpdateManager, secretsManager, nil, nil, nil)
+	settingsManager := settings.NewManager(store)
+	secretsManager := server.NewTimeBasedAuthSecretsManager(peersUpdateManager, config.TURNConfig, config.Relay, settingsManager)
+	mgmtServer, err := server.NewServer(context.Background(), config, accountManager, settingsManager, peersUpdateManager, secretsManager, nil, nil, nil)
 	if err != nil {
 		return nil, "", err
 	}
@@ -1473,3 +1474,8 @@ func getPeers(e *Engine) int {
 
 	return len(e.peerStore.PeersPubKey())
 }
+
+func TestEngine_IsIPv6SupportedWithoutFirewall(t *testing.T) {
+	engine := &Engine{}
+	assert.False(t, engine.isIPv6Supported(), "IPv6 must stay disabled without a firewall filtering it")
+}
@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"errors"
+	"net/netip"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/firewall/manager"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// UpdateKillSwitch blocks the traffic bypassing NetBird while an exit node is selected and the kill-switch is enabled.
+// The block doesn't depend on the state of the exit node: while it is connected, the traffic goes through the
+// NetBird interface anyway, and while it is down the traffic must not leak to the other interfaces.
+func (e *Engine) UpdateKillSwitch() {
+	if !e.config.ExitNodeKillSwitch || e.firewall == nil || e.routeManager == nil {
+		return
+	}
+
+	e.killSwitchMu.Lock()
+	defer e.killSwitchMu.Unlock()
+
+	if e.killSwitchUnsupported {
+		return
+	}
+
+	exitNode := e.routeManager.GetSelectedExitNode()
+	if (exitNode != "") == e.killSwitchActive {
+		return
+	}
+
+	if exitNode == "" {
+		if err := e.firewall.DisableKillSwitch(); err != nil {
+			log.Errorf("failed to disable kill-switch: %v", err)
+			return
+		}
+		e.killSwitchActive = false
+		log.Infof("kill-switch disabled, no exit node selected")
+		return
+	}
+
+	var allowedNetworks []netip.Prefix
+	if e.config.ExitNodeAllowLAN {
+		prefixes, err := getInterfacePrefixes()
+		if err != nil {
+			log.Warnf("failed to get all local networks, some of them might be blocked by the kill-switch: %v", err)
+		}
+		allowedNetworks = prefixes
+	}
+
+	if err := e.firewall.EnableKillSwitch(allowedNetworks); err != nil {
+		if errors.Is(err, manager.ErrKillSwitchNotSupported) {
+			e.killSwitchUnsupported = true
+			e.statusRecorder.PublishEvent(
+				proto.SystemEvent_WARNING,
+				proto.SystemEvent_NETWORK,
+				"Kill-switch is not supported",
+				"The exit node kill-switch is not supported on this system, traffic might bypass the exit node.",
+				nil,
+			)
+		}
+		log.Errorf("failed to enable kill-switch: %v", err)
+		return
+	}
+
+	e.killSwitchActive = true
+	log.Infof("kill-switch enabled for exit node %s, allowed networks: %v", exitNode, allowedNetworks)
+}
@@ -0,0 +1,183 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/netbirdio/netbird/client/firewall/manager"
+	"github.com/netbirdio/netbird/client/internal/peer"
+)
+
+const (
+	connectionTypeICE   = "ice"
+	connectionTypeRelay = "relay"
+	connectionTypeNone  = "none"
+)
+
+// Engine is the part of the running engine the metrics are read from
+type Engine interface {
+	UpdateWireGuardStats()
+	GetFirewallManager() manager.Manager
+}
+
+// Source returns the status recorder and the running engine at collection time, both can be nil
+type Source func() (*peer.Status, Engine)
+
+// packetDropCounter is implemented by the userspace packet filter
+type packetDropCounter interface {
+	DroppedPackets() map[string]uint64
+}
+
+type clientMetrics struct {
+	source Source
+
+	peerConnected     metric.Int64ObservableGauge
+	handshakeAge      metric.Float64ObservableGauge
+	latency           metric.Float64ObservableGauge
+	receivedBytes     metric.Int64ObservableCounter
+	sentBytes         metric.Int64ObservableCounter
+	iceReconnects     metric.Int64ObservableCounter
+	routeChanges      metric.Int64ObservableCounter
+	dnsUpstreamFails  metric.Int64ObservableCounter
+	packetFilterDrops metric.Int64ObservableCounter
+}
+
+// RegisterClientMetrics registers the client metrics with the meter, the values are read from the source on every collection
+func RegisterClientMetrics(meter metric.Meter, source Source) error {
+	m := &clientMetrics{source: source}
+
+	var err error
+	m.peerConnected, err = meter.Int64ObservableGauge("netbird_peer_connected",
+		metric.WithDescription("Whether the peer is connected, the connection_type is ice for direct and relay for relayed connections"),
+	)
+	if err != nil {
+		return err
+	}
+
+	m.handshakeAge, err = meter.Float64ObservableGauge("netbird_peer_handshake_age_seconds",
+		metric.WithDescription("Time since the last WireGuard handshake with the peer"),
+	)
+	if err != nil {
+		return err
+	}
+
+	m.latency, err = meter.Float64ObservableGauge("netbird_peer_latency_seconds",
+		metric.WithDescription("Round trip time to the peer measured by ICE"),
+	)
+	if err != nil {
+		return err
+	}
+
+	m.receivedBytes, err = meter.Int64ObservableCounter("netbird_peer_received_bytes_total",
+		metric.WithDescription("Total number of bytes received from the peer through WireGuard"),
+	)
+	if err != nil {
+		return err
+	}
+
+	m.sentBytes, err = meter.Int64ObservableCounter("netbird_peer_sent_bytes_total",
+		metric.WithDescription("Total number of bytes sent to the peer through WireGuard"),
+	)
+	if err != nil {
+		return err
+	}
+
+	m.iceReconnects, err = meter.Int64ObservableCounter("netbird_peer_ice_reconnects_total",
+		metric.WithDescription("Total number of ICE connections to the peer established after the first one"),
+	)
+	if err != nil {
+		return err
+	}
+
+	m.routeChanges, err = meter.Int64ObservableCounter("netbird_route_selection_changes_total",
+		metric.WithDescription("Total number of changes of the routing peer selected for a network"),
+	)
+	if err != nil {
+		return err
+	}
+
+	m.dnsUpstreamFails, err = meter.Int64ObservableCounter("netbird_dns_upstream_failures_total",
+		metric.WithDescription("Total number of queries an upstream nameserver failed to answer"),
+	)
+	if err != nil {
+		return err
+	}
+
+	m.packetFilterDrops, err = meter.Int64ObservableCounter("netbird_packet_filter_dropped_packets_total",
+		metric.WithDescription("Total number of incoming packets dropped by the userspace packet filter"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(m.observe,
+		m.peerConnected, m.handshakeAge, m.latency, m.receivedBytes, m.sentBytes, m.iceReconnects,
+		m.routeChanges, m.dnsUpstreamFails, m.packetFilterDrops,
+	)
+	return err
+}
+
+func (m *clientMetrics) observe(_ context.Context, o metric.Observer) error {
+	recorder, engine := m.source()
+	if recorder == nil {
+		return nil
+	}
+
+	if engine != nil {
+		engine.UpdateWireGuardStats()
+		if counter, ok := engine.GetFirewallManager().(packetDropCounter); ok {
+			for reason, count := range counter.DroppedPackets() {
+				o.ObserveInt64(m.packetFilterDrops, int64(count), metric.WithAttributes(attribute.String("reason", reason)))
+			}
+		}
+	}
+
+	for _, state := range recorder.GetFullStatus().Peers {
+		m.observePeer(o, state)
+	}
+
+	for network, count := range recorder.GetRouteChanges() {
+		o.ObserveInt64(m.routeChanges, int64(count), metric.WithAttributes(attribute.String("network", network)))
+	}
+
+	for upstream, count := range recorder.GetDNSUpstreamFailures() {
+		o.ObserveInt64(m.dnsUpstreamFails, int64(count), metric.WithAttributes(attribute.String("upstream", upstream)))
+	}
+
+	return nil
+}
+
+func (m *clientMetrics) observePeer(o metric.Observer, state peer.State) {
+	peerAttrs := metric.WithAttributes(
+		attribute.String("peer", state.PubKey),
+		attribute.String("fqdn", state.FQDN),
+	)
+
+	connected := int64(0)
+	connectionType := connectionTypeNone
+	if state.ConnStatus == peer.StatusConnected {
+		connected = 1
+		connectionType = connectionTypeICE
+		if state.Relayed {
+			connectionType = connectionTypeRelay
+		}
+	}
+	o.ObserveInt64(m.peerConnected, connected, metric.WithAttributes(
+		attribute.String("peer", state.PubKey),
+		attribute.String("fqdn", state.FQDN),
+		attribute.String("connection_type", connectionType),
+	))
+
+	if !state.LastWireguardHandshake.IsZero() {
+		o.ObserveFloat64(m.handshakeAge, time.Since(state.LastWireguardHandshake).Seconds(), peerAttrs)
+	}
+	if state.Latency > 0 {
+		o.ObserveFloat64(m.latency, state.Latency.Seconds(), peerAttrs)
+	}
+	o.ObserveInt64(m.receivedBytes, state.BytesRx, peerAttrs)
+	o.ObserveInt64(m.sentBytes, state.BytesTx, peerAttrs)
+	o.ObserveInt64(m.iceReconnects, int64(state.ICEReconnects), peerAttrs)
+}
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/firewall/manager"
+	"github.com/netbirdio/netbird/client/iface/configurer"
+	"github.com/netbirdio/netbird/client/internal/peer"
+)
+
+type firewallMock struct {
+	manager.Manager
+}
+
+func (f *firewallMock) DroppedPackets() map[string]uint64 {
+	return map[string]uint64{"peer_acl": 7}
+}
+
+type engineMock struct {
+	recorder *peer.Status
+	updated  int
+}
+
+func (e *engineMock) UpdateWireGuardStats() {
+	e.updated++
+	_ = e.recorder.UpdateWireGuardPeerState("relayed-peer", configurer.WGStats{
+		LastHandshake: time.Now().Add(-30 * time.Second),
+		RxBytes:       100,
+		TxBytes:       200,
+	})
+}
+
+func (e *engineMock) GetFirewallManager() manager.Manager {
+	return &firewallMock{}
+}
+
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	m.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, m.Endpoint, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestRegisterClientMetrics(t *testing.T) {
+	recorder := peer.NewRecorder("https://mgm")
+	require.NoError(t, recorder.AddPeer("direct-peer", "direct.netbird.cloud"))
+	require.NoError(t, recorder.AddPeer("relayed-peer", "relayed.netbird.cloud"))
+	require.NoError(t, recorder.UpdatePeerICEState(peer.State{PubKey: "direct-peer", ConnStatus: peer.StatusConnected}))
+	require.NoError(t, recorder.UpdatePeerICEState(peer.State{PubKey: "direct-peer", ConnStatus: peer.StatusConnected}))
+	require.NoError(t, recorder.UpdateLatency("direct-peer", 20*time.Millisecond))
+	require.NoError(t, recorder.UpdatePeerRelayedState(peer.State{PubKey: "relayed-peer", ConnStatus: peer.StatusConnected, Relayed: true}))
+	recorder.RecordRouteChange("10.0.0.0/24")
+	recorder.RecordDNSUpstreamFailure("8.8.8.8:53")
+	recorder.RecordDNSUpstreamFailure("8.8.8.8:53")
+
+	m, err := NewServer("127.0.0.1:0")
+	require.NoError(t, err)
+
+	engine := &engineMock{recorder: recorder}
+	var running bool
+	require.NoError(t, RegisterClientMetrics(m.Meter, func() (*peer.Status, Engine) {
+		if !running {
+			return recorder, nil
+		}
+		return recorder, engine
+	}))
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `netbird_peer_connected{connection_type="ice",fqdn="direct.netbird.cloud",peer="direct-peer"} 1`)
+	assert.Contains(t, body, `netbird_peer_connected{connection_type="relay",fqdn="relayed.netbird.cloud",peer="relayed-peer"} 1`)
+	assert.Contains(t, body, `netbird_peer_ice_reconnects_total{fqdn="direct.netbird.cloud",peer="direct-peer"} 1`)
+	assert.Contains(t, body, `netbird_peer_latency_seconds{fqdn="direct.netbird.cloud",peer="direct-peer"} 0.02`)
+	assert.Contains(t, body, `netbird_route_selection_changes_total{network="10.0.0.0/24"} 1`)
+	assert.Contains(t, body, `netbird_dns_upstream_failures_total{upstream="8.8.8.8:53"} 2`)
+	assert.NotContains(t, body, "netbird_packet_filter_dropped_packets_total{", "no engine is running")
+	assert.NotContains(t, body, "netbird_peer_handshake_age_seconds{")
+
+	running = true
+	body = scrape(t, m)
+	assert.Equal(t, 1, engine.updated, "the WireGuard stats should be refreshed on collection")
+	assert.Contains(t, body, `netbird_packet_filter_dropped_packets_total{reason="peer_acl"} 7`)
+	assert.Contains(t, body, `netbird_peer_handshake_age_seconds{fqdn="relayed.netbird.cloud",peer="relayed-peer"} 30`)
+	assert.Contains(t, body, `netbird_peer_received_bytes_total{fqdn="relayed.netbird.cloud",peer="relayed-peer"} 100`)
+	assert.Contains(t, body, `netbird_peer_sent_bytes_total{fqdn="relayed.netbird.cloud",peer="relayed-peer"} 200`)
+}
@@ -0,0 +1,71 @@
+// Package metrics exposes the connection state of the client daemon in the Prometheus/OpenMetrics format.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	prometheus2 "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	api "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+const defaultEndpoint = "/metrics"
+
+// Metrics holds the metrics information and exposes it
+type Metrics struct {
+	Meter    api.Meter
+	provider *metric.MeterProvider
+	Endpoint string
+
+	*http.Server
+}
+
+// NewServer initializes and returns a new Metrics instance listening on the given address.
+// The client uses its own registry so the metrics of embedded libraries are not exposed.
+func NewServer(addr string) (*Metrics, error) {
+	registry := prometheus2.NewRegistry()
+	exporter, err := prometheus.New(prometheus.WithRegisterer(registry), prometheus.WithoutScopeInfo())
+	if err != nil {
+		return nil, err
+	}
+
+	provider := metric.NewMeterProvider(metric.WithReader(exporter))
+
+	pkg := reflect.TypeOf(Metrics{}).PkgPath()
+	meter := provider.Meter(pkg)
+
+	router := http.NewServeMux()
+	router.Handle(defaultEndpoint, promhttp.HandlerFor(
+		registry,
+		promhttp.HandlerOpts{EnableOpenMetrics: true}))
+
+	server := &http.Server{
+		Addr:    addr,
+		Handler: router,
+	}
+
+	return &Metrics{
+		Meter:    meter,
+		provider: provider,
+		Endpoint: defaultEndpoint,
+		Server:   server,
+	}, nil
+}
+
+// Shutdown stops the metrics server
+func (m *Metrics) Shutdown(ctx context.Context) error {
+	if err := m.Server.Shutdown(ctx); err != nil {
+		return fmt.Errorf("http server: %w", err)
+	}
+
+	if err := m.provider.Shutdown(ctx); err != nil {
+		return fmt.Errorf("meter provider: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package peer
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// activityListener detects the traffic to an idle peer of the lazy connection strategy. It stands in as the
+// WireGuard endpoint of the peer, so the first handshake initiation WireGuard sends because of outgoing traffic
+// reaches the listener and triggers the connection.
+type activityListener struct {
+	log  *log.Entry
+	conn *net.UDPConn
+}
+
+func newActivityListener(log *log.Entry, wgConfig WgConfig, onActivity func()) (*activityListener, error) {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		return nil, fmt.Errorf("listen: %w", err)
+	}
+
+	endpoint, ok := conn.LocalAddr().(*net.UDPAddr)
+	if !ok {
+		_ = conn.Close()
+		return nil, fmt.Errorf("unexpected listener address: %s", conn.LocalAddr())
+	}
+
+	// no keepalive, otherwise WireGuard would trigger the connection without any traffic
+	if err := wgConfig.WgInterface.UpdatePeer(wgConfig.RemoteKey, wgConfig.AllowedIps, 0, endpoint, wgConfig.PreSharedKey); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("configure WireGuard endpoint: %w", err)
+	}
+
+	l := &activityListener{
+		log:  log,
+		conn: conn,
+	}
+	go l.listen(onActivity)
+	return l, nil
+}
+
+func (l *activityListener) listen(onActivity func()) {
+	buf := make([]byte, 1500)
+	if _, _, err := l.conn.ReadFromUDP(buf); err != nil {
+		if !errors.Is(err, net.ErrClosed) {
+			l.log.Errorf("failed to read from activity listener: %v", err)
+		}
+		return
+	}
+
+	l.log.Debugf("detected traffic to idle peer")
+	onActivity()
+}
+
+// Close stops the listener without triggering the connection. The WireGuard endpoint is left for the caller to replace
+func (l *activityListener) Close() {
+	if err := l.conn.Close(); err != nil {
+		l.log.Warnf("failed to close activity listener: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package peer
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/netbirdio/netbird/client/iface/configurer"
+	"github.com/netbirdio/netbird/client/iface/wgproxy"
+)
+
+type wgIfaceMock struct {
+	endpoint  *net.UDPAddr
+	keepAlive time.Duration
+}
+
+func (m *wgIfaceMock) UpdatePeer(_ string, _ []netip.Prefix, keepAlive time.Duration, endpoint *net.UDPAddr, _ *wgtypes.Key) error {
+	m.endpoint = endpoint
+	m.keepAlive = keepAlive
+	return nil
+}
+
+func (m *wgIfaceMock) RemovePeer(string) error {
+	return nil
+}
+
+func (m *wgIfaceMock) GetStats(string) (configurer.WGStats, error) {
+	return configurer.WGStats{}, nil
+}
+
+func (m *wgIfaceMock) GetProxy() wgproxy.Proxy {
+	return nil
+}
+
+func TestActivityListener(t *testing.T) {
+	wgIface := &wgIfaceMock{keepAlive: defaultWgKeepAlive}
+	wgConfig := WgConfig{
+		RemoteKey:   connConf.Key,
+		WgInterface: wgIface,
+		AllowedIps:  []netip.Prefix{netip.MustParsePrefix("100.64.0.10/32")},
+	}
+
+	activity := make(chan struct{}, 1)
+	listener, err := newActivityListener(log.WithField("peer", connConf.Key), wgConfig, func() {
+		activity <- struct{}{}
+	})
+	require.NoError(t, err)
+	defer listener.Close()
+
+	require.NotNil(t, wgIface.endpoint, "the listener should be the WireGuard endpoint of the peer")
+	assert.True(t, wgIface.endpoint.IP.IsLoopback())
+	assert.Zero(t, wgIface.keepAlive, "keepalives would trigger the connection without traffic")
+
+	conn, err := net.DialUDP("udp4", nil, wgIface.endpoint)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("handshake initiation"))
+	require.NoError(t, err)
+
+	select {
+	case <-activity:
+	case <-time.After(5 * time.Second):
+		t.Fatal("traffic to the peer should trigger the connection")
+	}
+}
+
+func TestActivityListener_Close(t *testing.T) {
+	wgConfig := WgConfig{
+		RemoteKey:   connConf.Key,
+		WgInterface: &wgIfaceMock{},
+		AllowedIps:  []netip.Prefix{netip.MustParsePrefix("100.64.0.10/32")},
+	}
+
+	activity := make(chan struct{}, 1)
+	listener, err := newActivityListener(log.WithField("peer", connConf.Key), wgConfig, func() {
+		activity <- struct{}{}
+	})
+	require.NoError(t, err)
+	listener.Close()
+
+	select {
+	case <-activity:
+		t.Fatal("closing the listener should not trigger the connection")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
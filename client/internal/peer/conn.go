@@ -80,6 +80,9 @@ type ConnConfig struct {
 
 	// ICEConfig ICE protocol configuration
 	ICEConfig icemaker.Config
+
+	// Strategy defines which connection types are used with the remote peer
+	Strategy ConnStrategy
 }
 
 type Conn struct {
@@ -114,6 +117,9 @@ type Conn struct {
 
 	guard     *guard.Guard
 	semaphore *semaphoregroup.SemaphoreGroup
+
+	// activity is set while the connection of the lazy strategy waits for traffic
+	activity *activityListener
 }
 
 // NewConn creates a new not opened Conn to the remote peer.
@@ -151,9 +157,11 @@ func NewConn(engineCtx context.Context, config ConnConfig, statusRecorder *Statu
 
 	conn.handshaker = NewHandshaker(ctx, connLog, config, signaler, conn.workerICE, conn.workerRelay)
 
-	conn.handshaker.AddOnNewOfferListener(conn.workerRelay.OnNewOffer)
-	if os.Getenv("NB_FORCE_RELAY") != "true" {
-		conn.handshaker.AddOnNewOfferListener(conn.workerICE.OnNewOffer)
+	if config.Strategy.usesRelay() {
+		conn.handshaker.AddOnNewOfferListener(conn.workerRelay.OnNewOffer)
+	}
+	if os.Getenv("NB_FORCE_RELAY") != "true" && config.Strategy.usesICE() {
+		conn.handshaker.AddOnNewOfferListener(conn.onICEOffer)
 	}
 
 	conn.guard = guard.NewGuard(connLog, ctrl, conn.isConnectedOnAllWay, config.Timeout, srWatcher)
@@ -165,10 +173,12 @@ func NewConn(engineCtx context.Context, config ConnConfig, statusRecorder *Statu
 
 // Open opens connection to the remote peer
 // It will try to establish a connection using ICE and in parallel with relay. The higher priority connection type will
-// be used.
+// be used. With the lazy strategy the connection is established once traffic to the peer or an offer from it shows up.
 func (conn *Conn) Open() {
-	conn.semaphore.Add(conn.ctx)
-	conn.log.Debugf("open connection to peer")
+	if conn.config.Strategy != ConnStrategyLazy {
+		conn.semaphore.Add(conn.ctx)
+	}
+	conn.log.Debugf("open connection to peer with %s strategy", conn.config.Strategy)
 
 	conn.mu.Lock()
 	defer conn.mu.Unlock()
@@ -185,10 +195,55 @@ func (conn *Conn) Open() {
 	if err != nil {
 		conn.log.Warnf("error while updating the state err: %v", err)
 	}
+	if err := conn.statusRecorder.UpdatePeerConnStrategy(conn.config.Key, conn.config.Strategy); err != nil {
+		conn.log.Warnf("error while updating the connection strategy err: %v", err)
+	}
+
+	if conn.config.Strategy == ConnStrategyLazy {
+		conn.waitForActivity()
+		return
+	}
 
 	go conn.startHandshakeAndReconnect(conn.ctx)
 }
 
+// waitForActivity defers the connection of the lazy strategy until traffic to the peer shows up.
+// The caller must hold the lock.
+func (conn *Conn) waitForActivity() {
+	listener, err := newActivityListener(conn.log, conn.config.WgConfig, conn.activate)
+	if err != nil {
+		conn.log.Errorf("failed to wait for traffic to the peer, connect right away: %v", err)
+		go conn.connectLazily()
+		return
+	}
+
+	conn.log.Infof("wait for traffic to the peer before connecting")
+	conn.activity = listener
+}
+
+// activate starts the connection of an idle peer of the lazy strategy. It does nothing if the connection is not
+// idle anymore.
+func (conn *Conn) activate() {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+
+	if conn.ctx.Err() != nil || conn.activity == nil {
+		return
+	}
+
+	conn.log.Infof("activity detected, open the lazy connection")
+	conn.activity.Close()
+	conn.activity = nil
+
+	go conn.connectLazily()
+}
+
+// connectLazily starts the deferred connection of the lazy strategy once the semaphore admits it
+func (conn *Conn) connectLazily() {
+	conn.semaphore.Add(conn.ctx)
+	conn.startHandshakeAndReconnect(conn.ctx)
+}
+
 func (conn *Conn) startHandshakeAndReconnect(ctx context.Context) {
 	defer conn.semaphore.Done(conn.ctx)
 	conn.waitInitialRandomSleepTime(ctx)
@@ -210,6 +265,11 @@ func (conn *Conn) Close() {
 	conn.log.Infof("close peer connection")
 	conn.ctxCancel()
 
+	if conn.activity != nil {
+		conn.activity.Close()
+		conn.activity = nil
+	}
+
 	if !conn.opened {
 		conn.log.Debugf("ignore close connection to peer")
 		return
@@ -279,9 +339,18 @@ func (conn *Conn) SetOnDisconnected(handler func(remotePeer string)) {
 
 func (conn *Conn) OnRemoteOffer(offer OfferAnswer) bool {
 	conn.log.Debugf("OnRemoteOffer, on status ICE: %s, status Relay: %s", conn.statusICE, conn.statusRelay)
+	if conn.config.Strategy == ConnStrategyLazy {
+		// the remote peer has traffic for us
+		conn.activate()
+	}
 	return conn.handshaker.OnRemoteOffer(offer)
 }
 
+// Strategy returns the connection strategy used with the remote peer
+func (conn *Conn) Strategy() ConnStrategy {
+	return conn.config.Strategy
+}
+
 // WgConfig returns the WireGuard config
 func (conn *Conn) WgConfig() WgConfig {
 	return conn.config.WgConfig
@@ -371,7 +440,7 @@ func (conn *Conn) onICEConnectionIsReady(priority ConnPriority, iceConnInfo ICEC
 	conn.doOnConnected(iceConnInfo.RosenpassPubKey, iceConnInfo.RosenpassAddr)
 }
 
-func (conn *Conn) onICEStateDisconnected() {
+func (conn *Conn) onICEStateDisconnected(reason string) {
 	conn.mu.Lock()
 	defer conn.mu.Unlock()
 
@@ -413,6 +482,7 @@ func (conn *Conn) onICEStateDisconnected() {
 		ConnStatus:       conn.evalStatus(),
 		Relayed:          conn.isRelayed(),
 		ConnStatusUpdate: time.Now(),
+		reason:           reason,
 	}
 
 	err := conn.statusRecorder.UpdatePeerICEStateToDisconnected(peerState)
@@ -473,7 +543,7 @@ func (conn *Conn) onRelayConnectionIsReady(rci RelayConnInfo) {
 	conn.doOnConnected(rci.rosenpassPubKey, rci.rosenpassAddr)
 }
 
-func (conn *Conn) onRelayDisconnected() {
+func (conn *Conn) onRelayDisconnected(reason string) {
 	conn.mu.Lock()
 	defer conn.mu.Unlock()
 
@@ -506,12 +576,22 @@ func (conn *Conn) onRelayDisconnected() {
 		ConnStatus:       conn.evalStatus(),
 		Relayed:          conn.isRelayed(),
 		ConnStatusUpdate: time.Now(),
+		reason:           reason,
 	}
 	if err := conn.statusRecorder.UpdatePeerRelayedStateToDisconnected(peerState); err != nil {
 		conn.log.Warnf("unable to save peer's state to Relay disconnected, got error: %v", err)
 	}
 }
 
+// onICEOffer starts ICE for the offer unless the relay is preferred and can be used with the remote peer
+func (conn *Conn) onICEOffer(remoteOfferAnswer *OfferAnswer) {
+	if conn.config.Strategy == ConnStrategyPreferRelay && conn.workerRelay.isRelaySupported(remoteOfferAnswer) {
+		conn.log.Debugf("relay is preferred and supported by the remote peer, skip ICE")
+		return
+	}
+	conn.workerICE.OnNewOffer(remoteOfferAnswer)
+}
+
 func (conn *Conn) listenGuardEvent(ctx context.Context) {
 	for {
 		select {
@@ -580,6 +660,7 @@ func (conn *Conn) setStatusToDisconnected() {
 		ConnStatus:       StatusDisconnected,
 		ConnStatusUpdate: time.Now(),
 		Mux:              new(sync.RWMutex),
+		reason:           reasonConnectionClosed,
 	}
 	err := conn.statusRecorder.UpdatePeerState(peerState)
 	if err != nil {
@@ -649,6 +730,16 @@ func (conn *Conn) isConnectedOnAllWay() (connected bool) {
 		}
 	}()
 
+	switch {
+	case conn.config.Strategy == ConnStrategyRelayOnly:
+		return conn.statusRelay.Get() == StatusConnected
+	case conn.config.Strategy == ConnStrategyDirectOnly:
+		return conn.statusICE.Get() != StatusDisconnected
+	case conn.config.Strategy == ConnStrategyPreferRelay && conn.workerRelay.IsRelayConnectionSupportedWithPeer():
+		// ICE is not started while the relay can be used
+		return conn.statusRelay.Get() == StatusConnected
+	}
+
 	if conn.statusICE.Get() == StatusDisconnected {
 		return false
 	}
@@ -0,0 +1,152 @@
+package peer
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// historySampleLimit bounds the samples kept per peer, an hour at the sample interval of the daemon
+	historySampleLimit = 360
+	// historyEventLimit bounds the connection events kept per peer
+	historyEventLimit = 100
+)
+
+const (
+	reasonICEConnected      = "ICE connection established"
+	reasonRelayConnected    = "relay connection established"
+	reasonICEDisconnected   = "ICE connection lost"
+	reasonRelayDisconnected = "relay connection lost"
+	reasonConnectionUpdated = "connection state updated"
+
+	// reasons of the connection changes reported by the conn state transitions
+	reasonICEFailed        = "ICE connection failed"
+	reasonICEChecksTimeout = "ICE connectivity checks timed out"
+	reasonRelayClosed      = "relay server connection closed"
+	reasonHandshakeTimeout = "WireGuard handshake timed out"
+	reasonConnectionClosed = "connection closed locally"
+)
+
+// HistorySample is a point of the connection quality time series of a peer
+type HistorySample struct {
+	Timestamp  time.Time
+	ConnStatus ConnStatus
+	Relayed    bool
+	Latency    time.Duration
+	BytesRx    int64
+	BytesTx    int64
+}
+
+// ConnectionEvent records a change of the connection status or the connection type of a peer
+type ConnectionEvent struct {
+	Timestamp time.Time
+	// ConnStatus and Relayed describe the connection after the change
+	ConnStatus ConnStatus
+	Relayed    bool
+	Reason     string
+}
+
+// PeerHistory is the bounded connection history of a peer, oldest entries first
+type PeerHistory struct {
+	PubKey  string
+	FQDN    string
+	IP      string
+	Samples []HistorySample
+	Events  []ConnectionEvent
+}
+
+type peerHistory struct {
+	samples []HistorySample
+	events  []ConnectionEvent
+}
+
+// RecordPeerHistory adds a sample of the current connection quality of every peer to its history
+func (d *Status) RecordPeerHistory() {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	now := time.Now()
+	for key, state := range d.peers {
+		history := d.getOrCreateHistory(key)
+		history.samples = appendBounded(history.samples, HistorySample{
+			Timestamp:  now,
+			ConnStatus: state.ConnStatus,
+			Relayed:    state.Relayed,
+			Latency:    state.Latency,
+			BytesRx:    state.BytesRx,
+			BytesTx:    state.BytesTx,
+		}, historySampleLimit)
+	}
+}
+
+// GetPeerHistory returns the connection history of all peers ordered by FQDN
+func (d *Status) GetPeerHistory() []PeerHistory {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	histories := make([]PeerHistory, 0, len(d.history))
+	for key, history := range d.history {
+		state := d.peers[key]
+		histories = append(histories, PeerHistory{
+			PubKey:  key,
+			FQDN:    state.FQDN,
+			IP:      state.IP,
+			Samples: append([]HistorySample(nil), history.samples...),
+			Events:  append([]ConnectionEvent(nil), history.events...),
+		})
+	}
+	sort.Slice(histories, func(i, j int) bool {
+		if histories[i].FQDN != histories[j].FQDN {
+			return histories[i].FQDN < histories[j].FQDN
+		}
+		return histories[i].PubKey < histories[j].PubKey
+	})
+	return histories
+}
+
+// recordConnectionEvent adds an event to the history of the peer if its connection status or type changed.
+// The caller must hold the status lock.
+func (d *Status) recordConnectionEvent(previous, current State, reason string) {
+	// the connection type only matters while connected
+	if previous.ConnStatus == current.ConnStatus && (current.ConnStatus != StatusConnected || previous.Relayed == current.Relayed) {
+		return
+	}
+
+	timestamp := current.ConnStatusUpdate
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	history := d.getOrCreateHistory(current.PubKey)
+	history.events = appendBounded(history.events, ConnectionEvent{
+		Timestamp:  timestamp,
+		ConnStatus: current.ConnStatus,
+		Relayed:    current.Relayed,
+		Reason:     reason,
+	}, historyEventLimit)
+}
+
+// eventReason returns the reason the conn reported with the state, or the fallback of the status update
+func eventReason(received State, fallback string) string {
+	if received.reason != "" {
+		return received.reason
+	}
+	return fallback
+}
+
+func (d *Status) getOrCreateHistory(key string) *peerHistory {
+	history, ok := d.history[key]
+	if !ok {
+		history = &peerHistory{}
+		d.history[key] = history
+	}
+	return history
+}
+
+func appendBounded[T any](entries []T, entry T, limit int) []T {
+	entries = append(entries, entry)
+	if len(entries) > limit {
+		entries = append(entries[:0], entries[len(entries)-limit:]...)
+	}
+	return entries
+}
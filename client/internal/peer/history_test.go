@@ -0,0 +1,82 @@
+package peer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus_ConnectionEvents(t *testing.T) {
+	key := "abc"
+	status := NewRecorder("https://mgm")
+	require.NoError(t, status.AddPeer(key, "abc.netbird"))
+
+	require.NoError(t, status.UpdatePeerRelayedState(State{PubKey: key, ConnStatus: StatusConnected, Relayed: true}))
+	require.NoError(t, status.UpdatePeerICEState(State{PubKey: key, ConnStatus: StatusConnected}))
+	// a repeated state is not an event
+	require.NoError(t, status.UpdatePeerICEState(State{PubKey: key, ConnStatus: StatusConnected}))
+	require.NoError(t, status.UpdatePeerICEStateToDisconnected(State{PubKey: key, ConnStatus: StatusConnected, Relayed: true}))
+	require.NoError(t, status.UpdatePeerRelayedStateToDisconnected(State{PubKey: key, ConnStatus: StatusDisconnected}))
+
+	histories := status.GetPeerHistory()
+	require.Len(t, histories, 1)
+	assert.Equal(t, "abc.netbird", histories[0].FQDN)
+
+	var reasons []string
+	for _, event := range histories[0].Events {
+		reasons = append(reasons, event.Reason)
+	}
+	assert.Equal(t, []string{reasonRelayConnected, reasonICEConnected, reasonICEDisconnected, reasonRelayDisconnected}, reasons)
+	assert.True(t, histories[0].Events[2].Relayed, "the ICE loss should switch the connection to the relay")
+	assert.Equal(t, StatusDisconnected, histories[0].Events[3].ConnStatus)
+}
+
+func TestStatus_ConnectionEventReasons(t *testing.T) {
+	key := "abc"
+	status := NewRecorder("https://mgm")
+	require.NoError(t, status.AddPeer(key, "abc.netbird"))
+
+	require.NoError(t, status.UpdatePeerICEState(State{PubKey: key, ConnStatus: StatusConnected}))
+	require.NoError(t, status.UpdatePeerICEStateToDisconnected(State{PubKey: key, ConnStatus: StatusDisconnected, reason: reasonICEFailed}))
+	require.NoError(t, status.UpdatePeerRelayedState(State{PubKey: key, ConnStatus: StatusConnected, Relayed: true}))
+	require.NoError(t, status.UpdatePeerRelayedStateToDisconnected(State{PubKey: key, ConnStatus: StatusDisconnected, reason: reasonHandshakeTimeout}))
+	require.NoError(t, status.UpdatePeerRelayedState(State{PubKey: key, ConnStatus: StatusConnected, Relayed: true}))
+	require.NoError(t, status.UpdatePeerState(State{PubKey: key, ConnStatus: StatusDisconnected, reason: reasonConnectionClosed}))
+
+	histories := status.GetPeerHistory()
+	require.Len(t, histories, 1)
+
+	var reasons []string
+	for _, event := range histories[0].Events {
+		reasons = append(reasons, event.Reason)
+	}
+	assert.Equal(t, []string{
+		reasonICEConnected,
+		reasonICEFailed,
+		reasonRelayConnected,
+		reasonHandshakeTimeout,
+		reasonRelayConnected,
+		reasonConnectionClosed,
+	}, reasons)
+}
+
+func TestStatus_RecordPeerHistory(t *testing.T) {
+	key := "abc"
+	status := NewRecorder("https://mgm")
+	require.NoError(t, status.AddPeer(key, "abc.netbird"))
+	require.NoError(t, status.UpdateLatency(key, 15*time.Millisecond))
+
+	for i := 0; i < historySampleLimit+10; i++ {
+		status.RecordPeerHistory()
+	}
+
+	histories := status.GetPeerHistory()
+	require.Len(t, histories, 1)
+	assert.Len(t, histories[0].Samples, historySampleLimit, "the samples should be bounded")
+	assert.Equal(t, 15*time.Millisecond, histories[0].Samples[0].Latency)
+
+	require.NoError(t, status.RemovePeer(key))
+	assert.Empty(t, status.GetPeerHistory(), "the history of removed peers should be dropped")
+}
@@ -51,7 +51,13 @@ type State struct {
 	BytesRx                    int64
 	Latency                    time.Duration
 	RosenpassEnabled           bool
-	routes                     map[string]struct{}
+	ConnStrategy               ConnStrategy
+	// ICEReconnects counts the ICE connections established after the first one
+	ICEReconnects  int
+	routes         map[string]struct{}
+	iceEstablished bool
+	// reason describes why the connection changed, it is recorded in the connection history only
+	reason string
 }
 
 // AddRoute add a single route to routes map
@@ -130,6 +136,18 @@ type NSGroupState struct {
 	Error   error
 }
 
+// RouteHealthState represents the result of the health probes run through a routing peer of a network
+type RouteHealthState struct {
+	Network   string
+	PeerKey   string
+	Type      string
+	Target    string
+	Healthy   bool
+	Chosen    bool
+	LastCheck time.Time
+	Error     error
+}
+
 // FullStatus contains the full state held by the Status instance
 type FullStatus struct {
 	Peers           []State
@@ -139,6 +157,7 @@ type FullStatus struct {
 	RosenpassState  RosenpassState
 	Relays          []relay.ProbeResult
 	NSGroupStates   []NSGroupState
+	RouteHealth     []RouteHealthState
 }
 
 // Status holds a state of peers, signal, management connections and relays
@@ -160,6 +179,10 @@ type Status struct {
 	rosenpassPermissive   bool
 	nsGroupStates         []NSGroupState
 	resolvedDomainsStates map[domain.Domain]ResolvedDomainInfo
+	routeHealthStates     map[string][]RouteHealthState
+	routeChanges          map[string]uint64
+	dnsUpstreamFailures   map[string]uint64
+	history               map[string]*peerHistory
 
 	// To reduce the number of notification invocation this bool will be true when need to call the notification
 	// Some Peer actions mostly used by in a batch when the network map has been synchronized. In these type of events
@@ -184,6 +207,10 @@ func NewRecorder(mgmAddress string) *Status {
 		notifier:              newNotifier(),
 		mgmAddress:            mgmAddress,
 		resolvedDomainsStates: map[domain.Domain]ResolvedDomainInfo{},
+		routeHealthStates:     map[string][]RouteHealthState{},
+		routeChanges:          map[string]uint64{},
+		dnsUpstreamFailures:   map[string]uint64{},
+		history:               map[string]*peerHistory{},
 	}
 }
 
@@ -246,6 +273,7 @@ func (d *Status) RemovePeer(peerPubKey string) error {
 	}
 
 	delete(d.peers, peerPubKey)
+	delete(d.history, peerPubKey)
 	d.peerListChangedForNotification = true
 	return nil
 }
@@ -266,6 +294,7 @@ func (d *Status) UpdatePeerState(receivedState State) error {
 
 	skipNotification := shouldSkipNotify(receivedState.ConnStatus, peerState)
 
+	previous := peerState
 	if receivedState.ConnStatus != peerState.ConnStatus {
 		peerState.ConnStatus = receivedState.ConnStatus
 		peerState.ConnStatusUpdate = receivedState.ConnStatusUpdate
@@ -277,6 +306,7 @@ func (d *Status) UpdatePeerState(receivedState State) error {
 		peerState.RelayServerAddress = receivedState.RelayServerAddress
 		peerState.RosenpassEnabled = receivedState.RosenpassEnabled
 	}
+	d.recordConnectionEvent(previous, peerState, eventReason(receivedState, reasonConnectionUpdated))
 
 	d.peers[receivedState.PubKey] = peerState
 
@@ -337,6 +367,7 @@ func (d *Status) UpdatePeerICEState(receivedState State) error {
 
 	skipNotification := shouldSkipNotify(receivedState.ConnStatus, peerState)
 
+	previous := peerState
 	peerState.ConnStatus = receivedState.ConnStatus
 	peerState.ConnStatusUpdate = receivedState.ConnStatusUpdate
 	peerState.Relayed = receivedState.Relayed
@@ -346,6 +377,12 @@ func (d *Status) UpdatePeerICEState(receivedState State) error {
 	peerState.RemoteIceCandidateEndpoint = receivedState.RemoteIceCandidateEndpoint
 	peerState.RosenpassEnabled = receivedState.RosenpassEnabled
 
+	if peerState.iceEstablished {
+		peerState.ICEReconnects++
+	}
+	peerState.iceEstablished = true
+	d.recordConnectionEvent(previous, peerState, eventReason(receivedState, reasonICEConnected))
+
 	d.peers[receivedState.PubKey] = peerState
 
 	if skipNotification {
@@ -368,11 +405,13 @@ func (d *Status) UpdatePeerRelayedState(receivedState State) error {
 
 	skipNotification := shouldSkipNotify(receivedState.ConnStatus, peerState)
 
+	previous := peerState
 	peerState.ConnStatus = receivedState.ConnStatus
 	peerState.ConnStatusUpdate = receivedState.ConnStatusUpdate
 	peerState.Relayed = receivedState.Relayed
 	peerState.RelayServerAddress = receivedState.RelayServerAddress
 	peerState.RosenpassEnabled = receivedState.RosenpassEnabled
+	d.recordConnectionEvent(previous, peerState, eventReason(receivedState, reasonRelayConnected))
 
 	d.peers[receivedState.PubKey] = peerState
 
@@ -396,10 +435,12 @@ func (d *Status) UpdatePeerRelayedStateToDisconnected(receivedState State) error
 
 	skipNotification := shouldSkipNotify(receivedState.ConnStatus, peerState)
 
+	previous := peerState
 	peerState.ConnStatus = receivedState.ConnStatus
 	peerState.Relayed = receivedState.Relayed
 	peerState.ConnStatusUpdate = receivedState.ConnStatusUpdate
 	peerState.RelayServerAddress = ""
+	d.recordConnectionEvent(previous, peerState, eventReason(receivedState, reasonRelayDisconnected))
 
 	d.peers[receivedState.PubKey] = peerState
 
@@ -423,6 +464,7 @@ func (d *Status) UpdatePeerICEStateToDisconnected(receivedState State) error {
 
 	skipNotification := shouldSkipNotify(receivedState.ConnStatus, peerState)
 
+	previous := peerState
 	peerState.ConnStatus = receivedState.ConnStatus
 	peerState.Relayed = receivedState.Relayed
 	peerState.ConnStatusUpdate = receivedState.ConnStatusUpdate
@@ -430,6 +472,7 @@ func (d *Status) UpdatePeerICEStateToDisconnected(receivedState State) error {
 	peerState.RemoteIceCandidateType = receivedState.RemoteIceCandidateType
 	peerState.LocalIceCandidateEndpoint = receivedState.LocalIceCandidateEndpoint
 	peerState.RemoteIceCandidateEndpoint = receivedState.RemoteIceCandidateEndpoint
+	d.recordConnectionEvent(previous, peerState, eventReason(receivedState, reasonICEDisconnected))
 
 	d.peers[receivedState.PubKey] = peerState
 
@@ -618,6 +661,32 @@ func (d *Status) UpdateDNSStates(dnsStates []NSGroupState) {
 	d.nsGroupStates = dnsStates
 }
 
+// UpdateRouteHealthStates replaces the health probe results of the network, an empty list removes them
+func (d *Status) UpdateRouteHealthStates(network string, states []RouteHealthState) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if len(states) == 0 {
+		delete(d.routeHealthStates, network)
+		return
+	}
+	d.routeHealthStates[network] = states
+}
+
+// RecordRouteChange counts a change of the routing peer selected for the network
+func (d *Status) RecordRouteChange(network string) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.routeChanges[network]++
+}
+
+// RecordDNSUpstreamFailure counts a query the upstream nameserver failed to answer
+func (d *Status) RecordDNSUpstreamFailure(upstream string) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.dnsUpstreamFailures[upstream]++
+}
+
 func (d *Status) UpdateResolvedDomainsStates(originalDomain domain.Domain, resolvedDomain domain.Domain, prefixes []netip.Prefix) {
 	d.mux.Lock()
 	defer d.mux.Unlock()
@@ -676,6 +745,19 @@ func (d *Status) UpdateLatency(pubKey string, latency time.Duration) error {
 	return nil
 }
 
+// UpdatePeerConnStrategy sets the connection strategy used with the peer
+func (d *Status) UpdatePeerConnStrategy(pubKey string, strategy ConnStrategy) error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	peerState, ok := d.peers[pubKey]
+	if !ok {
+		return errors.New("peer doesn't exist")
+	}
+	peerState.ConnStrategy = strategy
+	d.peers[pubKey] = peerState
+	return nil
+}
+
 // IsLoginRequired determines if a peer's login has expired.
 func (d *Status) IsLoginRequired() bool {
 	d.mux.Lock()
@@ -729,7 +811,8 @@ func (d *Status) GetRelayStates() []relay.ProbeResult {
 	}
 
 	relayState := relay.ProbeResult{
-		URI: instanceAddr,
+		URI:            instanceAddr,
+		ThrottledUntil: d.relayMgr.ThrottledUntil(),
 	}
 	return append(relayStates, relayState)
 }
@@ -742,6 +825,34 @@ func (d *Status) GetDNSStates() []NSGroupState {
 	return slices.Clone(d.nsGroupStates)
 }
 
+// GetRouteHealthStates returns the health probe results of all networks ordered by network
+func (d *Status) GetRouteHealthStates() []RouteHealthState {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	networks := maps.Keys(d.routeHealthStates)
+	slices.Sort(networks)
+	var states []RouteHealthState
+	for _, network := range networks {
+		states = append(states, d.routeHealthStates[network]...)
+	}
+	return states
+}
+
+// GetRouteChanges returns the number of routing peer selection changes by network
+func (d *Status) GetRouteChanges() map[string]uint64 {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	return maps.Clone(d.routeChanges)
+}
+
+// GetDNSUpstreamFailures returns the number of failed queries by upstream nameserver
+func (d *Status) GetDNSUpstreamFailures() map[string]uint64 {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	return maps.Clone(d.dnsUpstreamFailures)
+}
+
 func (d *Status) GetResolvedDomainsStates() map[domain.Domain]ResolvedDomainInfo {
 	d.mux.Lock()
 	defer d.mux.Unlock()
@@ -756,6 +867,7 @@ func (d *Status) GetFullStatus() FullStatus {
 		Relays:          d.GetRelayStates(),
 		RosenpassState:  d.GetRosenpassState(),
 		NSGroupStates:   d.GetDNSStates(),
+		RouteHealth:     d.GetRouteHealthStates(),
 	}
 
 	d.mux.Lock()
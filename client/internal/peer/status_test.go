@@ -58,6 +58,22 @@ func TestUpdatePeerState(t *testing.T) {
 	assert.Equal(t, ip, state.IP, "ip should be equal")
 }
 
+func TestUpdatePeerICEState_CountsReconnects(t *testing.T) {
+	key := "abc"
+	status := NewRecorder("https://mgm")
+	err := status.AddPeer(key, "abc.netbird")
+	assert.NoError(t, err, "shouldn't return error")
+
+	connected := State{PubKey: key, ConnStatus: StatusConnected}
+	assert.NoError(t, status.UpdatePeerICEState(connected))
+	assert.NoError(t, status.UpdatePeerICEStateToDisconnected(State{PubKey: key, ConnStatus: StatusDisconnected}))
+	assert.NoError(t, status.UpdatePeerICEState(connected))
+
+	state, err := status.GetPeer(key)
+	assert.NoError(t, err, "shouldn't return error on getting peer")
+	assert.Equal(t, 1, state.ICEReconnects, "only the second ICE connection should be counted")
+}
+
 func TestStatus_UpdatePeerFQDN(t *testing.T) {
 	key := "abc"
 	fqdn := "peer-a.netbird.local"
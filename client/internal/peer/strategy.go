@@ -0,0 +1,46 @@
+package peer
+
+import "fmt"
+
+// ConnStrategy defines which connection types are used with a remote peer
+type ConnStrategy int
+
+const (
+	// ConnStrategyDefault connects with ICE and the relay in parallel and prefers the direct connection
+	ConnStrategyDefault ConnStrategy = iota
+	// ConnStrategyRelayOnly connects through the relay only
+	ConnStrategyRelayOnly
+	// ConnStrategyDirectOnly connects with ICE without TURN candidates and never through the relay
+	ConnStrategyDirectOnly
+	// ConnStrategyPreferRelay connects through the relay and uses ICE only when the relay is not available with the peer
+	ConnStrategyPreferRelay
+	// ConnStrategyLazy connects only when traffic to the peer or an offer from the peer shows up
+	ConnStrategyLazy
+)
+
+func (s ConnStrategy) String() string {
+	switch s {
+	case ConnStrategyDefault:
+		return "default"
+	case ConnStrategyRelayOnly:
+		return "relay-only"
+	case ConnStrategyDirectOnly:
+		return "direct-only"
+	case ConnStrategyPreferRelay:
+		return "prefer-relay"
+	case ConnStrategyLazy:
+		return "lazy"
+	default:
+		return fmt.Sprintf("ConnStrategy(%d)", s)
+	}
+}
+
+// usesICE reports whether ICE may be used to connect to the peer
+func (s ConnStrategy) usesICE() bool {
+	return s != ConnStrategyRelayOnly
+}
+
+// usesRelay reports whether the relay may be used to connect to the peer
+func (s ConnStrategy) usesRelay() bool {
+	return s != ConnStrategyDirectOnly
+}
@@ -0,0 +1,30 @@
+package peer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnStrategy(t *testing.T) {
+	tests := []struct {
+		strategy  ConnStrategy
+		name      string
+		usesICE   bool
+		usesRelay bool
+	}{
+		{ConnStrategyDefault, "default", true, true},
+		{ConnStrategyRelayOnly, "relay-only", false, true},
+		{ConnStrategyDirectOnly, "direct-only", true, false},
+		{ConnStrategyPreferRelay, "prefer-relay", true, true},
+		{ConnStrategyLazy, "lazy", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.name, tt.strategy.String())
+			assert.Equal(t, tt.usesICE, tt.strategy.usesICE())
+			assert.Equal(t, tt.usesRelay, tt.strategy.usesRelay())
+		})
+	}
+}
@@ -87,7 +87,7 @@ func (w *WorkerICE) OnNewOffer(remoteOfferAnswer *OfferAnswer) {
 	}
 
 	var preferredCandidateTypes []ice.CandidateType
-	if w.hasRelayOnLocally && remoteOfferAnswer.RelaySrvAddress != "" {
+	if w.config.Strategy == ConnStrategyDirectOnly || (w.hasRelayOnLocally && remoteOfferAnswer.RelaySrvAddress != "") {
 		preferredCandidateTypes = icemaker.CandidateTypesP2P()
 	} else {
 		preferredCandidateTypes = icemaker.CandidateTypes()
@@ -215,7 +215,11 @@ func (w *WorkerICE) reCreateAgent(agentCancel context.CancelFunc, candidates []i
 		case ice.ConnectionStateFailed, ice.ConnectionStateDisconnected:
 			if w.lastKnownState != ice.ConnectionStateDisconnected {
 				w.lastKnownState = ice.ConnectionStateDisconnected
-				w.conn.onICEStateDisconnected()
+				reason := reasonICEChecksTimeout
+				if state == ice.ConnectionStateFailed {
+					reason = reasonICEFailed
+				}
+				w.conn.onICEStateDisconnected(reason)
 			}
 			w.closeAgent(agentCancel)
 		default:
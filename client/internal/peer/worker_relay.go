@@ -128,7 +128,7 @@ func (w *WorkerRelay) onWGDisconnected() {
 	_ = w.relayedConn.Close()
 	w.relayLock.Unlock()
 
-	w.conn.onRelayDisconnected()
+	w.conn.onRelayDisconnected(reasonHandshakeTimeout)
 }
 
 func (w *WorkerRelay) isRelaySupported(answer *OfferAnswer) bool {
@@ -147,5 +147,5 @@ func (w *WorkerRelay) preferredRelayServer(myRelayAddress, remoteRelayAddress st
 
 func (w *WorkerRelay) onRelayClientDisconnected() {
 	w.wgWatcher.DisableWgWatcher()
-	go w.conn.onRelayDisconnected()
+	go w.conn.onRelayDisconnected(reasonRelayClosed)
 }
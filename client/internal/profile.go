@@ -0,0 +1,180 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/netbirdio/netbird/client/internal/statemanager"
+)
+
+const (
+	// DefaultProfileName is the name of the profile stored in the daemon config file
+	DefaultProfileName = "default"
+
+	profilesDirName      = "profiles"
+	activeProfileFile    = "active_profile"
+	profileConfigFileExt = ".json"
+	profileStateFileExt  = ".state.json"
+)
+
+var profileNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,31}$`)
+
+// ErrProfileNotFound is returned when the requested profile doesn't exist
+var ErrProfileNotFound = errors.New("profile not found")
+
+// ProfileManager manages the named profiles of the client daemon.
+// Every profile has its own config file and thus its own management URL, WireGuard and SSH keys and settings,
+// and its own state file with the DNS, route and firewall changes to undo after an unclean shutdown.
+// The default profile uses the daemon config and state files, other profiles are stored next to them in the
+// profiles directories.
+type ProfileManager struct {
+	defaultConfigPath string
+	defaultStatePath  string
+}
+
+// NewProfileManager returns a profile manager for the profiles of the given daemon config file
+func NewProfileManager(defaultConfigPath string) *ProfileManager {
+	return &ProfileManager{
+		defaultConfigPath: defaultConfigPath,
+		defaultStatePath:  statemanager.GetDefaultStatePath(),
+	}
+}
+
+// ValidateProfileName checks if the name can be used as a profile name
+func ValidateProfileName(name string) error {
+	if !profileNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q, only up to 32 letters, digits, '-' and '_' are allowed", name)
+	}
+	return nil
+}
+
+// ConfigPath returns the path of the config file of the profile
+func (pm *ProfileManager) ConfigPath(name string) string {
+	if name == DefaultProfileName {
+		return pm.defaultConfigPath
+	}
+	return filepath.Join(pm.profilesDir(), name+profileConfigFileExt)
+}
+
+// StatePath returns the path of the state file of the profile. It is empty if the state can't be persisted
+func (pm *ProfileManager) StatePath(name string) string {
+	if name == DefaultProfileName || pm.defaultStatePath == "" {
+		return pm.defaultStatePath
+	}
+	return filepath.Join(filepath.Dir(pm.defaultStatePath), profilesDirName, name+profileStateFileExt)
+}
+
+// Exists checks if the profile exists. The default profile always exists
+func (pm *ProfileManager) Exists(name string) bool {
+	if name == DefaultProfileName {
+		return true
+	}
+	return fileExists(pm.ConfigPath(name))
+}
+
+// ListProfiles returns the names of all profiles sorted by name, the default profile comes first
+func (pm *ProfileManager) ListProfiles() ([]string, error) {
+	profiles := []string{DefaultProfileName}
+
+	entries, err := os.ReadDir(pm.profilesDir())
+	if errors.Is(err, os.ErrNotExist) {
+		return profiles, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read profiles dir: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != profileConfigFileExt {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), profileConfigFileExt)
+		if ValidateProfileName(name) != nil || name == DefaultProfileName {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return append(profiles, names...), nil
+}
+
+// AddProfile creates the config of a new profile with its own keys
+func (pm *ProfileManager) AddProfile(name, managementURL string) (*Config, error) {
+	if err := ValidateProfileName(name); err != nil {
+		return nil, err
+	}
+	if pm.Exists(name) {
+		return nil, fmt.Errorf("profile %s already exists", name)
+	}
+
+	return UpdateOrCreateConfig(ConfigInput{
+		ConfigPath:    pm.ConfigPath(name),
+		ManagementURL: managementURL,
+	})
+}
+
+// RemoveProfile deletes the config of the profile. The default and the active profile can't be removed
+func (pm *ProfileManager) RemoveProfile(name string) error {
+	if name == DefaultProfileName {
+		return fmt.Errorf("the default profile can't be removed")
+	}
+	if !pm.Exists(name) {
+		return ErrProfileNotFound
+	}
+	if pm.ActiveProfile() == name {
+		return fmt.Errorf("profile %s is active, switch to another profile first", name)
+	}
+
+	if err := os.Remove(pm.ConfigPath(name)); err != nil {
+		return fmt.Errorf("remove profile config: %w", err)
+	}
+	// the state is cleaned up when switching away from the profile, only the file can be left
+	if path := pm.StatePath(name); path != "" {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("remove profile state: %w", err)
+		}
+	}
+	return nil
+}
+
+// ActiveProfile returns the name of the active profile, it falls back to the default profile
+// if no profile has been selected or the selected profile doesn't exist anymore
+func (pm *ProfileManager) ActiveProfile() string {
+	data, err := os.ReadFile(pm.activeProfilePath())
+	if err != nil {
+		return DefaultProfileName
+	}
+
+	name := strings.TrimSpace(string(data))
+	if ValidateProfileName(name) != nil || !pm.Exists(name) {
+		return DefaultProfileName
+	}
+	return name
+}
+
+// SetActiveProfile persists the active profile, so the daemon starts with it after a restart
+func (pm *ProfileManager) SetActiveProfile(name string) error {
+	if !pm.Exists(name) {
+		return ErrProfileNotFound
+	}
+
+	if err := os.WriteFile(pm.activeProfilePath(), []byte(name+"\n"), 0600); err != nil {
+		return fmt.Errorf("write active profile: %w", err)
+	}
+	return nil
+}
+
+func (pm *ProfileManager) profilesDir() string {
+	return filepath.Join(filepath.Dir(pm.defaultConfigPath), profilesDirName)
+}
+
+func (pm *ProfileManager) activeProfilePath() string {
+	return filepath.Join(filepath.Dir(pm.defaultConfigPath), activeProfileFile)
+}
@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileManager(t *testing.T) {
+	defaultConfigPath := filepath.Join(t.TempDir(), "config.json")
+	pm := NewProfileManager(defaultConfigPath)
+
+	profiles, err := pm.ListProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{DefaultProfileName}, profiles)
+	assert.Equal(t, DefaultProfileName, pm.ActiveProfile())
+	assert.Equal(t, defaultConfigPath, pm.ConfigPath(DefaultProfileName))
+
+	workConfig, err := pm.AddProfile("work", "https://work.example.com:443")
+	require.NoError(t, err)
+	assert.Equal(t, "https://work.example.com:443", workConfig.ManagementURL.String())
+
+	homeConfig, err := pm.AddProfile("home", "")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultManagementURL, homeConfig.ManagementURL.String())
+	assert.NotEqual(t, workConfig.PrivateKey, homeConfig.PrivateKey, "every profile should have its own keys")
+	assert.NotEqual(t, workConfig.SSHKey, homeConfig.SSHKey, "every profile should have its own SSH key")
+
+	_, err = pm.AddProfile("work", "")
+	assert.Error(t, err, "adding an existing profile should fail")
+
+	_, err = pm.AddProfile("../work", "")
+	assert.Error(t, err, "adding a profile with an invalid name should fail")
+
+	profiles, err = pm.ListProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{DefaultProfileName, "home", "work"}, profiles)
+
+	require.NoError(t, pm.SetActiveProfile("work"))
+	assert.Equal(t, "work", pm.ActiveProfile())
+	assert.Equal(t, "work", NewProfileManager(defaultConfigPath).ActiveProfile(), "active profile should be persisted")
+
+	assert.ErrorIs(t, pm.SetActiveProfile("missing"), ErrProfileNotFound)
+	assert.Error(t, pm.RemoveProfile("work"), "removing the active profile should fail")
+	assert.Error(t, pm.RemoveProfile(DefaultProfileName), "removing the default profile should fail")
+	assert.ErrorIs(t, pm.RemoveProfile("missing"), ErrProfileNotFound)
+
+	require.NoError(t, pm.RemoveProfile("home"))
+	assert.False(t, pm.Exists("home"))
+
+	require.NoError(t, pm.SetActiveProfile(DefaultProfileName))
+	require.NoError(t, pm.RemoveProfile("work"))
+	assert.Equal(t, DefaultProfileName, pm.ActiveProfile())
+}
+
+func TestProfileManager_StatePath(t *testing.T) {
+	dir := t.TempDir()
+	pm := NewProfileManager(filepath.Join(dir, "config.json"))
+	pm.defaultStatePath = filepath.Join(dir, "state.json")
+
+	_, err := pm.AddProfile("work", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, pm.defaultStatePath, pm.StatePath(DefaultProfileName))
+	workStatePath := pm.StatePath("work")
+	assert.Equal(t, filepath.Join(dir, profilesDirName, "work"+profileStateFileExt), workStatePath)
+
+	// the state file must not show up as a profile
+	require.NoError(t, os.WriteFile(workStatePath, []byte("{}"), 0600))
+	profiles, err := pm.ListProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{DefaultProfileName, "work"}, profiles)
+
+	require.NoError(t, pm.RemoveProfile("work"))
+	assert.NoFileExists(t, workStatePath, "the state file should be removed with the profile")
+
+	pm.defaultStatePath = ""
+	assert.Empty(t, pm.StatePath("work"), "no state is persisted without a state file")
+}
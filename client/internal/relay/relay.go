@@ -20,6 +20,8 @@ type ProbeResult struct {
 	URI  string
 	Err  error
 	Addr string
+	// ThrottledUntil is the time until the relay server drops the messages of the client because of its rate limits
+	ThrottledUntil time.Time
 }
 
 // ProbeSTUN tries binding to the given STUN uri and acquiring an address
@@ -16,6 +16,7 @@ import (
 	"github.com/netbirdio/netbird/client/internal/peerstore"
 	"github.com/netbirdio/netbird/client/internal/routemanager/dnsinterceptor"
 	"github.com/netbirdio/netbird/client/internal/routemanager/dynamic"
+	"github.com/netbirdio/netbird/client/internal/routemanager/healthcheck"
 	"github.com/netbirdio/netbird/client/internal/routemanager/iface"
 	"github.com/netbirdio/netbird/client/internal/routemanager/refcounter"
 	"github.com/netbirdio/netbird/client/internal/routemanager/static"
@@ -36,12 +37,16 @@ const (
 	reasonRouteUpdate
 	reasonPeerUpdate
 	reasonShutdown
+	reasonHealthCheck
 )
 
 type routerPeerStatus struct {
 	connected bool
 	relayed   bool
 	latency   time.Duration
+	unhealthy bool
+	// lastCheck is the time the health of the routing peer was last probed
+	lastCheck time.Time
 }
 
 type routesUpdate struct {
@@ -70,6 +75,10 @@ type clientNetwork struct {
 	currentChosen       *route.Route
 	handler             RouteHandler
 	updateSerial        uint64
+	health              map[route.ID]*routeHealth
+	healthResults       chan healthResult
+	probing             *healthProbe
+	probe               probeFunc
 }
 
 func newClientNetworkWatcher(
@@ -95,6 +104,9 @@ func newClientNetworkWatcher(
 		routePeersNotifiers: make(map[string]chan struct{}),
 		routeUpdate:         make(chan routesUpdate),
 		peerStateUpdate:     make(chan struct{}),
+		health:              make(map[route.ID]*routeHealth),
+		healthResults:       make(chan healthResult),
+		probe:               healthcheck.Probe,
 		handler: handlerFromRoute(
 			rt,
 			routeRefCounter,
@@ -122,6 +134,12 @@ func (c *clientNetwork) getRouterPeerStatuses() map[route.ID]routerPeerStatus {
 			connected: peerStatus.ConnStatus == peer.StatusConnected,
 			relayed:   peerStatus.Relayed,
 			latency:   peerStatus.Latency,
+			unhealthy: c.isUnhealthy(r.ID),
+		}
+		if state, ok := c.health[r.ID]; ok {
+			status := routePeerStatuses[r.ID]
+			status.lastCheck = state.lastCheck
+			routePeerStatuses[r.ID] = status
 		}
 	}
 	return routePeerStatuses
@@ -133,6 +151,9 @@ func (c *clientNetwork) getRouterPeerStatuses() map[route.ID]routerPeerStatus {
 //
 // It follows these prioritization rules:
 // * Connected peers: Only routes with connected peers are considered.
+// * Health: Routes whose health checks fail are skipped unless no connected route is healthy.
+// In that case the route that was probed the longest time ago is chosen, so the routes take turns
+// until one of them passes its health check again.
 // * Metric: Routes with lower metrics (better) are prioritized.
 // * Non-relayed: Routes without relays are preferred.
 // * Latency: Routes with lower latency are prioritized.
@@ -150,6 +171,19 @@ func (c *clientNetwork) getBestRouteFromStatuses(routePeerStatuses map[route.ID]
 		currID = c.currentChosen.ID
 	}
 
+	var anyHealthy bool
+	for _, peerStatus := range routePeerStatuses {
+		if peerStatus.connected && !peerStatus.unhealthy {
+			anyHealthy = true
+			break
+		}
+	}
+	if !anyHealthy {
+		if id := c.getLeastRecentlyProbedRoute(routePeerStatuses); id != "" {
+			return id
+		}
+	}
+
 	for _, r := range c.routes {
 		tempScore := float64(0)
 		peerStatus, found := routePeerStatuses[r.ID]
@@ -157,6 +191,10 @@ func (c *clientNetwork) getBestRouteFromStatuses(routePeerStatuses map[route.ID]
 			continue
 		}
 
+		if peerStatus.unhealthy && anyHealthy {
+			continue
+		}
+
 		if r.Metric < route.MaxMetric {
 			metricDiff := route.MaxMetric - r.Metric
 			tempScore = float64(metricDiff) * 10
@@ -223,6 +261,26 @@ func (c *clientNetwork) getBestRouteFromStatuses(routePeerStatuses map[route.ID]
 	return chosen
 }
 
+// getLeastRecentlyProbedRoute returns the connected unhealthy route whose health was probed the longest time ago
+func (c *clientNetwork) getLeastRecentlyProbedRoute(routePeerStatuses map[route.ID]routerPeerStatus) route.ID {
+	var chosen route.ID
+	var chosenCheck time.Time
+	for _, r := range c.routes {
+		peerStatus, found := routePeerStatuses[r.ID]
+		if !found || !peerStatus.connected || !peerStatus.unhealthy {
+			continue
+		}
+		if chosen == "" || peerStatus.lastCheck.Before(chosenCheck) || (peerStatus.lastCheck.Equal(chosenCheck) && r.ID < chosen) {
+			chosen = r.ID
+			chosenCheck = peerStatus.lastCheck
+		}
+	}
+	if chosen != "" && (c.currentChosen == nil || c.currentChosen.ID != chosen) {
+		log.Infof("No routing peer passes its health check for network [%v], trying route %s with peer %s", c.handler, chosen, c.routes[chosen].Peer)
+	}
+	return chosen
+}
+
 func (c *clientNetwork) watchPeerStatusChanges(ctx context.Context, peerKey string, peerStateUpdate chan struct{}, closer chan struct{}) {
 	for {
 		select {
@@ -295,6 +353,9 @@ func (c *clientNetwork) recalculateRouteAndUpdatePeerAndSystem(rsn reason) error
 			return fmt.Errorf("remove route for peer %s: %w", c.currentChosen.Peer, err)
 		}
 
+		if c.currentChosen != nil {
+			c.statusRecorder.RecordRouteChange(c.handler.String())
+		}
 		c.currentChosen = nil
 
 		return nil
@@ -320,6 +381,9 @@ func (c *clientNetwork) recalculateRouteAndUpdatePeerAndSystem(rsn reason) error
 		}
 	}
 
+	if c.currentChosen == nil || c.currentChosen.ID != newChosenID {
+		c.statusRecorder.RecordRouteChange(c.handler.String())
+	}
 	c.currentChosen = c.routes[newChosenID]
 
 	if err := c.handler.AddAllowedIPs(c.currentChosen.Peer); err != nil {
@@ -397,7 +461,7 @@ func (c *clientNetwork) disconnectEvent(rsn reason) {
 	case reasonRouteUpdate:
 		severity = proto.SystemEvent_INFO
 		message = "Default route updated due to configuration change"
-	case reasonPeerUpdate:
+	case reasonPeerUpdate, reasonHealthCheck:
 		severity = proto.SystemEvent_WARNING
 		message = "Default route disconnected due to peer unreachability"
 		userMessage = "Exit node connection lost. Your internet access might be affected."
@@ -454,10 +518,15 @@ func (c *clientNetwork) handleUpdate(update routesUpdate) bool {
 // peersStateAndUpdateWatcher is the main point of reacting on client network routing events.
 // All the processing related to the client network should be done here. Thread-safe.
 func (c *clientNetwork) peersStateAndUpdateWatcher() {
+	healthTicker := time.NewTicker(healthCheckTick)
+	defer healthTicker.Stop()
+
 	for {
 		select {
 		case <-c.ctx.Done():
 			log.Debugf("Stopping watcher for network [%v]", c.handler)
+			c.stopHealthProbe()
+			c.statusRecorder.UpdateRouteHealthStates(c.handler.String(), nil)
 			if err := c.removeRouteFromPeerAndSystem(reasonShutdown); err != nil {
 				log.Errorf("Failed to remove routes for [%v]: %v", c.handler, err)
 			}
@@ -467,6 +536,17 @@ func (c *clientNetwork) peersStateAndUpdateWatcher() {
 			if err != nil {
 				log.Errorf("Failed to recalculate routes for network [%v]: %v", c.handler, err)
 			}
+			c.publishHealthStates()
+		case <-healthTicker.C:
+			c.startHealthProbe()
+		case result := <-c.healthResults:
+			if !c.handleHealthResult(result) {
+				continue
+			}
+			if err := c.recalculateRouteAndUpdatePeerAndSystem(reasonHealthCheck); err != nil {
+				log.Errorf("Failed to recalculate routes for network [%v]: %v", c.handler, err)
+			}
+			c.publishHealthStates()
 		case update := <-c.routeUpdate:
 			if update.updateSerial < c.updateSerial {
 				log.Warnf("Received a routes update with smaller serial number (%d -> %d), ignoring it", c.updateSerial, update.updateSerial)
@@ -479,6 +559,7 @@ func (c *clientNetwork) peersStateAndUpdateWatcher() {
 			isTrueRouteUpdate := c.handleUpdate(update)
 
 			c.updateSerial = update.updateSerial
+			c.syncHealthStates()
 
 			if isTrueRouteUpdate {
 				log.Debug("Client network update contains different routes, recalculating routes")
@@ -489,6 +570,7 @@ func (c *clientNetwork) peersStateAndUpdateWatcher() {
 			} else {
 				log.Debug("Route update is not different, skipping route recalculation")
 			}
+			c.publishHealthStates()
 
 			c.startPeersStatusChangeWatcher()
 		}
@@ -0,0 +1,82 @@
+package routemanager
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/netbirdio/netbird/route"
+)
+
+// IsExitNode checks if the route is an exit node route, a route to the default network
+func IsExitNode(r *route.Route) bool {
+	return r != nil && !r.IsDynamic() && r.Network.IsValid() && r.Network.Bits() == 0
+}
+
+// GetExitNodes returns the exit node routes with the network ID as key
+func (m *DefaultManager) GetExitNodes() map[route.NetID][]*route.Route {
+	exitNodes := make(map[route.NetID][]*route.Route)
+	for netID, routes := range m.GetClientRoutesWithNetID() {
+		if len(routes) > 0 && IsExitNode(routes[0]) {
+			exitNodes[netID] = routes
+		}
+	}
+	return exitNodes
+}
+
+// SelectExitNode selects the exit node and deselects all other exit nodes.
+// The selection of the other routes is kept.
+func (m *DefaultManager) SelectExitNode(netID route.NetID) error {
+	exitNodes := m.GetExitNodes()
+	if _, ok := exitNodes[netID]; !ok {
+		return fmt.Errorf("exit node '%s' is not available", netID)
+	}
+
+	var others []route.NetID
+	for id := range exitNodes {
+		if id != netID {
+			others = append(others, id)
+		}
+	}
+
+	allRoutes := maps.Keys(m.GetClientRoutesWithNetID())
+	if err := m.routeSelector.DeselectRoutes(others, allRoutes); err != nil {
+		return fmt.Errorf("deselect exit nodes: %w", err)
+	}
+	if err := m.routeSelector.SelectRoutes([]route.NetID{netID}, true, allRoutes); err != nil {
+		return fmt.Errorf("select exit node: %w", err)
+	}
+
+	m.TriggerSelection(m.GetClientRoutes())
+	return nil
+}
+
+// ClearExitNode deselects all exit nodes, the selection of the other routes is kept
+func (m *DefaultManager) ClearExitNode() error {
+	exitNodes := maps.Keys(m.GetExitNodes())
+	if len(exitNodes) == 0 {
+		return nil
+	}
+
+	if err := m.routeSelector.DeselectRoutes(exitNodes, maps.Keys(m.GetClientRoutesWithNetID())); err != nil {
+		return fmt.Errorf("deselect exit nodes: %w", err)
+	}
+
+	m.TriggerSelection(m.GetClientRoutes())
+	return nil
+}
+
+// GetSelectedExitNode returns the network ID of the selected exit node, it is empty if no exit node is selected.
+// If several exit nodes are selected, the first one in alphabetical order is returned.
+func (m *DefaultManager) GetSelectedExitNode() route.NetID {
+	var selected route.NetID
+	for netID := range m.GetExitNodes() {
+		if !m.routeSelector.IsSelected(netID) {
+			continue
+		}
+		if selected == "" || netID < selected {
+			selected = netID
+		}
+	}
+	return selected
+}
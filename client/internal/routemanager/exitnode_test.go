@@ -0,0 +1,91 @@
+package routemanager
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/internal/routeselector"
+	"github.com/netbirdio/netbird/management/domain"
+	"github.com/netbirdio/netbird/route"
+)
+
+func TestIsExitNode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		route    *route.Route
+		expected bool
+	}{
+		{
+			name:     "IPv4 default route",
+			route:    &route.Route{Network: netip.MustParsePrefix("0.0.0.0/0"), NetworkType: route.IPv4Network},
+			expected: true,
+		},
+		{
+			name:     "IPv6 default route",
+			route:    &route.Route{Network: netip.MustParsePrefix("::/0"), NetworkType: route.IPv6Network},
+			expected: true,
+		},
+		{
+			name:     "network route",
+			route:    &route.Route{Network: netip.MustParsePrefix("10.0.0.0/8"), NetworkType: route.IPv4Network},
+			expected: false,
+		},
+		{
+			name: "domain route",
+			route: &route.Route{
+				Domains:     domain.List{"example.com"},
+				NetworkType: route.DomainNetwork,
+			},
+			expected: false,
+		},
+		{
+			name:     "nil route",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsExitNode(tc.route))
+		})
+	}
+}
+
+func TestGetSelectedExitNode(t *testing.T) {
+	routes := []*route.Route{
+		{NetID: "exit-b", Peer: "peer1", Network: netip.MustParsePrefix("0.0.0.0/0"), NetworkType: route.IPv4Network},
+		{NetID: "exit-a", Peer: "peer2", Network: netip.MustParsePrefix("0.0.0.0/0"), NetworkType: route.IPv4Network},
+		{NetID: "office", Peer: "peer3", Network: netip.MustParsePrefix("10.0.0.0/8"), NetworkType: route.IPv4Network},
+	}
+
+	clientRoutes := route.HAMap{}
+	for _, r := range routes {
+		clientRoutes[r.GetHAUniqueID()] = append(clientRoutes[r.GetHAUniqueID()], r)
+	}
+
+	m := &DefaultManager{
+		clientRoutes:  clientRoutes,
+		routeSelector: routeselector.NewRouteSelector(),
+	}
+
+	exitNodes := m.GetExitNodes()
+	assert.Len(t, exitNodes, 2)
+	assert.Contains(t, exitNodes, route.NetID("exit-a"))
+	assert.Contains(t, exitNodes, route.NetID("exit-b"))
+
+	// all routes are selected by default
+	assert.Equal(t, route.NetID("exit-a"), m.GetSelectedExitNode())
+
+	allRoutes := []route.NetID{"exit-a", "exit-b", "office"}
+	require.NoError(t, m.routeSelector.DeselectRoutes([]route.NetID{"exit-a"}, allRoutes))
+	assert.Equal(t, route.NetID("exit-b"), m.GetSelectedExitNode())
+
+	require.NoError(t, m.routeSelector.DeselectRoutes([]route.NetID{"exit-b"}, allRoutes))
+	assert.Equal(t, route.NetID(""), m.GetSelectedExitNode())
+	assert.True(t, m.routeSelector.IsSelected("office"))
+
+	assert.Error(t, m.SelectExitNode("office"), "a network route must not be selected as exit node")
+}
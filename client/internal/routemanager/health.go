@@ -0,0 +1,169 @@
+package routemanager
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/route"
+)
+
+// healthCheckTick is the granularity the probes of the routing peers are scheduled with
+const healthCheckTick = time.Second
+
+type probeFunc func(ctx context.Context, healthCheck *route.HealthCheck) error
+
+type routeHealth struct {
+	healthCheck *route.HealthCheck
+	failures    int
+	healthy     bool
+	lastCheck   time.Time
+	lastErr     error
+}
+
+type healthResult struct {
+	routeID route.ID
+	err     error
+}
+
+// healthProbe is the probe in flight, only one runs at a time
+type healthProbe struct {
+	routeID route.ID
+	peerKey string
+}
+
+// isUnhealthy reports whether the health checks of the route failed more often in a row than allowed
+func (c *clientNetwork) isUnhealthy(id route.ID) bool {
+	state, ok := c.health[id]
+	return ok && !state.healthy
+}
+
+// syncHealthStates drops the results of removed routes and resets the ones whose health check changed
+func (c *clientNetwork) syncHealthStates() {
+	for id, state := range c.health {
+		r, ok := c.routes[id]
+		if !ok || !state.healthCheck.Equal(r.HealthCheck) {
+			delete(c.health, id)
+		}
+	}
+
+	for id, r := range c.routes {
+		if r.HealthCheck == nil {
+			continue
+		}
+		if _, ok := c.health[id]; !ok {
+			c.health[id] = &routeHealth{healthCheck: r.HealthCheck.Copy(), healthy: true}
+		}
+	}
+}
+
+// startHealthProbe runs the probe of the chosen routing peer once its interval elapsed.
+// The target is reached through the tunnel the traffic of the network takes, so probing never changes
+// the allowed IPs of the peers. Other routing peers are probed once they are chosen after a failover.
+func (c *clientNetwork) startHealthProbe() {
+	// the target is only routed into the tunnel while the network has a chosen peer
+	if c.probing != nil || c.currentChosen == nil {
+		return
+	}
+
+	r := c.currentChosen
+	state, ok := c.health[r.ID]
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(state.lastCheck) < state.healthCheck.GetInterval() {
+		return
+	}
+	if peerState, err := c.statusRecorder.GetPeer(r.Peer); err != nil || peerState.ConnStatus != peer.StatusConnected {
+		return
+	}
+
+	healthCheck := state.healthCheck
+	if _, err := healthCheck.TargetAddr(); err != nil {
+		log.Warnf("Invalid health check of route %s for network [%v]: %v", r.ID, c.handler, err)
+		state.lastCheck = now
+		return
+	}
+
+	probe := &healthProbe{routeID: r.ID, peerKey: r.Peer}
+	c.probing = probe
+
+	go func() {
+		err := c.probe(c.ctx, healthCheck)
+		select {
+		case c.healthResults <- healthResult{routeID: probe.routeID, err: err}:
+		case <-c.ctx.Done():
+		}
+	}()
+}
+
+// handleHealthResult records the probe result and reports whether the routing peer has to be chosen again.
+// This is the case when its health changed or when it failed again while being unhealthy, which lets
+// the next unhealthy routing peer take its turn while none is healthy.
+func (c *clientNetwork) handleHealthResult(result healthResult) bool {
+	c.stopHealthProbe()
+
+	state, ok := c.health[result.routeID]
+	r := c.routes[result.routeID]
+	if !ok || r == nil {
+		return false
+	}
+
+	state.lastCheck = time.Now()
+	state.lastErr = result.err
+	wasHealthy := state.healthy
+	if result.err == nil {
+		state.failures = 0
+		state.healthy = true
+	} else {
+		state.failures++
+		log.Debugf("Health check %s %s through peer %s failed (%d/%d): %v",
+			state.healthCheck.Type, state.healthCheck.Target, r.Peer, state.failures, state.healthCheck.GetFailureThreshold(), result.err)
+		if state.failures >= state.healthCheck.GetFailureThreshold() {
+			state.healthy = false
+		}
+	}
+
+	c.publishHealthStates()
+
+	if wasHealthy == state.healthy {
+		return !state.healthy
+	}
+	if state.healthy {
+		log.Infof("Routing peer %s is healthy again for network [%v]", r.Peer, c.handler)
+	} else {
+		log.Warnf("Routing peer %s is unhealthy for network [%v]: %v", r.Peer, c.handler, result.err)
+	}
+	return true
+}
+
+// stopHealthProbe clears the probe in flight so the next one can be started
+func (c *clientNetwork) stopHealthProbe() {
+	c.probing = nil
+}
+
+func (c *clientNetwork) publishHealthStates() {
+	var states []peer.RouteHealthState
+	for id, state := range c.health {
+		r := c.routes[id]
+		states = append(states, peer.RouteHealthState{
+			Network:   c.handler.String(),
+			PeerKey:   r.Peer,
+			Type:      string(state.healthCheck.Type),
+			Target:    state.healthCheck.Target,
+			Healthy:   state.healthy,
+			Chosen:    c.currentChosen != nil && c.currentChosen.ID == id,
+			LastCheck: state.lastCheck,
+			Error:     state.lastErr,
+		})
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].PeerKey < states[j].PeerKey
+	})
+	c.statusRecorder.UpdateRouteHealthStates(c.handler.String(), states)
+}
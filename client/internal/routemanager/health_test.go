@@ -0,0 +1,167 @@
+package routemanager
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/internal/routemanager/iface"
+	"github.com/netbirdio/netbird/client/internal/routemanager/static"
+	"github.com/netbirdio/netbird/route"
+)
+
+type allowedIPsRecorder struct {
+	iface.WGIface
+	allowedIPs map[string]string
+}
+
+func (r *allowedIPsRecorder) AddAllowedIP(peerKey string, allowedIP string) error {
+	r.allowedIPs[allowedIP] = peerKey
+	return nil
+}
+
+func (r *allowedIPsRecorder) RemoveAllowedIP(peerKey string, allowedIP string) error {
+	if r.allowedIPs[allowedIP] == peerKey {
+		delete(r.allowedIPs, allowedIP)
+	}
+	return nil
+}
+
+func newHealthTestNetwork(t *testing.T, network netip.Prefix, healthCheck *route.HealthCheck, probe probeFunc) (*clientNetwork, *allowedIPsRecorder) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	recorder := peer.NewRecorder("")
+	for _, key := range []string{"peer1", "peer2"} {
+		require.NoError(t, recorder.AddPeer(key, key+".netbird.cloud"))
+		require.NoError(t, recorder.UpdatePeerState(peer.State{PubKey: key, ConnStatus: peer.StatusConnected}))
+	}
+
+	wgInterface := &allowedIPsRecorder{allowedIPs: map[string]string{network.String(): "peer1"}}
+	routes := map[route.ID]*route.Route{
+		"route1": {ID: "route1", Network: network, Peer: "peer1", Metric: route.MaxMetric, HealthCheck: healthCheck},
+		"route2": {ID: "route2", Network: network, Peer: "peer2", Metric: route.MaxMetric, HealthCheck: healthCheck},
+	}
+
+	c := &clientNetwork{
+		ctx:            ctx,
+		statusRecorder: recorder,
+		wgInterface:    wgInterface,
+		routes:         routes,
+		currentChosen:  routes["route1"],
+		handler:        static.NewRoute(&route.Route{Network: network}, nil, nil),
+		health:         make(map[route.ID]*routeHealth),
+		healthResults:  make(chan healthResult, 1),
+		probe:          probe,
+	}
+	c.syncHealthStates()
+	return c, wgInterface
+}
+
+func runHealthProbe(t *testing.T, c *clientNetwork) (healthResult, bool) {
+	t.Helper()
+
+	c.startHealthProbe()
+	require.NotNil(t, c.probing, "expected a probe to be started")
+	select {
+	case result := <-c.healthResults:
+		return result, c.handleHealthResult(result)
+	case <-time.After(time.Second):
+		t.Fatal("probe result not received")
+		return healthResult{}, false
+	}
+}
+
+func TestClientNetwork_HealthProbeFailover(t *testing.T) {
+	network := netip.MustParsePrefix("10.0.0.0/24")
+	healthCheck := &route.HealthCheck{Type: route.HealthCheckTCP, Target: "10.0.0.10:22", FailureThreshold: 2}
+
+	c, wgInterface := newHealthTestNetwork(t, network, healthCheck, func(context.Context, *route.HealthCheck) error {
+		return errors.New("timeout")
+	})
+
+	result, changed := runHealthProbe(t, c)
+	assert.Equal(t, route.ID("route1"), result.routeID, "only the chosen routing peer should be probed")
+	assert.False(t, changed, "a single failure should not change the health")
+
+	c.startHealthProbe()
+	assert.Nil(t, c.probing, "no probe should run before the interval elapsed")
+
+	c.health["route1"].lastCheck = time.Time{}
+	result, changed = runHealthProbe(t, c)
+	assert.Equal(t, route.ID("route1"), result.routeID)
+	assert.True(t, changed, "reaching the failure threshold should change the health")
+	assert.True(t, c.isUnhealthy("route1"))
+	assert.False(t, c.isUnhealthy("route2"))
+	assert.Equal(t, map[string]string{network.String(): "peer1"}, wgInterface.allowedIPs, "probes should not change the allowed IPs")
+
+	statuses := c.getRouterPeerStatuses()
+	assert.Equal(t, route.ID("route2"), c.getBestRouteFromStatuses(statuses))
+
+	states := c.statusRecorder.GetRouteHealthStates()
+	require.Len(t, states, 2)
+	assert.Equal(t, "peer1", states[0].PeerKey)
+	assert.False(t, states[0].Healthy)
+	assert.EqualError(t, states[0].Error, "timeout")
+	assert.True(t, states[1].Healthy)
+}
+
+func TestClientNetwork_HealthProbeRotatesUnhealthyRoutes(t *testing.T) {
+	network := netip.MustParsePrefix("10.0.0.10/32")
+	healthCheck := &route.HealthCheck{Type: route.HealthCheckICMP, Target: "10.0.0.10", FailureThreshold: 1}
+
+	c, wgInterface := newHealthTestNetwork(t, network, healthCheck, func(context.Context, *route.HealthCheck) error {
+		return errors.New("unreachable")
+	})
+	c.health["route2"].healthy = false
+	c.health["route2"].lastCheck = time.Now().Add(-time.Minute)
+
+	_, changed := runHealthProbe(t, c)
+	require.True(t, changed)
+	require.True(t, c.isUnhealthy("route1"))
+
+	// no routing peer is healthy, the one probed the longest time ago takes its turn
+	statuses := c.getRouterPeerStatuses()
+	assert.Equal(t, route.ID("route2"), c.getBestRouteFromStatuses(statuses))
+
+	c.currentChosen = c.routes["route2"]
+	c.health["route2"].lastCheck = time.Time{}
+	result, changed := runHealthProbe(t, c)
+	assert.Equal(t, route.ID("route2"), result.routeID)
+	assert.True(t, changed, "a failing unhealthy routing peer should hand over to the next one")
+
+	statuses = c.getRouterPeerStatuses()
+	assert.Equal(t, route.ID("route1"), c.getBestRouteFromStatuses(statuses))
+	assert.Equal(t, map[string]string{network.String(): "peer1"}, wgInterface.allowedIPs, "probes should not change the allowed IPs")
+}
+
+func TestClientNetwork_SyncHealthStates(t *testing.T) {
+	network := netip.MustParsePrefix("10.0.0.0/24")
+	healthCheck := &route.HealthCheck{Type: route.HealthCheckICMP, Target: "10.0.0.1", FailureThreshold: 1}
+
+	c, _ := newHealthTestNetwork(t, network, healthCheck, func(context.Context, *route.HealthCheck) error {
+		return errors.New("unreachable")
+	})
+	_, changed := runHealthProbe(t, c)
+	require.True(t, changed)
+	require.True(t, c.isUnhealthy("route1"))
+
+	// a changed health check starts over
+	updated := c.routes["route1"].Copy()
+	updated.HealthCheck.Target = "10.0.0.2"
+	c.routes["route1"] = updated
+	c.syncHealthStates()
+	assert.False(t, c.isUnhealthy("route1"))
+
+	delete(c.routes, "route2")
+	c.syncHealthStates()
+	assert.NotContains(t, c.health, route.ID("route2"))
+}
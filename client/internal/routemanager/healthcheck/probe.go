@@ -0,0 +1,175 @@
+// Package healthcheck implements the active probes of HA routes
+package healthcheck
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/netbirdio/netbird/route"
+)
+
+const (
+	protocolICMP     = 1
+	protocolIPv6ICMP = 58
+)
+
+// Probe runs the health check once and returns nil if the target answered in time
+func Probe(ctx context.Context, healthCheck *route.HealthCheck) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheck.GetTimeout())
+	defer cancel()
+
+	switch healthCheck.Type {
+	case route.HealthCheckICMP:
+		addr, err := healthCheck.TargetAddr()
+		if err != nil {
+			return err
+		}
+		return probeICMP(ctx, addr)
+	case route.HealthCheckTCP:
+		return probeTCP(ctx, healthCheck.Target)
+	case route.HealthCheckHTTP:
+		return probeHTTP(ctx, healthCheck.Target)
+	default:
+		return fmt.Errorf("unsupported health check type %q", healthCheck.Type)
+	}
+}
+
+func probeTCP(ctx context.Context, target string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", target)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	if err := conn.Close(); err != nil {
+		return fmt.Errorf("close connection: %w", err)
+	}
+	return nil
+}
+
+func probeHTTP(ctx context.Context, target string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	// the probe should neither reuse connections nor follow redirects to hosts outside the routed network
+	client := &http.Client{
+		Transport: &http.Transport{DisableKeepAlives: true},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func probeICMP(ctx context.Context, addr netip.Addr) error {
+	conn, privileged, err := listenICMP(addr.Is6())
+	if err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return fmt.Errorf("set deadline: %w", err)
+		}
+	}
+
+	var msgType icmp.Type = ipv4.ICMPTypeEcho
+	proto := protocolICMP
+	if addr.Is6() {
+		msgType = ipv6.ICMPTypeEchoRequest
+		proto = protocolIPv6ICMP
+	}
+
+	// unprivileged sockets get their identifier assigned by the kernel, the payload identifies the reply
+	payload := make([]byte, 16)
+	if _, err := rand.Read(payload); err != nil {
+		return fmt.Errorf("generate echo payload: %w", err)
+	}
+	seq := int(binary.BigEndian.Uint16(payload))
+	msg := icmp.Message{
+		Type: msgType,
+		Body: &icmp.Echo{ID: os.Getpid() & 0xffff, Seq: seq, Data: payload},
+	}
+	data, err := msg.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("marshal echo request: %w", err)
+	}
+
+	var dst net.Addr = &net.IPAddr{IP: addr.AsSlice()}
+	if !privileged {
+		dst = &net.UDPAddr{IP: addr.AsSlice()}
+	}
+	if _, err := conn.WriteTo(data, dst); err != nil {
+		return fmt.Errorf("send echo request: %w", err)
+	}
+
+	buf := make([]byte, 1500)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				return errors.New("no echo reply received")
+			}
+			return fmt.Errorf("read echo reply: %w", err)
+		}
+
+		reply, err := icmp.ParseMessage(proto, buf[:n])
+		if err != nil {
+			continue
+		}
+		if reply.Type != ipv4.ICMPTypeEchoReply && reply.Type != ipv6.ICMPTypeEchoReply {
+			continue
+		}
+		if echo, ok := reply.Body.(*icmp.Echo); ok && echo.Seq == seq && bytes.Equal(echo.Data, payload) {
+			return nil
+		}
+	}
+}
+
+// listenICMP opens a raw ICMP socket and falls back to an unprivileged datagram socket where raw sockets are not permitted
+func listenICMP(v6 bool) (*icmp.PacketConn, bool, error) {
+	network, address, fallback := "ip4:icmp", "0.0.0.0", "udp4"
+	if v6 {
+		network, address, fallback = "ip6:ipv6-icmp", "::", "udp6"
+	}
+
+	conn, err := icmp.ListenPacket(network, address)
+	if err == nil {
+		return conn, true, nil
+	}
+
+	conn, fallbackErr := icmp.ListenPacket(fallback, address)
+	if fallbackErr != nil {
+		return nil, false, errors.Join(err, fallbackErr)
+	}
+	return conn, false, nil
+}
@@ -0,0 +1,81 @@
+package healthcheck
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/route"
+)
+
+func TestProbe_TCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+
+	healthCheck := &route.HealthCheck{Type: route.HealthCheckTCP, Target: addr, Timeout: time.Second}
+	assert.NoError(t, Probe(context.Background(), healthCheck))
+
+	require.NoError(t, listener.Close())
+	assert.Error(t, Probe(context.Background(), healthCheck), "closed port should fail the probe")
+}
+
+func TestProbe_HTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusNoContent)
+		case "/redirect":
+			http.Redirect(w, r, "http://192.0.2.1/", http.StatusFound)
+		case "/slow":
+			time.Sleep(500 * time.Millisecond)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		path    string
+		timeout time.Duration
+		wantErr bool
+	}{
+		{path: "/ok", timeout: time.Second},
+		{path: "/redirect", timeout: time.Second},
+		{path: "/unavailable", timeout: time.Second, wantErr: true},
+		{path: "/slow", timeout: 100 * time.Millisecond, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			healthCheck := &route.HealthCheck{Type: route.HealthCheckHTTP, Target: server.URL + tt.path, Timeout: tt.timeout}
+			err := Probe(context.Background(), healthCheck)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProbe_ICMP(t *testing.T) {
+	conn, _, err := listenICMP(false)
+	if err != nil {
+		t.Skipf("ICMP sockets are not permitted: %v", err)
+	}
+	_ = conn.Close()
+
+	healthCheck := &route.HealthCheck{Type: route.HealthCheckICMP, Target: "127.0.0.1", Timeout: time.Second}
+	assert.NoError(t, Probe(context.Background(), healthCheck))
+}
+
+func TestProbe_UnsupportedType(t *testing.T) {
+	assert.Error(t, Probe(context.Background(), &route.HealthCheck{Type: "udp", Target: "127.0.0.1:53"}))
+}
@@ -42,6 +42,10 @@ type Manager interface {
 	GetRouteSelector() *routeselector.RouteSelector
 	GetClientRoutes() route.HAMap
 	GetClientRoutesWithNetID() map[route.NetID][]*route.Route
+	GetExitNodes() map[route.NetID][]*route.Route
+	SelectExitNode(netID route.NetID) error
+	ClearExitNode() error
+	GetSelectedExitNode() route.NetID
 	SetRouteChangeListener(listener listener.NetworkChangeListener)
 	InitialRouteRange() []string
 	EnableServerRouter(firewall firewall.Manager) error
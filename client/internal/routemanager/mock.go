@@ -69,6 +69,26 @@ func (m *MockManager) GetClientRoutesWithNetID() map[route.NetID][]*route.Route
 	return nil
 }
 
+// GetExitNodes mock implementation of GetExitNodes from Manager interface
+func (m *MockManager) GetExitNodes() map[route.NetID][]*route.Route {
+	return nil
+}
+
+// SelectExitNode mock implementation of SelectExitNode from Manager interface
+func (m *MockManager) SelectExitNode(route.NetID) error {
+	return nil
+}
+
+// ClearExitNode mock implementation of ClearExitNode from Manager interface
+func (m *MockManager) ClearExitNode() error {
+	return nil
+}
+
+// GetSelectedExitNode mock implementation of GetSelectedExitNode from Manager interface
+func (m *MockManager) GetSelectedExitNode() route.NetID {
+	return ""
+}
+
 // Start mock implementation of Start from Manager interface
 func (m *MockManager) Start(ctx context.Context, iface *iface.WGIface) {
 }
@@ -54,6 +54,30 @@ type DaemonServiceClient interface {
 	TracePacket(ctx context.Context, in *TracePacketRequest, opts ...grpc.CallOption) (*TracePacketResponse, error)
 	SubscribeEvents(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (DaemonService_SubscribeEventsClient, error)
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	// StartCapture starts recording the decrypted packets of the WireGuard interface into a pcapng file
+	StartCapture(ctx context.Context, in *StartCaptureRequest, opts ...grpc.CallOption) (*StartCaptureResponse, error)
+	// GetCaptureStatus returns the statistics of the current or last capture
+	GetCaptureStatus(ctx context.Context, in *GetCaptureStatusRequest, opts ...grpc.CallOption) (*GetCaptureStatusResponse, error)
+	// StopCapture stops the running capture
+	StopCapture(ctx context.Context, in *StopCaptureRequest, opts ...grpc.CallOption) (*StopCaptureResponse, error)
+	// ListSSHRecordings lists the terminal sessions recorded by the SSH server
+	ListSSHRecordings(ctx context.Context, in *ListSSHRecordingsRequest, opts ...grpc.CallOption) (*ListSSHRecordingsResponse, error)
+	// GetPeerHistory returns the connection quality samples and connection changes recorded for the peers
+	GetPeerHistory(ctx context.Context, in *GetPeerHistoryRequest, opts ...grpc.CallOption) (*GetPeerHistoryResponse, error)
+	// ListProfiles lists the profiles of the daemon
+	ListProfiles(ctx context.Context, in *ListProfilesRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error)
+	// AddProfile creates a new profile with its own config and keys
+	AddProfile(ctx context.Context, in *AddProfileRequest, opts ...grpc.CallOption) (*AddProfileResponse, error)
+	// RemoveProfile deletes a profile and its config
+	RemoveProfile(ctx context.Context, in *RemoveProfileRequest, opts ...grpc.CallOption) (*RemoveProfileResponse, error)
+	// SwitchProfile disconnects the active profile and activates the given one
+	SwitchProfile(ctx context.Context, in *SwitchProfileRequest, opts ...grpc.CallOption) (*SwitchProfileResponse, error)
+	// ListExitNodes lists the exit nodes available to the client
+	ListExitNodes(ctx context.Context, in *ListExitNodesRequest, opts ...grpc.CallOption) (*ListExitNodesResponse, error)
+	// SelectExitNode routes the internet traffic through the given exit node
+	SelectExitNode(ctx context.Context, in *SelectExitNodeRequest, opts ...grpc.CallOption) (*SelectExitNodeResponse, error)
+	// ClearExitNode stops routing the internet traffic through an exit node
+	ClearExitNode(ctx context.Context, in *ClearExitNodeRequest, opts ...grpc.CallOption) (*ClearExitNodeResponse, error)
 }
 
 type daemonServiceClient struct {
@@ -258,6 +282,114 @@ func (c *daemonServiceClient) GetEvents(ctx context.Context, in *GetEventsReques
 	return out, nil
 }
 
+func (c *daemonServiceClient) StartCapture(ctx context.Context, in *StartCaptureRequest, opts ...grpc.CallOption) (*StartCaptureResponse, error) {
+	out := new(StartCaptureResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/StartCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetCaptureStatus(ctx context.Context, in *GetCaptureStatusRequest, opts ...grpc.CallOption) (*GetCaptureStatusResponse, error) {
+	out := new(GetCaptureStatusResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetCaptureStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) StopCapture(ctx context.Context, in *StopCaptureRequest, opts ...grpc.CallOption) (*StopCaptureResponse, error) {
+	out := new(StopCaptureResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/StopCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ListSSHRecordings(ctx context.Context, in *ListSSHRecordingsRequest, opts ...grpc.CallOption) (*ListSSHRecordingsResponse, error) {
+	out := new(ListSSHRecordingsResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/ListSSHRecordings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetPeerHistory(ctx context.Context, in *GetPeerHistoryRequest, opts ...grpc.CallOption) (*GetPeerHistoryResponse, error) {
+	out := new(GetPeerHistoryResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetPeerHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ListProfiles(ctx context.Context, in *ListProfilesRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error) {
+	out := new(ListProfilesResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/ListProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) AddProfile(ctx context.Context, in *AddProfileRequest, opts ...grpc.CallOption) (*AddProfileResponse, error) {
+	out := new(AddProfileResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/AddProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) RemoveProfile(ctx context.Context, in *RemoveProfileRequest, opts ...grpc.CallOption) (*RemoveProfileResponse, error) {
+	out := new(RemoveProfileResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/RemoveProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) SwitchProfile(ctx context.Context, in *SwitchProfileRequest, opts ...grpc.CallOption) (*SwitchProfileResponse, error) {
+	out := new(SwitchProfileResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/SwitchProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ListExitNodes(ctx context.Context, in *ListExitNodesRequest, opts ...grpc.CallOption) (*ListExitNodesResponse, error) {
+	out := new(ListExitNodesResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/ListExitNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) SelectExitNode(ctx context.Context, in *SelectExitNodeRequest, opts ...grpc.CallOption) (*SelectExitNodeResponse, error) {
+	out := new(SelectExitNodeResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/SelectExitNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ClearExitNode(ctx context.Context, in *ClearExitNodeRequest, opts ...grpc.CallOption) (*ClearExitNodeResponse, error) {
+	out := new(ClearExitNodeResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/ClearExitNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaemonServiceServer is the server API for DaemonService service.
 // All implementations must embed UnimplementedDaemonServiceServer
 // for forward compatibility
@@ -298,6 +430,30 @@ type DaemonServiceServer interface {
 	TracePacket(context.Context, *TracePacketRequest) (*TracePacketResponse, error)
 	SubscribeEvents(*SubscribeRequest, DaemonService_SubscribeEventsServer) error
 	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	// StartCapture starts recording the decrypted packets of the WireGuard interface into a pcapng file
+	StartCapture(context.Context, *StartCaptureRequest) (*StartCaptureResponse, error)
+	// GetCaptureStatus returns the statistics of the current or last capture
+	GetCaptureStatus(context.Context, *GetCaptureStatusRequest) (*GetCaptureStatusResponse, error)
+	// StopCapture stops the running capture
+	StopCapture(context.Context, *StopCaptureRequest) (*StopCaptureResponse, error)
+	// ListSSHRecordings lists the terminal sessions recorded by the SSH server
+	ListSSHRecordings(context.Context, *ListSSHRecordingsRequest) (*ListSSHRecordingsResponse, error)
+	// GetPeerHistory returns the connection quality samples and connection changes recorded for the peers
+	GetPeerHistory(context.Context, *GetPeerHistoryRequest) (*GetPeerHistoryResponse, error)
+	// ListProfiles lists the profiles of the daemon
+	ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error)
+	// AddProfile creates a new profile with its own config and keys
+	AddProfile(context.Context, *AddProfileRequest) (*AddProfileResponse, error)
+	// RemoveProfile deletes a profile and its config
+	RemoveProfile(context.Context, *RemoveProfileRequest) (*RemoveProfileResponse, error)
+	// SwitchProfile disconnects the active profile and activates the given one
+	SwitchProfile(context.Context, *SwitchProfileRequest) (*SwitchProfileResponse, error)
+	// ListExitNodes lists the exit nodes available to the client
+	ListExitNodes(context.Context, *ListExitNodesRequest) (*ListExitNodesResponse, error)
+	// SelectExitNode routes the internet traffic through the given exit node
+	SelectExitNode(context.Context, *SelectExitNodeRequest) (*SelectExitNodeResponse, error)
+	// ClearExitNode stops routing the internet traffic through an exit node
+	ClearExitNode(context.Context, *ClearExitNodeRequest) (*ClearExitNodeResponse, error)
 	mustEmbedUnimplementedDaemonServiceServer()
 }
 
@@ -362,6 +518,42 @@ func (UnimplementedDaemonServiceServer) SubscribeEvents(*SubscribeRequest, Daemo
 func (UnimplementedDaemonServiceServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
 }
+func (UnimplementedDaemonServiceServer) StartCapture(context.Context, *StartCaptureRequest) (*StartCaptureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCapture not implemented")
+}
+func (UnimplementedDaemonServiceServer) GetCaptureStatus(context.Context, *GetCaptureStatusRequest) (*GetCaptureStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCaptureStatus not implemented")
+}
+func (UnimplementedDaemonServiceServer) StopCapture(context.Context, *StopCaptureRequest) (*StopCaptureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopCapture not implemented")
+}
+func (UnimplementedDaemonServiceServer) ListSSHRecordings(context.Context, *ListSSHRecordingsRequest) (*ListSSHRecordingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSSHRecordings not implemented")
+}
+func (UnimplementedDaemonServiceServer) GetPeerHistory(context.Context, *GetPeerHistoryRequest) (*GetPeerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerHistory not implemented")
+}
+func (UnimplementedDaemonServiceServer) ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfiles not implemented")
+}
+func (UnimplementedDaemonServiceServer) AddProfile(context.Context, *AddProfileRequest) (*AddProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProfile not implemented")
+}
+func (UnimplementedDaemonServiceServer) RemoveProfile(context.Context, *RemoveProfileRequest) (*RemoveProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProfile not implemented")
+}
+func (UnimplementedDaemonServiceServer) SwitchProfile(context.Context, *SwitchProfileRequest) (*SwitchProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchProfile not implemented")
+}
+func (UnimplementedDaemonServiceServer) ListExitNodes(context.Context, *ListExitNodesRequest) (*ListExitNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExitNodes not implemented")
+}
+func (UnimplementedDaemonServiceServer) SelectExitNode(context.Context, *SelectExitNodeRequest) (*SelectExitNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectExitNode not implemented")
+}
+func (UnimplementedDaemonServiceServer) ClearExitNode(context.Context, *ClearExitNodeRequest) (*ClearExitNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearExitNode not implemented")
+}
 func (UnimplementedDaemonServiceServer) mustEmbedUnimplementedDaemonServiceServer() {}
 
 // UnsafeDaemonServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -720,6 +912,222 @@ func _DaemonService_GetEvents_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_StartCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).StartCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/StartCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).StartCapture(ctx, req.(*StartCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetCaptureStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCaptureStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetCaptureStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetCaptureStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetCaptureStatus(ctx, req.(*GetCaptureStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_StopCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).StopCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/StopCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).StopCapture(ctx, req.(*StopCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ListSSHRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSSHRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ListSSHRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/ListSSHRecordings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ListSSHRecordings(ctx, req.(*ListSSHRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetPeerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetPeerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetPeerHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetPeerHistory(ctx, req.(*GetPeerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ListProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/ListProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ListProfiles(ctx, req.(*ListProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_AddProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).AddProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/AddProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).AddProfile(ctx, req.(*AddProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_RemoveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).RemoveProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/RemoveProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).RemoveProfile(ctx, req.(*RemoveProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_SwitchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).SwitchProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/SwitchProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).SwitchProfile(ctx, req.(*SwitchProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ListExitNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExitNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ListExitNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/ListExitNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ListExitNodes(ctx, req.(*ListExitNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_SelectExitNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectExitNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).SelectExitNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/SelectExitNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).SelectExitNode(ctx, req.(*SelectExitNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ClearExitNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearExitNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ClearExitNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/ClearExitNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ClearExitNode(ctx, req.(*ClearExitNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DaemonService_ServiceDesc is the grpc.ServiceDesc for DaemonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -799,6 +1207,54 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvents",
 			Handler:    _DaemonService_GetEvents_Handler,
 		},
+		{
+			MethodName: "StartCapture",
+			Handler:    _DaemonService_StartCapture_Handler,
+		},
+		{
+			MethodName: "GetCaptureStatus",
+			Handler:    _DaemonService_GetCaptureStatus_Handler,
+		},
+		{
+			MethodName: "StopCapture",
+			Handler:    _DaemonService_StopCapture_Handler,
+		},
+		{
+			MethodName: "ListSSHRecordings",
+			Handler:    _DaemonService_ListSSHRecordings_Handler,
+		},
+		{
+			MethodName: "GetPeerHistory",
+			Handler:    _DaemonService_GetPeerHistory_Handler,
+		},
+		{
+			MethodName: "ListProfiles",
+			Handler:    _DaemonService_ListProfiles_Handler,
+		},
+		{
+			MethodName: "AddProfile",
+			Handler:    _DaemonService_AddProfile_Handler,
+		},
+		{
+			MethodName: "RemoveProfile",
+			Handler:    _DaemonService_RemoveProfile_Handler,
+		},
+		{
+			MethodName: "SwitchProfile",
+			Handler:    _DaemonService_SwitchProfile_Handler,
+		},
+		{
+			MethodName: "ListExitNodes",
+			Handler:    _DaemonService_ListExitNodes_Handler,
+		},
+		{
+			MethodName: "SelectExitNode",
+			Handler:    _DaemonService_SelectExitNode_Handler,
+		},
+		{
+			MethodName: "ClearExitNode",
+			Handler:    _DaemonService_ClearExitNode_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,257 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"os"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/firewall/uspfilter"
+	"github.com/netbirdio/netbird/client/internal/capture"
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+type packetCapturer interface {
+	SetPacketCapture(capture uspfilter.PacketCapture)
+	RemovePacketCapture(capture uspfilter.PacketCapture) bool
+}
+
+// captureSession is a running or finished packet capture written to a file
+type captureSession struct {
+	capture   *capture.Capture
+	file      *os.File
+	capturer  packetCapturer
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// close detaches the capture from the filter and closes the capture file. A capture of a newer session set on the
+// filter in the meantime is kept
+func (c *captureSession) close() error {
+	c.closeOnce.Do(func() {
+		if err := c.capture.Stop(); err != nil {
+			c.closeErr = err
+		}
+		c.capturer.RemovePacketCapture(c.capture)
+		if err := c.file.Close(); err != nil && c.closeErr == nil {
+			c.closeErr = fmt.Errorf("close capture file: %w", err)
+		}
+	})
+	return c.closeErr
+}
+
+func (c *captureSession) running() bool {
+	select {
+	case <-c.capture.Done():
+		return false
+	default:
+		return true
+	}
+}
+
+func (c *captureSession) stats() *proto.CaptureStats {
+	stats := c.capture.Stats()
+	return &proto.CaptureStats{
+		Path:       c.file.Name(),
+		Packets:    stats.Packets,
+		Bytes:      stats.Bytes,
+		StopReason: stats.Reason,
+	}
+}
+
+// StartCapture starts recording the decrypted packets of the WireGuard interface into a pcapng file
+func (s *Server) StartCapture(_ context.Context, req *proto.StartCaptureRequest) (*proto.StartCaptureResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.capture != nil && s.capture.running() {
+		return nil, gstatus.Errorf(codes.FailedPrecondition, "a capture is already running, stop it first")
+	}
+
+	if s.connectClient == nil {
+		return nil, gstatus.Errorf(codes.FailedPrecondition, "connect client not initialized")
+	}
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return nil, gstatus.Errorf(codes.FailedPrecondition, "engine not initialized")
+	}
+
+	fwManager := engine.GetFirewallManager()
+	if fwManager == nil {
+		return nil, gstatus.Errorf(codes.FailedPrecondition, "firewall manager not initialized")
+	}
+	capturer, ok := fwManager.(packetCapturer)
+	if !ok {
+		return nil, gstatus.Errorf(codes.Unimplemented, "packet capture requires the userspace WireGuard device")
+	}
+
+	filter, err := s.captureFilter(req)
+	if err != nil {
+		return nil, gstatus.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
+	file, err := os.CreateTemp("", "netbird.capture.*.pcapng")
+	if err != nil {
+		return nil, gstatus.Errorf(codes.Internal, "create capture file: %v", err)
+	}
+
+	c, err := capture.New(file, capture.Options{
+		InterfaceName: s.config.WgIface,
+		Filter:        filter,
+		Duration:      req.GetDuration().AsDuration(),
+		MaxBytes:      req.GetMaxBytes(),
+		SnapLen:       req.GetSnapLen(),
+	})
+	if err != nil {
+		if closeErr := file.Close(); closeErr != nil {
+			log.Debugf("failed to close capture file: %v", closeErr)
+		}
+		if removeErr := os.Remove(file.Name()); removeErr != nil {
+			log.Debugf("failed to remove capture file: %v", removeErr)
+		}
+		return nil, gstatus.Errorf(codes.Internal, "start capture: %v", err)
+	}
+
+	if s.capture != nil {
+		if err := s.capture.close(); err != nil {
+			log.Debugf("failed to close previous packet capture: %v", err)
+		}
+		s.removeCaptureFile(s.capture)
+	}
+
+	session := &captureSession{
+		capture:  c,
+		file:     file,
+		capturer: capturer,
+	}
+	s.capture = session
+	capturer.SetPacketCapture(c)
+
+	// the capture stops by itself when a limit is reached
+	go func() {
+		<-c.Done()
+		if err := session.close(); err != nil {
+			log.Errorf("failed to close packet capture: %v", err)
+		}
+	}()
+
+	log.Infof("started packet capture to %s with filter %q", file.Name(), filter.String())
+
+	return &proto.StartCaptureResponse{Path: file.Name(), Filter: filter.String()}, nil
+}
+
+// GetCaptureStatus returns the statistics of the current or last capture
+func (s *Server) GetCaptureStatus(context.Context, *proto.GetCaptureStatusRequest) (*proto.GetCaptureStatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.capture == nil {
+		return nil, gstatus.Errorf(codes.NotFound, "no capture was started")
+	}
+
+	return &proto.GetCaptureStatusResponse{
+		Running: s.capture.running(),
+		Stats:   s.capture.stats(),
+	}, nil
+}
+
+// StopCapture stops the running capture
+func (s *Server) StopCapture(context.Context, *proto.StopCaptureRequest) (*proto.StopCaptureResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.capture == nil {
+		return nil, gstatus.Errorf(codes.NotFound, "no capture was started")
+	}
+
+	if err := s.capture.close(); err != nil {
+		return nil, gstatus.Errorf(codes.Internal, "stop capture: %v", err)
+	}
+
+	stats := s.capture.stats()
+	log.Infof("stopped packet capture to %s: %d packets, %s", stats.GetPath(), stats.GetPackets(), stats.GetStopReason())
+
+	return &proto.StopCaptureResponse{Stats: stats}, nil
+}
+
+// captureFilter resolves the peers of the request to their addresses and builds the capture filter
+func (s *Server) captureFilter(req *proto.StartCaptureRequest) (capture.Filter, error) {
+	filter := capture.Filter{
+		Protocols: req.GetProtocols(),
+	}
+
+	for _, port := range req.GetPorts() {
+		if port == 0 || port > 65535 {
+			return filter, fmt.Errorf("invalid port %d", port)
+		}
+		filter.Ports = append(filter.Ports, uint16(port))
+	}
+
+	for _, value := range req.GetPrefixes() {
+		prefix, err := parsePrefixOrAddr(value)
+		if err != nil {
+			return filter, err
+		}
+		filter.Prefixes = append(filter.Prefixes, prefix)
+	}
+
+	if len(req.GetPeers()) > 0 {
+		peers := s.statusRecorder.GetFullStatus().Peers
+		for _, name := range req.GetPeers() {
+			prefix, err := resolveCapturePeer(peers, name)
+			if err != nil {
+				return filter, err
+			}
+			filter.Prefixes = append(filter.Prefixes, prefix)
+		}
+	}
+
+	return filter, filter.Validate()
+}
+
+func resolveCapturePeer(peers []peer.State, name string) (netip.Prefix, error) {
+	name = strings.TrimSuffix(name, ".")
+	for _, p := range peers {
+		hostname, _, _ := strings.Cut(p.FQDN, ".")
+		if p.PubKey != name && p.IP != name && !strings.EqualFold(p.FQDN, name) && !strings.EqualFold(hostname, name) {
+			continue
+		}
+
+		addr, err := netip.ParseAddr(p.IP)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("parse address of peer %s: %w", name, err)
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	return netip.Prefix{}, fmt.Errorf("peer %s not found", name)
+}
+
+func parsePrefixOrAddr(value string) (netip.Prefix, error) {
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid prefix %s: %w", value, err)
+		}
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid IP address %s: %w", value, err)
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// removeCaptureFile removes the file of a finished capture that is replaced by a new one
+func (s *Server) removeCaptureFile(session *captureSession) {
+	if err := os.Remove(session.file.Name()); err != nil && !os.IsNotExist(err) {
+		log.Warnf("failed to remove previous capture file: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+)
+
+func TestResolveCapturePeer(t *testing.T) {
+	peers := []peer.State{
+		{IP: "100.64.0.10", PubKey: "key-a", FQDN: "peer-a.netbird.cloud"},
+		{IP: "100.64.0.11", PubKey: "key-b", FQDN: "peer-b.netbird.cloud"},
+	}
+
+	tests := []struct {
+		name     string
+		expected netip.Prefix
+		wantErr  bool
+	}{
+		{name: "peer-a.netbird.cloud", expected: netip.MustParsePrefix("100.64.0.10/32")},
+		{name: "peer-b.netbird.cloud.", expected: netip.MustParsePrefix("100.64.0.11/32")},
+		{name: "Peer-B", expected: netip.MustParsePrefix("100.64.0.11/32")},
+		{name: "100.64.0.10", expected: netip.MustParsePrefix("100.64.0.10/32")},
+		{name: "key-b", expected: netip.MustParsePrefix("100.64.0.11/32")},
+		{name: "peer-c", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, err := resolveCapturePeer(peers, tt.name)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, prefix)
+		})
+	}
+}
+
+func TestParsePrefixOrAddr(t *testing.T) {
+	prefix, err := parsePrefixOrAddr("10.0.0.1/8")
+	require.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), prefix)
+
+	prefix, err = parsePrefixOrAddr("fd00::1")
+	require.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("fd00::1/128"), prefix)
+
+	_, err = parsePrefixOrAddr("peer-a")
+	assert.Error(t, err)
+}
@@ -27,7 +27,6 @@ import (
 	"github.com/netbirdio/netbird/client/anonymize"
 	"github.com/netbirdio/netbird/client/internal/peer"
 	"github.com/netbirdio/netbird/client/internal/routemanager/systemops"
-	"github.com/netbirdio/netbird/client/internal/statemanager"
 	"github.com/netbirdio/netbird/client/proto"
 	mgmProto "github.com/netbirdio/netbird/management/proto"
 )
@@ -46,6 +45,7 @@ nftables.txt: Anonymized nftables rules with packet counters, if --system-info f
 config.txt: Anonymized configuration information of the NetBird client.
 network_map.json: Anonymized network map containing peer configurations, routes, DNS settings, and firewall rules.
 state.json: Anonymized client state dump containing netbird states.
+capture.pcapng: Packets of the last packet capture, if --capture flag was provided. The packets are not anonymized.
 
 
 Anonymization Process
@@ -127,6 +127,13 @@ nftables.txt:
 - Shows packet and byte counters for each rule
 - All IP addresses are anonymized
 - Chain names, table names, and other non-sensitive information remain unchanged
+
+Packet Capture
+The capture.pcapng file contains the decrypted packets recorded by the last 'netbird debug capture' run:
+- Inbound and outbound packets are stored as two interfaces, named after the WireGuard interface with the suffixes -in and -out
+- The capture filter is stored in the interface description of the file
+- Packets and their IP addresses are NOT anonymized, as this would make the capture unusable
+- The file can be opened with Wireshark or tcpdump
 `
 
 const (
@@ -200,6 +207,12 @@ func (s *Server) createArchive(bundlePath *os.File, req *proto.DebugBundleReques
 		log.Errorf("Failed to add corrupted state files to debug bundle: %v", err)
 	}
 
+	if req.GetIncludeCapture() {
+		if err := s.addCapture(archive); err != nil {
+			log.Errorf("Failed to add packet capture to debug bundle: %v", err)
+		}
+	}
+
 	if s.logFile != "console" {
 		if err := s.addLogfile(req, anonymizer, archive); err != nil {
 			return fmt.Errorf("add log file: %w", err)
@@ -308,6 +321,8 @@ func (s *Server) addCommonConfigFields(configContent *strings.Builder) {
 	configContent.WriteString(fmt.Sprintf("DisableFirewall: %v\n", s.config.DisableFirewall))
 
 	configContent.WriteString(fmt.Sprintf("BlockLANAccess: %v\n", s.config.BlockLANAccess))
+	configContent.WriteString(fmt.Sprintf("ExitNodeKillSwitch: %v\n", s.config.ExitNodeKillSwitch))
+	configContent.WriteString(fmt.Sprintf("ExitNodeAllowLAN: %v\n", s.config.ExitNodeAllowLAN))
 }
 
 func (s *Server) addRoutes(req *proto.DebugBundleRequest, anonymizer *anonymize.Anonymizer, archive *zip.Writer) error {
@@ -374,7 +389,7 @@ func (s *Server) addNetworkMap(req *proto.DebugBundleRequest, anonymizer *anonym
 }
 
 func (s *Server) addStateFile(req *proto.DebugBundleRequest, anonymizer *anonymize.Anonymizer, archive *zip.Writer) error {
-	path := statemanager.GetDefaultStatePath()
+	path := s.statePath()
 	if path == "" {
 		return nil
 	}
@@ -412,7 +427,7 @@ func (s *Server) addStateFile(req *proto.DebugBundleRequest, anonymizer *anonymi
 }
 
 func (s *Server) addCorruptedStateFiles(archive *zip.Writer) error {
-	pattern := statemanager.GetDefaultStatePath()
+	pattern := s.statePath()
 	if pattern == "" {
 		return nil
 	}
@@ -441,6 +456,31 @@ func (s *Server) addCorruptedStateFiles(archive *zip.Writer) error {
 	return nil
 }
 
+func (s *Server) addCapture(archive *zip.Writer) error {
+	if s.capture == nil {
+		return errors.New("no capture was started")
+	}
+	if s.capture.running() {
+		return errors.New("capture is still running")
+	}
+
+	captureFile, err := os.Open(s.capture.file.Name())
+	if err != nil {
+		return fmt.Errorf("open capture file: %w", err)
+	}
+	defer func() {
+		if err := captureFile.Close(); err != nil {
+			log.Errorf("Failed to close capture file: %v", err)
+		}
+	}()
+
+	if err := addFileToZip(archive, captureFile, "capture.pcapng"); err != nil {
+		return fmt.Errorf("add capture file to zip: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Server) addLogfile(req *proto.DebugBundleRequest, anonymizer *anonymize.Anonymizer, archive *zip.Writer) error {
 	logDir := filepath.Dir(s.logFile)
 
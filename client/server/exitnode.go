@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+	"github.com/netbirdio/netbird/route"
+)
+
+// ListExitNodes returns the exit nodes available to the client and the kill-switch settings
+func (s *Server) ListExitNodes(context.Context, *proto.ListExitNodesRequest) (*proto.ListExitNodesResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.connectClient == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	routeManager := engine.GetRouteManager()
+	routeSelector := routeManager.GetRouteSelector()
+
+	var exitNodes []*proto.ExitNode
+	for id, routes := range routeManager.GetExitNodes() {
+		selected := routeSelector.IsSelected(id)
+		exitNodes = append(exitNodes, &proto.ExitNode{
+			Id:        string(id),
+			Network:   routes[0].Network.String(),
+			Selected:  selected,
+			Connected: selected && s.isExitNodeConnected(routes),
+		})
+	}
+
+	sort.Slice(exitNodes, func(i, j int) bool {
+		return exitNodes[i].Id < exitNodes[j].Id
+	})
+
+	resp := &proto.ListExitNodesResponse{
+		ExitNodes: exitNodes,
+	}
+	if s.config != nil {
+		resp.KillSwitch = s.config.ExitNodeKillSwitch
+		resp.KillSwitchAllowLan = s.config.ExitNodeAllowLAN
+	}
+
+	return resp, nil
+}
+
+// SelectExitNode routes the internet traffic through the given exit node, other exit nodes are deselected
+func (s *Server) SelectExitNode(_ context.Context, req *proto.SelectExitNodeRequest) (*proto.SelectExitNodeResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.connectClient == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	if err := engine.GetRouteManager().SelectExitNode(route.NetID(req.GetId())); err != nil {
+		return nil, err
+	}
+	engine.UpdateKillSwitch()
+
+	s.statusRecorder.PublishEvent(
+		proto.SystemEvent_INFO,
+		proto.SystemEvent_SYSTEM,
+		"Exit node selected",
+		"",
+		map[string]string{"exit_node": req.GetId()},
+	)
+
+	return &proto.SelectExitNodeResponse{}, nil
+}
+
+// ClearExitNode deselects all exit nodes, the internet traffic is not routed through NetBird anymore
+func (s *Server) ClearExitNode(context.Context, *proto.ClearExitNodeRequest) (*proto.ClearExitNodeResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.connectClient == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	if err := engine.GetRouteManager().ClearExitNode(); err != nil {
+		return nil, err
+	}
+	engine.UpdateKillSwitch()
+
+	s.statusRecorder.PublishEvent(
+		proto.SystemEvent_INFO,
+		proto.SystemEvent_SYSTEM,
+		"Exit node cleared",
+		"",
+		nil,
+	)
+
+	return &proto.ClearExitNodeResponse{}, nil
+}
+
+// isExitNodeConnected checks if one of the routing peers of the exit node is connected and routes its traffic
+func (s *Server) isExitNodeConnected(routes []*route.Route) bool {
+	for _, r := range routes {
+		state, err := s.statusRecorder.GetPeer(r.Peer)
+		if err != nil || state.ConnStatus != peer.StatusConnected {
+			continue
+		}
+		if _, ok := state.GetRoutes()[r.Network.String()]; ok {
+			return true
+		}
+	}
+	return false
+}
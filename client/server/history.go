@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/internal"
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// historySampleInterval is the interval the connection quality of the peers is recorded with
+const historySampleInterval = 10 * time.Second
+
+// sampleConnectionHistory records the connection quality of the peers until the context is done
+func sampleConnectionHistory(ctx context.Context, connectClient *internal.ConnectClient, statusRecorder *peer.Status) {
+	ticker := time.NewTicker(historySampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// the transfer stats are only refreshed on demand
+			if engine := connectClient.Engine(); engine != nil {
+				engine.UpdateWireGuardStats()
+			}
+			statusRecorder.RecordPeerHistory()
+		}
+	}
+}
+
+// GetPeerHistory returns the connection history of the peers
+func (s *Server) GetPeerHistory(_ context.Context, req *proto.GetPeerHistoryRequest) (*proto.GetPeerHistoryResponse, error) {
+	s.mutex.Lock()
+	statusRecorder := s.statusRecorder
+	s.mutex.Unlock()
+
+	if statusRecorder == nil {
+		return nil, gstatus.Errorf(codes.FailedPrecondition, "status recorder not initialized")
+	}
+
+	resp := &proto.GetPeerHistoryResponse{}
+	for _, history := range statusRecorder.GetPeerHistory() {
+		if req.GetPeer() != "" && !matchesPeer(history, req.GetPeer()) {
+			continue
+		}
+		resp.Peers = append(resp.Peers, toProtoPeerHistory(history))
+	}
+
+	if req.GetPeer() != "" && len(resp.Peers) == 0 {
+		return nil, gstatus.Errorf(codes.NotFound, "no history recorded for peer %s", req.GetPeer())
+	}
+	return resp, nil
+}
+
+// matchesPeer matches the public key, IP, FQDN or the hostname part of the FQDN like the capture peer filter
+func matchesPeer(history peer.PeerHistory, name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	hostname, _, _ := strings.Cut(history.FQDN, ".")
+	return history.PubKey == name || history.IP == name ||
+		(history.FQDN != "" && (strings.EqualFold(history.FQDN, name) || strings.EqualFold(hostname, name)))
+}
+
+func toProtoPeerHistory(history peer.PeerHistory) *proto.PeerHistory {
+	pbHistory := &proto.PeerHistory{
+		PubKey: history.PubKey,
+		Fqdn:   history.FQDN,
+		Ip:     history.IP,
+	}
+
+	for _, sample := range history.Samples {
+		pbHistory.Samples = append(pbHistory.Samples, &proto.PeerHistorySample{
+			Timestamp:  timestamppb.New(sample.Timestamp),
+			ConnStatus: sample.ConnStatus.String(),
+			Relayed:    sample.Relayed,
+			Latency:    durationpb.New(sample.Latency),
+			BytesRx:    sample.BytesRx,
+			BytesTx:    sample.BytesTx,
+		})
+	}
+
+	for _, event := range history.Events {
+		pbHistory.Events = append(pbHistory.Events, &proto.PeerConnectionEvent{
+			Timestamp:  timestamppb.New(event.Timestamp),
+			ConnStatus: event.ConnStatus.String(),
+			Relayed:    event.Relayed,
+			Reason:     event.Reason,
+		})
+	}
+
+	return pbHistory
+}
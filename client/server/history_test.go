@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+func TestGetPeerHistory(t *testing.T) {
+	recorder := peer.NewRecorder("https://mgm")
+	require.NoError(t, recorder.AddPeer("key-a", "peer-a.netbird.cloud"))
+	require.NoError(t, recorder.AddPeer("key-b", "peer-b.netbird.cloud"))
+	require.NoError(t, recorder.UpdatePeerRelayedState(peer.State{PubKey: "key-a", ConnStatus: peer.StatusConnected, Relayed: true}))
+	require.NoError(t, recorder.UpdateLatency("key-a", 25*time.Millisecond))
+	recorder.RecordPeerHistory()
+
+	s := &Server{statusRecorder: recorder}
+
+	resp, err := s.GetPeerHistory(context.Background(), &proto.GetPeerHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetPeers(), 2)
+
+	for _, name := range []string{"key-a", "peer-a.netbird.cloud.", "PEER-A"} {
+		resp, err = s.GetPeerHistory(context.Background(), &proto.GetPeerHistoryRequest{Peer: name})
+		require.NoError(t, err, name)
+		require.Len(t, resp.GetPeers(), 1, name)
+		assert.Equal(t, "key-a", resp.GetPeers()[0].GetPubKey())
+	}
+
+	history := resp.GetPeers()[0]
+	require.Len(t, history.GetSamples(), 1)
+	assert.Equal(t, peer.StatusConnected.String(), history.GetSamples()[0].GetConnStatus())
+	assert.Equal(t, 25*time.Millisecond, history.GetSamples()[0].GetLatency().AsDuration())
+	require.Len(t, history.GetEvents(), 1)
+	assert.True(t, history.GetEvents()[0].GetRelayed())
+
+	_, err = s.GetPeerHistory(context.Background(), &proto.GetPeerHistoryRequest{Peer: "key-A"})
+	assert.Equal(t, codes.NotFound, gstatus.Code(err), "public keys are case sensitive")
+}
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/netbirdio/netbird/client/internal/metrics"
+	"github.com/netbirdio/netbird/client/internal/peer"
+)
+
+// RegisterMetrics exposes the connection state of the client through the meter, the values are read on every collection
+func (s *Server) RegisterMetrics(meter metric.Meter) error {
+	return metrics.RegisterClientMetrics(meter, s.metricsSource)
+}
+
+func (s *Server) metricsSource() (*peer.Status, metrics.Engine) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return s.statusRecorder, nil
+	}
+	return s.statusRecorder, engine
+}
@@ -134,6 +134,7 @@ func (s *Server) SelectNetworks(_ context.Context, req *proto.SelectNetworksRequ
 		}
 	}
 	routeManager.TriggerSelection(routeManager.GetClientRoutes())
+	engine.UpdateKillSwitch()
 
 	s.statusRecorder.PublishEvent(
 		proto.SystemEvent_INFO,
@@ -176,6 +177,7 @@ func (s *Server) DeselectNetworks(_ context.Context, req *proto.SelectNetworksRe
 		}
 	}
 	routeManager.TriggerSelection(routeManager.GetClientRoutes())
+	engine.UpdateKillSwitch()
 
 	s.statusRecorder.PublishEvent(
 		proto.SystemEvent_INFO,
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/internal"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// ListProfiles returns the profiles of the daemon
+func (s *Server) ListProfiles(_ context.Context, _ *proto.ListProfilesRequest) (*proto.ListProfilesResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	names, err := s.profileManager.ListProfiles()
+	if err != nil {
+		return nil, gstatus.Errorf(codes.Internal, "failed to list profiles: %v", err)
+	}
+
+	activeProfile := s.profileManager.ActiveProfile()
+	resp := &proto.ListProfilesResponse{}
+	for _, name := range names {
+		profile := &proto.Profile{
+			Name:   name,
+			Active: name == activeProfile,
+		}
+
+		config, err := internal.ReadConfig(s.profileManager.ConfigPath(name))
+		if err != nil {
+			log.Warnf("failed to read config of profile %s: %v", name, err)
+		} else if config.ManagementURL != nil {
+			profile.ManagementUrl = config.ManagementURL.String()
+		}
+
+		resp.Profiles = append(resp.Profiles, profile)
+	}
+
+	return resp, nil
+}
+
+// AddProfile creates a new profile
+func (s *Server) AddProfile(_ context.Context, req *proto.AddProfileRequest) (*proto.AddProfileResponse, error) {
+	if err := internal.ValidateProfileName(req.GetName()); err != nil {
+		return nil, gstatus.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.profileManager.Exists(req.GetName()) {
+		return nil, gstatus.Errorf(codes.AlreadyExists, "profile %s already exists", req.GetName())
+	}
+
+	if _, err := s.profileManager.AddProfile(req.GetName(), req.GetManagementUrl()); err != nil {
+		return nil, gstatus.Errorf(codes.Internal, "failed to add profile: %v", err)
+	}
+
+	log.Infof("added profile %s", req.GetName())
+
+	return &proto.AddProfileResponse{}, nil
+}
+
+// RemoveProfile deletes a profile that is not active
+func (s *Server) RemoveProfile(_ context.Context, req *proto.RemoveProfileRequest) (*proto.RemoveProfileResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.profileManager.RemoveProfile(req.GetName()); err != nil {
+		if errors.Is(err, internal.ErrProfileNotFound) {
+			return nil, gstatus.Errorf(codes.NotFound, "profile %s not found", req.GetName())
+		}
+		return nil, gstatus.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	log.Infof("removed profile %s", req.GetName())
+
+	return &proto.RemoveProfileResponse{}, nil
+}
+
+// SwitchProfile disconnects the engine of the active profile and activates the given profile.
+// The new profile is connected with the next up command.
+func (s *Server) SwitchProfile(_ context.Context, req *proto.SwitchProfileRequest) (*proto.SwitchProfileResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	name := req.GetName()
+	if !s.profileManager.Exists(name) {
+		return nil, gstatus.Errorf(codes.NotFound, "profile %s not found", name)
+	}
+
+	configPath := s.profileManager.ConfigPath(name)
+	if s.latestConfigInput.ConfigPath == configPath {
+		return &proto.SwitchProfileResponse{}, nil
+	}
+
+	if s.oauthAuthFlow.waitCancel != nil {
+		s.oauthAuthFlow.waitCancel()
+	}
+	s.oauthAuthFlow = oauthAuthFlow{}
+
+	if s.actCancel != nil {
+		s.actCancel()
+		s.actCancel = nil
+	}
+	if err := s.connectClient.Stop(); err != nil {
+		log.Errorf("failed to stop the engine of the previous profile: %v", err)
+	}
+	s.connectClient = nil
+
+	// the engine undoes its changes when stopping, clean up what an unclean stop left in the state of the profile
+	if err := restoreResidualState(s.rootCtx, s.statePath()); err != nil {
+		log.Warnf(errRestoreResidualState, err)
+	}
+
+	if err := s.profileManager.SetActiveProfile(name); err != nil {
+		return nil, gstatus.Errorf(codes.Internal, "failed to switch profile: %v", err)
+	}
+
+	// the settings of the previous profile must not leak into the new one
+	s.latestConfigInput = internal.ConfigInput{
+		ConfigPath: configPath,
+	}
+
+	config, err := internal.UpdateOrCreateConfig(s.latestConfigInput)
+	if err != nil {
+		return nil, gstatus.Errorf(codes.Internal, "failed to load config of profile %s: %v", name, err)
+	}
+	s.config = config
+
+	if s.statusRecorder != nil {
+		s.statusRecorder.UpdateManagementAddress(config.ManagementURL.String())
+		s.statusRecorder.UpdateRosenpass(config.RosenpassEnabled, config.RosenpassPermissive)
+	}
+
+	internal.CtxGetState(s.rootCtx).Set(internal.StatusIdle)
+
+	log.Infof("switched to profile %s", name)
+
+	return &proto.SwitchProfileResponse{}, nil
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/internal"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+func TestSwitchProfile(t *testing.T) {
+	ctx := internal.CtxInitState(context.Background())
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	statePath := filepath.Join(dir, "state.json")
+	t.Setenv("NB_DNS_STATE_FILE", statePath)
+	s := New(ctx, configPath, "")
+	assert.Equal(t, statePath, s.statePath())
+
+	_, err := s.AddProfile(ctx, &proto.AddProfileRequest{Name: "work", ManagementUrl: "https://work.example.com:443"})
+	require.NoError(t, err)
+
+	_, err = s.AddProfile(ctx, &proto.AddProfileRequest{Name: "work"})
+	assert.Equal(t, codes.AlreadyExists, gstatus.Code(err))
+
+	_, err = s.SwitchProfile(ctx, &proto.SwitchProfileRequest{Name: "missing"})
+	assert.Equal(t, codes.NotFound, gstatus.Code(err))
+
+	_, err = s.SwitchProfile(ctx, &proto.SwitchProfileRequest{Name: "work"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://work.example.com:443", s.config.ManagementURL.String())
+	assert.Equal(t, filepath.Join(filepath.Dir(configPath), "profiles", "work.json"), s.latestConfigInput.ConfigPath)
+	assert.Equal(t, filepath.Join(dir, "profiles", "work.state.json"), s.statePath(), "every profile should have its own state")
+
+	resp, err := s.ListProfiles(ctx, &proto.ListProfilesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Profiles, 2)
+	assert.False(t, resp.Profiles[0].Active)
+	assert.Equal(t, "work", resp.Profiles[1].Name)
+	assert.True(t, resp.Profiles[1].Active)
+	assert.Equal(t, "https://work.example.com:443", resp.Profiles[1].ManagementUrl)
+
+	_, err = s.RemoveProfile(ctx, &proto.RemoveProfileRequest{Name: "work"})
+	assert.Equal(t, codes.FailedPrecondition, gstatus.Code(err))
+
+	// a restarted daemon starts with the active profile
+	restarted := New(ctx, configPath, "")
+	assert.Equal(t, s.latestConfigInput.ConfigPath, restarted.latestConfigInput.ConfigPath)
+
+	_, err = s.SwitchProfile(ctx, &proto.SwitchProfileRequest{Name: internal.DefaultProfileName})
+	require.NoError(t, err)
+	assert.Equal(t, configPath, s.latestConfigInput.ConfigPath)
+	assert.Equal(t, statePath, s.statePath())
+
+	_, err = s.RemoveProfile(ctx, &proto.RemoveProfileRequest{Name: "work"})
+	require.NoError(t, err)
+}
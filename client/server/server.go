@@ -66,6 +66,10 @@ type Server struct {
 
 	lastProbe         time.Time
 	persistNetworkMap bool
+
+	capture *captureSession
+
+	profileManager *internal.ProfileManager
 }
 
 type oauthAuthFlow struct {
@@ -77,13 +81,15 @@ type oauthAuthFlow struct {
 
 // New server instance constructor.
 func New(ctx context.Context, configPath, logFile string) *Server {
+	profileManager := internal.NewProfileManager(configPath)
 	return &Server{
 		rootCtx: ctx,
 		latestConfigInput: internal.ConfigInput{
-			ConfigPath: configPath,
+			ConfigPath: profileManager.ConfigPath(profileManager.ActiveProfile()),
 		},
 		logFile:           logFile,
 		persistNetworkMap: true,
+		profileManager:    profileManager,
 	}
 }
 
@@ -96,7 +102,7 @@ func (s *Server) Start() error {
 		log.Warnf("failed to redirect stderr: %v", err)
 	}
 
-	if err := restoreResidualState(s.rootCtx); err != nil {
+	if err := s.restoreResidualProfileStates(s.rootCtx); err != nil {
 		log.Warnf(errRestoreResidualState, err)
 	}
 
@@ -164,6 +170,7 @@ func (s *Server) connectWithRetryRuns(ctx context.Context, config *internal.Conf
 ) {
 	backOff := getConnectWithBackoff(ctx)
 	retryStarted := false
+	stateFilePath := s.statePath()
 
 	go func() {
 		t := time.NewTicker(24 * time.Hour)
@@ -192,8 +199,13 @@ func (s *Server) connectWithRetryRuns(ctx context.Context, config *internal.Conf
 		log.Tracef("running client connection")
 		s.connectClient = internal.NewConnectClient(ctx, config, statusRecorder)
 		s.connectClient.SetNetworkMapPersistence(s.persistNetworkMap)
+		s.connectClient.SetStateFilePath(stateFilePath)
+
+		sampleCtx, stopSampling := context.WithCancel(ctx)
+		go sampleConnectionHistory(sampleCtx, s.connectClient, statusRecorder)
 
 		err := s.connectClient.Run(runningChan)
+		stopSampling()
 		if err != nil {
 			log.Debugf("run client connection exited with error: %v. Will retry in the background", err)
 		}
@@ -291,7 +303,7 @@ func (s *Server) Login(callerCtx context.Context, msg *proto.LoginRequest) (*pro
 	s.actCancel = cancel
 	s.mutex.Unlock()
 
-	if err := restoreResidualState(ctx); err != nil {
+	if err := restoreResidualState(ctx, s.statePath()); err != nil {
 		log.Warnf(errRestoreResidualState, err)
 	}
 
@@ -405,6 +417,15 @@ func (s *Server) Login(callerCtx context.Context, msg *proto.LoginRequest) (*pro
 		s.latestConfigInput.BlockLANAccess = msg.BlockLanAccess
 	}
 
+	if msg.ExitNodeKillSwitch != nil {
+		inputConfig.ExitNodeKillSwitch = msg.ExitNodeKillSwitch
+		s.latestConfigInput.ExitNodeKillSwitch = msg.ExitNodeKillSwitch
+	}
+	if msg.ExitNodeAllowLan != nil {
+		inputConfig.ExitNodeAllowLAN = msg.ExitNodeAllowLan
+		s.latestConfigInput.ExitNodeAllowLAN = msg.ExitNodeAllowLan
+	}
+
 	if msg.CleanDNSLabels {
 		inputConfig.DNSLabels = domain.List{}
 		s.latestConfigInput.DNSLabels = nil
@@ -588,7 +609,7 @@ func (s *Server) Up(callerCtx context.Context, _ *proto.UpRequest) (*proto.UpRes
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	if err := restoreResidualState(callerCtx); err != nil {
+	if err := restoreResidualState(callerCtx, s.statePath()); err != nil {
 		log.Warnf(errRestoreResidualState, err)
 	}
 
@@ -830,6 +851,7 @@ func toProtoFullStatus(fullStatus peer.FullStatus) *proto.FullStatus {
 			RosenpassEnabled:           peerState.RosenpassEnabled,
 			Networks:                   maps.Keys(peerState.GetRoutes()),
 			Latency:                    durationpb.New(peerState.Latency),
+			ConnectionStrategy:         peerState.ConnStrategy.String(),
 		}
 		pbFullStatus.Peers = append(pbFullStatus.Peers, pbPeerState)
 	}
@@ -842,6 +864,9 @@ func toProtoFullStatus(fullStatus peer.FullStatus) *proto.FullStatus {
 		if err := relayState.Err; err != nil {
 			pbRelayState.Error = err.Error()
 		}
+		if relayState.ThrottledUntil.After(time.Now()) {
+			pbRelayState.ThrottledUntil = timestamppb.New(relayState.ThrottledUntil)
+		}
 		pbFullStatus.Relays = append(pbFullStatus.Relays, pbRelayState)
 	}
 
@@ -859,6 +884,24 @@ func toProtoFullStatus(fullStatus peer.FullStatus) *proto.FullStatus {
 		pbFullStatus.DnsServers = append(pbFullStatus.DnsServers, pbDnsState)
 	}
 
+	for _, healthState := range fullStatus.RouteHealth {
+		pbHealthState := &proto.RouteHealthState{
+			Network:    healthState.Network,
+			PeerPubKey: healthState.PeerKey,
+			Type:       healthState.Type,
+			Target:     healthState.Target,
+			Healthy:    healthState.Healthy,
+			Chosen:     healthState.Chosen,
+		}
+		if !healthState.LastCheck.IsZero() {
+			pbHealthState.LastCheck = timestamppb.New(healthState.LastCheck)
+		}
+		if healthState.Error != nil {
+			pbHealthState.Error = healthState.Error.Error()
+		}
+		pbFullStatus.RouteHealth = append(pbFullStatus.RouteHealth, pbHealthState)
+	}
+
 	return &pbFullStatus
 }
 
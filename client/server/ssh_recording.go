@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/proto"
+	nbssh "github.com/netbirdio/netbird/client/ssh"
+)
+
+// ListSSHRecordings lists the metadata of the terminal sessions recorded by the SSH server. The daemon socket is
+// accessible to all local users, so the recorded sessions are never sent through it. They are read from the
+// recording directory, which is only accessible to root
+func (s *Server) ListSSHRecordings(context.Context, *proto.ListSSHRecordingsRequest) (*proto.ListSSHRecordingsResponse, error) {
+	dir := nbssh.DefaultRecordingConfig().Dir
+	recordings, err := nbssh.ListRecordings(dir)
+	if err != nil {
+		return nil, gstatus.Errorf(codes.Internal, "list SSH recordings: %v", err)
+	}
+
+	resp := &proto.ListSSHRecordingsResponse{Dir: dir}
+	for _, recording := range recordings {
+		pbRecording := &proto.SSHRecording{
+			Id:         recording.ID,
+			PeerKey:    recording.PeerKey,
+			LocalUser:  recording.LocalUser,
+			RemoteAddr: recording.RemoteAddr,
+			StartedAt:  timestamppb.New(recording.StartedAt),
+			Size:       recording.Size,
+			Truncated:  recording.Truncated,
+		}
+		if !recording.EndedAt.IsZero() {
+			pbRecording.EndedAt = timestamppb.New(recording.EndedAt)
+		}
+		resp.Recordings = append(resp.Recordings, pbRecording)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,110 @@
+package ssh
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gliderlabs/ssh"
+	log "github.com/sirupsen/logrus"
+)
+
+// peerContextKey is the key of the WireGuard public key of the authenticated peer in the SSH context
+var peerContextKey = &struct{ name string }{"netbird-peer"}
+
+// loginContextKey is the key of the loginState of the connection in the SSH context
+var loginContextKey = &struct{ name string }{"netbird-login"}
+
+// loginState tracks the last login outcome reported for a connection, so the checks of its channels and requests
+// report the login once and only report again when the authorization of the connection changes
+type loginState struct {
+	mu       sync.Mutex
+	reported bool
+	allowed  bool
+}
+
+// LoginEvent is a login attempt of a peer with an authorized key
+type LoginEvent struct {
+	// PeerKey is the WireGuard public key of the peer the login came from
+	PeerKey string
+	// LocalUser is the local user the peer tried to log in as
+	LocalUser string
+	// Allowed indicates whether the login was allowed
+	Allowed bool
+	// Reason the login was denied
+	Reason string
+	// Timestamp of the login attempt
+	Timestamp time.Time
+}
+
+// SetAuthorizedUsers restricts the local users the peers may log in as. The users are indexed by the peer WireGuard
+// public key, peers without users can't log in. A nil map allows the peers to log in as any local user.
+// Established sessions are checked again when they open a channel or a port forwarding
+func (srv *DefaultServer) SetAuthorizedUsers(users map[string][]string) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.authorizedUsers = users
+}
+
+// SetLoginListener sets the function called once per allowed or denied login of a peer with an authorized key
+func (srv *DefaultServer) SetLoginListener(listener func(LoginEvent)) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.loginListener = listener
+}
+
+// checkUser checks whether the peer may log in as the local user
+func (srv *DefaultServer) checkUser(peer, localUser string) error {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if srv.authorizedUsers != nil && !slices.Contains(srv.authorizedUsers[peer], localUser) {
+		return fmt.Errorf("peer is not authorized to log in as %s", localUser)
+	}
+	return nil
+}
+
+// reportLogin reports the login attempt of the peer to the listener, err is the reason the login was denied
+func (srv *DefaultServer) reportLogin(peer, localUser string, err error) {
+	srv.mu.Lock()
+	listener := srv.loginListener
+	srv.mu.Unlock()
+
+	if listener == nil {
+		return
+	}
+
+	event := LoginEvent{PeerKey: peer, LocalUser: localUser, Allowed: err == nil, Timestamp: time.Now()}
+	if err != nil {
+		event.Reason = err.Error()
+	}
+	listener(event)
+}
+
+// authorizeContext checks whether the peer authenticated in the context may still log in as the user of the context.
+// The authorized users might have changed since the peer authenticated. The login of the connection is reported on
+// its first check after the authentication, and again only when the outcome changes
+func (srv *DefaultServer) authorizeContext(ctx ssh.Context) error {
+	peer, _ := ctx.Value(peerContextKey).(string)
+	err := srv.checkUser(peer, ctx.User())
+
+	if state, ok := ctx.Value(loginContextKey).(*loginState); ok {
+		state.mu.Lock()
+		report := !state.reported || state.allowed != (err == nil)
+		state.reported, state.allowed = true, err == nil
+		state.mu.Unlock()
+
+		if report {
+			srv.reportLogin(peer, ctx.User(), err)
+		}
+	}
+
+	if err != nil {
+		log.Warnf("denied SSH access from %v, user %s: %v", ctx.RemoteAddr(), ctx.User(), err)
+		return err
+	}
+	return nil
+}
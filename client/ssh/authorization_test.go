@@ -0,0 +1,99 @@
+package ssh
+
+import (
+	"os/user"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_AuthorizedUsers(t *testing.T) {
+	hostKey, err := GeneratePrivateKey(ED25519)
+	require.NoError(t, err)
+	server, err := newDefaultServer(hostKey, "127.0.0.1:0")
+	require.NoError(t, err)
+
+	clientKey, err := GeneratePrivateKey(ED25519)
+	require.NoError(t, err)
+	clientPubKey, err := GeneratePublicKey(clientKey)
+	require.NoError(t, err)
+	require.NoError(t, server.AddAuthorizedKey("remotePeer", string(clientPubKey)))
+	server.SetFeatures(Features{LocalPortForwarding: true})
+
+	var mu sync.Mutex
+	var events []LoginEvent
+	server.SetLoginListener(func(event LoginEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	})
+	lastEvent := func() LoginEvent {
+		mu.Lock()
+		defer mu.Unlock()
+		require.NotEmpty(t, events)
+		return events[len(events)-1]
+	}
+	eventCount := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(events)
+	}
+
+	go func() {
+		_ = server.Start()
+	}()
+	t.Cleanup(func() {
+		_ = server.Stop()
+	})
+
+	currentUser, err := user.Current()
+	require.NoError(t, err)
+	addr := server.listener.Addr().String()
+
+	server.SetAuthorizedUsers(map[string][]string{"remotePeer": {"not-" + currentUser.Username}})
+	_, err = DialWithKey(addr, currentUser.Username, clientKey)
+	assert.Error(t, err, "login as a user that isn't authorized should be denied")
+	event := lastEvent()
+	assert.Equal(t, "remotePeer", event.PeerKey)
+	assert.Equal(t, currentUser.Username, event.LocalUser)
+	assert.False(t, event.Allowed)
+	assert.NotEmpty(t, event.Reason)
+
+	server.SetAuthorizedUsers(map[string][]string{"remotePeer": {currentUser.Username}})
+	client, err := DialWithKey(addr, currentUser.Username, clientKey)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	echoAddr := startEchoServer(t)
+	for i := 0; i < 2; i++ {
+		conn, err := client.client.Dial("tcp", echoAddr)
+		require.NoError(t, err)
+		assertEcho(t, conn)
+	}
+	assert.True(t, lastEvent().Allowed)
+	assert.Equal(t, 2, eventCount(), "the login should be reported once per connection")
+
+	// revoking the authorization denies new channels of the established connection
+	server.SetAuthorizedUsers(map[string][]string{})
+	for i := 0; i < 2; i++ {
+		_, err = client.client.Dial("tcp", echoAddr)
+		assert.Error(t, err, "local port forwarding should be denied after the authorization is revoked")
+	}
+	assert.False(t, lastEvent().Allowed)
+	assert.Equal(t, 3, eventCount(), "the denial should be reported once per connection")
+
+	// a nil map allows any local user
+	server.SetAuthorizedUsers(nil)
+	client, err = DialWithKey(addr, currentUser.Username, clientKey)
+	require.NoError(t, err)
+	conn, err := client.client.Dial("tcp", echoAddr)
+	require.NoError(t, err)
+	assertEcho(t, conn)
+	_ = client.Close()
+	assert.True(t, lastEvent().Allowed)
+	assert.Equal(t, 4, eventCount())
+}
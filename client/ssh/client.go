@@ -1,6 +1,7 @@
 package ssh
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
@@ -84,6 +85,37 @@ func (c *Client) OpenTerminal() error {
 	return nil
 }
 
+// LocalForward accepts connections on the local bind address and forwards them through the remote server
+// to the target address. Blocks until the context is done or the listener fails
+func (c *Client) LocalForward(ctx context.Context, forward Forward) error {
+	listener, err := net.Listen("tcp", forward.BindAddress)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", forward.BindAddress, err)
+	}
+
+	return forwardConnections(ctx, listener, func() (net.Conn, error) {
+		return c.client.Dial("tcp", forward.TargetAddress)
+	})
+}
+
+// RemoteForward accepts connections on the bind address of the remote server and forwards them
+// to the target address. Blocks until the context is done or the listener fails
+func (c *Client) RemoteForward(ctx context.Context, forward Forward) error {
+	listener, err := c.client.Listen("tcp", forward.BindAddress)
+	if err != nil {
+		return fmt.Errorf("listen on remote %s: %w", forward.BindAddress, err)
+	}
+
+	return forwardConnections(ctx, listener, func() (net.Conn, error) {
+		return net.Dial("tcp", forward.TargetAddress)
+	})
+}
+
+// Wait blocks until the connection to the remote server is closed
+func (c *Client) Wait() error {
+	return c.client.Wait()
+}
+
 // DialWithKey connects to the remote SSH server with a provided private key file (PEM).
 func DialWithKey(addr, user string, privateKey []byte) (*Client, error) {
 
@@ -0,0 +1,175 @@
+package ssh
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/sftp"
+)
+
+// Upload copies a local file to the remote path over SFTP. Directories are copied only if recursive is set.
+// Like scp, the file is copied into the remote path if it is an existing directory
+func (c *Client) Upload(localPath, remotePath string, recursive bool) error {
+	client, err := sftp.NewClient(c.client)
+	if err != nil {
+		return fmt.Errorf("start sftp session: %w", err)
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	if remotePath == "" {
+		remotePath = "."
+	}
+	if remoteInfo, err := client.Stat(remotePath); err == nil && remoteInfo.IsDir() {
+		remotePath = path.Join(remotePath, filepath.Base(localPath))
+	}
+
+	if !info.IsDir() {
+		return uploadFile(client, localPath, remotePath, info.Mode())
+	}
+
+	if !recursive {
+		return fmt.Errorf("%s is a directory, use the recursive flag to copy directories", localPath)
+	}
+
+	return filepath.WalkDir(localPath, func(localFile string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(localPath, localFile)
+		if err != nil {
+			return err
+		}
+		remoteFile := path.Join(remotePath, filepath.ToSlash(rel))
+
+		switch {
+		case entry.IsDir():
+			return client.MkdirAll(remoteFile)
+		case entry.Type().IsRegular():
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			return uploadFile(client, localFile, remoteFile, info.Mode())
+		default:
+			// symlinks and special files are skipped
+			return nil
+		}
+	})
+}
+
+// Download copies a remote file to the local path over SFTP. Directories are copied only if recursive is set.
+// Like scp, the file is copied into the local path if it is an existing directory
+func (c *Client) Download(remotePath, localPath string, recursive bool) error {
+	client, err := sftp.NewClient(c.client)
+	if err != nil {
+		return fmt.Errorf("start sftp session: %w", err)
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	if remotePath == "" {
+		remotePath = "."
+	}
+	info, err := client.Stat(remotePath)
+	if err != nil {
+		return fmt.Errorf("stat remote %s: %w", remotePath, err)
+	}
+
+	if localInfo, err := os.Stat(localPath); err == nil && localInfo.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(remotePath))
+	}
+
+	if !info.IsDir() {
+		return downloadFile(client, remotePath, localPath, info.Mode())
+	}
+
+	if !recursive {
+		return fmt.Errorf("%s is a directory, use the recursive flag to copy directories", remotePath)
+	}
+
+	walker := client.Walk(remotePath)
+	for walker.Step() {
+		if err := walker.Err(); err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(remotePath, walker.Path())
+		if err != nil {
+			return err
+		}
+		localFile := filepath.Join(localPath, rel)
+
+		switch info := walker.Stat(); {
+		case info.IsDir():
+			if err := os.MkdirAll(localFile, 0o755); err != nil {
+				return err
+			}
+		case info.Mode().IsRegular():
+			if err := downloadFile(client, walker.Path(), localFile, info.Mode()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func uploadFile(client *sftp.Client, localPath, remotePath string, mode fs.FileMode) error {
+	src, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+
+	dst, err := client.OpenFile(remotePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return fmt.Errorf("open remote %s: %w", remotePath, err)
+	}
+	defer func() {
+		_ = dst.Close()
+	}()
+
+	if _, err = dst.ReadFrom(src); err != nil {
+		return fmt.Errorf("upload %s: %w", localPath, err)
+	}
+
+	return client.Chmod(remotePath, mode.Perm())
+}
+
+func downloadFile(client *sftp.Client, remotePath, localPath string, mode fs.FileMode) error {
+	src, err := client.Open(remotePath)
+	if err != nil {
+		return fmt.Errorf("open remote %s: %w", remotePath, err)
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+
+	dst, err := os.OpenFile(localPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dst.Close()
+	}()
+
+	if _, err = src.WriteTo(dst); err != nil {
+		return fmt.Errorf("download %s: %w", remotePath, err)
+	}
+
+	return nil
+}
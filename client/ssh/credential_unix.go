@@ -0,0 +1,42 @@
+//go:build !windows
+
+package ssh
+
+import (
+	"fmt"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// setUserCredential makes the command run with the user and group IDs of the local user
+func setUserCredential(cmd *exec.Cmd, localUser *user.User) error {
+	uid, err := strconv.ParseUint(localUser.Uid, 10, 32)
+	if err != nil {
+		return fmt.Errorf("parse uid of user %s: %w", localUser.Username, err)
+	}
+
+	gid, err := strconv.ParseUint(localUser.Gid, 10, 32)
+	if err != nil {
+		return fmt.Errorf("parse gid of user %s: %w", localUser.Username, err)
+	}
+
+	var groups []uint32
+	groupIDs, err := localUser.GroupIds()
+	if err != nil {
+		return fmt.Errorf("get groups of user %s: %w", localUser.Username, err)
+	}
+	for _, groupID := range groupIDs {
+		id, err := strconv.ParseUint(groupID, 10, 32)
+		if err != nil {
+			continue
+		}
+		groups = append(groups, uint32(id))
+	}
+
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Credential: &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid), Groups: groups},
+	}
+	return nil
+}
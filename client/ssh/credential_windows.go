@@ -0,0 +1,11 @@
+package ssh
+
+import (
+	"fmt"
+	"os/exec"
+	"os/user"
+)
+
+func setUserCredential(_ *exec.Cmd, localUser *user.User) error {
+	return fmt.Errorf("running commands as user %s is not supported on windows", localUser.Username)
+}
@@ -0,0 +1,177 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/gliderlabs/ssh"
+	log "github.com/sirupsen/logrus"
+)
+
+// Forward is a TCP port forwarding in the format of the ssh -L and -R options: [bind_address:]port:host:hostport
+type Forward struct {
+	// BindAddress is the address the forwarded connections are accepted on
+	BindAddress string
+	// TargetAddress is the address the forwarded connections are opened to
+	TargetAddress string
+}
+
+// ParseForward parses a port forwarding specification. The bind address defaults to localhost,
+// IPv6 addresses are enclosed in square brackets
+func ParseForward(spec string) (Forward, error) {
+	fields := splitForwardSpec(spec)
+
+	var bindHost string
+	switch len(fields) {
+	case 3:
+		bindHost = "localhost"
+	case 4:
+		bindHost, fields = fields[0], fields[1:]
+	default:
+		return Forward{}, fmt.Errorf("invalid port forwarding %q, expected [bind_address:]port:host:hostport", spec)
+	}
+
+	for _, port := range []string{fields[0], fields[2]} {
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return Forward{}, fmt.Errorf("invalid port %q in port forwarding %q", port, spec)
+		}
+	}
+
+	if fields[1] == "" {
+		return Forward{}, fmt.Errorf("missing host in port forwarding %q", spec)
+	}
+
+	return Forward{
+		BindAddress:   net.JoinHostPort(bindHost, fields[0]),
+		TargetAddress: net.JoinHostPort(fields[1], fields[2]),
+	}, nil
+}
+
+// splitForwardSpec splits the specification by colons that aren't enclosed in square brackets and removes the brackets
+func splitForwardSpec(spec string) []string {
+	var fields []string
+	var field strings.Builder
+	inBrackets := false
+
+	for _, c := range spec {
+		switch {
+		case c == '[' && field.Len() == 0:
+			inBrackets = true
+		case c == ']' && inBrackets:
+			inBrackets = false
+		case c == ':' && !inBrackets:
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteRune(c)
+		}
+	}
+
+	return append(fields, field.String())
+}
+
+// localPortForwardingHandler allows direct-tcpip channels when the local port forwarding is enabled
+func (srv *DefaultServer) localPortForwardingHandler(ctx ssh.Context, host string, port uint32) bool {
+	address := net.JoinHostPort(host, strconv.FormatUint(uint64(port), 10))
+	if !srv.getFeatures().LocalPortForwarding {
+		log.Warnf("denied local port forwarding to %s from %v, user %s: local port forwarding is disabled", address, ctx.RemoteAddr(), ctx.User())
+		return false
+	}
+
+	if err := srv.authorizeContext(ctx); err != nil {
+		return false
+	}
+
+	log.Debugf("local port forwarding to %s from %v, user %s", address, ctx.RemoteAddr(), ctx.User())
+	return true
+}
+
+// remotePortForwardingHandler allows tcpip-forward requests when the remote port forwarding is enabled.
+// Only loopback addresses can be bound so forwarded ports aren't exposed to the network
+func (srv *DefaultServer) remotePortForwardingHandler(ctx ssh.Context, host string, port uint32) bool {
+	address := net.JoinHostPort(host, strconv.FormatUint(uint64(port), 10))
+	if !srv.getFeatures().RemotePortForwarding {
+		log.Warnf("denied remote port forwarding on %s from %v, user %s: remote port forwarding is disabled", address, ctx.RemoteAddr(), ctx.User())
+		return false
+	}
+
+	if err := srv.authorizeContext(ctx); err != nil {
+		return false
+	}
+
+	if !isLoopbackHost(host) {
+		log.Warnf("denied remote port forwarding on %s from %v, user %s: only loopback addresses can be bound", address, ctx.RemoteAddr(), ctx.User())
+		return false
+	}
+
+	log.Debugf("remote port forwarding on %s from %v, user %s", address, ctx.RemoteAddr(), ctx.User())
+	return true
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	addr, err := netip.ParseAddr(host)
+	return err == nil && addr.IsLoopback()
+}
+
+// forwardConnections accepts connections on the listener and pipes them to the connections returned by dial
+// until the context is done
+func forwardConnections(ctx context.Context, listener net.Listener, dial func() (net.Conn, error)) error {
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		_ = listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		go func() {
+			target, err := dial()
+			if err != nil {
+				log.Warnf("failed forwarding connection from %v: %v", conn.RemoteAddr(), err)
+				_ = conn.Close()
+				return
+			}
+			pipe(conn, target)
+		}()
+	}
+}
+
+// pipe copies data between the connections until one of them is closed
+func pipe(a, b net.Conn) {
+	defer func() {
+		_ = a.Close()
+		_ = b.Close()
+	}()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(a, b)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(b, a)
+		done <- struct{}{}
+	}()
+	<-done
+}
@@ -0,0 +1,197 @@
+package ssh
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startTestServer starts a server with the features and connects to it as the current user
+func startTestServer(t *testing.T, features Features) *Client {
+	t.Helper()
+
+	hostKey, err := GeneratePrivateKey(ED25519)
+	require.NoError(t, err)
+	server, err := newDefaultServer(hostKey, "127.0.0.1:0")
+	require.NoError(t, err)
+
+	clientKey, err := GeneratePrivateKey(ED25519)
+	require.NoError(t, err)
+	clientPubKey, err := GeneratePublicKey(clientKey)
+	require.NoError(t, err)
+	require.NoError(t, server.AddAuthorizedKey("remotePeer", string(clientPubKey)))
+	server.SetFeatures(features)
+
+	go func() {
+		_ = server.Start()
+	}()
+	t.Cleanup(func() {
+		_ = server.Stop()
+	})
+
+	currentUser, err := user.Current()
+	require.NoError(t, err)
+
+	client, err := DialWithKey(server.listener.Addr().String(), currentUser.Username, clientKey)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+// startEchoServer returns the address of a TCP server writing back what it receives
+func startEchoServer(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func assertEcho(t *testing.T, conn net.Conn) {
+	t.Helper()
+	defer conn.Close()
+
+	// channels of the SSH connection don't support deadlines
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+	_, err := conn.Write([]byte("ping"))
+	require.NoError(t, err)
+
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+}
+
+func TestParseForward(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    Forward
+		wantErr bool
+	}{
+		{spec: "8080:10.0.0.1:80", want: Forward{BindAddress: "localhost:8080", TargetAddress: "10.0.0.1:80"}},
+		{spec: "0.0.0.0:8080:db.internal:5432", want: Forward{BindAddress: "0.0.0.0:8080", TargetAddress: "db.internal:5432"}},
+		{spec: "[::1]:8080:[fd00::1]:80", want: Forward{BindAddress: "[::1]:8080", TargetAddress: "[fd00::1]:80"}},
+		{spec: "8080:10.0.0.1", wantErr: true},
+		{spec: "http:10.0.0.1:80", wantErr: true},
+		{spec: "8080::80", wantErr: true},
+		{spec: "8080:10.0.0.1:70000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseForward(tt.spec)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestServer_LocalPortForwarding(t *testing.T) {
+	echoAddr := startEchoServer(t)
+
+	client := startTestServer(t, Features{LocalPortForwarding: true})
+	conn, err := client.client.Dial("tcp", echoAddr)
+	require.NoError(t, err)
+	assertEcho(t, conn)
+
+	client = startTestServer(t, Features{})
+	_, err = client.client.Dial("tcp", echoAddr)
+	assert.Error(t, err, "local port forwarding should be denied when disabled")
+}
+
+func TestServer_RemotePortForwarding(t *testing.T) {
+	echoAddr := startEchoServer(t)
+
+	client := startTestServer(t, Features{RemotePortForwarding: true})
+	listener, err := client.client.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = forwardConnections(ctx, listener, func() (net.Conn, error) {
+			return net.Dial("tcp", echoAddr)
+		})
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	assertEcho(t, conn)
+
+	_, err = client.client.Listen("tcp", "0.0.0.0:0")
+	assert.Error(t, err, "only loopback addresses should be bound")
+
+	client = startTestServer(t, Features{})
+	_, err = client.client.Listen("tcp", "127.0.0.1:0")
+	assert.Error(t, err, "remote port forwarding should be denied when disabled")
+}
+
+func TestServer_SFTP(t *testing.T) {
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(localDir, "data", "nested"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(localDir, "data", "file.txt"), []byte("file"), 0o640))
+	require.NoError(t, os.WriteFile(filepath.Join(localDir, "data", "nested", "nested.txt"), []byte("nested"), 0o600))
+
+	client := startTestServer(t, Features{SFTP: true})
+
+	err := client.Upload(filepath.Join(localDir, "data"), remoteDir, false)
+	assert.Error(t, err, "directories should only be copied recursively")
+
+	require.NoError(t, client.Upload(filepath.Join(localDir, "data", "file.txt"), filepath.Join(remoteDir, "copy.txt"), false))
+	content, err := os.ReadFile(filepath.Join(remoteDir, "copy.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "file", string(content))
+
+	require.NoError(t, client.Upload(filepath.Join(localDir, "data"), remoteDir, true))
+	content, err = os.ReadFile(filepath.Join(remoteDir, "data", "nested", "nested.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "nested", string(content))
+
+	downloadDir := t.TempDir()
+	require.NoError(t, client.Download(filepath.Join(remoteDir, "data"), downloadDir, true))
+	content, err = os.ReadFile(filepath.Join(downloadDir, "data", "file.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "file", string(content))
+
+	info, err := os.Stat(filepath.Join(downloadDir, "data", "nested", "nested.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	client = startTestServer(t, Features{})
+	err = client.Upload(filepath.Join(localDir, "data", "file.txt"), remoteDir, false)
+	assert.Error(t, err, "SFTP should be denied when disabled")
+}
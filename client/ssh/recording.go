@@ -0,0 +1,411 @@
+package ssh
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gliderlabs/ssh"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/configs"
+)
+
+const (
+	// recordingExtension is the extension of the asciinema v2 recording files
+	recordingExtension = ".cast"
+	// recordingMetaExtension is the extension of the recording metadata files
+	recordingMetaExtension = ".json"
+
+	eventOutput = "o"
+	eventInput  = "i"
+	eventResize = "r"
+)
+
+// RecordingConfig configures where and how long the terminal sessions are recorded
+type RecordingConfig struct {
+	// Dir is the directory the recordings are stored in
+	Dir string
+	// MaxSize is the size in bytes after which a recording is truncated, 0 means no limit
+	MaxSize int64
+	// MaxTotalSize is the total size in bytes of the recordings after which the oldest ones are removed, 0 means no limit
+	MaxTotalSize int64
+	// Retention is how long the recordings are kept, 0 means forever
+	Retention time.Duration
+}
+
+// DefaultRecordingConfig returns the recording configuration of the state directory.
+// It can be overridden with the NB_SSH_RECORDING_DIR, NB_SSH_RECORDING_MAX_SIZE, NB_SSH_RECORDING_MAX_TOTAL_SIZE
+// and NB_SSH_RECORDING_RETENTION environment variables
+func DefaultRecordingConfig() RecordingConfig {
+	config := RecordingConfig{
+		Dir:          filepath.Join(configs.StateDir, "ssh-recordings"),
+		MaxSize:      50 << 20,
+		MaxTotalSize: 1 << 30,
+		Retention:    30 * 24 * time.Hour,
+	}
+
+	if dir := os.Getenv("NB_SSH_RECORDING_DIR"); dir != "" {
+		config.Dir = dir
+	}
+	config.MaxSize = parseEnvSize("NB_SSH_RECORDING_MAX_SIZE", config.MaxSize)
+	config.MaxTotalSize = parseEnvSize("NB_SSH_RECORDING_MAX_TOTAL_SIZE", config.MaxTotalSize)
+	if value := os.Getenv("NB_SSH_RECORDING_RETENTION"); value != "" {
+		retention, err := time.ParseDuration(value)
+		if err != nil {
+			log.Warnf("unable to parse environment variable NB_SSH_RECORDING_RETENTION: %s. using default: %s", value, config.Retention)
+		} else {
+			config.Retention = retention
+		}
+	}
+
+	return config
+}
+
+// parseEnvSize parses the size in bytes of the environment variable
+func parseEnvSize(envVar string, defaultSize int64) int64 {
+	value := os.Getenv(envVar)
+	if value == "" {
+		return defaultSize
+	}
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size < 0 {
+		log.Warnf("unable to parse environment variable %s: %s. using default: %d", envVar, value, defaultSize)
+		return defaultSize
+	}
+	return size
+}
+
+// Recording is the metadata of a recorded terminal session
+type Recording struct {
+	// ID identifies the recording in the recording directory
+	ID string `json:"id"`
+	// PeerKey is the WireGuard public key of the peer the session came from
+	PeerKey string `json:"peer_key"`
+	// LocalUser is the local user of the session
+	LocalUser string `json:"local_user"`
+	// RemoteAddr is the address the session came from
+	RemoteAddr string    `json:"remote_addr"`
+	StartedAt  time.Time `json:"started_at"`
+	// EndedAt is zero while the session is running
+	EndedAt time.Time `json:"ended_at"`
+	// Size is the size in bytes of the recording file
+	Size int64 `json:"size"`
+	// Truncated indicates whether the recording was stopped because it reached the size limit
+	Truncated bool `json:"truncated"`
+}
+
+// SetRecordingListener sets the function called when a recorded terminal session ends
+func (srv *DefaultServer) SetRecordingListener(listener func(Recording)) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.recordingListener = listener
+}
+
+// startRecording prunes the old recordings and starts recording the PTY session
+func (srv *DefaultServer) startRecording(session ssh.Session, ptyReq ssh.Pty) (*recorder, error) {
+	if err := pruneRecordings(srv.recordingConfig); err != nil {
+		log.Warnf("failed to prune SSH session recordings: %v", err)
+	}
+
+	peer, _ := session.Context().Value(peerContextKey).(string)
+	rec, err := newRecorder(srv.recordingConfig, Recording{
+		PeerKey:    peer,
+		LocalUser:  session.User(),
+		RemoteAddr: session.RemoteAddr().String(),
+		StartedAt:  time.Now(),
+	}, ptyReq.Window.Width, ptyReq.Window.Height, ptyReq.Term)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("recording SSH session of user %s from %s as %s", session.User(), session.RemoteAddr(), rec.recording.ID)
+	return rec, nil
+}
+
+// stopRecording finishes the recording of the session and reports it to the listener
+func (srv *DefaultServer) stopRecording(rec *recorder) {
+	recording, err := rec.close()
+	if err != nil {
+		log.Warnf("failed to finish SSH session recording %s: %v", recording.ID, err)
+	}
+
+	srv.mu.Lock()
+	listener := srv.recordingListener
+	srv.mu.Unlock()
+
+	if listener != nil {
+		listener(recording)
+	}
+}
+
+// recorder writes a terminal session in the asciinema v2 format
+type recorder struct {
+	mu        sync.Mutex
+	dir       string
+	file      *os.File
+	maxSize   int64
+	recording Recording
+	// pending are the trailing bytes of an incomplete UTF-8 sequence per event type
+	pending map[string][]byte
+}
+
+// newRecorder creates the recording file of a session with the terminal size and writes its header
+func newRecorder(config RecordingConfig, recording Recording, width, height int, term string) (*recorder, error) {
+	if err := os.MkdirAll(config.Dir, 0700); err != nil {
+		return nil, fmt.Errorf("create recording directory: %w", err)
+	}
+
+	id, err := newRecordingID(recording.StartedAt)
+	if err != nil {
+		return nil, err
+	}
+	recording.ID = id
+
+	file, err := os.OpenFile(filepath.Join(config.Dir, id+recordingExtension), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("create recording file: %w", err)
+	}
+
+	r := &recorder{
+		dir:       config.Dir,
+		file:      file,
+		maxSize:   config.MaxSize,
+		recording: recording,
+		pending:   make(map[string][]byte),
+	}
+
+	header, err := json.Marshal(map[string]any{
+		"version":   2,
+		"width":     width,
+		"height":    height,
+		"timestamp": recording.StartedAt.Unix(),
+		"env":       map[string]string{"TERM": term},
+	})
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if err := r.writeLine(header); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if err := r.writeMeta(); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func newRecordingID(startedAt time.Time) (string, error) {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("generate recording id: %w", err)
+	}
+	return startedAt.UTC().Format("20060102T150405Z") + "-" + hex.EncodeToString(suffix), nil
+}
+
+// writer returns a writer recording the data written to it as events of the type.
+// The writer never fails so that recording problems don't break the session
+func (r *recorder) writer(eventType string) io.Writer {
+	return recorderWriter{recorder: r, eventType: eventType}
+}
+
+type recorderWriter struct {
+	recorder  *recorder
+	eventType string
+}
+
+func (w recorderWriter) Write(p []byte) (int, error) {
+	w.recorder.event(w.eventType, p)
+	return len(p), nil
+}
+
+// resize records a change of the terminal size
+func (r *recorder) resize(width, height int) {
+	r.event(eventResize, []byte(fmt.Sprintf("%dx%d", width, height)))
+}
+
+// event records the data as an event of the type. Incomplete UTF-8 sequences at the end of the data are kept
+// until the next event of the same type, so that multibyte characters split between reads aren't mangled
+func (r *recorder) event(eventType string, data []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil || r.recording.Truncated {
+		return
+	}
+
+	data = append(r.pending[eventType], data...)
+	data, r.pending[eventType] = splitIncompleteRune(data)
+	if len(data) == 0 {
+		return
+	}
+
+	elapsed := time.Since(r.recording.StartedAt).Round(time.Microsecond).Seconds()
+	line, err := json.Marshal([]any{elapsed, eventType, string(data)})
+	if err != nil {
+		log.Debugf("failed to encode recording event: %v", err)
+		return
+	}
+
+	if r.maxSize > 0 && r.recording.Size+int64(len(line))+1 > r.maxSize {
+		log.Warnf("SSH session recording %s reached the size limit of %d bytes, truncating", r.recording.ID, r.maxSize)
+		r.recording.Truncated = true
+		return
+	}
+
+	if err := r.writeLine(line); err != nil {
+		log.Warnf("failed to write SSH session recording %s: %v", r.recording.ID, err)
+	}
+}
+
+// splitIncompleteRune splits the data into the complete UTF-8 sequences and a trailing incomplete one
+func splitIncompleteRune(data []byte) ([]byte, []byte) {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if !utf8.RuneStart(data[i]) {
+			continue
+		}
+		if utf8.FullRune(data[i:]) {
+			return data, nil
+		}
+		return data[:i], slices.Clone(data[i:])
+	}
+	return data, nil
+}
+
+func (r *recorder) writeLine(line []byte) error {
+	n, err := r.file.Write(append(line, '\n'))
+	r.recording.Size += int64(n)
+	return err
+}
+
+// writeMeta writes the metadata of the recording next to it
+func (r *recorder) writeMeta() error {
+	meta, err := json.Marshal(r.recording)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(r.dir, r.recording.ID+recordingMetaExtension), meta, 0600)
+}
+
+// close finishes the recording and returns its metadata
+func (r *recorder) close() (Recording, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return r.recording, nil
+	}
+
+	r.recording.EndedAt = time.Now()
+	err := r.file.Close()
+	r.file = nil
+	if metaErr := r.writeMeta(); metaErr != nil {
+		err = errors.Join(err, metaErr)
+	}
+	return r.recording, err
+}
+
+// ListRecordings returns the recordings of the directory ordered by their start time
+func ListRecordings(dir string) ([]Recording, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var recordings []Recording
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != recordingMetaExtension {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			log.Debugf("failed to read recording metadata %s: %v", entry.Name(), err)
+			continue
+		}
+		var recording Recording
+		if err := json.Unmarshal(data, &recording); err != nil || recording.ID == "" {
+			log.Debugf("invalid recording metadata %s: %v", entry.Name(), err)
+			continue
+		}
+		recordings = append(recordings, recording)
+	}
+
+	slices.SortFunc(recordings, func(a, b Recording) int {
+		return a.StartedAt.Compare(b.StartedAt)
+	})
+	return recordings, nil
+}
+
+// OpenRecording opens the asciinema v2 file of the recording in the directory
+func OpenRecording(dir, id string) (*os.File, error) {
+	if id == "" || id != filepath.Base(id) || strings.HasPrefix(id, ".") {
+		return nil, fmt.Errorf("invalid recording id %q", id)
+	}
+	return os.Open(filepath.Join(dir, id+recordingExtension))
+}
+
+// removeRecording removes the recording file and its metadata
+func removeRecording(dir, id string) error {
+	err := os.Remove(filepath.Join(dir, id+recordingExtension))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return os.Remove(filepath.Join(dir, id+recordingMetaExtension))
+}
+
+// pruneRecordings removes the finished recordings older than the retention and then the oldest finished
+// recordings until the total size is below the limit
+func pruneRecordings(config RecordingConfig) error {
+	recordings, err := ListRecordings(config.Dir)
+	if err != nil {
+		return err
+	}
+
+	var kept []Recording
+	var totalSize int64
+	for _, recording := range recordings {
+		if config.Retention > 0 && time.Since(recording.StartedAt) > config.Retention {
+			if err := removeRecording(config.Dir, recording.ID); err != nil {
+				log.Warnf("failed to remove expired SSH session recording %s: %v", recording.ID, err)
+			}
+			continue
+		}
+		kept = append(kept, recording)
+		totalSize += recording.Size
+	}
+
+	for _, recording := range kept {
+		if config.MaxTotalSize <= 0 || totalSize <= config.MaxTotalSize {
+			break
+		}
+		if recording.EndedAt.IsZero() {
+			continue
+		}
+		if err := removeRecording(config.Dir, recording.ID); err != nil {
+			log.Warnf("failed to remove SSH session recording %s: %v", recording.ID, err)
+			continue
+		}
+		totalSize -= recording.Size
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package ssh
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readRecording(t *testing.T, dir, id string) (map[string]any, [][]any) {
+	t.Helper()
+
+	file, err := OpenRecording(dir, id)
+	require.NoError(t, err)
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	require.True(t, scanner.Scan())
+	var header map[string]any
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &header))
+
+	var events [][]any
+	for scanner.Scan() {
+		var event []any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+	return header, events
+}
+
+func TestRecorder(t *testing.T) {
+	config := RecordingConfig{Dir: t.TempDir()}
+	rec, err := newRecorder(config, Recording{PeerKey: "remotePeer", LocalUser: "root", StartedAt: time.Now()}, 80, 24, "xterm")
+	require.NoError(t, err)
+
+	_, err = io.WriteString(rec.writer(eventInput), "ls\r")
+	require.NoError(t, err)
+	// the euro sign is split between two writes
+	_, err = rec.writer(eventOutput).Write([]byte{'a', 0xe2, 0x82})
+	require.NoError(t, err)
+	_, err = rec.writer(eventOutput).Write([]byte{0xac, 'b'})
+	require.NoError(t, err)
+	rec.resize(120, 40)
+
+	recording, err := rec.close()
+	require.NoError(t, err)
+	assert.False(t, recording.EndedAt.IsZero())
+	assert.False(t, recording.Truncated)
+
+	header, events := readRecording(t, config.Dir, recording.ID)
+	assert.Equal(t, float64(2), header["version"])
+	assert.Equal(t, float64(80), header["width"])
+	assert.Equal(t, float64(24), header["height"])
+
+	require.Len(t, events, 4)
+	assert.Equal(t, []any{"i", "ls\r"}, events[0][1:])
+	assert.Equal(t, []any{"o", "a"}, events[1][1:])
+	assert.Equal(t, []any{"o", "€b"}, events[2][1:])
+	assert.Equal(t, []any{"r", "120x40"}, events[3][1:])
+
+	recordings, err := ListRecordings(config.Dir)
+	require.NoError(t, err)
+	require.Len(t, recordings, 1)
+	assert.Equal(t, recording.ID, recordings[0].ID)
+	assert.Equal(t, "remotePeer", recordings[0].PeerKey)
+	assert.Equal(t, "root", recordings[0].LocalUser)
+
+	info, err := os.Stat(filepath.Join(config.Dir, recording.ID+recordingExtension))
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), recordings[0].Size)
+}
+
+func TestRecorder_MaxSize(t *testing.T) {
+	config := RecordingConfig{Dir: t.TempDir(), MaxSize: 200}
+	rec, err := newRecorder(config, Recording{StartedAt: time.Now()}, 80, 24, "xterm")
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		_, err = io.WriteString(rec.writer(eventOutput), "0123456789")
+		require.NoError(t, err)
+	}
+
+	recording, err := rec.close()
+	require.NoError(t, err)
+	assert.True(t, recording.Truncated)
+	assert.LessOrEqual(t, recording.Size, config.MaxSize)
+
+	_, events := readRecording(t, config.Dir, recording.ID)
+	assert.NotEmpty(t, events)
+	assert.Less(t, len(events), 20)
+}
+
+func TestPruneRecordings(t *testing.T) {
+	config := RecordingConfig{Dir: t.TempDir(), Retention: time.Hour}
+
+	newRecording := func(startedAt time.Time) Recording {
+		rec, err := newRecorder(config, Recording{StartedAt: startedAt}, 80, 24, "xterm")
+		require.NoError(t, err)
+		_, _ = io.WriteString(rec.writer(eventOutput), "output")
+		recording, err := rec.close()
+		require.NoError(t, err)
+		return recording
+	}
+
+	expired := newRecording(time.Now().Add(-2 * time.Hour))
+	oldest := newRecording(time.Now().Add(-30 * time.Minute))
+	newest := newRecording(time.Now())
+
+	config.MaxTotalSize = newest.Size + 1
+	require.NoError(t, pruneRecordings(config))
+
+	recordings, err := ListRecordings(config.Dir)
+	require.NoError(t, err)
+	require.Len(t, recordings, 1)
+	assert.Equal(t, newest.ID, recordings[0].ID)
+
+	for _, id := range []string{expired.ID, oldest.ID} {
+		_, err = OpenRecording(config.Dir, id)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	}
+}
+
+func TestOpenRecording_InvalidID(t *testing.T) {
+	dir := t.TempDir()
+	for _, id := range []string{"", "../recording", ".hidden", "dir/recording"} {
+		_, err := OpenRecording(dir, id)
+		assert.Error(t, err, id)
+	}
+}
@@ -41,16 +41,46 @@ type Server interface {
 	RemoveAuthorizedKey(peer string)
 	// AddAuthorizedKey add a given peer key to server authorized keys
 	AddAuthorizedKey(peer, newKey string) error
+	// SetFeatures enables or disables the optional features of the server
+	SetFeatures(features Features)
+	// SetAuthorizedUsers restricts the local users the peers may log in as, a nil map allows any local user
+	SetAuthorizedUsers(users map[string][]string)
+	// SetLoginListener sets the function called once per allowed or denied login of a peer with an authorized key
+	SetLoginListener(listener func(LoginEvent))
+	// SetRecordingListener sets the function called when a recorded terminal session ends
+	SetRecordingListener(listener func(Recording))
+}
+
+// Features are the optional capabilities of the SSH server, controlled per peer by the management service.
+// Interactive sessions are always available.
+type Features struct {
+	// SFTP enables the sftp subsystem used by sftp, scp and netbird ssh cp
+	SFTP bool
+	// LocalPortForwarding allows clients to open connections from the server (direct-tcpip, ssh -L)
+	LocalPortForwarding bool
+	// RemotePortForwarding allows clients to listen on the loopback interface of the server (tcpip-forward, ssh -R)
+	RemotePortForwarding bool
+	// SessionRecording records the terminal sessions in the asciinema v2 format
+	SessionRecording bool
 }
 
 // DefaultServer is the embedded NetBird SSH server
 type DefaultServer struct {
 	listener net.Listener
+	server   *ssh.Server
 	// authorizedKeys is ssh pub key indexed by peer WireGuard public key
 	authorizedKeys map[string]ssh.PublicKey
-	mu             sync.Mutex
-	hostKeyPEM     []byte
-	sessions       []ssh.Session
+	// authorizedUsers are the local users the peers may log in as indexed by peer WireGuard public key,
+	// nil if any local user is allowed
+	authorizedUsers map[string][]string
+	loginListener   func(LoginEvent)
+	features        Features
+	mu              sync.Mutex
+	hostKeyPEM      []byte
+	sessions        []ssh.Session
+	// recordingConfig configures the recordings of the terminal sessions when SessionRecording is enabled
+	recordingConfig   RecordingConfig
+	recordingListener func(Recording)
 }
 
 // newDefaultServer creates new server with provided host key
@@ -60,7 +90,14 @@ func newDefaultServer(hostKeyPEM []byte, addr string) (*DefaultServer, error) {
 		return nil, err
 	}
 	allowedKeys := make(map[string]ssh.PublicKey)
-	return &DefaultServer{listener: ln, mu: sync.Mutex{}, hostKeyPEM: hostKeyPEM, authorizedKeys: allowedKeys, sessions: make([]ssh.Session, 0)}, nil
+	return &DefaultServer{
+		listener:        ln,
+		mu:              sync.Mutex{},
+		hostKeyPEM:      hostKeyPEM,
+		authorizedKeys:  allowedKeys,
+		sessions:        make([]ssh.Session, 0),
+		recordingConfig: DefaultRecordingConfig(),
+	}, nil
 }
 
 // RemoveAuthorizedKey removes SSH key of a given peer from the authorized keys
@@ -85,11 +122,34 @@ func (srv *DefaultServer) AddAuthorizedKey(peer, newKey string) error {
 	return nil
 }
 
+// SetFeatures enables or disables the optional features of the server.
+// The features are checked when a channel or a forwarding is requested, established ones are not closed.
+func (srv *DefaultServer) SetFeatures(features Features) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.features = features
+}
+
+func (srv *DefaultServer) getFeatures() Features {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	return srv.features
+}
+
 // Stop stops SSH server.
 func (srv *DefaultServer) Stop() error {
 	srv.mu.Lock()
 	defer srv.mu.Unlock()
-	err := srv.listener.Close()
+
+	var err error
+	if srv.server != nil {
+		// closes the listener and the connections including the port forwarding listeners
+		err = srv.server.Close()
+	} else {
+		err = srv.listener.Close()
+	}
 	if err != nil {
 		return err
 	}
@@ -105,15 +165,29 @@ func (srv *DefaultServer) Stop() error {
 
 func (srv *DefaultServer) publicKeyHandler(ctx ssh.Context, key ssh.PublicKey) bool {
 	srv.mu.Lock()
-	defer srv.mu.Unlock()
-
-	for _, allowed := range srv.authorizedKeys {
+	peer := ""
+	for p, allowed := range srv.authorizedKeys {
 		if ssh.KeysEqual(allowed, key) {
-			return true
+			peer = p
+			break
 		}
 	}
+	srv.mu.Unlock()
+
+	if peer == "" {
+		return false
+	}
+
+	// allowed logins are reported once the connection is used, see authorizeContext
+	if err := srv.checkUser(peer, ctx.User()); err != nil {
+		srv.reportLogin(peer, ctx.User(), err)
+		log.Warnf("denied SSH login from %v, user %s: %v", ctx.RemoteAddr(), ctx.User(), err)
+		return false
+	}
 
-	return false
+	ctx.SetValue(peerContextKey, peer)
+	ctx.SetValue(loginContextKey, &loginState{})
+	return true
 }
 
 func prepareUserEnv(user *user.User, shell string) []string {
@@ -145,6 +219,12 @@ func (srv *DefaultServer) sessionHandler(session ssh.Session) {
 		}
 	}()
 
+	if err := srv.authorizeContext(session.Context()); err != nil {
+		_, _ = fmt.Fprintf(session.Stderr(), "remote SSH server denied access as user %s\n", session.User())
+		_ = session.Exit(1)
+		return
+	}
+
 	log.Infof("Establishing SSH session for %s from host %s", session.User(), session.RemoteAddr().String())
 
 	localUser, err := userNameLookup(session.User())
@@ -187,6 +267,18 @@ func (srv *DefaultServer) sessionHandler(session ssh.Session) {
 		}
 
 		log.Debugf("Login command: %s", cmd.String())
+		var rec *recorder
+		if srv.getFeatures().SessionRecording {
+			rec, err = srv.startRecording(session, ptyReq)
+			if err != nil {
+				log.Errorf("failed starting SSH session recording: %v", err)
+				_, _ = fmt.Fprintf(session.Stderr(), "remote SSH server couldn't record the session\n")
+				_ = session.Exit(1)
+				return
+			}
+			defer srv.stopRecording(rec)
+		}
+
 		file, err := pty.Start(cmd)
 		if err != nil {
 			log.Errorf("failed starting SSH server: %v", err)
@@ -195,10 +287,13 @@ func (srv *DefaultServer) sessionHandler(session ssh.Session) {
 		go func() {
 			for win := range winCh {
 				setWinSize(file, win.Width, win.Height)
+				if rec != nil {
+					rec.resize(win.Width, win.Height)
+				}
 			}
 		}()
 
-		srv.stdInOut(file, session)
+		srv.stdInOut(file, session, rec)
 
 		err = cmd.Wait()
 		if err != nil {
@@ -217,10 +312,17 @@ func (srv *DefaultServer) sessionHandler(session ssh.Session) {
 	log.Debugf("SSH session ended")
 }
 
-func (srv *DefaultServer) stdInOut(file *os.File, session ssh.Session) {
+func (srv *DefaultServer) stdInOut(file *os.File, session ssh.Session, rec *recorder) {
+	var input io.Reader = session
+	var output io.Writer = session
+	if rec != nil {
+		input = io.TeeReader(session, rec.writer(eventInput))
+		output = io.MultiWriter(session, rec.writer(eventOutput))
+	}
+
 	go func() {
 		// stdin
-		_, err := io.Copy(file, session)
+		_, err := io.Copy(file, input)
 		if err != nil {
 			_ = session.Exit(1)
 			return
@@ -237,7 +339,7 @@ func (srv *DefaultServer) stdInOut(file *os.File, session ssh.Session) {
 			return
 		default:
 			// stdout
-			writtenBytes, err := io.Copy(session, file)
+			writtenBytes, err := io.Copy(output, file)
 			if err != nil && writtenBytes != 0 {
 				_ = session.Exit(0)
 				return
@@ -251,9 +353,39 @@ func (srv *DefaultServer) stdInOut(file *os.File, session ssh.Session) {
 func (srv *DefaultServer) Start() error {
 	log.Infof("starting SSH server on addr: %s", srv.listener.Addr().String())
 
-	publicKeyOption := ssh.PublicKeyAuth(srv.publicKeyHandler)
-	hostKeyPEM := ssh.HostKeyPEM(srv.hostKeyPEM)
-	err := ssh.Serve(srv.listener, srv.sessionHandler, publicKeyOption, hostKeyPEM)
+	if err := pruneRecordings(srv.recordingConfig); err != nil {
+		log.Warnf("failed to prune SSH session recordings: %v", err)
+	}
+
+	forwardHandler := &ssh.ForwardedTCPHandler{}
+	server := &ssh.Server{
+		Handler: srv.sessionHandler,
+		ChannelHandlers: map[string]ssh.ChannelHandler{
+			"session":      ssh.DefaultSessionHandler,
+			"direct-tcpip": ssh.DirectTCPIPHandler,
+		},
+		RequestHandlers: map[string]ssh.RequestHandler{
+			"tcpip-forward":        forwardHandler.HandleSSHRequest,
+			"cancel-tcpip-forward": forwardHandler.HandleSSHRequest,
+		},
+		SubsystemHandlers: map[string]ssh.SubsystemHandler{
+			"sftp": srv.sftpHandler,
+		},
+		LocalPortForwardingCallback:   srv.localPortForwardingHandler,
+		ReversePortForwardingCallback: srv.remotePortForwardingHandler,
+	}
+
+	for _, option := range []ssh.Option{ssh.PublicKeyAuth(srv.publicKeyHandler), ssh.HostKeyPEM(srv.hostKeyPEM)} {
+		if err := server.SetOption(option); err != nil {
+			return err
+		}
+	}
+
+	srv.mu.Lock()
+	srv.server = server
+	srv.mu.Unlock()
+
+	err := server.Serve(srv.listener)
 	if err != nil {
 		return err
 	}
@@ -4,11 +4,15 @@ import "context"
 
 // MockServer mocks ssh.Server
 type MockServer struct {
-	Ctx                     context.Context
-	StopFunc                func() error
-	StartFunc               func() error
-	AddAuthorizedKeyFunc    func(peer, newKey string) error
-	RemoveAuthorizedKeyFunc func(peer string)
+	Ctx                      context.Context
+	StopFunc                 func() error
+	StartFunc                func() error
+	AddAuthorizedKeyFunc     func(peer, newKey string) error
+	RemoveAuthorizedKeyFunc  func(peer string)
+	SetFeaturesFunc          func(features Features)
+	SetAuthorizedUsersFunc   func(users map[string][]string)
+	SetLoginListenerFunc     func(listener func(LoginEvent))
+	SetRecordingListenerFunc func(listener func(Recording))
 }
 
 // RemoveAuthorizedKey removes SSH key of a given peer from the authorized keys
@@ -42,3 +46,35 @@ func (srv *MockServer) Start() error {
 	}
 	return srv.StartFunc()
 }
+
+// SetFeatures enables or disables the optional features of the server
+func (srv *MockServer) SetFeatures(features Features) {
+	if srv.SetFeaturesFunc == nil {
+		return
+	}
+	srv.SetFeaturesFunc(features)
+}
+
+// SetAuthorizedUsers restricts the local users the peers may log in as
+func (srv *MockServer) SetAuthorizedUsers(users map[string][]string) {
+	if srv.SetAuthorizedUsersFunc == nil {
+		return
+	}
+	srv.SetAuthorizedUsersFunc(users)
+}
+
+// SetLoginListener sets the function called once per login
+func (srv *MockServer) SetLoginListener(listener func(LoginEvent)) {
+	if srv.SetLoginListenerFunc == nil {
+		return
+	}
+	srv.SetLoginListenerFunc(listener)
+}
+
+// SetRecordingListener sets the function called when a recorded terminal session ends
+func (srv *MockServer) SetRecordingListener(listener func(Recording)) {
+	if srv.SetRecordingListenerFunc == nil {
+		return
+	}
+	srv.SetRecordingListenerFunc(listener)
+}
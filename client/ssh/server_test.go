@@ -1,11 +1,16 @@
 package ssh
 
 import (
+	"context"
 	"fmt"
-	"github.com/stretchr/testify/assert"
-	"golang.org/x/crypto/ssh"
+	"net"
 	"strings"
+	"sync"
 	"testing"
+
+	gliderssh "github.com/gliderlabs/ssh"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestServer_AddAuthorizedKey(t *testing.T) {
@@ -113,9 +118,31 @@ func TestServer_PubKeyHandler(t *testing.T) {
 	}
 
 	for _, key := range keys {
-		accepted := server.publicKeyHandler(nil, key)
+		accepted := server.publicKeyHandler(newTestContext("root"), key)
 
 		assert.Truef(t, accepted, "expecting SSH connection to be accepted for a given SSH key %s", string(ssh.MarshalAuthorizedKey(key)))
 	}
 
 }
+
+// testContext is the ssh.Context of a connection authenticating as the user
+type testContext struct {
+	context.Context
+	sync.Mutex
+	user string
+}
+
+func newTestContext(user string) *testContext {
+	return &testContext{Context: context.Background(), user: user}
+}
+
+func (c *testContext) User() string                        { return c.user }
+func (c *testContext) SessionID() string                   { return "" }
+func (c *testContext) ClientVersion() string               { return "" }
+func (c *testContext) ServerVersion() string               { return "" }
+func (c *testContext) RemoteAddr() net.Addr                { return &net.TCPAddr{IP: net.IPv4(100, 64, 0, 1)} }
+func (c *testContext) LocalAddr() net.Addr                 { return &net.TCPAddr{IP: net.IPv4(100, 64, 0, 2)} }
+func (c *testContext) Permissions() *gliderssh.Permissions { return &gliderssh.Permissions{} }
+func (c *testContext) SetValue(key, value interface{}) {
+	c.Context = context.WithValue(c.Context, key, value)
+}
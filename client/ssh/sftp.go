@@ -0,0 +1,93 @@
+package ssh
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/user"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/pkg/sftp"
+	log "github.com/sirupsen/logrus"
+)
+
+// SFTPServerCommand is the hidden netbird subcommand that serves SFTP on its standard input and output.
+// The SSH server runs it with the credentials of the local user when the user differs from the user of the server.
+var SFTPServerCommand = []string{"ssh", "sftp-server"}
+
+// ServeSFTP serves the SFTP protocol on rwc until the client disconnects. Relative paths are resolved from workDir
+func ServeSFTP(rwc io.ReadWriteCloser, workDir string) error {
+	server, err := sftp.NewServer(rwc, sftp.WithServerWorkingDirectory(workDir))
+	if err != nil {
+		return fmt.Errorf("create sftp server: %w", err)
+	}
+
+	return server.Serve()
+}
+
+// sftpHandler handles the sftp subsystem requests
+func (srv *DefaultServer) sftpHandler(session ssh.Session) {
+	if !srv.getFeatures().SFTP {
+		log.Warnf("denied SFTP session from %v, user %s: SFTP is disabled", session.RemoteAddr(), session.User())
+		_, _ = fmt.Fprintf(session.Stderr(), "SFTP is disabled on the remote SSH server\n")
+		_ = session.Exit(1)
+		return
+	}
+
+	if err := srv.authorizeContext(session.Context()); err != nil {
+		_, _ = fmt.Fprintf(session.Stderr(), "remote SSH server denied access as user %s\n", session.User())
+		_ = session.Exit(1)
+		return
+	}
+
+	localUser, err := userNameLookup(session.User())
+	if err != nil {
+		log.Warnf("failed SFTP session from %v, user %s: %v", session.RemoteAddr(), session.User(), err)
+		_ = session.Exit(1)
+		return
+	}
+
+	log.Infof("Establishing SFTP session for %s from host %s", session.User(), session.RemoteAddr().String())
+
+	if err = serveSFTPSession(session, localUser); err != nil {
+		log.Warnf("failed SFTP session from %v, user %s: %v", session.RemoteAddr(), session.User(), err)
+		_ = session.Exit(1)
+		return
+	}
+
+	_ = session.Exit(0)
+	log.Debugf("SFTP session ended")
+}
+
+// serveSFTPSession serves SFTP in the server process if it runs as the local user,
+// otherwise in a child process running with the credentials of the local user
+func serveSFTPSession(session ssh.Session, localUser *user.User) error {
+	current, err := user.Current()
+	if err != nil {
+		return fmt.Errorf("get current user: %w", err)
+	}
+
+	if current.Uid == localUser.Uid {
+		return ServeSFTP(session, localUser.HomeDir)
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("get executable: %w", err)
+	}
+
+	cmd := exec.CommandContext(session.Context(), executable, SFTPServerCommand...)
+	cmd.Dir = localUser.HomeDir
+	cmd.Env = prepareUserEnv(localUser, getUserShell(localUser.Uid))
+	cmd.Stdin = session
+	cmd.Stdout = session
+	cmd.Stderr = session.Stderr()
+
+	if err = setUserCredential(cmd, localUser); err != nil {
+		return err
+	}
+
+	log.Debugf("SFTP command: %s", cmd.String())
+	return cmd.Run()
+}
@@ -0,0 +1,208 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/netbirdio/netbird/client/anonymize"
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+type PeerHistorySampleOutput struct {
+	Timestamp        time.Time     `json:"timestamp" yaml:"timestamp"`
+	Status           string        `json:"status" yaml:"status"`
+	ConnType         string        `json:"connectionType" yaml:"connectionType"`
+	Latency          time.Duration `json:"latency" yaml:"latency"`
+	TransferReceived int64         `json:"transferReceived" yaml:"transferReceived"`
+	TransferSent     int64         `json:"transferSent" yaml:"transferSent"`
+}
+
+type PeerConnectionEventOutput struct {
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+	Status    string    `json:"status" yaml:"status"`
+	ConnType  string    `json:"connectionType" yaml:"connectionType"`
+	Reason    string    `json:"reason" yaml:"reason"`
+}
+
+type PeerHistoryOutput struct {
+	FQDN                   string                      `json:"fqdn" yaml:"fqdn"`
+	IP                     string                      `json:"netbirdIp" yaml:"netbirdIp"`
+	PubKey                 string                      `json:"publicKey" yaml:"publicKey"`
+	ConnectionTypeSwitches int                         `json:"connectionTypeSwitches" yaml:"connectionTypeSwitches"`
+	Disconnects            int                         `json:"disconnects" yaml:"disconnects"`
+	Samples                []PeerHistorySampleOutput   `json:"samples" yaml:"samples"`
+	Events                 []PeerConnectionEventOutput `json:"events" yaml:"events"`
+}
+
+type PeerHistoriesOutput struct {
+	Peers []PeerHistoryOutput `json:"peers" yaml:"peers"`
+}
+
+// ConvertToPeerHistoriesOutput converts the daemon response and counts the connection type switches and disconnects of every peer
+func ConvertToPeerHistoriesOutput(resp *proto.GetPeerHistoryResponse, anon bool) PeerHistoriesOutput {
+	var output PeerHistoriesOutput
+	for _, pbHistory := range resp.GetPeers() {
+		history := PeerHistoryOutput{
+			FQDN:    pbHistory.GetFqdn(),
+			IP:      pbHistory.GetIp(),
+			PubKey:  pbHistory.GetPubKey(),
+			Samples: []PeerHistorySampleOutput{},
+			Events:  []PeerConnectionEventOutput{},
+		}
+
+		for _, sample := range pbHistory.GetSamples() {
+			history.Samples = append(history.Samples, PeerHistorySampleOutput{
+				Timestamp:        sample.GetTimestamp().AsTime().Local(),
+				Status:           sample.GetConnStatus(),
+				ConnType:         historyConnType(sample.GetConnStatus(), sample.GetRelayed()),
+				Latency:          sample.GetLatency().AsDuration(),
+				TransferReceived: sample.GetBytesRx(),
+				TransferSent:     sample.GetBytesTx(),
+			})
+		}
+
+		lastConnType := ""
+		for _, event := range pbHistory.GetEvents() {
+			connType := historyConnType(event.GetConnStatus(), event.GetRelayed())
+			switch {
+			case lastConnType == "":
+			case connType == "":
+				history.Disconnects++
+			case connType != lastConnType:
+				history.ConnectionTypeSwitches++
+			}
+			lastConnType = connType
+
+			history.Events = append(history.Events, PeerConnectionEventOutput{
+				Timestamp: event.GetTimestamp().AsTime().Local(),
+				Status:    event.GetConnStatus(),
+				ConnType:  connType,
+				Reason:    event.GetReason(),
+			})
+		}
+
+		output.Peers = append(output.Peers, history)
+	}
+
+	if anon {
+		anonymizer := anonymize.NewAnonymizer(anonymize.DefaultAddresses())
+		for i := range output.Peers {
+			output.Peers[i].FQDN = anonymizer.AnonymizeDomain(output.Peers[i].FQDN)
+			output.Peers[i].IP = anonymizer.AnonymizeIPString(output.Peers[i].IP)
+		}
+	}
+
+	return output
+}
+
+// historyConnType returns the connection type like the peer details, empty while not connected
+func historyConnType(connStatus string, relayed bool) string {
+	if connStatus != peer.StatusConnected.String() {
+		return ""
+	}
+	if relayed {
+		return "Relayed"
+	}
+	return "P2P"
+}
+
+func ParsePeerHistoriesToJSON(histories PeerHistoriesOutput) (string, error) {
+	jsonBytes, err := json.Marshal(histories)
+	if err != nil {
+		return "", fmt.Errorf("json marshal failed")
+	}
+	return string(jsonBytes), err
+}
+
+func ParsePeerHistoriesToYAML(histories PeerHistoriesOutput) (string, error) {
+	yamlBytes, err := yaml.Marshal(histories)
+	if err != nil {
+		return "", fmt.Errorf("yaml marshal failed")
+	}
+	return string(yamlBytes), nil
+}
+
+// ParsePeerHistories prints the connection changes and the samples of every peer,
+// the transfer of a sample is the amount since the previous sample
+func ParsePeerHistories(histories PeerHistoriesOutput) string {
+	var b strings.Builder
+	for i, history := range histories.Peers {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(fmt.Sprintf("%s:\n"+
+			"  NetBird IP: %s\n"+
+			"  Public key: %s\n"+
+			"  Connection type switches: %d\n"+
+			"  Disconnects: %d\n",
+			history.FQDN,
+			history.IP,
+			history.PubKey,
+			history.ConnectionTypeSwitches,
+			history.Disconnects,
+		))
+
+		b.WriteString("\n  Connection changes:\n")
+		if len(history.Events) == 0 {
+			b.WriteString("  -\n")
+		} else {
+			w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+			for _, event := range history.Events {
+				_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n",
+					event.Timestamp.Format(time.DateTime),
+					event.Status,
+					valueOrDash(event.ConnType),
+					event.Reason,
+				)
+			}
+			_ = w.Flush()
+		}
+
+		b.WriteString("\n  Samples:\n")
+		if len(history.Samples) == 0 {
+			b.WriteString("  -\n")
+			continue
+		}
+		w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "  TIME\tSTATUS\tTYPE\tLATENCY\tRECEIVED\tSENT")
+		for j, sample := range history.Samples {
+			received, sent := "-", "-"
+			if j > 0 {
+				previous := history.Samples[j-1]
+				received = toIEC(transferSince(previous.TransferReceived, sample.TransferReceived))
+				sent = toIEC(transferSince(previous.TransferSent, sample.TransferSent))
+			}
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\n",
+				sample.Timestamp.Format(time.TimeOnly),
+				sample.Status,
+				valueOrDash(sample.ConnType),
+				sample.Latency.Round(time.Microsecond),
+				received,
+				sent,
+			)
+		}
+		_ = w.Flush()
+	}
+	return b.String()
+}
+
+// transferSince returns the bytes transferred since the previous sample, the WireGuard counters restart with the connection
+func transferSince(previous, current int64) int64 {
+	if current < previous {
+		return current
+	}
+	return current - previous
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
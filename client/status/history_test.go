@@ -0,0 +1,66 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+func TestConvertToPeerHistoriesOutput(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := func(offset time.Duration, connStatus string, relayed bool, reason string) *proto.PeerConnectionEvent {
+		return &proto.PeerConnectionEvent{
+			Timestamp:  timestamppb.New(start.Add(offset)),
+			ConnStatus: connStatus,
+			Relayed:    relayed,
+			Reason:     reason,
+		}
+	}
+
+	resp := &proto.GetPeerHistoryResponse{
+		Peers: []*proto.PeerHistory{
+			{
+				PubKey: "Pubkey1",
+				Fqdn:   "peer-1.awesome-domain.com",
+				Ip:     "192.168.178.101",
+				Samples: []*proto.PeerHistorySample{
+					{Timestamp: timestamppb.New(start), ConnStatus: "Connected", Latency: durationpb.New(10 * time.Millisecond), BytesRx: 1000, BytesTx: 500},
+					{Timestamp: timestamppb.New(start.Add(10 * time.Second)), ConnStatus: "Connected", Relayed: true, Latency: durationpb.New(30 * time.Millisecond), BytesRx: 3048, BytesTx: 600},
+				},
+				Events: []*proto.PeerConnectionEvent{
+					event(0, "Connected", false, "ICE connection established"),
+					event(5*time.Second, "Connected", true, "ICE connection lost"),
+					event(8*time.Second, "Disconnected", false, "relay connection lost"),
+					event(9*time.Second, "Connecting", false, "connection state updated"),
+					event(12*time.Second, "Connected", false, "ICE connection established"),
+				},
+			},
+		},
+	}
+
+	output := ConvertToPeerHistoriesOutput(resp, false)
+	require.Len(t, output.Peers, 1)
+	history := output.Peers[0]
+	assert.Equal(t, 1, history.ConnectionTypeSwitches)
+	assert.Equal(t, 1, history.Disconnects)
+	assert.Equal(t, "Relayed", history.Samples[1].ConnType)
+	assert.Equal(t, "", history.Events[3].ConnType)
+
+	parsed := ParsePeerHistories(output)
+	assert.Contains(t, parsed, "peer-1.awesome-domain.com:\n"+
+		"  NetBird IP: 192.168.178.101\n"+
+		"  Public key: Pubkey1\n"+
+		"  Connection type switches: 1\n"+
+		"  Disconnects: 1\n")
+	assert.Contains(t, parsed, "Connected     Relayed  ICE connection lost\n")
+	assert.Contains(t, parsed, "Connected  Relayed  30ms     2.0 KiB   100 B\n", "the transfer should be the amount since the previous sample")
+
+	anonymized := ConvertToPeerHistoriesOutput(resp, true)
+	assert.NotEqual(t, "peer-1.awesome-domain.com", anonymized.Peers[0].FQDN)
+}
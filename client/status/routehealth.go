@@ -0,0 +1,92 @@
+package status
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+type RouteHealthOutput struct {
+	Network   string    `json:"network" yaml:"network"`
+	Peer      string    `json:"peer" yaml:"peer"`
+	Type      string    `json:"type" yaml:"type"`
+	Target    string    `json:"target" yaml:"target"`
+	Healthy   bool      `json:"healthy" yaml:"healthy"`
+	Chosen    bool      `json:"chosen" yaml:"chosen"`
+	LastCheck time.Time `json:"lastCheck" yaml:"lastCheck"`
+	Error     string    `json:"error" yaml:"error"`
+}
+
+// mapRouteHealth converts the health probe results, the routing peers are shown by their FQDN if known
+func mapRouteHealth(states []*proto.RouteHealthState, peers []*proto.PeerState) []RouteHealthOutput {
+	fqdns := make(map[string]string, len(peers))
+	for _, peer := range peers {
+		fqdns[peer.GetPubKey()] = peer.GetFqdn()
+	}
+
+	mapped := make([]RouteHealthOutput, 0, len(states))
+	for _, state := range states {
+		peer := fqdns[state.GetPeerPubKey()]
+		if peer == "" {
+			peer = state.GetPeerPubKey()
+		}
+
+		var lastCheck time.Time
+		if state.GetLastCheck() != nil {
+			lastCheck = state.GetLastCheck().AsTime()
+		}
+
+		mapped = append(mapped, RouteHealthOutput{
+			Network:   state.GetNetwork(),
+			Peer:      peer,
+			Type:      state.GetType(),
+			Target:    state.GetTarget(),
+			Healthy:   state.GetHealthy(),
+			Chosen:    state.GetChosen(),
+			LastCheck: lastCheck,
+			Error:     state.GetError(),
+		})
+	}
+	return mapped
+}
+
+func parseRouteHealth(states []RouteHealthOutput) string {
+	var healthString strings.Builder
+	for _, state := range states {
+		healthy := "Healthy"
+		if !state.Healthy {
+			healthy = "Unhealthy"
+		}
+		if state.Chosen {
+			healthy += ", chosen"
+		}
+
+		reason := ""
+		if state.Error != "" {
+			reason = fmt.Sprintf(", last error: %s", state.Error)
+		}
+
+		healthString.WriteString(fmt.Sprintf("\n  [%s] via %s (%s %s) is %s, checked: %s%s",
+			state.Network,
+			state.Peer,
+			state.Type,
+			state.Target,
+			healthy,
+			timeAgo(state.LastCheck),
+			reason,
+		))
+	}
+	return healthString.String()
+}
+
+func countHealthy(states []RouteHealthOutput) int {
+	count := 0
+	for _, state := range states {
+		if state.Healthy {
+			count++
+		}
+	}
+	return count
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"net/url"
 	"os"
 	"runtime"
 	"sort"
@@ -26,6 +27,7 @@ type PeerStateDetailOutput struct {
 	Status                 string           `json:"status" yaml:"status"`
 	LastStatusUpdate       time.Time        `json:"lastStatusUpdate" yaml:"lastStatusUpdate"`
 	ConnType               string           `json:"connectionType" yaml:"connectionType"`
+	ConnStrategy           string           `json:"connectionStrategy" yaml:"connectionStrategy"`
 	IceCandidateType       IceCandidateType `json:"iceCandidateType" yaml:"iceCandidateType"`
 	IceCandidateEndpoint   IceCandidateType `json:"iceCandidateEndpoint" yaml:"iceCandidateEndpoint"`
 	RelayAddress           string           `json:"relayAddress" yaml:"relayAddress"`
@@ -56,9 +58,10 @@ type ManagementStateOutput struct {
 }
 
 type RelayStateOutputDetail struct {
-	URI       string `json:"uri" yaml:"uri"`
-	Available bool   `json:"available" yaml:"available"`
-	Error     string `json:"error" yaml:"error"`
+	URI            string    `json:"uri" yaml:"uri"`
+	Available      bool      `json:"available" yaml:"available"`
+	Error          string    `json:"error" yaml:"error"`
+	ThrottledUntil time.Time `json:"throttledUntil" yaml:"throttledUntil"`
 }
 
 type RelayStateOutput struct {
@@ -95,6 +98,7 @@ type OutputOverview struct {
 	Networks            []string                   `json:"networks" yaml:"networks"`
 	NSServerGroups      []NsServerGroupStateOutput `json:"dnsServers" yaml:"dnsServers"`
 	Events              []SystemEventOutput        `json:"events" yaml:"events"`
+	RouteHealth         []RouteHealthOutput        `json:"routeHealth" yaml:"routeHealth"`
 }
 
 func ConvertToStatusOutputOverview(resp *proto.StatusResponse, anon bool, statusFilter string, prefixNamesFilter []string, prefixNamesFilterMap map[string]struct{}, ipsFilter map[string]struct{}) OutputOverview {
@@ -133,6 +137,7 @@ func ConvertToStatusOutputOverview(resp *proto.StatusResponse, anon bool, status
 		Networks:            pbFullStatus.GetLocalPeerState().GetNetworks(),
 		NSServerGroups:      mapNSGroups(pbFullStatus.GetDnsServers()),
 		Events:              mapEvents(pbFullStatus.GetEvents()),
+		RouteHealth:         mapRouteHealth(pbFullStatus.GetRouteHealth(), pbFullStatus.GetPeers()),
 	}
 
 	if anon {
@@ -149,11 +154,16 @@ func mapRelays(relays []*proto.RelayState) RelayStateOutput {
 	var relaysAvailable int
 	for _, relay := range relays {
 		available := relay.GetAvailable()
+		var throttledUntil time.Time
+		if relay.GetThrottledUntil() != nil {
+			throttledUntil = relay.GetThrottledUntil().AsTime().Local()
+		}
 		relayStateDetail = append(relayStateDetail,
 			RelayStateOutputDetail{
-				URI:       relay.URI,
-				Available: available,
-				Error:     relay.GetError(),
+				URI:            relay.URI,
+				Available:      available,
+				Error:          relay.GetError(),
+				ThrottledUntil: throttledUntil,
 			},
 		)
 
@@ -230,6 +240,7 @@ func mapPeers(
 			Status:           pbPeerState.GetConnStatus(),
 			LastStatusUpdate: timeLocal,
 			ConnType:         connType,
+			ConnStrategy:     pbPeerState.GetConnectionStrategy(),
 			IceCandidateType: IceCandidateType{
 				Local:  localICE,
 				Remote: remoteICE,
@@ -332,6 +343,9 @@ func ParseGeneralSummary(overview OutputOverview, showURL bool, showRelays bool,
 				available = "Unavailable"
 				reason = fmt.Sprintf(", reason: %s", relay.Error)
 			}
+			if relay.ThrottledUntil.After(time.Now()) {
+				reason += fmt.Sprintf(", throttled until %s", relay.ThrottledUntil.Format(time.TimeOnly))
+			}
 			relaysString += fmt.Sprintf("\n  [%s] is %s%s", relay.URI, available, reason)
 		}
 	} else {
@@ -344,6 +358,12 @@ func ParseGeneralSummary(overview OutputOverview, showURL bool, showRelays bool,
 		networks = strings.Join(overview.Networks, ", ")
 	}
 
+	// only shown when routes with health checks are in use
+	var routeHealthString string
+	if len(overview.RouteHealth) > 0 {
+		routeHealthString = fmt.Sprintf("Route health: %d/%d Healthy\n", countHealthy(overview.RouteHealth), len(overview.RouteHealth))
+	}
+
 	var dnsServersString string
 	if showNameServers {
 		for _, nsServerGroup := range overview.NSServerGroups {
@@ -403,6 +423,7 @@ func ParseGeneralSummary(overview OutputOverview, showURL bool, showRelays bool,
 			"Interface type: %s\n"+
 			"Quantum resistance: %s\n"+
 			"Networks: %s\n"+
+			"%s"+
 			"Peers count: %s\n",
 		fmt.Sprintf("%s/%s%s", goos, goarch, goarm),
 		overview.DaemonVersion,
@@ -416,6 +437,7 @@ func ParseGeneralSummary(overview OutputOverview, showURL bool, showRelays bool,
 		interfaceTypeString,
 		rosenpassEnabledStatus,
 		networks,
+		routeHealthString,
 		peersCountString,
 	)
 	return summary
@@ -426,14 +448,21 @@ func ParseToFullDetailSummary(overview OutputOverview) string {
 	parsedEventsString := parseEvents(overview.Events)
 	summary := ParseGeneralSummary(overview, true, true, true)
 
+	var parsedRouteHealthString string
+	if len(overview.RouteHealth) > 0 {
+		parsedRouteHealthString = fmt.Sprintf("Route health:%s\n", parseRouteHealth(overview.RouteHealth))
+	}
+
 	return fmt.Sprintf(
 		"Peers detail:"+
 			"%s\n"+
 			"Events:"+
 			"%s\n"+
+			"%s"+
 			"%s",
 		parsedPeersString,
 		parsedEventsString,
+		parsedRouteHealthString,
 		summary,
 	)
 }
@@ -495,6 +524,7 @@ func parsePeers(peers PeersStateOutput, rosenpassEnabled, rosenpassPermissive bo
 				"  Status: %s\n"+
 				"  -- detail --\n"+
 				"  Connection type: %s\n"+
+				"  Connection strategy: %s\n"+
 				"  ICE candidate (Local/Remote): %s/%s\n"+
 				"  ICE candidate endpoints (Local/Remote): %s/%s\n"+
 				"  Relay server address: %s\n"+
@@ -509,6 +539,7 @@ func parsePeers(peers PeersStateOutput, rosenpassEnabled, rosenpassPermissive bo
 			peerState.PubKey,
 			peerState.Status,
 			peerState.ConnType,
+			valueOrDash(peerState.ConnStrategy),
 			localICE,
 			remoteICE,
 			localICEEndpoint,
@@ -677,6 +708,26 @@ func anonymizePeerDetail(a *anonymize.Anonymizer, peer *PeerStateDetailOutput) {
 	}
 }
 
+// anonymizeNameServer anonymizes a nameserver in the ip:port or the URL format of encrypted nameservers
+func anonymizeNameServer(a *anonymize.Anonymizer, ns string) string {
+	if host, port, err := net.SplitHostPort(ns); err == nil {
+		return fmt.Sprintf("%s:%s", a.AnonymizeIPString(host), port)
+	}
+
+	nsURL, err := url.Parse(ns)
+	if err != nil || nsURL.Host == "" {
+		return ns
+	}
+
+	if host, port, err := net.SplitHostPort(nsURL.Host); err == nil {
+		nsURL.Host = net.JoinHostPort(a.AnonymizeIPString(host), port)
+	}
+	if hostname := nsURL.Query().Get("hostname"); hostname != "" {
+		nsURL.RawQuery = url.Values{"hostname": []string{a.AnonymizeDomain(hostname)}}.Encode()
+	}
+	return nsURL.String()
+}
+
 func anonymizeOverview(a *anonymize.Anonymizer, overview *OutputOverview) {
 	for i, peer := range overview.Peers.Details {
 		peer := peer
@@ -701,10 +752,7 @@ func anonymizeOverview(a *anonymize.Anonymizer, overview *OutputOverview) {
 			overview.NSServerGroups[i].Domains[j] = a.AnonymizeDomain(domain)
 		}
 		for j, ns := range nsGroup.Servers {
-			host, port, err := net.SplitHostPort(ns)
-			if err == nil {
-				overview.NSServerGroups[i].Servers[j] = fmt.Sprintf("%s:%s", a.AnonymizeIPString(host), port)
-			}
+			overview.NSServerGroups[i].Servers[j] = anonymizeNameServer(a, ns)
 		}
 	}
 
@@ -712,6 +760,13 @@ func anonymizeOverview(a *anonymize.Anonymizer, overview *OutputOverview) {
 		overview.Networks[i] = a.AnonymizeRoute(route)
 	}
 
+	for i, health := range overview.RouteHealth {
+		overview.RouteHealth[i].Network = a.AnonymizeRoute(health.Network)
+		overview.RouteHealth[i].Peer = a.AnonymizeDomain(health.Peer)
+		overview.RouteHealth[i].Target = a.AnonymizeString(health.Target)
+		overview.RouteHealth[i].Error = a.AnonymizeString(health.Error)
+	}
+
 	overview.FQDN = a.AnonymizeDomain(overview.FQDN)
 
 	for i, event := range overview.Events {
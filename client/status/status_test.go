@@ -13,6 +13,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/netbirdio/netbird/client/anonymize"
 	"github.com/netbirdio/netbird/client/proto"
 	"github.com/netbirdio/netbird/version"
 )
@@ -47,7 +48,8 @@ var resp = &proto.StatusResponse{
 				Networks: []string{
 					"10.1.0.0/24",
 				},
-				Latency: durationpb.New(time.Duration(10000000)),
+				Latency:            durationpb.New(time.Duration(10000000)),
+				ConnectionStrategy: "default",
 			},
 			{
 				IP:                         "192.168.178.102",
@@ -64,6 +66,7 @@ var resp = &proto.StatusResponse{
 				BytesRx:                    2000,
 				BytesTx:                    1000,
 				Latency:                    durationpb.New(time.Duration(10000000)),
+				ConnectionStrategy:         "relay-only",
 			},
 		},
 		ManagementState: &proto.ManagementState{
@@ -119,6 +122,17 @@ var resp = &proto.StatusResponse{
 				Error:   "timeout",
 			},
 		},
+		RouteHealth: []*proto.RouteHealthState{
+			{
+				Network:    "10.1.0.0/24",
+				PeerPubKey: "Pubkey1",
+				Type:       "tcp",
+				Target:     "10.1.0.10:22",
+				Healthy:    true,
+				Chosen:     true,
+				LastCheck:  timestamppb.New(time.Date(2001, 1, 1, 1, 1, 5, 0, time.UTC)),
+			},
+		},
 	},
 	DaemonVersion: "0.14.1",
 }
@@ -135,6 +149,7 @@ var overview = OutputOverview{
 				Status:           "Connected",
 				LastStatusUpdate: time.Date(2001, 1, 1, 1, 1, 1, 0, time.UTC),
 				ConnType:         "P2P",
+				ConnStrategy:     "default",
 				IceCandidateType: IceCandidateType{
 					Local:  "",
 					Remote: "",
@@ -158,6 +173,7 @@ var overview = OutputOverview{
 				Status:           "Connected",
 				LastStatusUpdate: time.Date(2002, 2, 2, 2, 2, 2, 0, time.UTC),
 				ConnType:         "Relayed",
+				ConnStrategy:     "relay-only",
 				IceCandidateType: IceCandidateType{
 					Local:  "relay",
 					Remote: "prflx",
@@ -173,7 +189,18 @@ var overview = OutputOverview{
 			},
 		},
 	},
-	Events:        []SystemEventOutput{},
+	Events: []SystemEventOutput{},
+	RouteHealth: []RouteHealthOutput{
+		{
+			Network:   "10.1.0.0/24",
+			Peer:      "peer-1.awesome-domain.com",
+			Type:      "tcp",
+			Target:    "10.1.0.10:22",
+			Healthy:   true,
+			Chosen:    true,
+			LastCheck: time.Date(2001, 1, 1, 1, 1, 5, 0, time.UTC),
+		},
+	},
 	CliVersion:    version.NetbirdVersion(),
 	DaemonVersion: "0.14.1",
 	ManagementState: ManagementStateOutput{
@@ -280,6 +307,7 @@ func TestParsingToJSON(t *testing.T) {
                 "status": "Connected",
                 "lastStatusUpdate": "2001-01-01T01:01:01Z",
                 "connectionType": "P2P",
+                "connectionStrategy": "default",
                 "iceCandidateType": {
                   "local": "",
                   "remote": ""
@@ -305,6 +333,7 @@ func TestParsingToJSON(t *testing.T) {
                 "status": "Connected",
                 "lastStatusUpdate": "2002-02-02T02:02:02Z",
                 "connectionType": "Relayed",
+                "connectionStrategy": "relay-only",
                 "iceCandidateType": {
                   "local": "relay",
                   "remote": "prflx"
@@ -342,12 +371,14 @@ func TestParsingToJSON(t *testing.T) {
               {
                 "uri": "stun:my-awesome-stun.com:3478",
                 "available": true,
-                "error": ""
+                "error": "",
+                "throttledUntil": "0001-01-01T00:00:00Z"
               },
               {
                 "uri": "turns:my-awesome-turn.com:443?transport=tcp",
                 "available": false,
-                "error": "context: deadline exceeded"
+                "error": "context: deadline exceeded",
+                "throttledUntil": "0001-01-01T00:00:00Z"
               }
             ]
           },
@@ -382,7 +413,19 @@ func TestParsingToJSON(t *testing.T) {
               "error": "timeout"
             }
           ],
-          "events": []
+          "events": [],
+          "routeHealth": [
+            {
+              "network": "10.1.0.0/24",
+              "peer": "peer-1.awesome-domain.com",
+              "type": "tcp",
+              "target": "10.1.0.10:22",
+              "healthy": true,
+              "chosen": true,
+              "lastCheck": "2001-01-01T01:01:05Z",
+              "error": ""
+            }
+          ]
         }`
 	// @formatter:on
 
@@ -406,6 +449,7 @@ func TestParsingToYAML(t *testing.T) {
           status: Connected
           lastStatusUpdate: 2001-01-01T01:01:01Z
           connectionType: P2P
+          connectionStrategy: default
           iceCandidateType:
             local: ""
             remote: ""
@@ -426,6 +470,7 @@ func TestParsingToYAML(t *testing.T) {
           status: Connected
           lastStatusUpdate: 2002-02-02T02:02:02Z
           connectionType: Relayed
+          connectionStrategy: relay-only
           iceCandidateType:
             local: relay
             remote: prflx
@@ -456,9 +501,11 @@ relays:
         - uri: stun:my-awesome-stun.com:3478
           available: true
           error: ""
+          throttledUntil: 0001-01-01T00:00:00Z
         - uri: turns:my-awesome-turn.com:443?transport=tcp
           available: false
           error: 'context: deadline exceeded'
+          throttledUntil: 0001-01-01T00:00:00Z
 netbirdIp: 192.168.178.100/16
 publicKey: Some-Pub-Key
 usesKernelInterface: true
@@ -482,6 +529,15 @@ dnsServers:
       enabled: false
       error: timeout
 events: []
+routeHealth:
+    - network: 10.1.0.0/24
+      peer: peer-1.awesome-domain.com
+      type: tcp
+      target: 10.1.0.10:22
+      healthy: true
+      chosen: true
+      lastCheck: 2001-01-01T01:01:05Z
+      error: ""
 `
 
 	assert.Equal(t, expectedYAML, yaml)
@@ -493,6 +549,7 @@ func TestParsingToDetail(t *testing.T) {
 	lastHandshake1 := timeAgo(overview.Peers.Details[0].LastWireguardHandshake)
 	lastConnectionUpdate2 := timeAgo(overview.Peers.Details[1].LastStatusUpdate)
 	lastHandshake2 := timeAgo(overview.Peers.Details[1].LastWireguardHandshake)
+	lastHealthCheck := timeAgo(overview.RouteHealth[0].LastCheck)
 
 	detail := ParseToFullDetailSummary(overview)
 
@@ -504,6 +561,7 @@ func TestParsingToDetail(t *testing.T) {
   Status: Connected
   -- detail --
   Connection type: P2P
+  Connection strategy: default
   ICE candidate (Local/Remote): -/-
   ICE candidate endpoints (Local/Remote): -/-
   Relay server address: 
@@ -520,6 +578,7 @@ func TestParsingToDetail(t *testing.T) {
   Status: Connected
   -- detail --
   Connection type: Relayed
+  Connection strategy: relay-only
   ICE candidate (Local/Remote): relay/prflx
   ICE candidate endpoints (Local/Remote): 10.0.0.1:10001/10.0.10.1:10002
   Relay server address: 
@@ -531,6 +590,8 @@ func TestParsingToDetail(t *testing.T) {
   Latency: 10ms
 
 Events: No events recorded
+Route health:
+  [10.1.0.0/24] via peer-1.awesome-domain.com (tcp 10.1.0.10:22) is Healthy, chosen, checked: %s
 OS: %s/%s
 Daemon version: 0.14.1
 CLI version: %s
@@ -547,8 +608,9 @@ NetBird IP: 192.168.178.100/16
 Interface type: Kernel
 Quantum resistance: false
 Networks: 10.10.0.0/24
+Route health: 1/1 Healthy
 Peers count: 2/2 Connected
-`, lastConnectionUpdate1, lastHandshake1, lastConnectionUpdate2, lastHandshake2, runtime.GOOS, runtime.GOARCH, overview.CliVersion)
+`, lastConnectionUpdate1, lastHandshake1, lastConnectionUpdate2, lastHandshake2, lastHealthCheck, runtime.GOOS, runtime.GOARCH, overview.CliVersion)
 
 	assert.Equal(t, expectedDetail, detail)
 }
@@ -568,6 +630,7 @@ NetBird IP: 192.168.178.100/16
 Interface type: Kernel
 Quantum resistance: false
 Networks: 10.10.0.0/24
+Route health: 1/1 Healthy
 Peers count: 2/2 Connected
 `
 
@@ -601,3 +664,32 @@ func TestTimeAgo(t *testing.T) {
 		})
 	}
 }
+
+func TestAnonymizeNameServer(t *testing.T) {
+	a := anonymize.NewAnonymizer(anonymize.DefaultAddresses())
+
+	assert.Equal(t, "198.51.100.0:53", anonymizeNameServer(a, "45.90.28.1:53"))
+
+	anonymized := anonymizeNameServer(a, "tls://45.90.28.2:853?hostname=dns.resolver.example")
+	assert.NotContains(t, anonymized, "45.90.28.2")
+	assert.NotContains(t, anonymized, "dns.resolver.example")
+	assert.Contains(t, anonymized, "tls://198.51.100.1:853?hostname=")
+
+	assert.Equal(t, "https://198.51.100.2:443/dns-query", anonymizeNameServer(a, "https://45.90.28.3:443/dns-query"))
+}
+
+func TestParsingThrottledRelay(t *testing.T) {
+	throttledUntil := time.Now().Add(time.Minute)
+	relays := mapRelays([]*proto.RelayState{
+		{
+			URI:            "rels://my-awesome-relay.com:443",
+			Available:      true,
+			ThrottledUntil: timestamppb.New(throttledUntil),
+		},
+	})
+	require.Len(t, relays.Details, 1)
+	assert.True(t, relays.Details[0].ThrottledUntil.Equal(throttledUntil))
+
+	summary := ParseGeneralSummary(OutputOverview{Relays: relays}, false, true, false)
+	assert.Contains(t, summary, "[rels://my-awesome-relay.com:443] is Available, throttled until "+throttledUntil.Local().Format(time.TimeOnly))
+}
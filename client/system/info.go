@@ -66,6 +66,9 @@ type Info struct {
 	DisableServerRoutes bool
 	DisableDNS          bool
 	DisableFirewall     bool
+
+	// IPv6Supported is set when the IPv6 overlay address can be configured, it is known once the engine runs
+	IPv6Supported bool
 }
 
 func (i *Info) SetFlags(
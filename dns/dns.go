@@ -23,6 +23,9 @@ const (
 
 const invalidHostLabel = "[^a-zA-Z0-9-]+"
 
+// maxTXTStringLength is the maximum length of a single character string of a TXT record
+const maxTXTStringLength = 255
+
 // Config represents a dns configuration that is exchanged between management and peers
 type Config struct {
 	// ServiceEnable indicates if the service should be enabled
@@ -39,13 +42,17 @@ type CustomZone struct {
 	Domain string
 	// Records custom zone records
 	Records []SimpleRecord
+	// SearchDomainDisabled indicates whether to skip adding the zone to the host search domains
+	SearchDomainDisabled bool
 }
 
-// SimpleRecord provides a simple DNS record specification for CNAME, A and AAAA records
+// SimpleRecord provides a simple DNS record specification for A, AAAA, CNAME, TXT, SRV, MX and PTR records.
+// The name may start with a wildcard label, e.g. *.example.com
 type SimpleRecord struct {
 	// Name domain name
 	Name string
-	// Type of record, 1 for A, 5 for CNAME, 28 for AAAA. see https://pkg.go.dev/github.com/miekg/dns@v1.1.41#pkg-constants
+	// Type of record, 1 for A, 5 for CNAME, 12 for PTR, 15 for MX, 16 for TXT, 28 for AAAA and 33 for SRV.
+	// see https://pkg.go.dev/github.com/miekg/dns@v1.1.41#pkg-constants
 	Type int
 	// Class dns class, currently use the DefaultClass for all records
 	Class string
@@ -59,7 +66,32 @@ type SimpleRecord struct {
 // <Name> <TTL> <Class> <Type> <RDATA>
 func (s SimpleRecord) String() string {
 	fqdn := dns.Fqdn(s.Name)
-	return fmt.Sprintf("%s %d %s %s %s", fqdn, s.TTL, s.Class, dns.Type(s.Type).String(), s.RData)
+	rData := s.RData
+	if s.Type == int(dns.TypeTXT) {
+		rData = quoteTXT(rData)
+	}
+	return fmt.Sprintf("%s %d %s %s %s", fqdn, s.TTL, s.Class, dns.Type(s.Type).String(), rData)
+}
+
+// quoteTXT quotes the plain text of a TXT record, splitting it into strings of at most 255 bytes.
+// Text that is already quoted is returned as is
+func quoteTXT(text string) string {
+	if strings.HasPrefix(text, `"`) {
+		return text
+	}
+
+	var chunks []string
+	for len(text) > maxTXTStringLength {
+		chunks = append(chunks, text[:maxTXTStringLength])
+		text = text[maxTXTStringLength:]
+	}
+	chunks = append(chunks, text)
+
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	for i, chunk := range chunks {
+		chunks[i] = `"` + escaper.Replace(chunk) + `"`
+	}
+	return strings.Join(chunks, " ")
 }
 
 // Len returns the length of the RData field, based on its type
@@ -109,6 +141,27 @@ func GetParsedDomainLabel(name string) (string, error) {
 	return validHost, nil
 }
 
+// GetReverseZoneName returns the in-addr.arpa zone of the network, the prefix length is rounded up to the nearest octet
+func GetReverseZoneName(ipNet *net.IPNet) (string, error) {
+	networkIP := ipNet.IP.Mask(ipNet.Mask)
+	maskOnes, _ := ipNet.Mask.Size()
+
+	// round up to nearest byte
+	octetsToUse := (maskOnes + 7) / 8
+
+	octets := strings.Split(networkIP.String(), ".")
+	if octetsToUse > len(octets) {
+		return "", fmt.Errorf("invalid network mask size for reverse DNS: %d", maskOnes)
+	}
+
+	reverseOctets := make([]string, octetsToUse)
+	for i := 0; i < octetsToUse; i++ {
+		reverseOctets[octetsToUse-1-i] = octets[i]
+	}
+
+	return dns.Fqdn(strings.Join(reverseOctets, ".") + ".in-addr.arpa"), nil
+}
+
 // NormalizeZone returns a normalized domain name without the wildcard prefix
 func NormalizeZone(domain string) string {
 	d, _ := strings.CutPrefix(domain, "*.")
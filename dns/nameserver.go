@@ -13,6 +13,10 @@ const (
 	InvalidNameServerType NameServerType = iota
 	// UDPNameServerType udp nameserver type
 	UDPNameServerType
+	// TLSNameServerType DNS-over-TLS nameserver type
+	TLSNameServerType
+	// HTTPSNameServerType DNS-over-HTTPS nameserver type
+	HTTPSNameServerType
 )
 
 const (
@@ -22,6 +26,12 @@ const (
 	InvalidNameServerTypeString = "invalid"
 	// UDPNameServerTypeString udp nameserver type as string
 	UDPNameServerTypeString = "udp"
+	// TLSNameServerTypeString DNS-over-TLS nameserver type as string
+	TLSNameServerTypeString = "tls"
+	// HTTPSNameServerTypeString DNS-over-HTTPS nameserver type as string
+	HTTPSNameServerTypeString = "https"
+	// DefaultDoHPath is the URL path of DNS-over-HTTPS nameservers if none is set
+	DefaultDoHPath = "/dns-query"
 )
 
 // NameServerType nameserver type
@@ -32,6 +42,10 @@ func (n NameServerType) String() string {
 	switch n {
 	case UDPNameServerType:
 		return UDPNameServerTypeString
+	case TLSNameServerType:
+		return TLSNameServerTypeString
+	case HTTPSNameServerType:
+		return HTTPSNameServerTypeString
 	default:
 		return InvalidNameServerTypeString
 	}
@@ -42,6 +56,10 @@ func ToNameServerType(typeString string) NameServerType {
 	switch typeString {
 	case UDPNameServerTypeString:
 		return UDPNameServerType
+	case TLSNameServerTypeString:
+		return TLSNameServerType
+	case HTTPSNameServerTypeString:
+		return HTTPSNameServerType
 	default:
 		return InvalidNameServerType
 	}
@@ -79,6 +97,11 @@ type NameServer struct {
 	NSType NameServerType
 	// Port nameserver listening port
 	Port int
+	// Hostname is the TLS server name used to verify the certificate of tls and https nameservers.
+	// The IP address is verified if it is empty.
+	Hostname string `json:",omitempty"`
+	// Path is the URL path of https nameservers, DefaultDoHPath if empty
+	Path string `json:",omitempty"`
 }
 
 // EventMeta returns activity event meta related to the nameserver group
@@ -89,9 +112,11 @@ func (g *NameServerGroup) EventMeta() map[string]any {
 // Copy copies a nameserver object
 func (n *NameServer) Copy() *NameServer {
 	return &NameServer{
-		IP:     n.IP,
-		NSType: n.NSType,
-		Port:   n.Port,
+		IP:       n.IP,
+		NSType:   n.NSType,
+		Port:     n.Port,
+		Hostname: n.Hostname,
+		Path:     n.Path,
 	}
 }
 
@@ -99,10 +124,50 @@ func (n *NameServer) Copy() *NameServer {
 func (n *NameServer) IsEqual(other *NameServer) bool {
 	return other.IP == n.IP &&
 		other.NSType == n.NSType &&
-		other.Port == n.Port
+		other.Port == n.Port &&
+		other.Hostname == n.Hostname &&
+		other.Path == n.Path
 }
 
-// ParseNameServerURL parses a nameserver url in the format <type>://<ip>:<port>, e.g., udp://1.1.1.1:53
+// IsEncrypted returns true if queries to the nameserver are sent over TLS or HTTPS
+func (n *NameServer) IsEncrypted() bool {
+	return n.NSType == TLSNameServerType || n.NSType == HTTPSNameServerType
+}
+
+// ServerName returns the name used to verify the certificate of an encrypted nameserver
+func (n *NameServer) ServerName() string {
+	if n.Hostname != "" {
+		return n.Hostname
+	}
+	return n.IP.String()
+}
+
+// DoHPath returns the URL path of a DNS-over-HTTPS nameserver
+func (n *NameServer) DoHPath() string {
+	if n.Path != "" {
+		return n.Path
+	}
+	return DefaultDoHPath
+}
+
+// URL returns the nameserver in the format accepted by ParseNameServerURL
+func (n *NameServer) URL() string {
+	nsURL := url.URL{
+		Scheme: n.NSType.String(),
+		Host:   netip.AddrPortFrom(n.IP, uint16(n.Port)).String(),
+	}
+	if n.NSType == HTTPSNameServerType {
+		nsURL.Path = n.Path
+	}
+	if n.Hostname != "" {
+		nsURL.RawQuery = url.Values{"hostname": []string{n.Hostname}}.Encode()
+	}
+	return nsURL.String()
+}
+
+// ParseNameServerURL parses a nameserver url in the format <type>://<ip>:<port>[/<path>][?hostname=<name>], e.g., udp://1.1.1.1:53,
+// tls://1.1.1.1:853?hostname=one.one.one.one or https://1.1.1.1:443/dns-query?hostname=cloudflare-dns.com.
+// The path is only accepted for https nameservers and the hostname only for tls and https nameservers.
 func ParseNameServerURL(nsURL string) (NameServer, error) {
 	parsedURL, err := url.Parse(nsURL)
 	if err != nil {
@@ -129,6 +194,20 @@ func ParseNameServerURL(nsURL string) (NameServer, error) {
 
 	ns.IP = parsedAddr
 
+	if path := parsedURL.EscapedPath(); path != "" && path != "/" {
+		if nsType != HTTPSNameServerType {
+			return NameServer{}, fmt.Errorf("invalid nameserver url path, only %s nameservers have a path, got %s", HTTPSNameServerTypeString, path)
+		}
+		ns.Path = path
+	}
+
+	if hostname := parsedURL.Query().Get("hostname"); hostname != "" {
+		if !ns.IsEncrypted() {
+			return NameServer{}, fmt.Errorf("invalid nameserver url hostname, only %s and %s nameservers have a hostname", TLSNameServerTypeString, HTTPSNameServerTypeString)
+		}
+		ns.Hostname = hostname
+	}
+
 	return ns, nil
 }
 
@@ -22,4 +22,6 @@ type Client interface {
 	GetNetworkMap(sysInfo *system.Info) (*proto.NetworkMap, error)
 	IsHealthy() bool
 	SyncMeta(sysInfo *system.Info) error
+	ReportSSHLogins(logins []*proto.SSHLogin) error
+	ReportSSHSessions(sessions []*proto.SSHSession) error
 }
@@ -479,6 +479,62 @@ func (c *GrpcClient) SyncMeta(sysInfo *system.Info) error {
 	return err
 }
 
+// ReportSSHLogins reports the logins to the SSH server of the peer to the Management Service
+func (c *GrpcClient) ReportSSHLogins(logins []*proto.SSHLogin) error {
+	if !c.ready() {
+		return errors.New(errMsgNoMgmtConnection)
+	}
+
+	serverPubKey, err := c.GetServerPublicKey()
+	if err != nil {
+		log.Debugf(errMsgMgmtPublicKey, err)
+		return err
+	}
+
+	reportReq, err := encryption.EncryptMessage(*serverPubKey, c.key, &proto.SSHLoginReport{Logins: logins})
+	if err != nil {
+		log.Errorf("failed to encrypt message: %s", err)
+		return err
+	}
+
+	mgmCtx, cancel := context.WithTimeout(c.ctx, ConnectTimeout)
+	defer cancel()
+
+	_, err = c.realClient.ReportSSHLogins(mgmCtx, &proto.EncryptedMessage{
+		WgPubKey: c.key.PublicKey().String(),
+		Body:     reportReq,
+	})
+	return err
+}
+
+// ReportSSHSessions reports the terminal sessions recorded by the SSH server of the peer to the Management Service
+func (c *GrpcClient) ReportSSHSessions(sessions []*proto.SSHSession) error {
+	if !c.ready() {
+		return errors.New(errMsgNoMgmtConnection)
+	}
+
+	serverPubKey, err := c.GetServerPublicKey()
+	if err != nil {
+		log.Debugf(errMsgMgmtPublicKey, err)
+		return err
+	}
+
+	reportReq, err := encryption.EncryptMessage(*serverPubKey, c.key, &proto.SSHSessionReport{Sessions: sessions})
+	if err != nil {
+		log.Errorf("failed to encrypt message: %s", err)
+		return err
+	}
+
+	mgmCtx, cancel := context.WithTimeout(c.ctx, ConnectTimeout)
+	defer cancel()
+
+	_, err = c.realClient.ReportSSHSessions(mgmCtx, &proto.EncryptedMessage{
+		WgPubKey: c.key.PublicKey().String(),
+		Body:     reportReq,
+	})
+	return err
+}
+
 func (c *GrpcClient) notifyDisconnected(err error) {
 	c.connStateCallbackLock.RLock()
 	defer c.connStateCallbackLock.RUnlock()
@@ -550,6 +606,7 @@ func infoToMetaData(info *system.Info) *proto.PeerSystemMeta {
 			DisableServerRoutes: info.DisableServerRoutes,
 			DisableDNS:          info.DisableDNS,
 			DisableFirewall:     info.DisableFirewall,
+			Ipv6Supported:       info.IPv6Supported,
 		},
 	}
 }
@@ -19,6 +19,8 @@ type MockClient struct {
 	GetDeviceAuthorizationFlowFunc func(serverKey wgtypes.Key) (*proto.DeviceAuthorizationFlow, error)
 	GetPKCEAuthorizationFlowFunc   func(serverKey wgtypes.Key) (*proto.PKCEAuthorizationFlow, error)
 	SyncMetaFunc                   func(sysInfo *system.Info) error
+	ReportSSHLoginsFunc            func(logins []*proto.SSHLogin) error
+	ReportSSHSessionsFunc          func(sessions []*proto.SSHSession) error
 }
 
 func (m *MockClient) IsHealthy() bool {
@@ -85,3 +87,17 @@ func (m *MockClient) SyncMeta(sysInfo *system.Info) error {
 	}
 	return m.SyncMetaFunc(sysInfo)
 }
+
+func (m *MockClient) ReportSSHLogins(logins []*proto.SSHLogin) error {
+	if m.ReportSSHLoginsFunc == nil {
+		return nil
+	}
+	return m.ReportSSHLoginsFunc(logins)
+}
+
+func (m *MockClient) ReportSSHSessions(sessions []*proto.SSHSession) error {
+	if m.ReportSSHSessionsFunc == nil {
+		return nil
+	}
+	return m.ReportSSHSessionsFunc(sessions)
+}
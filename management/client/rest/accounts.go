@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
+	"net/url"
 
 	"github.com/netbirdio/netbird/management/server/http/api"
 )
@@ -52,3 +54,39 @@ func (a *AccountsAPI) Delete(ctx context.Context, accountID string) error {
 
 	return nil
 }
+
+// ExportConfig export the declarative account configuration, returns the configuration in the requested format
+func (a *AccountsAPI) ExportConfig(ctx context.Context, accountID string, params api.GetApiAccountsAccountIdConfigParams) ([]byte, error) {
+	path := "/api/accounts/" + accountID + "/config"
+	if params.Format != nil {
+		path += "?" + url.Values{"format": {string(*params.Format)}}.Encode()
+	}
+
+	resp, err := a.c.newRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// ApplyConfig apply the declarative account configuration, returns the changes made to the account
+func (a *AccountsAPI) ApplyConfig(ctx context.Context, accountID string, request api.PutApiAccountsAccountIdConfigJSONRequestBody, params api.PutApiAccountsAccountIdConfigParams) (*api.AccountConfigPlan, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/api/accounts/" + accountID + "/config"
+	if params.DryRun != nil && *params.DryRun {
+		path += "?dry_run=true"
+	}
+
+	resp, err := a.c.newRequest(ctx, "PUT", path, bytes.NewReader(requestBytes))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	ret, err := parseResponse[api.AccountConfigPlan](resp)
+	return &ret, err
+}
@@ -135,6 +135,96 @@ func TestAccounts_Delete_Err(t *testing.T) {
 	})
 }
 
+func TestAccounts_ExportConfig_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/accounts/Test/config", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "yaml", r.URL.Query().Get("format"))
+			_, err := w.Write([]byte("groups:\n  - name: devs\n"))
+			require.NoError(t, err)
+		})
+		ret, err := c.Accounts.ExportConfig(context.Background(), "Test", api.GetApiAccountsAccountIdConfigParams{
+			Format: ptr(api.GetApiAccountsAccountIdConfigParamsFormatYaml),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "groups:\n  - name: devs\n", string(ret))
+	})
+}
+
+func TestAccounts_ExportConfig_Err(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/accounts/Test/config", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(util.ErrorResponse{Message: "No", Code: 403})
+			w.WriteHeader(403)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Accounts.ExportConfig(context.Background(), "Test", api.GetApiAccountsAccountIdConfigParams{})
+		assert.Error(t, err)
+		assert.Equal(t, "No", err.Error())
+		assert.Empty(t, ret)
+	})
+}
+
+func TestAccounts_ApplyConfig_200(t *testing.T) {
+	plan := api.AccountConfigPlan{
+		DryRun: true,
+		Changes: []api.AccountConfigChange{
+			{Kind: api.AccountConfigChangeKindGroup, Action: api.AccountConfigChangeActionCreate, Name: "devs", Id: "Test"},
+		},
+	}
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/accounts/Test/config", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "PUT", r.Method)
+			assert.Equal(t, "true", r.URL.Query().Get("dry_run"))
+			reqBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var req api.PutApiAccountsAccountIdConfigJSONRequestBody
+			err = json.Unmarshal(reqBytes, &req)
+			require.NoError(t, err)
+			assert.Contains(t, req, "groups")
+			retBytes, _ := json.Marshal(plan)
+			_, err = w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Accounts.ApplyConfig(context.Background(), "Test", api.PutApiAccountsAccountIdConfigJSONRequestBody{
+			"groups": []map[string]string{{"name": "devs"}},
+		}, api.PutApiAccountsAccountIdConfigParams{DryRun: ptr(true)})
+		require.NoError(t, err)
+		assert.Equal(t, plan, *ret)
+	})
+}
+
+func TestAccounts_ApplyConfig_Err(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/accounts/Test/config", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(util.ErrorResponse{Message: "No", Code: 400})
+			w.WriteHeader(400)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Accounts.ApplyConfig(context.Background(), "Test", api.PutApiAccountsAccountIdConfigJSONRequestBody{}, api.PutApiAccountsAccountIdConfigParams{})
+		assert.Error(t, err)
+		assert.Equal(t, "No", err.Error())
+		assert.Nil(t, ret)
+	})
+}
+
+func TestAccounts_Integration_ExportApplyConfig(t *testing.T) {
+	withBlackBoxServer(t, func(c *rest.Client) {
+		exported, err := c.Accounts.ExportConfig(context.Background(), "bf1c8084-ba50-4ce7-9439-34653001fc3b", api.GetApiAccountsAccountIdConfigParams{})
+		require.NoError(t, err)
+
+		var config api.AccountConfig
+		require.NoError(t, json.Unmarshal(exported, &config))
+
+		plan, err := c.Accounts.ApplyConfig(context.Background(), "bf1c8084-ba50-4ce7-9439-34653001fc3b", config, api.PutApiAccountsAccountIdConfigParams{DryRun: ptr(true)})
+		require.NoError(t, err)
+		assert.True(t, plan.DryRun)
+		assert.Empty(t, plan.Changes, "applying the exported configuration should not change the account")
+	})
+}
+
 func TestAccounts_Integration_List(t *testing.T) {
 	withBlackBoxServer(t, func(c *rest.Client) {
 		accounts, err := c.Accounts.List(context.Background())
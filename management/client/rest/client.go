@@ -66,6 +66,9 @@ type Client struct {
 	// Events NetBird Events APIs
 	// see more: https://docs.netbird.io/api/resources/events
 	Events *EventsAPI
+
+	// Roles NetBird custom Roles APIs
+	Roles *RolesAPI
 }
 
 // New initialize new Client instance
@@ -87,6 +90,7 @@ func New(managementURL, token string) *Client {
 	client.DNS = &DNSAPI{client}
 	client.GeoLocation = &GeoLocationAPI{client}
 	client.Events = &EventsAPI{client}
+	client.Roles = &RolesAPI{client}
 	return client
 }
 
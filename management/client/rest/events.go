@@ -2,6 +2,9 @@ package rest
 
 import (
 	"context"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/netbirdio/netbird/management/server/http/api"
 )
@@ -22,3 +25,58 @@ func (a *EventsAPI) List(ctx context.Context) ([]api.Event, error) {
 	ret, err := parseResponse[[]api.Event](resp)
 	return ret, err
 }
+
+// Query list events matching the filters, returns a page of events and the cursor of the next page.
+// The cursor is empty on the last page.
+// See more: https://docs.netbird.io/api/resources/events#list-all-events
+func (a *EventsAPI) Query(ctx context.Context, params api.GetApiEventsParams) ([]api.Event, string, error) {
+	path := "/api/events"
+	if query := encodeEventsParams(params).Encode(); query != "" {
+		path += "?" + query
+	}
+
+	resp, err := a.c.newRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	ret, err := parseResponse[[]api.Event](resp)
+	if err != nil {
+		return nil, "", err
+	}
+	return ret, resp.Header.Get("X-Next-Cursor"), nil
+}
+
+func encodeEventsParams(params api.GetApiEventsParams) url.Values {
+	values := url.Values{}
+	if params.ActivityCode != nil {
+		for _, code := range *params.ActivityCode {
+			values.Add("activity_code", code)
+		}
+	}
+	if params.InitiatorId != nil {
+		values.Set("initiator_id", *params.InitiatorId)
+	}
+	if params.TargetId != nil {
+		values.Set("target_id", *params.TargetId)
+	}
+	if params.StartDate != nil {
+		values.Set("start_date", params.StartDate.Format(time.RFC3339Nano))
+	}
+	if params.EndDate != nil {
+		values.Set("end_date", params.EndDate.Format(time.RFC3339Nano))
+	}
+	if params.Search != nil {
+		values.Set("search", *params.Search)
+	}
+	if params.Cursor != nil {
+		values.Set("cursor", *params.Cursor)
+	}
+	if params.Limit != nil {
+		values.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	if params.Order != nil {
+		values.Set("order", string(*params.Order))
+	}
+	return values
+}
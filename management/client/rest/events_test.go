@@ -53,6 +53,47 @@ func TestEvents_List_Err(t *testing.T) {
 	})
 }
 
+func TestEvents_Query_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/events", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, []string{"account.create", "policy.update"}, r.URL.Query()["activity_code"])
+			assert.Equal(t, "user-id", r.URL.Query().Get("initiator_id"))
+			assert.Equal(t, "10", r.URL.Query().Get("cursor"))
+			assert.Equal(t, "5", r.URL.Query().Get("limit"))
+			retBytes, _ := json.Marshal([]api.Event{testEvent})
+			w.Header().Set("X-Next-Cursor", "15")
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, cursor, err := c.Events.Query(context.Background(), api.GetApiEventsParams{
+			ActivityCode: &[]string{"account.create", "policy.update"},
+			InitiatorId:  ptr("user-id"),
+			Cursor:       ptr("10"),
+			Limit:        ptr(5),
+		})
+		require.NoError(t, err)
+		assert.Len(t, ret, 1)
+		assert.Equal(t, testEvent, ret[0])
+		assert.Equal(t, "15", cursor)
+	})
+}
+
+func TestEvents_Query_Err(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/events", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(util.ErrorResponse{Message: "No", Code: 400})
+			w.WriteHeader(400)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, cursor, err := c.Events.Query(context.Background(), api.GetApiEventsParams{})
+		assert.Error(t, err)
+		assert.Equal(t, "No", err.Error())
+		assert.Empty(t, ret)
+		assert.Empty(t, cursor)
+	})
+}
+
 func TestEvents_Integration(t *testing.T) {
 	withBlackBoxServer(t, func(c *rest.Client) {
 		// Do something that would trigger any event
@@ -80,3 +80,35 @@ func (a *PoliciesAPI) Delete(ctx context.Context, policyID string) error {
 
 	return nil
 }
+
+// Explain evaluates whether a peer can reach a destination peer or network resource
+// See more: https://docs.netbird.io/api/resources/policies#explain-a-connection
+func (a *PoliciesAPI) Explain(ctx context.Context, request api.PostApiPoliciesExplainJSONRequestBody) (*api.PolicyExplanation, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.newRequest(ctx, "POST", "/api/policies/explain", bytes.NewReader(requestBytes))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	ret, err := parseResponse[api.PolicyExplanation](resp)
+	return &ret, err
+}
+
+// DryRun returns the peer pairs that would gain or lose access with a proposed policy change
+// See more: https://docs.netbird.io/api/resources/policies#dry-run-a-policy-change
+func (a *PoliciesAPI) DryRun(ctx context.Context, request api.PostApiPoliciesDryRunJSONRequestBody) (*api.PolicyDryRunResponse, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.newRequest(ctx, "POST", "/api/policies/dry-run", bytes.NewReader(requestBytes))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	ret, err := parseResponse[api.PolicyDryRunResponse](resp)
+	return &ret, err
+}
@@ -186,6 +186,91 @@ func TestPolicies_Delete_Err(t *testing.T) {
 	})
 }
 
+func TestPolicies_Explain_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/policies/explain", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			reqBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var req api.PostApiPoliciesExplainJSONRequestBody
+			err = json.Unmarshal(reqBytes, &req)
+			require.NoError(t, err)
+			assert.Equal(t, "peerA", req.SourcePeerId)
+			retBytes, _ := json.Marshal(api.PolicyExplanation{Allowed: true, Reason: "allowed"})
+			_, err = w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Policies.Explain(context.Background(), api.PostApiPoliciesExplainJSONRequestBody{
+			SourcePeerId:      "peerA",
+			DestinationPeerId: ptr("peerB"),
+			Protocol:          api.PolicyExplainRequestProtocolTcp,
+			Port:              ptr(443),
+		})
+		require.NoError(t, err)
+		assert.True(t, ret.Allowed)
+		assert.Equal(t, "allowed", ret.Reason)
+	})
+}
+
+func TestPolicies_Explain_Err(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/policies/explain", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(util.ErrorResponse{Message: "No", Code: 400})
+			w.WriteHeader(400)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Policies.Explain(context.Background(), api.PostApiPoliciesExplainJSONRequestBody{
+			SourcePeerId: "peerA",
+		})
+		assert.Error(t, err)
+		assert.Equal(t, "No", err.Error())
+		assert.Nil(t, ret)
+	})
+}
+
+func TestPolicies_DryRun_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/policies/dry-run", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			reqBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var req api.PostApiPoliciesDryRunJSONRequestBody
+			err = json.Unmarshal(reqBytes, &req)
+			require.NoError(t, err)
+			assert.Equal(t, "Test", *req.PolicyId)
+			retBytes, _ := json.Marshal(api.PolicyDryRunResponse{
+				Lost: []api.PeerAccessPair{{PeerId: "peerA", RemotePeerId: "peerB"}},
+			})
+			_, err = w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Policies.DryRun(context.Background(), api.PostApiPoliciesDryRunJSONRequestBody{
+			PolicyId: ptr("Test"),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, ret.Gained)
+		assert.Len(t, ret.Lost, 1)
+	})
+}
+
+func TestPolicies_DryRun_Err(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/policies/dry-run", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(util.ErrorResponse{Message: "No", Code: 400})
+			w.WriteHeader(400)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Policies.DryRun(context.Background(), api.PostApiPoliciesDryRunJSONRequestBody{
+			PolicyId: ptr("Test"),
+		})
+		assert.Error(t, err)
+		assert.Equal(t, "No", err.Error())
+		assert.Nil(t, ret)
+	})
+}
+
 func TestPolicies_Integration(t *testing.T) {
 	withBlackBoxServer(t, func(c *rest.Client) {
 		policies, err := c.Policies.List(context.Background())
@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// RolesAPI APIs for Roles, do not use directly
+type RolesAPI struct {
+	c *Client
+}
+
+// List list all roles
+func (a *RolesAPI) List(ctx context.Context) ([]api.Role, error) {
+	resp, err := a.c.newRequest(ctx, "GET", "/api/roles", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	ret, err := parseResponse[[]api.Role](resp)
+	return ret, err
+}
+
+// Get get role info
+func (a *RolesAPI) Get(ctx context.Context, roleID string) (*api.Role, error) {
+	resp, err := a.c.newRequest(ctx, "GET", "/api/roles/"+roleID, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	ret, err := parseResponse[api.Role](resp)
+	return &ret, err
+}
+
+// Create create new role
+func (a *RolesAPI) Create(ctx context.Context, request api.PostApiRolesJSONRequestBody) (*api.Role, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.newRequest(ctx, "POST", "/api/roles", bytes.NewReader(requestBytes))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	ret, err := parseResponse[api.Role](resp)
+	return &ret, err
+}
+
+// Update update role info
+func (a *RolesAPI) Update(ctx context.Context, roleID string, request api.PutApiRolesRoleIdJSONRequestBody) (*api.Role, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.newRequest(ctx, "PUT", "/api/roles/"+roleID, bytes.NewReader(requestBytes))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	ret, err := parseResponse[api.Role](resp)
+	return &ret, err
+}
+
+// Delete delete role
+func (a *RolesAPI) Delete(ctx context.Context, roleID string) error {
+	resp, err := a.c.newRequest(ctx, "DELETE", "/api/roles/"+roleID, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/netbirdio/netbird/management/client/rest"
+	"github.com/netbirdio/netbird/management/server/gitops"
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+var (
+	configManagementURL string
+	configToken         string
+	configAccountID     string
+	configFormat        string
+	configFile          string
+	configDryRun        bool
+
+	accountConfigCmd = &cobra.Command{
+		Use:   "config",
+		Short: "Exports and applies the declarative configuration of an account",
+		Long: "Exports and applies the groups, posture checks, policies, routes, networks and DNS configuration of an account " +
+			"as a YAML or JSON document. Objects reference each other by name, so the document can be kept under version control " +
+			"and applied repeatedly. The commands use the management API with a personal access token of an admin user.",
+		SilenceUsage: true,
+	}
+
+	accountConfigExportCmd = &cobra.Command{
+		Use:   "export [--file account.yaml]",
+		Short: "Exports the account configuration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, accountID, err := accountConfigClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			format, err := accountConfigFormat()
+			if err != nil {
+				return err
+			}
+
+			apiFormat := api.GetApiAccountsAccountIdConfigParamsFormat(format)
+			data, err := client.Accounts.ExportConfig(cmd.Context(), accountID, api.GetApiAccountsAccountIdConfigParams{Format: &apiFormat})
+			if err != nil {
+				return fmt.Errorf("export account configuration: %w", err)
+			}
+
+			if configFile == "" || configFile == "-" {
+				_, err = cmd.OutOrStdout().Write(data)
+				return err
+			}
+
+			return os.WriteFile(configFile, data, 0600)
+		},
+	}
+
+	accountConfigApplyCmd = &cobra.Command{
+		Use:   "apply --file account.yaml [--dry-run]",
+		Short: "Applies the account configuration",
+		Long: "Creates, updates and deletes the objects of the account so that it matches the configuration. " +
+			"All changes are applied in a single transaction. With --dry-run the changes are printed without being applied.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := accountConfigFormat()
+			if err != nil {
+				return err
+			}
+
+			var data []byte
+			if configFile == "-" {
+				data, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				data, err = os.ReadFile(configFile)
+			}
+			if err != nil {
+				return fmt.Errorf("read account configuration: %w", err)
+			}
+
+			doc, err := gitops.Unmarshal(data, format)
+			if err != nil {
+				return err
+			}
+			if err = doc.Validate(); err != nil {
+				return fmt.Errorf("invalid account configuration: %w", err)
+			}
+
+			request, err := toAccountConfigRequest(doc)
+			if err != nil {
+				return err
+			}
+
+			client, accountID, err := accountConfigClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			plan, err := client.Accounts.ApplyConfig(cmd.Context(), accountID, request, api.PutApiAccountsAccountIdConfigParams{DryRun: &configDryRun})
+			if err != nil {
+				return fmt.Errorf("apply account configuration: %w", err)
+			}
+
+			printAccountConfigPlan(cmd.OutOrStdout(), plan)
+			return nil
+		},
+	}
+)
+
+func init() {
+	accountConfigCmd.PersistentFlags().StringVar(&configManagementURL, "management-url", "http://localhost:80", "management API URL")
+	accountConfigCmd.PersistentFlags().StringVar(&configToken, "token", "", "personal access token used to authenticate against the management API. Defaults to the NB_API_TOKEN environment variable")
+	accountConfigCmd.PersistentFlags().StringVar(&configAccountID, "account-id", "", "ID of the account. Defaults to the account of the token owner")
+	accountConfigCmd.PersistentFlags().StringVar(&configFormat, "format", "", "document format, json or yaml. Defaults to the format matching the file extension or yaml")
+	accountConfigCmd.PersistentFlags().StringVar(&configFile, "file", "", "document location, - for stdin or stdout")
+
+	accountConfigApplyCmd.Flags().BoolVar(&configDryRun, "dry-run", false, "print the changes without applying them")
+	accountConfigApplyCmd.MarkFlagRequired("file") //nolint
+
+	accountConfigCmd.AddCommand(accountConfigExportCmd, accountConfigApplyCmd)
+	rootCmd.AddCommand(accountConfigCmd)
+}
+
+// accountConfigClient returns the API client and the ID of the account to manage
+func accountConfigClient(ctx context.Context) (*rest.Client, string, error) {
+	token := configToken
+	if token == "" {
+		token = os.Getenv("NB_API_TOKEN")
+	}
+	if token == "" {
+		return nil, "", fmt.Errorf("a personal access token is required, use --token or NB_API_TOKEN")
+	}
+
+	client := rest.New(strings.TrimSuffix(configManagementURL, "/"), token)
+	if configAccountID != "" {
+		return client, configAccountID, nil
+	}
+
+	accounts, err := client.Accounts.List(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("get account: %w", err)
+	}
+	if len(accounts) == 0 {
+		return nil, "", fmt.Errorf("the token owner has no account")
+	}
+
+	return client, accounts[0].Id, nil
+}
+
+// accountConfigFormat returns the format given by the flag or matching the file extension
+func accountConfigFormat() (gitops.Format, error) {
+	if configFormat != "" {
+		return gitops.ParseFormat(configFormat)
+	}
+
+	if strings.EqualFold(filepath.Ext(configFile), ".json") {
+		return gitops.FormatJSON, nil
+	}
+
+	return gitops.FormatYAML, nil
+}
+
+func toAccountConfigRequest(doc *gitops.Document) (api.PutApiAccountsAccountIdConfigJSONRequestBody, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("encode account configuration: %w", err)
+	}
+
+	var request api.PutApiAccountsAccountIdConfigJSONRequestBody
+	if err = json.Unmarshal(data, &request); err != nil {
+		return nil, fmt.Errorf("encode account configuration: %w", err)
+	}
+
+	return request, nil
+}
+
+func printAccountConfigPlan(w io.Writer, plan *api.AccountConfigPlan) {
+	if len(plan.Changes) == 0 {
+		_, _ = fmt.Fprintln(w, "The account matches the configuration, no changes")
+		return
+	}
+
+	for _, change := range plan.Changes {
+		_, _ = fmt.Fprintf(w, "%-6s %-16s %s\n", change.Action, change.Kind, change.Name)
+	}
+
+	if plan.DryRun {
+		_, _ = fmt.Fprintf(w, "%d changes would be applied\n", len(plan.Changes))
+		return
+	}
+	_, _ = fmt.Fprintf(w, "%d changes applied\n", len(plan.Changes))
+}
@@ -26,14 +26,19 @@ import (
 	"github.com/netbirdio/netbird/management/server/activity"
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
 	"github.com/netbirdio/netbird/management/server/geolocation"
+	"github.com/netbirdio/netbird/management/server/gitops"
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/integrated_validator"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/roles"
+	"github.com/netbirdio/netbird/management/server/settings"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/management/server/users"
 	"github.com/netbirdio/netbird/management/server/util"
 	"github.com/netbirdio/netbird/route"
 )
@@ -81,6 +86,9 @@ type AccountManager interface {
 	GetPeers(ctx context.Context, accountID, userID string) ([]*nbpeer.Peer, error)
 	MarkPeerConnected(ctx context.Context, peerKey string, connected bool, realIP net.IP, accountID string) error
 	DeletePeer(ctx context.Context, accountID, peerID, userID string) error
+	GetPendingPeers(ctx context.Context, accountID, userID string) ([]*nbpeer.Peer, error)
+	ApprovePeer(ctx context.Context, accountID, userID, peerID string) (*nbpeer.Peer, error)
+	RejectPeer(ctx context.Context, accountID, userID, peerID string) error
 	UpdatePeer(ctx context.Context, accountID, userID string, peer *nbpeer.Peer) (*nbpeer.Peer, error)
 	GetNetworkMap(ctx context.Context, peerID string) (*types.NetworkMap, error)
 	GetPeerNetwork(ctx context.Context, peerID string) (*types.Network, error)
@@ -104,8 +112,10 @@ type AccountManager interface {
 	SavePolicy(ctx context.Context, accountID, userID string, policy *types.Policy) (*types.Policy, error)
 	DeletePolicy(ctx context.Context, accountID, policyID, userID string) error
 	ListPolicies(ctx context.Context, accountID, userID string) ([]*types.Policy, error)
+	ExplainPolicyAccess(ctx context.Context, accountID, userID string, req *types.PolicyExplainRequest) (*types.PolicyExplanation, error)
+	DryRunPolicy(ctx context.Context, accountID, userID, policyID string, policy *types.Policy) (*types.PolicyAccessDiff, error)
 	GetRoute(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error)
-	CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool) (*route.Route, error)
+	CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, healthCheck *route.HealthCheck) (*route.Route, error)
 	SaveRoute(ctx context.Context, accountID, userID string, route *route.Route) error
 	DeleteRoute(ctx context.Context, accountID string, routeID route.ID, userID string) error
 	ListRoutes(ctx context.Context, accountID, userID string) ([]*route.Route, error)
@@ -114,9 +124,15 @@ type AccountManager interface {
 	SaveNameServerGroup(ctx context.Context, accountID, userID string, nsGroupToSave *nbdns.NameServerGroup) error
 	DeleteNameServerGroup(ctx context.Context, accountID, nsGroupID, userID string) error
 	ListNameServerGroups(ctx context.Context, accountID string, userID string) ([]*nbdns.NameServerGroup, error)
+	GetDNSZone(ctx context.Context, accountID, userID, zoneID string) (*types.DNSZone, error)
+	CreateDNSZone(ctx context.Context, accountID, userID string, zone *types.DNSZone) (*types.DNSZone, error)
+	SaveDNSZone(ctx context.Context, accountID, userID string, zoneToSave *types.DNSZone) error
+	DeleteDNSZone(ctx context.Context, accountID, zoneID, userID string) error
+	ListDNSZones(ctx context.Context, accountID, userID string) ([]*types.DNSZone, error)
 	GetDNSDomain() string
 	StoreEvent(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any)
 	GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error)
+	QueryEvents(ctx context.Context, accountID, userID string, query *activity.Query) ([]*activity.Event, uint64, error)
 	GetDNSSettings(ctx context.Context, accountID string, userID string) (*types.DNSSettings, error)
 	SaveDNSSettings(ctx context.Context, accountID string, userID string, dnsSettingsToSave *types.DNSSettings) error
 	GetPeer(ctx context.Context, accountID, peerID, userID string) (*nbpeer.Peer, error)
@@ -137,6 +153,8 @@ type AccountManager interface {
 	SyncAndMarkPeer(ctx context.Context, accountID string, peerPubKey string, meta nbpeer.PeerSystemMeta, realIP net.IP) (*nbpeer.Peer, *types.NetworkMap, []*posture.Checks, error)
 	OnPeerDisconnected(ctx context.Context, accountID string, peerPubKey string) error
 	SyncPeerMeta(ctx context.Context, peerPubKey string, meta nbpeer.PeerSystemMeta) error
+	RecordPeerSSHLogins(ctx context.Context, peerPubKey string, logins []nbpeer.SSHLogin) error
+	RecordPeerSSHSessions(ctx context.Context, peerPubKey string, sessions []nbpeer.SSHSession) error
 	FindExistingPostureCheck(accountID string, checks *posture.ChecksDefinition) (*posture.Checks, error)
 	GetAccountIDForPeerKey(ctx context.Context, peerKey string) (string, error)
 	GetAccountSettings(ctx context.Context, accountID string, userID string) (*types.Settings, error)
@@ -144,6 +162,16 @@ type AccountManager interface {
 	UpdateAccountPeers(ctx context.Context, accountID string)
 	BuildUserInfosForAccount(ctx context.Context, accountID, initiatorUserID string, accountUsers []*types.User) (map[string]*types.UserInfo, error)
 	SyncUserJWTGroups(ctx context.Context, userAuth nbcontext.UserAuth) error
+	GetCustomRole(ctx context.Context, accountID, roleID, userID string) (*types.CustomRole, error)
+	ListCustomRoles(ctx context.Context, accountID, userID string) ([]*types.CustomRole, error)
+	SaveCustomRole(ctx context.Context, accountID, userID string, role *types.CustomRole) (*types.CustomRole, error)
+	DeleteCustomRole(ctx context.Context, accountID, roleID, userID string) error
+	ListEventSinks(ctx context.Context, accountID, userID string) ([]*types.EventSink, error)
+	GetEventSink(ctx context.Context, accountID, sinkID, userID string) (*types.EventSink, error)
+	SaveEventSink(ctx context.Context, accountID, userID string, eventSink *types.EventSink) (*types.EventSink, error)
+	DeleteEventSink(ctx context.Context, accountID, sinkID, userID string) error
+	ExportAccountConfig(ctx context.Context, accountID, userID string) (*gitops.Document, error)
+	ApplyAccountConfig(ctx context.Context, accountID, userID string, doc *gitops.Document, dryRun bool) (*gitops.Plan, error)
 }
 
 type DefaultAccountManager struct {
@@ -174,12 +202,21 @@ type DefaultAccountManager struct {
 
 	peerInactivityExpiry Scheduler
 
+	// policyRuleScheduler updates account peers when scheduled policy rules become active or inactive
+	policyRuleScheduler Scheduler
+
+	// peerApprovalExpiry rejects the pending peers that haven't been approved within the account approval timeout
+	peerApprovalExpiry Scheduler
+
 	// userDeleteFromIDPEnabled allows to delete user from IDP when user is deleted from account
 	userDeleteFromIDPEnabled bool
 
 	integratedPeerValidator integrated_validator.IntegratedValidator
 
 	metrics telemetry.AppMetrics
+
+	// permissionsManager validates user permissions including the grants of account custom roles
+	permissionsManager permissions.Manager
 }
 
 // getJWTGroupsChanges calculates the changes needed to sync a user's JWT groups.
@@ -263,10 +300,13 @@ func BuildManager(
 		eventStore:               eventStore,
 		peerLoginExpiry:          NewDefaultScheduler(),
 		peerInactivityExpiry:     NewDefaultScheduler(),
+		policyRuleScheduler:      NewDefaultScheduler(),
+		peerApprovalExpiry:       NewDefaultScheduler(),
 		userDeleteFromIDPEnabled: userDeleteFromIDPEnabled,
-		integratedPeerValidator:  integratedPeerValidator,
+		integratedPeerValidator:  newPeerApprovalValidator(integratedPeerValidator),
 		metrics:                  metrics,
 		requestBuffer:            NewAccountRequestBuffer(ctx, store),
+		permissionsManager:       permissions.NewManager(users.NewManager(store), settings.NewManager(store), roles.NewManager(store)),
 	}
 	accountsCounter, err := store.GetAccountsCounter(ctx)
 	if err != nil {
@@ -309,6 +349,10 @@ func BuildManager(
 		am.onPeersInvalidated(ctx, accountID)
 	})
 
+	am.loadEventSinks(ctx)
+	go am.schedulePolicyRuleTransitions(ctx)
+	go am.schedulePeerApprovalExpirations(ctx)
+
 	return am, nil
 }
 
@@ -334,6 +378,14 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		return nil, status.Errorf(status.InvalidArgument, "peer login expiration can't be smaller than one hour")
 	}
 
+	if newSettings.PeerApprovalTimeout < 0 {
+		return nil, status.Errorf(status.InvalidArgument, "peer approval timeout can't be negative")
+	}
+
+	if newSettings.RelayBytesPerSecond < 0 || newSettings.RelayMessagesPerSecond < 0 {
+		return nil, status.Errorf(status.InvalidArgument, "relay limits can't be negative")
+	}
+
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
@@ -384,11 +436,28 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		account.Network.Serial++
 	}
 
+	if oldSettings.IPv6Enabled != newSettings.IPv6Enabled {
+		if newSettings.IPv6Enabled {
+			account.Network.NetV6 = types.NewNetworkV6(account.Network.Identifier)
+			am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountIPv6Enabled, nil)
+		} else {
+			account.Network.NetV6 = net.IPNet{}
+			am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountIPv6Disabled, nil)
+		}
+		updateAccountPeers = true
+		account.Network.Serial++
+	}
+
 	err = am.handleInactivityExpirationSettings(ctx, oldSettings, newSettings, userID, accountID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = am.handlePeerApprovalSettings(ctx, account, oldSettings, newSettings, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
 	err = am.handleGroupsPropagationSettings(ctx, oldSettings, newSettings, userID, accountID)
 	if err != nil {
 		return nil, fmt.Errorf("groups propagation failed: %w", err)
@@ -401,6 +470,11 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		return nil, err
 	}
 
+	// the expiration is scheduled from the stored settings
+	if oldSettings.PeerApprovalTimeout != newSettings.PeerApprovalTimeout {
+		am.checkAndSchedulePeerApprovalExpiration(ctx, accountID)
+	}
+
 	if updateAccountPeers {
 		go am.UpdateAccountPeers(ctx, accountID)
 	}
@@ -408,6 +482,41 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 	return updatedAccount, nil
 }
 
+func (am *DefaultAccountManager) handlePeerApprovalSettings(ctx context.Context, account *types.Account, oldSettings, newSettings *types.Settings, userID, accountID string) error {
+	setupKeyIDs := make(map[string]struct{}, len(account.SetupKeys))
+	for _, key := range account.SetupKeys {
+		setupKeyIDs[key.Id] = struct{}{}
+	}
+
+	// keys and users removed after they were added to the approval scope are kept
+	for _, setupKeyID := range newSettings.PeerApprovalSetupKeys {
+		if _, ok := setupKeyIDs[setupKeyID]; !ok && !slices.Contains(oldSettings.PeerApprovalSetupKeys, setupKeyID) {
+			return status.Errorf(status.InvalidArgument, "setup key %s of the peer approval doesn't exist", setupKeyID)
+		}
+	}
+	for _, approvalUserID := range newSettings.PeerApprovalUsers {
+		if _, ok := account.Users[approvalUserID]; !ok && !slices.Contains(oldSettings.PeerApprovalUsers, approvalUserID) {
+			return status.Errorf(status.InvalidArgument, "user %s of the peer approval doesn't exist", approvalUserID)
+		}
+	}
+
+	if oldSettings.PeerApprovalRequired != newSettings.PeerApprovalRequired {
+		event := activity.AccountPeerApprovalEnabled
+		if !newSettings.PeerApprovalRequired {
+			event = activity.AccountPeerApprovalDisabled
+		}
+		am.StoreEvent(ctx, userID, accountID, accountID, event, nil)
+	}
+
+	if !slices.Equal(oldSettings.PeerApprovalSetupKeys, newSettings.PeerApprovalSetupKeys) ||
+		!slices.Equal(oldSettings.PeerApprovalUsers, newSettings.PeerApprovalUsers) ||
+		oldSettings.PeerApprovalTimeout != newSettings.PeerApprovalTimeout {
+		am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountPeerApprovalSettingsUpdated, nil)
+	}
+
+	return nil
+}
+
 func (am *DefaultAccountManager) handleGroupsPropagationSettings(ctx context.Context, oldSettings, newSettings *types.Settings, userID, accountID string) error {
 	if oldSettings.GroupsPropagationEnabled != newSettings.GroupsPropagationEnabled {
 		if newSettings.GroupsPropagationEnabled {
@@ -745,9 +854,70 @@ func (am *DefaultAccountManager) loadAccount(ctx context.Context, accountID inte
 		}
 		matchedUserData = append(matchedUserData, datum)
 	}
+
+	go am.updateUsersSSHUsername(ctx, accountIDString, matchedUserData)
+
 	return matchedUserData, nil
 }
 
+// updateUsersSSHUsername stores the local OS user names derived from the IdP data of the users and updates the
+// account peers when SSH authorizations map the users to their local users
+func (am *DefaultAccountManager) updateUsersSSHUsername(ctx context.Context, accountID string, userData []*idp.UserData) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	users, err := am.Store.GetAccountUsers(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get users of account %s: %v", accountID, err)
+		return
+	}
+
+	usernames := make(map[string]string, len(userData))
+	for _, datum := range userData {
+		usernames[datum.ID] = types.SSHUsernameFromUserData(datum.Email, datum.Name)
+	}
+
+	var changedUsers []*types.User
+	for _, user := range users {
+		username, ok := usernames[user.Id]
+		if !ok || user.SSHUsername == username {
+			continue
+		}
+		user.SSHUsername = username
+		changedUsers = append(changedUsers, user)
+	}
+	if len(changedUsers) == 0 {
+		return
+	}
+
+	if err = am.Store.SaveUsers(ctx, store.LockingStrengthUpdate, changedUsers); err != nil {
+		log.WithContext(ctx).Errorf("failed to save SSH user names of account %s: %v", accountID, err)
+		return
+	}
+
+	policies, err := am.Store.GetAccountPolicies(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get policies of account %s: %v", accountID, err)
+		return
+	}
+	if anyPolicyUsesSSHLocalUserPlaceholder(policies) {
+		go am.UpdateAccountPeers(ctx, accountID)
+	}
+}
+
+func anyPolicyUsesSSHLocalUserPlaceholder(policies []*types.Policy) bool {
+	for _, policy := range policies {
+		for _, rule := range policy.Rules {
+			for _, authorization := range rule.SSHAuthorizations {
+				if slices.Contains(authorization.LocalUsers, types.SSHLocalUserPlaceholder) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func (am *DefaultAccountManager) lookupUserInCacheByEmail(ctx context.Context, email string, accountID string) (*idp.UserData, error) {
 	data, err := am.getAccountFromCache(ctx, accountID, false)
 	if err != nil {
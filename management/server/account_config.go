@@ -0,0 +1,1811 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/miekg/dns"
+	"github.com/rs/xid"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/domain"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/gitops"
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/management/server/util"
+	"github.com/netbirdio/netbird/route"
+)
+
+// accountConfigModules are the modules managed by the declarative account configuration
+var accountConfigModules = []permissions.Module{
+	permissions.Groups, permissions.PostureChecks, permissions.Policies,
+	permissions.Routes, permissions.Networks, permissions.DNS,
+}
+
+// errAccountConfigDryRun rolls back the transaction of a dry run
+var errAccountConfigDryRun = errors.New("account configuration dry run")
+
+// ExportAccountConfig returns the declarative configuration of the account
+func (am *DefaultAccountManager) ExportAccountConfig(ctx context.Context, accountID, userID string) (*gitops.Document, error) {
+	if err := am.validateAccountConfigPermissions(ctx, accountID, userID, permissions.Read); err != nil {
+		return nil, err
+	}
+
+	state, err := loadAccountConfigState(ctx, am.Store, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.export()
+}
+
+// ApplyAccountConfig computes the changes required to bring the account in line with the document and applies them
+// in a single transaction. With dryRun set the changes are validated and returned without being applied.
+func (am *DefaultAccountManager) ApplyAccountConfig(ctx context.Context, accountID, userID string, doc *gitops.Document, dryRun bool) (*gitops.Plan, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "invalid account configuration: %v", err)
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if err := am.validateAccountConfigPermissions(ctx, accountID, userID, permissions.Write); err != nil {
+		return nil, err
+	}
+
+	var applier *accountConfigApplier
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		state, err := loadAccountConfigState(ctx, transaction, accountID)
+		if err != nil {
+			return err
+		}
+
+		applier = &accountConfigApplier{
+			accountConfigState: state,
+			am:                 am,
+			ctx:                ctx,
+			transaction:        transaction,
+			userID:             userID,
+			plan:               gitops.NewPlan(dryRun),
+		}
+
+		if err = applier.apply(doc); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return errAccountConfigDryRun
+		}
+
+		if !applier.plan.HasChanges() {
+			return nil
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID)
+	})
+	if dryRun && errors.Is(err, errAccountConfigDryRun) {
+		return applier.plan, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, storeEvent := range applier.events {
+		storeEvent()
+	}
+
+	if applier.plan.HasChanges() {
+		am.UpdateAccountPeers(ctx, accountID)
+		am.checkAndSchedulePolicyRuleTransitions(ctx, accountID)
+	}
+
+	return applier.plan, nil
+}
+
+// validateAccountConfigPermissions checks that the user is allowed to perform the operation on all the modules
+// managed by the declarative configuration
+func (am *DefaultAccountManager) validateAccountConfigPermissions(ctx context.Context, accountID, userID string, operation permissions.Operation) error {
+	for _, module := range accountConfigModules {
+		if err := am.validateUserPermissions(ctx, accountID, userID, module, operation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nameIndex resolves the names of the objects of a kind to their IDs and back
+type nameIndex struct {
+	kind  string
+	ids   map[string][]string
+	names map[string]string
+}
+
+func newNameIndex(kind string) *nameIndex {
+	return &nameIndex{
+		kind:  kind,
+		ids:   make(map[string][]string),
+		names: make(map[string]string),
+	}
+}
+
+func (n *nameIndex) add(id, name string) {
+	n.ids[name] = append(n.ids[name], id)
+	n.names[id] = name
+}
+
+func (n *nameIndex) remove(id string) {
+	name, ok := n.names[id]
+	if !ok {
+		return
+	}
+	delete(n.names, id)
+	n.ids[name] = slices.DeleteFunc(n.ids[name], func(existing string) bool { return existing == id })
+}
+
+// id returns the ID of the object with the given name. Names shared by several objects can't be referenced
+func (n *nameIndex) id(name string) (string, error) {
+	ids := n.ids[name]
+	switch len(ids) {
+	case 0:
+		return "", status.Errorf(status.InvalidArgument, "%s %s doesn't exist", n.kind, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", status.Errorf(status.PreconditionFailed, "%s name %s is used by %d objects and can't be referenced by name", n.kind, name, len(ids))
+	}
+}
+
+func (n *nameIndex) idList(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, err := n.id(name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// name returns the name of the object with the given ID. References to objects that no longer exist
+// have no effect and are reported with an empty name
+func (n *nameIndex) name(id string) (string, error) {
+	name, ok := n.names[id]
+	if !ok {
+		return "", nil
+	}
+
+	if _, err := n.id(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func (n *nameIndex) nameList(ids []string) ([]string, error) {
+	var names []string
+	for _, id := range ids {
+		name, err := n.name(id)
+		if err != nil {
+			return nil, err
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// accountConfigState holds the account objects managed by the declarative configuration
+type accountConfigState struct {
+	accountID string
+
+	groups           []*types.Group
+	peers            []*nbpeer.Peer
+	postureChecks    []*posture.Checks
+	policies         []*types.Policy
+	routes           []*route.Route
+	networks         []*networkTypes.Network
+	resources        []*resourceTypes.NetworkResource
+	routers          []*routerTypes.NetworkRouter
+	nameserverGroups []*nbdns.NameServerGroup
+	dnsZones         []*types.DNSZone
+	dnsSettings      *types.DNSSettings
+
+	groupNames         *nameIndex
+	peerLabels         *nameIndex
+	postureCheckNames  *nameIndex
+	resourceNames      *nameIndex
+	resourceGroups     map[string][]string
+	resourceTypesByIDs map[string]string
+}
+
+func loadAccountConfigState(ctx context.Context, s store.Store, accountID string) (*accountConfigState, error) {
+	state := &accountConfigState{
+		accountID:          accountID,
+		groupNames:         newNameIndex("group"),
+		peerLabels:         newNameIndex("peer"),
+		postureCheckNames:  newNameIndex("posture checks"),
+		resourceNames:      newNameIndex("network resource"),
+		resourceGroups:     make(map[string][]string),
+		resourceTypesByIDs: make(map[string]string),
+	}
+
+	var err error
+	if state.groups, err = s.GetAccountGroups(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+	if state.peers, err = s.GetAccountPeers(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+	if state.postureChecks, err = s.GetAccountPostureChecks(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+	if state.policies, err = s.GetAccountPolicies(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+	if state.routes, err = s.GetAccountRoutes(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+	if state.networks, err = s.GetAccountNetworks(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+	if state.resources, err = s.GetNetworkResourcesByAccountID(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+	if state.routers, err = s.GetNetworkRoutersByAccountID(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+	if state.nameserverGroups, err = s.GetAccountNameServerGroups(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+	if state.dnsZones, err = s.GetAccountDNSZones(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+	if state.dnsSettings, err = s.GetAccountDNSSettings(ctx, store.LockingStrengthShare, accountID); err != nil {
+		return nil, err
+	}
+
+	for _, group := range state.groups {
+		state.groupNames.add(group.ID, group.Name)
+		for _, resource := range group.Resources {
+			state.resourceGroups[resource.ID] = append(state.resourceGroups[resource.ID], group.ID)
+		}
+	}
+	for _, peer := range state.peers {
+		state.peerLabels.add(peer.ID, peer.DNSLabel)
+	}
+	for _, checks := range state.postureChecks {
+		state.postureCheckNames.add(checks.ID, checks.Name)
+	}
+	for _, resource := range state.resources {
+		state.resourceNames.add(resource.ID, resource.Name)
+		state.resourceTypesByIDs[resource.ID] = resource.Type.String()
+	}
+
+	return state, nil
+}
+
+// isManagedGroup returns true for the groups the declarative configuration creates, updates and deletes
+func isManagedGroup(group *types.Group) bool {
+	return group.Issued == types.GroupIssuedAPI && !group.IsGroupAll()
+}
+
+func (s *accountConfigState) export() (*gitops.Document, error) {
+	doc := &gitops.Document{
+		Groups:           []gitops.Group{},
+		PostureChecks:    []gitops.PostureChecks{},
+		Policies:         []gitops.Policy{},
+		Routes:           []gitops.Route{},
+		Networks:         []gitops.Network{},
+		NameserverGroups: []gitops.NameserverGroup{},
+		DNSZones:         []gitops.DNSZone{},
+	}
+
+	for _, group := range s.groups {
+		if !isManagedGroup(group) {
+			continue
+		}
+		g, err := s.exportGroup(group)
+		if err != nil {
+			return nil, err
+		}
+		doc.Groups = append(doc.Groups, g)
+	}
+
+	for _, checks := range s.postureChecks {
+		doc.PostureChecks = append(doc.PostureChecks, exportPostureChecks(checks))
+	}
+
+	for _, policy := range s.policies {
+		p, err := s.exportPolicy(policy)
+		if err != nil {
+			return nil, err
+		}
+		doc.Policies = append(doc.Policies, p)
+	}
+
+	for _, r := range s.routes {
+		exported, err := s.exportRoute(r)
+		if err != nil {
+			return nil, err
+		}
+		doc.Routes = append(doc.Routes, exported)
+	}
+
+	for _, network := range s.networks {
+		n, err := s.exportNetwork(network)
+		if err != nil {
+			return nil, err
+		}
+		doc.Networks = append(doc.Networks, n)
+	}
+
+	for _, nsGroup := range s.nameserverGroups {
+		n, err := s.exportNameserverGroup(nsGroup)
+		if err != nil {
+			return nil, err
+		}
+		doc.NameserverGroups = append(doc.NameserverGroups, n)
+	}
+
+	for _, zone := range s.dnsZones {
+		z, err := s.exportDNSZone(zone)
+		if err != nil {
+			return nil, err
+		}
+		doc.DNSZones = append(doc.DNSZones, z)
+	}
+
+	disabledGroups, err := s.groupNames.nameList(s.dnsSettings.DisabledManagementGroups)
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(disabledGroups)
+	doc.DNS.DisabledManagementGroups = disabledGroups
+
+	doc.Sort()
+
+	if err = doc.Validate(); err != nil {
+		return nil, status.Errorf(status.PreconditionFailed, "account configuration can't be exported: %v", err)
+	}
+
+	return doc, nil
+}
+
+func (s *accountConfigState) exportGroup(group *types.Group) (gitops.Group, error) {
+	connectionStrategy := group.ConnectionStrategy
+	if connectionStrategy == types.ConnectionStrategyDefault {
+		connectionStrategy = ""
+	}
+
+	// peers of dynamic groups follow the rule
+	if group.IsDynamic() {
+		return gitops.Group{Name: group.Name, ConnectionStrategy: connectionStrategy, Rule: group.Rule}, nil
+	}
+
+	peers, err := s.peerLabels.nameList(group.Peers)
+	if err != nil {
+		return gitops.Group{}, err
+	}
+	slices.Sort(peers)
+
+	return gitops.Group{Name: group.Name, Peers: peers, ConnectionStrategy: connectionStrategy}, nil
+}
+
+func exportPostureChecks(checks *posture.Checks) gitops.PostureChecks {
+	return gitops.PostureChecks{
+		Name:        checks.Name,
+		Description: checks.Description,
+		Checks:      checks.Checks,
+	}
+}
+
+func (s *accountConfigState) exportPolicy(policy *types.Policy) (gitops.Policy, error) {
+	postureChecks, err := s.postureCheckNames.nameList(policy.SourcePostureChecks)
+	if err != nil {
+		return gitops.Policy{}, err
+	}
+
+	p := gitops.Policy{
+		Name:                policy.Name,
+		Description:         policy.Description,
+		Enabled:             policy.Enabled,
+		SourcePostureChecks: postureChecks,
+	}
+
+	for _, rule := range policy.Rules {
+		r := gitops.PolicyRule{
+			Name:          rule.Name,
+			Description:   rule.Description,
+			Enabled:       rule.Enabled,
+			Action:        string(rule.Action),
+			Protocol:      string(rule.Protocol),
+			Bidirectional: rule.Bidirectional,
+			Ports:         slices.Clone(rule.Ports),
+		}
+
+		if r.Sources, err = s.groupNames.nameList(rule.Sources); err != nil {
+			return gitops.Policy{}, err
+		}
+		if r.Destinations, err = s.groupNames.nameList(rule.Destinations); err != nil {
+			return gitops.Policy{}, err
+		}
+		if r.SourceResource, err = s.resourceNames.name(rule.SourceResource.ID); err != nil {
+			return gitops.Policy{}, err
+		}
+		if r.DestinationResource, err = s.resourceNames.name(rule.DestinationResource.ID); err != nil {
+			return gitops.Policy{}, err
+		}
+
+		for _, portRange := range rule.PortRanges {
+			r.PortRanges = append(r.PortRanges, gitops.PortRange{Start: portRange.Start, End: portRange.End})
+		}
+
+		r.Schedule = exportSchedule(rule.Schedule)
+
+		for _, authorization := range rule.SSHAuthorizations {
+			sshAuthorization := gitops.SSHAuthorization{
+				Users:      slices.Clone(authorization.Users),
+				LocalUsers: slices.Clone(authorization.LocalUsers),
+			}
+			if sshAuthorization.Groups, err = s.groupNames.nameList(authorization.Groups); err != nil {
+				return gitops.Policy{}, err
+			}
+			r.SSHAuthorizations = append(r.SSHAuthorizations, sshAuthorization)
+		}
+
+		p.Rules = append(p.Rules, r)
+	}
+
+	return p, nil
+}
+
+func exportSchedule(schedule *types.PolicyRuleSchedule) *gitops.Schedule {
+	if schedule == nil {
+		return nil
+	}
+
+	s := &gitops.Schedule{
+		TimeZone: schedule.TimeZone,
+		StartsAt: schedule.StartsAt,
+		EndsAt:   schedule.EndsAt,
+	}
+
+	for _, window := range schedule.Windows {
+		w := gitops.TimeWindow{Start: window.Start, End: window.End}
+		for _, day := range window.Days {
+			w.Days = append(w.Days, strings.ToLower(day.String()))
+		}
+		s.Windows = append(s.Windows, w)
+	}
+
+	return s
+}
+
+func (s *accountConfigState) exportRoute(r *route.Route) (gitops.Route, error) {
+	exported := gitops.Route{
+		NetworkID:   string(r.NetID),
+		Description: r.Description,
+		KeepRoute:   r.KeepRoute,
+		Masquerade:  r.Masquerade,
+		Metric:      r.Metric,
+		Enabled:     r.Enabled,
+	}
+
+	if len(r.Domains) > 0 {
+		exported.Domains = r.Domains.ToSafeStringList()
+	} else {
+		exported.Network = r.Network.String()
+	}
+
+	var err error
+	if exported.Peer, err = s.peerLabels.name(r.Peer); err != nil {
+		return gitops.Route{}, err
+	}
+	if exported.PeerGroups, err = s.groupNames.nameList(r.PeerGroups); err != nil {
+		return gitops.Route{}, err
+	}
+	if exported.Groups, err = s.groupNames.nameList(r.Groups); err != nil {
+		return gitops.Route{}, err
+	}
+	if exported.AccessControlGroups, err = s.groupNames.nameList(r.AccessControlGroups); err != nil {
+		return gitops.Route{}, err
+	}
+
+	return exported, nil
+}
+
+func (s *accountConfigState) exportNetwork(network *networkTypes.Network) (gitops.Network, error) {
+	n := gitops.Network{
+		Name:        network.Name,
+		Description: network.Description,
+	}
+
+	for _, resource := range s.resources {
+		if resource.NetworkID != network.ID {
+			continue
+		}
+		r, err := s.exportNetworkResource(resource, s.resourceGroups[resource.ID])
+		if err != nil {
+			return gitops.Network{}, err
+		}
+		n.Resources = append(n.Resources, r)
+	}
+
+	for _, router := range s.routers {
+		if router.NetworkID != network.ID {
+			continue
+		}
+		r, err := s.exportNetworkRouter(router)
+		if err != nil {
+			return gitops.Network{}, err
+		}
+		n.Routers = append(n.Routers, r)
+	}
+
+	return n, nil
+}
+
+func (s *accountConfigState) exportNetworkResource(resource *resourceTypes.NetworkResource, groupIDs []string) (gitops.NetworkResource, error) {
+	groups, err := s.groupNames.nameList(groupIDs)
+	if err != nil {
+		return gitops.NetworkResource{}, err
+	}
+	slices.Sort(groups)
+
+	address := resource.Prefix.String()
+	if resource.Domain != "" {
+		address = resource.Domain
+	}
+
+	return gitops.NetworkResource{
+		Name:        resource.Name,
+		Description: resource.Description,
+		Address:     address,
+		Enabled:     resource.Enabled,
+		Groups:      groups,
+	}, nil
+}
+
+func (s *accountConfigState) exportNetworkRouter(router *routerTypes.NetworkRouter) (gitops.NetworkRouter, error) {
+	r := gitops.NetworkRouter{
+		Masquerade: router.Masquerade,
+		Metric:     router.Metric,
+		Enabled:    router.Enabled,
+	}
+
+	var err error
+	if r.Peer, err = s.peerLabels.name(router.Peer); err != nil {
+		return gitops.NetworkRouter{}, err
+	}
+	if r.PeerGroups, err = s.groupNames.nameList(router.PeerGroups); err != nil {
+		return gitops.NetworkRouter{}, err
+	}
+
+	return r, nil
+}
+
+func (s *accountConfigState) exportNameserverGroup(nsGroup *nbdns.NameServerGroup) (gitops.NameserverGroup, error) {
+	groups, err := s.groupNames.nameList(nsGroup.Groups)
+	if err != nil {
+		return gitops.NameserverGroup{}, err
+	}
+
+	n := gitops.NameserverGroup{
+		Name:                 nsGroup.Name,
+		Description:          nsGroup.Description,
+		Groups:               groups,
+		Primary:              nsGroup.Primary,
+		Domains:              slices.Clone(nsGroup.Domains),
+		SearchDomainsEnabled: nsGroup.SearchDomainsEnabled,
+		Enabled:              nsGroup.Enabled,
+	}
+
+	for _, ns := range nsGroup.NameServers {
+		n.Nameservers = append(n.Nameservers, gitops.Nameserver{
+			IP:       ns.IP.String(),
+			NSType:   ns.NSType.String(),
+			Port:     ns.Port,
+			Hostname: ns.Hostname,
+			Path:     ns.Path,
+		})
+	}
+
+	return n, nil
+}
+
+func (s *accountConfigState) exportDNSZone(zone *types.DNSZone) (gitops.DNSZone, error) {
+	groups, err := s.groupNames.nameList(zone.Groups)
+	if err != nil {
+		return gitops.DNSZone{}, err
+	}
+
+	z := gitops.DNSZone{
+		Name:                 zone.Name,
+		Description:          zone.Description,
+		Enabled:              zone.Enabled,
+		SearchDomainsEnabled: zone.SearchDomainsEnabled,
+		Groups:               groups,
+	}
+
+	for _, record := range zone.Records {
+		z.Records = append(z.Records, gitops.DNSRecord{
+			Name:    strings.TrimSuffix(record.Name, "."),
+			Type:    dns.Type(record.Type).String(),
+			TTL:     record.TTL,
+			Content: record.RData,
+		})
+	}
+
+	return z, nil
+}
+
+// sameConfig returns true if the exported forms of two objects are equal
+func sameConfig(a, b any) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(aJSON) == string(bJSON)
+}
+
+// accountConfigApplier applies a document to the account state inside a transaction
+type accountConfigApplier struct {
+	*accountConfigState
+
+	am          *DefaultAccountManager
+	ctx         context.Context
+	transaction store.Store
+	userID      string
+
+	plan   *gitops.Plan
+	events []func()
+
+	// deletedGroups and deletedPostureChecks are removed after the objects referencing them have been updated
+	deletedGroups        []*types.Group
+	deletedPostureChecks []*posture.Checks
+}
+
+func (a *accountConfigApplier) apply(doc *gitops.Document) error {
+	steps := []func(*gitops.Document) error{
+		a.applyGroups,
+		a.applyPostureChecks,
+		a.applyNetworks,
+		a.applyPolicies,
+		a.applyRoutes,
+		a.applyNameserverGroups,
+		a.applyDNSZones,
+		a.applyDNSSettings,
+		a.deletePostureChecks,
+		a.deleteGroups,
+	}
+
+	for _, step := range steps {
+		if err := step(doc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *accountConfigApplier) storeEvent(targetID string, activityID activity.ActivityDescriber, meta map[string]any) {
+	ctx, userID, accountID := a.ctx, a.userID, a.accountID
+	a.events = append(a.events, func() {
+		a.am.StoreEvent(ctx, userID, targetID, accountID, activityID, meta)
+	})
+}
+
+// applyGroupRule evaluates the peers of a dynamic group
+func (a *accountConfigApplier) applyGroupRule(group *types.Group) error {
+	if !group.IsDynamic() {
+		return nil
+	}
+	if err := group.ApplyRule(a.peers); err != nil {
+		return status.Errorf(status.InvalidArgument, "invalid rule of group %s: %v", group.Name, err)
+	}
+	return nil
+}
+
+func (a *accountConfigApplier) applyGroups(doc *gitops.Document) error {
+	desired := make(map[string]struct{}, len(doc.Groups))
+	var groupsToSave []*types.Group
+
+	for _, g := range doc.Groups {
+		desired[g.Name] = struct{}{}
+
+		if err := types.ValidateConnectionStrategy(g.ConnectionStrategy); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid group %s: %v", g.Name, err)
+		}
+
+		peers, err := a.peerLabels.idList(g.Peers)
+		if err != nil {
+			return err
+		}
+
+		var existing *types.Group
+		for _, group := range a.groups {
+			if group.Name != g.Name {
+				continue
+			}
+			if !isManagedGroup(group) {
+				return status.Errorf(status.InvalidArgument, "group %s is issued by %s and can't be managed by the account configuration", g.Name, group.Issued)
+			}
+			if existing != nil {
+				return status.Errorf(status.PreconditionFailed, "group name %s is used by several groups and can't be referenced by name", g.Name)
+			}
+			existing = group
+		}
+
+		if existing == nil {
+			group := &types.Group{
+				ID:        xid.New().String(),
+				AccountID: a.accountID,
+				Name:      g.Name,
+				Issued:    types.GroupIssuedAPI,
+				Peers:     peers,
+
+				ConnectionStrategy: g.ConnectionStrategy,
+				Rule:               g.Rule,
+			}
+			if err = a.applyGroupRule(group); err != nil {
+				return err
+			}
+			a.groups = append(a.groups, group)
+			a.groupNames.add(group.ID, group.Name)
+			a.plan.Add(gitops.KindGroup, gitops.ActionCreate, group.Name, group.ID)
+			groupsToSave = append(groupsToSave, group)
+			continue
+		}
+
+		current, err := a.exportGroup(existing)
+		if err != nil {
+			return err
+		}
+		updated := existing.Copy()
+		updated.AccountID = a.accountID
+		updated.Peers = peers
+		updated.ConnectionStrategy = g.ConnectionStrategy
+		updated.Rule = g.Rule
+		if err = a.applyGroupRule(updated); err != nil {
+			return err
+		}
+		target, err := a.exportGroup(updated)
+		if err != nil {
+			return err
+		}
+		if sameConfig(current, target) {
+			continue
+		}
+
+		a.plan.Add(gitops.KindGroup, gitops.ActionUpdate, updated.Name, updated.ID)
+		groupsToSave = append(groupsToSave, updated)
+	}
+
+	for _, group := range groupsToSave {
+		a.events = append(a.events, a.am.prepareGroupEvents(a.ctx, a.transaction, a.accountID, a.userID, group)...)
+	}
+
+	if err := a.transaction.SaveGroups(a.ctx, store.LockingStrengthUpdate, groupsToSave); err != nil {
+		return err
+	}
+
+	for _, group := range a.groups {
+		if _, ok := desired[group.Name]; !ok && isManagedGroup(group) {
+			a.deletedGroups = append(a.deletedGroups, group)
+		}
+	}
+
+	return nil
+}
+
+func (a *accountConfigApplier) applyPostureChecks(doc *gitops.Document) error {
+	desired := make(map[string]struct{}, len(doc.PostureChecks))
+
+	for _, p := range doc.PostureChecks {
+		desired[p.Name] = struct{}{}
+
+		checks := &posture.Checks{
+			AccountID:   a.accountID,
+			Name:        p.Name,
+			Description: p.Description,
+			Checks:      p.Checks.Copy(),
+		}
+
+		action := gitops.ActionCreate
+		if id, err := a.postureCheckNames.id(p.Name); err == nil {
+			existing := a.postureChecks[slices.IndexFunc(a.postureChecks, func(c *posture.Checks) bool { return c.ID == id })]
+			if sameConfig(exportPostureChecks(existing), exportPostureChecks(checks)) {
+				continue
+			}
+			checks.ID = id
+			action = gitops.ActionUpdate
+		}
+
+		if err := validatePostureChecks(a.ctx, a.transaction, a.accountID, checks); err != nil {
+			return err
+		}
+
+		if err := a.transaction.SavePostureChecks(a.ctx, store.LockingStrengthUpdate, checks); err != nil {
+			return err
+		}
+
+		activityID := activity.PostureCheckUpdated
+		if action == gitops.ActionCreate {
+			activityID = activity.PostureCheckCreated
+			a.postureCheckNames.add(checks.ID, checks.Name)
+		}
+
+		a.plan.Add(gitops.KindPostureChecks, action, checks.Name, checks.ID)
+		a.storeEvent(checks.ID, activityID, checks.EventMeta())
+	}
+
+	for _, checks := range a.postureChecks {
+		if _, ok := desired[checks.Name]; !ok {
+			a.deletedPostureChecks = append(a.deletedPostureChecks, checks)
+		}
+	}
+
+	return nil
+}
+
+func (a *accountConfigApplier) applyNetworks(doc *gitops.Document) error {
+	desiredNetworks := make(map[string]struct{}, len(doc.Networks))
+	desiredResources := make(map[string]struct{})
+
+	for _, n := range doc.Networks {
+		desiredNetworks[n.Name] = struct{}{}
+
+		network, err := a.saveNetwork(n)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range n.Resources {
+			desiredResources[r.Name] = struct{}{}
+			if err = a.saveNetworkResource(network, r); err != nil {
+				return err
+			}
+		}
+
+		if err = a.applyNetworkRouters(network, n.Routers); err != nil {
+			return err
+		}
+	}
+
+	for _, resource := range a.resources {
+		if _, ok := desiredResources[resource.Name]; ok {
+			continue
+		}
+		if err := a.deleteNetworkResource(resource); err != nil {
+			return err
+		}
+	}
+
+	for _, network := range a.networks {
+		if _, ok := desiredNetworks[network.Name]; ok {
+			continue
+		}
+
+		if err := a.applyNetworkRouters(network, nil); err != nil {
+			return err
+		}
+
+		if err := a.transaction.DeleteNetwork(a.ctx, store.LockingStrengthUpdate, a.accountID, network.ID); err != nil {
+			return err
+		}
+
+		a.plan.Add(gitops.KindNetwork, gitops.ActionDelete, network.Name, network.ID)
+		a.storeEvent(network.ID, activity.NetworkDeleted, network.EventMeta())
+	}
+
+	return nil
+}
+
+func (a *accountConfigApplier) saveNetwork(n gitops.Network) (*networkTypes.Network, error) {
+	var existing []*networkTypes.Network
+	for _, network := range a.networks {
+		if network.Name == n.Name {
+			existing = append(existing, network)
+		}
+	}
+
+	switch len(existing) {
+	case 0:
+		network := networkTypes.NewNetwork(a.accountID, n.Name, n.Description)
+		if err := a.transaction.SaveNetwork(a.ctx, store.LockingStrengthUpdate, network); err != nil {
+			return nil, err
+		}
+		a.plan.Add(gitops.KindNetwork, gitops.ActionCreate, network.Name, network.ID)
+		a.storeEvent(network.ID, activity.NetworkCreated, network.EventMeta())
+		return network, nil
+	case 1:
+		network := existing[0]
+		if network.Description == n.Description {
+			return network, nil
+		}
+
+		network = network.Copy()
+		network.Description = n.Description
+		if err := a.transaction.SaveNetwork(a.ctx, store.LockingStrengthUpdate, network); err != nil {
+			return nil, err
+		}
+		a.plan.Add(gitops.KindNetwork, gitops.ActionUpdate, network.Name, network.ID)
+		a.storeEvent(network.ID, activity.NetworkUpdated, network.EventMeta())
+		return network, nil
+	default:
+		return nil, status.Errorf(status.PreconditionFailed, "network name %s is used by %d networks and can't be referenced by name", n.Name, len(existing))
+	}
+}
+
+func (a *accountConfigApplier) saveNetworkResource(network *networkTypes.Network, r gitops.NetworkResource) error {
+	groupIDs, err := a.groupNames.idList(r.Groups)
+	if err != nil {
+		return err
+	}
+
+	resource, err := resourceTypes.NewNetworkResource(a.accountID, network.ID, r.Name, r.Description, r.Address, groupIDs, r.Enabled)
+	if err != nil {
+		return status.Errorf(status.InvalidArgument, "invalid network resource %s: %v", r.Name, err)
+	}
+
+	var existing *resourceTypes.NetworkResource
+	if id, err := a.resourceNames.id(r.Name); err == nil {
+		existing = a.resources[slices.IndexFunc(a.resources, func(res *resourceTypes.NetworkResource) bool { return res.ID == id })]
+		resource.ID = existing.ID
+	}
+
+	var oldGroupIDs []string
+	action := gitops.ActionCreate
+	activityID := activity.NetworkResourceCreated
+	if existing != nil {
+		oldGroupIDs = a.resourceGroups[existing.ID]
+
+		current, err := a.exportNetworkResource(existing, oldGroupIDs)
+		if err != nil {
+			return err
+		}
+		target, err := a.exportNetworkResource(resource, groupIDs)
+		if err != nil {
+			return err
+		}
+		if existing.NetworkID == network.ID && sameConfig(current, target) {
+			return nil
+		}
+
+		action = gitops.ActionUpdate
+		activityID = activity.NetworkResourceUpdated
+	}
+
+	if err = a.transaction.SaveNetworkResource(a.ctx, store.LockingStrengthUpdate, resource); err != nil {
+		return err
+	}
+
+	addedGroups := util.Difference(groupIDs, oldGroupIDs)
+	removedGroups := util.Difference(oldGroupIDs, groupIDs)
+	if existing != nil && existing.Type != resource.Type {
+		// group memberships carry the resource type
+		addedGroups, removedGroups = groupIDs, oldGroupIDs
+	}
+
+	for _, groupID := range removedGroups {
+		if err = a.removeResourceFromGroup(resource, groupID); err != nil {
+			return err
+		}
+	}
+
+	for _, groupID := range addedGroups {
+		if err = a.transaction.AddResourceToGroup(a.ctx, a.accountID, groupID, &types.Resource{ID: resource.ID, Type: resource.Type.String()}); err != nil {
+			return err
+		}
+		a.resourceGroups[resource.ID] = append(a.resourceGroups[resource.ID], groupID)
+		a.storeResourceGroupEvent(resource, groupID, activity.ResourceAddedToGroup)
+	}
+
+	if existing == nil {
+		a.resourceNames.add(resource.ID, resource.Name)
+	}
+	a.resourceTypesByIDs[resource.ID] = resource.Type.String()
+
+	a.plan.Add(gitops.KindNetworkResource, action, resource.Name, resource.ID)
+	a.storeEvent(resource.ID, activityID, resource.EventMeta(network))
+
+	return nil
+}
+
+func (a *accountConfigApplier) deleteNetworkResource(resource *resourceTypes.NetworkResource) error {
+	for _, groupID := range slices.Clone(a.resourceGroups[resource.ID]) {
+		if err := a.removeResourceFromGroup(resource, groupID); err != nil {
+			return err
+		}
+	}
+
+	if err := a.transaction.DeleteNetworkResource(a.ctx, store.LockingStrengthUpdate, a.accountID, resource.ID); err != nil {
+		return err
+	}
+
+	a.resourceNames.remove(resource.ID)
+
+	network := &networkTypes.Network{ID: resource.NetworkID}
+	if i := slices.IndexFunc(a.networks, func(n *networkTypes.Network) bool { return n.ID == resource.NetworkID }); i >= 0 {
+		network = a.networks[i]
+	}
+
+	a.plan.Add(gitops.KindNetworkResource, gitops.ActionDelete, resource.Name, resource.ID)
+	a.storeEvent(resource.ID, activity.NetworkResourceDeleted, resource.EventMeta(network))
+
+	return nil
+}
+
+func (a *accountConfigApplier) removeResourceFromGroup(resource *resourceTypes.NetworkResource, groupID string) error {
+	if err := a.transaction.RemoveResourceFromGroup(a.ctx, a.accountID, groupID, resource.ID); err != nil {
+		return err
+	}
+
+	a.resourceGroups[resource.ID] = slices.DeleteFunc(a.resourceGroups[resource.ID], func(id string) bool { return id == groupID })
+	a.storeResourceGroupEvent(resource, groupID, activity.ResourceRemovedFromGroup)
+
+	return nil
+}
+
+func (a *accountConfigApplier) storeResourceGroupEvent(resource *resourceTypes.NetworkResource, groupID string, activityID activity.Activity) {
+	i := slices.IndexFunc(a.groups, func(g *types.Group) bool { return g.ID == groupID })
+	if i < 0 {
+		return
+	}
+	a.storeEvent(groupID, activityID, a.groups[i].EventMetaResource(resource))
+}
+
+// applyNetworkRouters brings the routers of the network in line with the desired routers. Routers are identified
+// by their routing peer or peer groups
+func (a *accountConfigApplier) applyNetworkRouters(network *networkTypes.Network, desired []gitops.NetworkRouter) error {
+	existing := make(map[string]*routerTypes.NetworkRouter)
+	for _, router := range a.routers {
+		if router.NetworkID != network.ID {
+			continue
+		}
+		r, err := a.exportNetworkRouter(router)
+		if err != nil {
+			return err
+		}
+		existing[r.Key()] = router
+	}
+
+	for _, r := range desired {
+		peerID, err := a.peerID(r.Peer)
+		if err != nil {
+			return err
+		}
+		peerGroups, err := a.groupNames.idList(r.PeerGroups)
+		if err != nil {
+			return err
+		}
+
+		router, err := routerTypes.NewNetworkRouter(a.accountID, network.ID, peerID, peerGroups, r.Masquerade, r.Metric, r.Enabled)
+		if err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid router of network %s: %v", network.Name, err)
+		}
+
+		action := gitops.ActionCreate
+		activityID := activity.NetworkRouterCreated
+		if old, ok := existing[r.Key()]; ok {
+			delete(existing, r.Key())
+
+			current, err := a.exportNetworkRouter(old)
+			if err != nil {
+				return err
+			}
+			if sameConfig(current, r) {
+				continue
+			}
+			router.ID = old.ID
+			action = gitops.ActionUpdate
+			activityID = activity.NetworkRouterUpdated
+		}
+
+		if err = a.transaction.SaveNetworkRouter(a.ctx, store.LockingStrengthUpdate, router); err != nil {
+			return err
+		}
+
+		a.plan.Add(gitops.KindNetworkRouter, action, network.Name+"/"+r.Key(), router.ID)
+		a.storeEvent(router.ID, activityID, router.EventMeta(network))
+	}
+
+	for key, router := range existing {
+		if err := a.transaction.DeleteNetworkRouter(a.ctx, store.LockingStrengthUpdate, a.accountID, router.ID); err != nil {
+			return err
+		}
+
+		a.plan.Add(gitops.KindNetworkRouter, gitops.ActionDelete, network.Name+"/"+key, router.ID)
+		a.storeEvent(router.ID, activity.NetworkRouterDeleted, router.EventMeta(network))
+	}
+
+	return nil
+}
+
+// peerID resolves an optional peer DNS label
+func (a *accountConfigApplier) peerID(label string) (string, error) {
+	if label == "" {
+		return "", nil
+	}
+	return a.peerLabels.id(label)
+}
+
+func (a *accountConfigApplier) applyPolicies(doc *gitops.Document) error {
+	existing := make(map[string]*types.Policy, len(a.policies))
+	for _, policy := range a.policies {
+		if _, ok := existing[policy.Name]; ok {
+			return status.Errorf(status.PreconditionFailed, "policy name %s is used by several policies and can't be referenced by name", policy.Name)
+		}
+		existing[policy.Name] = policy
+	}
+
+	for _, p := range doc.Policies {
+		old := existing[p.Name]
+		delete(existing, p.Name)
+
+		policy, err := a.buildPolicy(p, old)
+		if err != nil {
+			return err
+		}
+
+		action := gitops.ActionCreate
+		activityID := activity.PolicyAdded
+		saveFunc := a.transaction.CreatePolicy
+		if old != nil {
+			current, err := a.exportPolicy(old)
+			if err != nil {
+				return err
+			}
+			target, err := a.exportPolicy(policy)
+			if err != nil {
+				return err
+			}
+			if sameConfig(current, target) {
+				continue
+			}
+			action = gitops.ActionUpdate
+			activityID = activity.PolicyUpdated
+			saveFunc = a.transaction.SavePolicy
+		}
+
+		if err = validatePolicy(a.ctx, a.transaction, a.accountID, policy); err != nil {
+			return err
+		}
+		for _, rule := range policy.Rules {
+			rule.PolicyID = policy.ID
+		}
+
+		if err = saveFunc(a.ctx, store.LockingStrengthUpdate, policy); err != nil {
+			return err
+		}
+
+		a.plan.Add(gitops.KindPolicy, action, policy.Name, policy.ID)
+		a.storeEvent(policy.ID, activityID, policy.EventMeta())
+	}
+
+	for _, policy := range a.policies {
+		if _, ok := existing[policy.Name]; !ok {
+			continue
+		}
+
+		if err := a.transaction.DeletePolicy(a.ctx, store.LockingStrengthUpdate, a.accountID, policy.ID); err != nil {
+			return err
+		}
+
+		a.plan.Add(gitops.KindPolicy, gitops.ActionDelete, policy.Name, policy.ID)
+		a.storeEvent(policy.ID, activity.PolicyRemoved, policy.EventMeta())
+	}
+
+	return nil
+}
+
+// buildPolicy converts the document policy to a policy. Rules keep the IDs of the existing rules with the same name
+func (a *accountConfigApplier) buildPolicy(p gitops.Policy, existing *types.Policy) (*types.Policy, error) {
+	postureChecks, err := a.postureCheckNames.idList(p.SourcePostureChecks)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &types.Policy{
+		AccountID:           a.accountID,
+		Name:                p.Name,
+		Description:         p.Description,
+		Enabled:             p.Enabled,
+		SourcePostureChecks: postureChecks,
+	}
+
+	if existing != nil {
+		policy.ID = existing.ID
+	}
+
+	for _, r := range p.Rules {
+		rule, err := a.buildPolicyRule(p.Name, r)
+		if err != nil {
+			return nil, err
+		}
+
+		rule.ID = xid.New().String()
+		if existing != nil {
+			for _, existingRule := range existing.Rules {
+				if existingRule.Name == rule.Name {
+					rule.ID = existingRule.ID
+				}
+			}
+		}
+
+		policy.Rules = append(policy.Rules, rule)
+	}
+
+	return policy, nil
+}
+
+func (a *accountConfigApplier) buildPolicyRule(policyName string, r gitops.PolicyRule) (*types.PolicyRule, error) {
+	action := types.PolicyTrafficActionType(r.Action)
+	if action != types.PolicyTrafficActionAccept && action != types.PolicyTrafficActionDrop {
+		return nil, status.Errorf(status.InvalidArgument, "invalid action %s of rule %s of policy %s", r.Action, r.Name, policyName)
+	}
+
+	protocol := types.PolicyRuleProtocolType(r.Protocol)
+	switch protocol {
+	case types.PolicyRuleProtocolALL, types.PolicyRuleProtocolTCP, types.PolicyRuleProtocolUDP, types.PolicyRuleProtocolICMP:
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "invalid protocol %s of rule %s of policy %s", r.Protocol, r.Name, policyName)
+	}
+
+	rule := &types.PolicyRule{
+		Name:          r.Name,
+		Description:   r.Description,
+		Enabled:       r.Enabled,
+		Action:        action,
+		Protocol:      protocol,
+		Bidirectional: r.Bidirectional,
+		Ports:         slices.Clone(r.Ports),
+	}
+
+	var err error
+	if rule.Sources, err = a.groupNames.idList(r.Sources); err != nil {
+		return nil, err
+	}
+	if rule.Destinations, err = a.groupNames.idList(r.Destinations); err != nil {
+		return nil, err
+	}
+	if rule.SourceResource, err = a.resourceReference(r.SourceResource); err != nil {
+		return nil, err
+	}
+	if rule.DestinationResource, err = a.resourceReference(r.DestinationResource); err != nil {
+		return nil, err
+	}
+
+	for _, portRange := range r.PortRanges {
+		rule.PortRanges = append(rule.PortRanges, types.RulePortRange{Start: portRange.Start, End: portRange.End})
+	}
+
+	if rule.Schedule, err = buildSchedule(r.Schedule); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "invalid schedule of rule %s of policy %s: %v", r.Name, policyName, err)
+	}
+
+	for _, authorization := range r.SSHAuthorizations {
+		sshAuthorization := types.SSHAuthorization{
+			Users:      slices.Clone(authorization.Users),
+			LocalUsers: slices.Clone(authorization.LocalUsers),
+		}
+		if sshAuthorization.Groups, err = a.groupNames.idList(authorization.Groups); err != nil {
+			return nil, err
+		}
+		rule.SSHAuthorizations = append(rule.SSHAuthorizations, sshAuthorization)
+	}
+
+	return rule, nil
+}
+
+func (a *accountConfigApplier) resourceReference(name string) (types.Resource, error) {
+	if name == "" {
+		return types.Resource{}, nil
+	}
+
+	id, err := a.resourceNames.id(name)
+	if err != nil {
+		return types.Resource{}, err
+	}
+
+	return types.Resource{ID: id, Type: a.resourceTypesByIDs[id]}, nil
+}
+
+var weekdaysByName = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+func buildSchedule(schedule *gitops.Schedule) (*types.PolicyRuleSchedule, error) {
+	if schedule == nil {
+		return nil, nil
+	}
+
+	s := &types.PolicyRuleSchedule{
+		TimeZone: schedule.TimeZone,
+		StartsAt: schedule.StartsAt,
+		EndsAt:   schedule.EndsAt,
+	}
+
+	for _, window := range schedule.Windows {
+		w := types.PolicyRuleTimeWindow{Start: window.Start, End: window.End}
+		for _, day := range window.Days {
+			weekday, ok := weekdaysByName[day]
+			if !ok {
+				return nil, fmt.Errorf("invalid day %s", day)
+			}
+			w.Days = append(w.Days, weekday)
+		}
+		s.Windows = append(s.Windows, w)
+	}
+
+	return s, nil
+}
+
+func (a *accountConfigApplier) applyRoutes(doc *gitops.Document) error {
+	existing := make(map[string]*route.Route, len(a.routes))
+	for _, r := range a.routes {
+		exported, err := a.exportRoute(r)
+		if err != nil {
+			return err
+		}
+		if _, ok := existing[exported.Key()]; ok {
+			return status.Errorf(status.PreconditionFailed, "route %s is defined more than once and can't be referenced by name", exported.Key())
+		}
+		existing[exported.Key()] = r
+	}
+
+	desired := make(map[string]struct{}, len(doc.Routes))
+	for _, r := range doc.Routes {
+		desired[r.Key()] = struct{}{}
+	}
+
+	account, err := a.transaction.GetAccount(a.ctx, a.accountID)
+	if err != nil {
+		return err
+	}
+
+	// routes are removed first so that replaced routes don't conflict with their replacements
+	for key, r := range existing {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+
+		if err = a.transaction.DeleteRoute(a.ctx, store.LockingStrengthUpdate, a.accountID, string(r.ID)); err != nil {
+			return err
+		}
+		delete(account.Routes, r.ID)
+
+		a.plan.Add(gitops.KindRoute, gitops.ActionDelete, key, string(r.ID))
+		a.storeEvent(string(r.ID), activity.RouteRemoved, r.EventMeta())
+	}
+
+	for _, r := range doc.Routes {
+		old := existing[r.Key()]
+
+		newRoute, err := a.buildRoute(r, old)
+		if err != nil {
+			return err
+		}
+
+		action := gitops.ActionCreate
+		activityID := activity.RouteCreated
+		if old != nil {
+			current, err := a.exportRoute(old)
+			if err != nil {
+				return err
+			}
+			target, err := a.exportRoute(newRoute)
+			if err != nil {
+				return err
+			}
+			if sameConfig(current, target) {
+				continue
+			}
+			action = gitops.ActionUpdate
+			activityID = activity.RouteUpdated
+		}
+
+		if err = a.validateRoute(account, newRoute); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid route %s: %v", r.Key(), err)
+		}
+
+		if err = a.transaction.SaveRoute(a.ctx, store.LockingStrengthUpdate, newRoute); err != nil {
+			return err
+		}
+		if account.Routes == nil {
+			account.Routes = make(map[route.ID]*route.Route)
+		}
+		account.Routes[newRoute.ID] = newRoute
+
+		a.plan.Add(gitops.KindRoute, action, r.Key(), string(newRoute.ID))
+		a.storeEvent(string(newRoute.ID), activityID, newRoute.EventMeta())
+	}
+
+	return nil
+}
+
+func (a *accountConfigApplier) buildRoute(r gitops.Route, existing *route.Route) (*route.Route, error) {
+	newRoute := &route.Route{
+		ID:          route.ID(xid.New().String()),
+		AccountID:   a.accountID,
+		NetID:       route.NetID(r.NetworkID),
+		Description: r.Description,
+		KeepRoute:   r.KeepRoute,
+		Masquerade:  r.Masquerade,
+		Metric:      r.Metric,
+		Enabled:     r.Enabled,
+	}
+
+	if existing != nil {
+		newRoute.ID = existing.ID
+	}
+
+	if len(r.Domains) > 0 && r.Network != "" {
+		return nil, status.Errorf(status.InvalidArgument, "route %s has both domains and network", r.Key())
+	}
+
+	if len(r.Domains) > 0 {
+		domains, err := domain.ValidateDomains(r.Domains)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid domains of route %s: %v", r.Key(), err)
+		}
+		newRoute.Domains = domains
+		newRoute.Network = getPlaceholderIP()
+		newRoute.NetworkType = route.DomainNetwork
+	} else {
+		networkType, prefix, err := route.ParseNetwork(r.Network)
+		if err != nil {
+			return nil, err
+		}
+		newRoute.Network = prefix
+		newRoute.NetworkType = networkType
+	}
+
+	var err error
+	if newRoute.Peer, err = a.peerID(r.Peer); err != nil {
+		return nil, err
+	}
+	if newRoute.PeerGroups, err = a.groupNames.idList(r.PeerGroups); err != nil {
+		return nil, err
+	}
+	if newRoute.Groups, err = a.groupNames.idList(r.Groups); err != nil {
+		return nil, err
+	}
+	if newRoute.AccessControlGroups, err = a.groupNames.idList(r.AccessControlGroups); err != nil {
+		return nil, err
+	}
+
+	return newRoute, nil
+}
+
+// validateRoute applies the checks of the routes API to a route of the configuration
+func (a *accountConfigApplier) validateRoute(account *types.Account, r *route.Route) error {
+	if r.Metric < route.MinMetric || r.Metric > route.MaxMetric {
+		return fmt.Errorf("metric should be between %d and %d", route.MinMetric, route.MaxMetric)
+	}
+
+	if utf8.RuneCountInString(string(r.NetID)) > route.MaxNetIDChar {
+		return fmt.Errorf("identifier should be between 1 and %d", route.MaxNetIDChar)
+	}
+
+	if r.Peer != "" && len(r.PeerGroups) > 0 {
+		return fmt.Errorf("peer and peer groups should not be provided at the same time")
+	}
+
+	if r.Peer == "" && len(r.PeerGroups) == 0 {
+		return fmt.Errorf("either a peer or peer groups should be provided")
+	}
+
+	if len(r.Groups) == 0 {
+		return fmt.Errorf("the list of distribution groups should not be empty")
+	}
+
+	if peer := account.GetPeer(r.Peer); peer != nil && peer.Meta.GoOS != "linux" {
+		return fmt.Errorf("non-linux peers are not supported as network routes")
+	}
+
+	return a.am.checkRoutePrefixOrDomainsExistForPeers(account, r.Peer, r.ID, r.PeerGroups, r.Network, r.Domains)
+}
+
+func (a *accountConfigApplier) applyNameserverGroups(doc *gitops.Document) error {
+	existing := make(map[string]*nbdns.NameServerGroup, len(a.nameserverGroups))
+	for _, nsGroup := range a.nameserverGroups {
+		existing[nsGroup.Name] = nsGroup
+	}
+
+	for _, n := range doc.NameserverGroups {
+		old := existing[n.Name]
+		delete(existing, n.Name)
+
+		nsGroup, err := a.buildNameserverGroup(n)
+		if err != nil {
+			return err
+		}
+
+		action := gitops.ActionCreate
+		activityID := activity.NameserverGroupCreated
+		if old != nil {
+			current, err := a.exportNameserverGroup(old)
+			if err != nil {
+				return err
+			}
+			target, err := a.exportNameserverGroup(nsGroup)
+			if err != nil {
+				return err
+			}
+			if sameConfig(current, target) {
+				continue
+			}
+			nsGroup.ID = old.ID
+			action = gitops.ActionUpdate
+			activityID = activity.NameserverGroupUpdated
+		}
+
+		if err = validateNameServerGroup(a.ctx, a.transaction, a.accountID, nsGroup); err != nil {
+			return err
+		}
+
+		if err = a.transaction.SaveNameServerGroup(a.ctx, store.LockingStrengthUpdate, nsGroup); err != nil {
+			return err
+		}
+
+		a.plan.Add(gitops.KindNameserverGroup, action, nsGroup.Name, nsGroup.ID)
+		a.storeEvent(nsGroup.ID, activityID, nsGroup.EventMeta())
+	}
+
+	for _, nsGroup := range a.nameserverGroups {
+		if _, ok := existing[nsGroup.Name]; !ok {
+			continue
+		}
+
+		if err := a.transaction.DeleteNameServerGroup(a.ctx, store.LockingStrengthUpdate, a.accountID, nsGroup.ID); err != nil {
+			return err
+		}
+
+		a.plan.Add(gitops.KindNameserverGroup, gitops.ActionDelete, nsGroup.Name, nsGroup.ID)
+		a.storeEvent(nsGroup.ID, activity.NameserverGroupDeleted, nsGroup.EventMeta())
+	}
+
+	return nil
+}
+
+func (a *accountConfigApplier) buildNameserverGroup(n gitops.NameserverGroup) (*nbdns.NameServerGroup, error) {
+	groups, err := a.groupNames.idList(n.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	nsGroup := &nbdns.NameServerGroup{
+		ID:                   xid.New().String(),
+		AccountID:            a.accountID,
+		Name:                 n.Name,
+		Description:          n.Description,
+		Groups:               groups,
+		Primary:              n.Primary,
+		Domains:              slices.Clone(n.Domains),
+		Enabled:              n.Enabled,
+		SearchDomainsEnabled: n.SearchDomainsEnabled,
+	}
+
+	for _, ns := range n.Nameservers {
+		ip, err := netip.ParseAddr(ns.IP)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid nameserver IP %s of nameserver group %s", ns.IP, n.Name)
+		}
+
+		nsType := nbdns.ToNameServerType(ns.NSType)
+		if nsType == nbdns.InvalidNameServerType {
+			return nil, status.Errorf(status.InvalidArgument, "invalid nameserver type %s of nameserver group %s", ns.NSType, n.Name)
+		}
+
+		nsGroup.NameServers = append(nsGroup.NameServers, nbdns.NameServer{
+			IP:       ip,
+			NSType:   nsType,
+			Port:     ns.Port,
+			Hostname: ns.Hostname,
+			Path:     ns.Path,
+		})
+	}
+
+	return nsGroup, nil
+}
+
+func (a *accountConfigApplier) applyDNSZones(doc *gitops.Document) error {
+	existing := make(map[string]*types.DNSZone, len(a.dnsZones))
+	for _, zone := range a.dnsZones {
+		existing[zone.Name] = zone
+	}
+
+	for _, z := range doc.DNSZones {
+		zone, err := a.buildDNSZone(z)
+		if err != nil {
+			return err
+		}
+
+		old := existing[zone.Name]
+		delete(existing, zone.Name)
+
+		action := gitops.ActionCreate
+		activityID := activity.DNSZoneCreated
+		if old != nil {
+			current, err := a.exportDNSZone(old)
+			if err != nil {
+				return err
+			}
+			target, err := a.exportDNSZone(zone)
+			if err != nil {
+				return err
+			}
+			if sameConfig(current, target) {
+				continue
+			}
+			zone.ID = old.ID
+			action = gitops.ActionUpdate
+			activityID = activity.DNSZoneUpdated
+		}
+
+		if err = a.am.validateDNSZone(a.ctx, a.transaction, a.accountID, zone); err != nil {
+			return err
+		}
+
+		if err = a.transaction.SaveDNSZone(a.ctx, store.LockingStrengthUpdate, zone); err != nil {
+			return err
+		}
+
+		a.plan.Add(gitops.KindDNSZone, action, zone.Name, zone.ID)
+		a.storeEvent(zone.ID, activityID, zone.EventMeta())
+	}
+
+	for _, zone := range a.dnsZones {
+		if _, ok := existing[zone.Name]; !ok {
+			continue
+		}
+
+		if err := a.transaction.DeleteDNSZone(a.ctx, store.LockingStrengthUpdate, a.accountID, zone.ID); err != nil {
+			return err
+		}
+
+		a.plan.Add(gitops.KindDNSZone, gitops.ActionDelete, zone.Name, zone.ID)
+		a.storeEvent(zone.ID, activity.DNSZoneDeleted, zone.EventMeta())
+	}
+
+	return nil
+}
+
+// buildDNSZone converts a document zone, names are normalized the same way as the zones created through the API
+func (a *accountConfigApplier) buildDNSZone(z gitops.DNSZone) (*types.DNSZone, error) {
+	groups, err := a.groupNames.idList(z.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := types.NewDNSZone(a.accountID)
+	zone.Name = strings.ToLower(strings.TrimSuffix(z.Name, "."))
+	zone.Description = z.Description
+	zone.Enabled = z.Enabled
+	zone.SearchDomainsEnabled = z.SearchDomainsEnabled
+	zone.Groups = groups
+
+	for _, record := range z.Records {
+		recordType, ok := types.DNSZoneRecordTypes[strings.ToUpper(record.Type)]
+		if !ok {
+			return nil, status.Errorf(status.InvalidArgument, "unsupported type %s of record %s in dns zone %s", record.Type, record.Name, z.Name)
+		}
+
+		zone.Records = append(zone.Records, nbdns.SimpleRecord{
+			Name:  dns.Fqdn(strings.ToLower(record.Name)),
+			Type:  int(recordType),
+			Class: nbdns.DefaultClass,
+			TTL:   record.TTL,
+			RData: strings.TrimSpace(record.Content),
+		})
+	}
+
+	return zone, nil
+}
+
+func (a *accountConfigApplier) applyDNSSettings(doc *gitops.Document) error {
+	disabledGroups, err := a.groupNames.idList(doc.DNS.DisabledManagementGroups)
+	if err != nil {
+		return err
+	}
+
+	oldGroups := a.dnsSettings.DisabledManagementGroups
+	addedGroups := util.Difference(disabledGroups, oldGroups)
+	removedGroups := util.Difference(oldGroups, disabledGroups)
+	if len(addedGroups) == 0 && len(removedGroups) == 0 {
+		return nil
+	}
+
+	// the store skips nil fields on update, so an empty list is needed to clear the groups
+	if disabledGroups == nil {
+		disabledGroups = []string{}
+	}
+
+	settings := &types.DNSSettings{DisabledManagementGroups: disabledGroups}
+	if err = validateDNSSettings(a.ctx, a.transaction, a.accountID, settings); err != nil {
+		return err
+	}
+
+	a.events = append(a.events, a.am.prepareDNSSettingsEvents(a.ctx, a.transaction, a.accountID, a.userID, addedGroups, removedGroups)...)
+
+	if err = a.transaction.SaveDNSSettings(a.ctx, store.LockingStrengthUpdate, a.accountID, settings); err != nil {
+		return err
+	}
+
+	a.plan.Add(gitops.KindDNSSettings, gitops.ActionUpdate, "dns", a.accountID)
+
+	return nil
+}
+
+func (a *accountConfigApplier) deletePostureChecks(_ *gitops.Document) error {
+	for _, checks := range a.deletedPostureChecks {
+		if err := isPostureCheckLinkedToPolicy(a.ctx, a.transaction, checks.ID, a.accountID); err != nil {
+			return err
+		}
+
+		if err := a.transaction.DeletePostureChecks(a.ctx, store.LockingStrengthUpdate, a.accountID, checks.ID); err != nil {
+			return err
+		}
+
+		a.plan.Add(gitops.KindPostureChecks, gitops.ActionDelete, checks.Name, checks.ID)
+		a.storeEvent(checks.ID, activity.PostureCheckDeleted, checks.EventMeta())
+	}
+
+	return nil
+}
+
+func (a *accountConfigApplier) deleteGroups(_ *gitops.Document) error {
+	groupIDs := make([]string, 0, len(a.deletedGroups))
+	for _, deleted := range a.deletedGroups {
+		// the group is reloaded as its resources might have changed while applying the networks
+		group, err := a.transaction.GetGroupByID(a.ctx, store.LockingStrengthUpdate, a.accountID, deleted.ID)
+		if err != nil {
+			return err
+		}
+
+		if err = validateDeleteGroup(a.ctx, a.transaction, group, a.userID); err != nil {
+			return status.Errorf(status.PreconditionFailed, "group %s can't be deleted: %v", group.Name, err)
+		}
+
+		groupIDs = append(groupIDs, group.ID)
+		a.plan.Add(gitops.KindGroup, gitops.ActionDelete, group.Name, group.ID)
+		a.storeEvent(group.ID, activity.GroupDeleted, group.EventMeta())
+	}
+
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	return a.transaction.DeleteGroups(a.ctx, store.LockingStrengthUpdate, a.accountID, groupIDs)
+}
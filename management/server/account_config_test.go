@@ -0,0 +1,613 @@
+package server
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/netbirdio/netbird/management/server/gitops"
+	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/route"
+)
+
+func setupAccountConfigTest(t *testing.T) (*DefaultAccountManager, string, string, []string) {
+	t.Helper()
+
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	accountID, userID := "account-config", "account-config-admin"
+	_, err = createAccount(manager, accountID, userID, "example.com")
+	require.NoError(t, err)
+
+	var labels []string
+	for _, hostname := range []string{"laptop", "gateway"} {
+		key, err := wgtypes.GeneratePrivateKey()
+		require.NoError(t, err)
+
+		peer, _, _, err := manager.AddPeer(context.Background(), "", userID, &nbpeer.Peer{
+			Key:  key.PublicKey().String(),
+			Name: hostname,
+			Meta: nbpeer.PeerSystemMeta{Hostname: hostname, GoOS: "linux"},
+		})
+		require.NoError(t, err)
+		labels = append(labels, peer.DNSLabel)
+	}
+
+	return manager, accountID, userID, labels
+}
+
+func testAccountConfig(laptop, gateway string) *gitops.Document {
+	return &gitops.Document{
+		Groups: []gitops.Group{
+			{Name: "devs", Peers: []string{laptop}},
+			{Name: "gateways", Peers: []string{gateway}},
+		},
+		PostureChecks: []gitops.PostureChecks{
+			{Name: "min-version", Checks: posture.ChecksDefinition{NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.25.0"}}},
+		},
+		Policies: []gitops.Policy{
+			{
+				Name:                "devs-to-db",
+				Enabled:             true,
+				SourcePostureChecks: []string{"min-version"},
+				Rules: []gitops.PolicyRule{
+					{Name: "postgres", Enabled: true, Action: "accept", Protocol: "tcp", Sources: []string{"devs"}, DestinationResource: "db", Ports: []string{"5432"}},
+				},
+			},
+		},
+		Routes: []gitops.Route{
+			{NetworkID: "office", Network: "10.10.0.0/16", Peer: gateway, Metric: 9999, Enabled: true, Groups: []string{"devs"}},
+		},
+		Networks: []gitops.Network{
+			{
+				Name:      "prod",
+				Resources: []gitops.NetworkResource{{Name: "db", Address: "10.20.0.10/32", Enabled: true, Groups: []string{"gateways"}}},
+				Routers:   []gitops.NetworkRouter{{PeerGroups: []string{"gateways"}, Metric: 9999, Enabled: true}},
+			},
+		},
+		NameserverGroups: []gitops.NameserverGroup{
+			{Name: "google", Nameservers: []gitops.Nameserver{{IP: "8.8.8.8", NSType: "udp", Port: 53}}, Groups: []string{"devs"}, Primary: true, Enabled: true},
+		},
+		DNSZones: []gitops.DNSZone{
+			{
+				Name:    "internal.example.com",
+				Enabled: true,
+				Groups:  []string{"devs"},
+				Records: []gitops.DNSRecord{
+					{Name: "db.internal.example.com", Type: "A", TTL: 300, Content: "10.20.0.10"},
+					{Name: "internal.example.com", Type: "TXT", TTL: 300, Content: "v=spf1 -all"},
+				},
+			},
+		},
+		DNS: gitops.DNSSettings{DisabledManagementGroups: []string{"gateways"}},
+	}
+}
+
+func exportedAccountConfig(t *testing.T, manager *DefaultAccountManager, accountID, userID string) string {
+	t.Helper()
+
+	doc, err := manager.ExportAccountConfig(context.Background(), accountID, userID)
+	require.NoError(t, err)
+
+	data, err := gitops.Marshal(doc, gitops.FormatYAML)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestApplyAccountConfig(t *testing.T) {
+	manager, accountID, userID, labels := setupAccountConfigTest(t)
+	doc := testAccountConfig(labels[0], labels[1])
+
+	initial := exportedAccountConfig(t, manager, accountID, userID)
+
+	plan, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, true)
+	require.NoError(t, err)
+	assert.True(t, plan.DryRun)
+	assert.NotEmpty(t, plan.Changes)
+	assert.Equal(t, initial, exportedAccountConfig(t, manager, accountID, userID), "dry run should not change the account")
+
+	plan, err = manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, false)
+	require.NoError(t, err)
+	assert.False(t, plan.DryRun)
+	i := findChange(plan, gitops.KindPolicy, "Default")
+	require.NotEqual(t, -1, i, "the default policy is not part of the configuration")
+	assert.Equal(t, gitops.ActionDelete, plan.Changes[i].Action)
+
+	doc.Sort()
+	expected, err := gitops.Marshal(doc, gitops.FormatYAML)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), exportedAccountConfig(t, manager, accountID, userID))
+
+	plan, err = manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, false)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Changes, "applying the same configuration again should not change the account")
+
+	t.Run("update and delete", func(t *testing.T) {
+		doc.Policies[0].Rules[0].Ports = []string{"5432", "5433"}
+		doc.Routes = nil
+		doc.NameserverGroups = nil
+		doc.DNSZones[0].Records = doc.DNSZones[0].Records[:1]
+
+		plan, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, false)
+		require.NoError(t, err)
+
+		actions := make(map[gitops.Kind]gitops.Action)
+		for _, change := range plan.Changes {
+			actions[change.Kind] = change.Action
+		}
+		assert.Equal(t, map[gitops.Kind]gitops.Action{
+			gitops.KindPolicy:          gitops.ActionUpdate,
+			gitops.KindRoute:           gitops.ActionDelete,
+			gitops.KindNameserverGroup: gitops.ActionDelete,
+			gitops.KindDNSZone:         gitops.ActionUpdate,
+		}, actions)
+
+		policies, err := manager.Store.GetAccountPolicies(context.Background(), store.LockingStrengthShare, accountID)
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+		require.Len(t, policies[0].Rules, 1)
+		assert.Equal(t, []string{"5432", "5433"}, policies[0].Rules[0].Ports)
+	})
+
+	t.Run("failed apply is rolled back", func(t *testing.T) {
+		before := exportedAccountConfig(t, manager, accountID, userID)
+
+		invalid := testAccountConfig(labels[0], labels[1])
+		invalid.Groups = append(invalid.Groups, gitops.Group{Name: "ops"})
+		invalid.Policies[0].Rules[0].Sources = []string{"unknown"}
+
+		_, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, invalid, false)
+		require.Error(t, err)
+		assert.Equal(t, before, exportedAccountConfig(t, manager, accountID, userID))
+	})
+
+	t.Run("removed group can no longer be referenced", func(t *testing.T) {
+		invalid := testAccountConfig(labels[0], labels[1])
+		invalid.Groups = invalid.Groups[:1]
+		invalid.Networks[0].Routers[0].PeerGroups = nil
+		invalid.Networks[0].Routers[0].Peer = labels[1]
+		invalid.Networks[0].Resources[0].Groups = nil
+		invalid.DNS.DisabledManagementGroups = nil
+
+		_, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, invalid, false)
+		require.NoError(t, err, "the group is no longer referenced")
+
+		invalid.Routes = []gitops.Route{{NetworkID: "office", Network: "10.10.0.0/16", Peer: labels[1], Metric: 9999, Enabled: true, Groups: []string{"gateways"}}}
+		_, err = manager.ApplyAccountConfig(context.Background(), accountID, userID, invalid, false)
+		require.Error(t, err, "the route references a group that doesn't exist")
+	})
+}
+
+func TestApplyAccountConfig_Validation(t *testing.T) {
+	manager, accountID, userID, labels := setupAccountConfigTest(t)
+
+	doc := testAccountConfig(labels[0], labels[1])
+	doc.Groups = append(doc.Groups, gitops.Group{Name: "devs"})
+	_, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, true)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.InvalidArgument, sErr.Type(), "duplicate names")
+
+	doc = testAccountConfig(labels[0], labels[1])
+	doc.Groups = append(doc.Groups, gitops.Group{Name: "All"})
+	_, err = manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, true)
+	assert.Error(t, err, "the All group can't be managed")
+
+	doc = testAccountConfig(labels[0], labels[1])
+	doc.Policies[0].Rules[0].Action = "allow"
+	_, err = manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, true)
+	assert.Error(t, err, "invalid action")
+
+	doc = testAccountConfig(labels[0], labels[1])
+	doc.Groups[0].Peers = []string{"unknown-peer"}
+	_, err = manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, true)
+	assert.Error(t, err, "unknown peer")
+
+	doc = testAccountConfig(labels[0], labels[1])
+	doc.DNSZones[0].Records[0].Type = "NS"
+	_, err = manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, true)
+	assert.Error(t, err, "unsupported record type")
+
+	_, err = manager.ApplyAccountConfig(context.Background(), accountID, "unknown-user", testAccountConfig(labels[0], labels[1]), true)
+	assert.Error(t, err, "unknown user")
+}
+
+func TestApplyAccountConfig_DeletePlan(t *testing.T) {
+	manager, accountID, userID, labels := setupAccountConfigTest(t)
+
+	_, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, testAccountConfig(labels[0], labels[1]), false)
+	require.NoError(t, err)
+	applied := exportedAccountConfig(t, manager, accountID, userID)
+
+	plan, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, &gitops.Document{}, true)
+	require.NoError(t, err)
+	assert.Equal(t, applied, exportedAccountConfig(t, manager, accountID, userID), "dry run should not change the account")
+
+	tests := []struct {
+		kind   gitops.Kind
+		name   string
+		action gitops.Action
+	}{
+		{gitops.KindGroup, "devs", gitops.ActionDelete},
+		{gitops.KindGroup, "gateways", gitops.ActionDelete},
+		{gitops.KindPostureChecks, "min-version", gitops.ActionDelete},
+		{gitops.KindPolicy, "devs-to-db", gitops.ActionDelete},
+		{gitops.KindRoute, "office@" + labels[1], gitops.ActionDelete},
+		{gitops.KindNetwork, "prod", gitops.ActionDelete},
+		{gitops.KindNetworkResource, "db", gitops.ActionDelete},
+		{gitops.KindNetworkRouter, "prod/gateways", gitops.ActionDelete},
+		{gitops.KindNameserverGroup, "google", gitops.ActionDelete},
+		{gitops.KindDNSZone, "internal.example.com", gitops.ActionDelete},
+		{gitops.KindDNSSettings, "dns", gitops.ActionUpdate},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.kind)+" "+tt.name, func(t *testing.T) {
+			i := findChange(plan, tt.kind, tt.name)
+			require.NotEqual(t, -1, i, "missing change in plan %+v", plan.Changes)
+			assert.Equal(t, tt.action, plan.Changes[i].Action)
+		})
+	}
+	assert.Len(t, plan.Changes, len(tests))
+
+	_, err = manager.ApplyAccountConfig(context.Background(), accountID, userID, &gitops.Document{}, false)
+	require.NoError(t, err)
+
+	doc, err := manager.ExportAccountConfig(context.Background(), accountID, userID)
+	require.NoError(t, err)
+	empty := &gitops.Document{}
+	empty.Groups, empty.PostureChecks, empty.Policies, empty.Routes = []gitops.Group{}, []gitops.PostureChecks{}, []gitops.Policy{}, []gitops.Route{}
+	empty.Networks, empty.NameserverGroups, empty.DNSZones = []gitops.Network{}, []gitops.NameserverGroup{}, []gitops.DNSZone{}
+	assert.Equal(t, empty, doc)
+}
+
+func TestApplyAccountConfig_InvalidDocuments(t *testing.T) {
+	manager, accountID, userID, labels := setupAccountConfigTest(t)
+	initial := exportedAccountConfig(t, manager, accountID, userID)
+
+	tests := []struct {
+		name   string
+		modify func(doc *gitops.Document)
+	}{
+		{
+			name:   "duplicate policy",
+			modify: func(doc *gitops.Document) { doc.Policies = append(doc.Policies, doc.Policies[0]) },
+		},
+		{
+			name:   "dynamic group with peers",
+			modify: func(doc *gitops.Document) { doc.Groups[0].Rule = "os == linux" },
+		},
+		{
+			name:   "invalid connection strategy",
+			modify: func(doc *gitops.Document) { doc.Groups[0].ConnectionStrategy = "sometimes" },
+		},
+		{
+			name:   "route without network identifier",
+			modify: func(doc *gitops.Document) { doc.Routes[0].NetworkID = "" },
+		},
+		{
+			name:   "route with network and domains",
+			modify: func(doc *gitops.Document) { doc.Routes[0].Domains = []string{"example.com"} },
+		},
+		{
+			name:   "route with invalid network",
+			modify: func(doc *gitops.Document) { doc.Routes[0].Network = "10.10.0.0/33" },
+		},
+		{
+			name:   "route with invalid metric",
+			modify: func(doc *gitops.Document) { doc.Routes[0].Metric = route.MaxMetric + 1 },
+		},
+		{
+			name:   "route without distribution groups",
+			modify: func(doc *gitops.Document) { doc.Routes[0].Groups = nil },
+		},
+		{
+			name:   "route with peer and peer groups",
+			modify: func(doc *gitops.Document) { doc.Routes[0].PeerGroups = []string{"gateways"} },
+		},
+		{
+			name:   "route with unknown peer",
+			modify: func(doc *gitops.Document) { doc.Routes[0].Peer = "unknown-peer" },
+		},
+		{
+			name:   "invalid protocol",
+			modify: func(doc *gitops.Document) { doc.Policies[0].Rules[0].Protocol = "sctp" },
+		},
+		{
+			name:   "unknown posture checks",
+			modify: func(doc *gitops.Document) { doc.Policies[0].SourcePostureChecks = []string{"unknown"} },
+		},
+		{
+			name:   "unknown destination resource",
+			modify: func(doc *gitops.Document) { doc.Policies[0].Rules[0].DestinationResource = "unknown" },
+		},
+		{
+			name: "invalid schedule day",
+			modify: func(doc *gitops.Document) {
+				doc.Policies[0].Rules[0].Schedule = &gitops.Schedule{Windows: []gitops.TimeWindow{{Days: []string{"someday"}, Start: "08:00", End: "18:00"}}}
+			},
+		},
+		{
+			name: "invalid posture checks",
+			modify: func(doc *gitops.Document) {
+				doc.PostureChecks[0].Checks = posture.ChecksDefinition{NBVersionCheck: &posture.NBVersionCheck{}}
+			},
+		},
+		{
+			name:   "invalid network resource address",
+			modify: func(doc *gitops.Document) { doc.Networks[0].Resources[0].Address = "not an address" },
+		},
+		{
+			name:   "network router without routing peers",
+			modify: func(doc *gitops.Document) { doc.Networks[0].Routers[0].PeerGroups = nil },
+		},
+		{
+			name:   "invalid nameserver IP",
+			modify: func(doc *gitops.Document) { doc.NameserverGroups[0].Nameservers[0].IP = "8.8.8" },
+		},
+		{
+			name:   "invalid nameserver type",
+			modify: func(doc *gitops.Document) { doc.NameserverGroups[0].Nameservers[0].NSType = "doh" },
+		},
+		{
+			name:   "nameserver group with unknown group",
+			modify: func(doc *gitops.Document) { doc.NameserverGroups[0].Groups = []string{"unknown"} },
+		},
+		{
+			name: "duplicate dns zone",
+			modify: func(doc *gitops.Document) {
+				doc.DNSZones = append(doc.DNSZones, gitops.DNSZone{Name: "Internal.example.com."})
+			},
+		},
+		{
+			name:   "dns settings with unknown group",
+			modify: func(doc *gitops.Document) { doc.DNS.DisabledManagementGroups = []string{"unknown"} },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := testAccountConfig(labels[0], labels[1])
+			tt.modify(doc)
+
+			for _, dryRun := range []bool{true, false} {
+				_, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, dryRun)
+				sErr, ok := status.FromError(err)
+				require.True(t, ok, "expected a status error, got %v", err)
+				assert.Equal(t, status.InvalidArgument, sErr.Type(), sErr.Message)
+			}
+			assert.Equal(t, initial, exportedAccountConfig(t, manager, accountID, userID), "a failed apply should not change the account")
+		})
+	}
+}
+
+func TestApplyAccountConfig_Conflicts(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, manager *DefaultAccountManager, accountID, userID string, labels []string)
+		modify       func(doc *gitops.Document)
+		expectedType status.Type
+	}{
+		{
+			name: "group issued by JWT",
+			setup: func(t *testing.T, manager *DefaultAccountManager, accountID, _ string, _ []string) {
+				require.NoError(t, manager.Store.SaveGroup(context.Background(), store.LockingStrengthUpdate,
+					&types.Group{ID: "jwt-devs", AccountID: accountID, Name: "devs", Issued: types.GroupIssuedJWT}))
+			},
+			expectedType: status.InvalidArgument,
+		},
+		{
+			name: "group name used by several groups",
+			setup: func(t *testing.T, manager *DefaultAccountManager, accountID, _ string, _ []string) {
+				for _, id := range []string{"devs-1", "devs-2"} {
+					require.NoError(t, manager.Store.SaveGroup(context.Background(), store.LockingStrengthUpdate,
+						&types.Group{ID: id, AccountID: accountID, Name: "devs", Issued: types.GroupIssuedAPI}))
+				}
+			},
+			expectedType: status.PreconditionFailed,
+		},
+		{
+			name: "network name used by several networks",
+			setup: func(t *testing.T, manager *DefaultAccountManager, accountID, _ string, _ []string) {
+				for i := 0; i < 2; i++ {
+					require.NoError(t, manager.Store.SaveNetwork(context.Background(), store.LockingStrengthUpdate, networkTypes.NewNetwork(accountID, "prod", "")))
+				}
+			},
+			expectedType: status.PreconditionFailed,
+		},
+		{
+			name: "route defined more than once",
+			setup: func(t *testing.T, manager *DefaultAccountManager, accountID, _ string, labels []string) {
+				peerID := accountConfigPeerID(t, manager, accountID, labels[1])
+				for i, network := range []string{"10.10.0.0/16", "10.11.0.0/16"} {
+					require.NoError(t, manager.Store.SaveRoute(context.Background(), store.LockingStrengthUpdate, &route.Route{
+						ID:          route.ID("office-" + string(rune('a'+i))),
+						AccountID:   accountID,
+						NetID:       "office",
+						Network:     netip.MustParsePrefix(network),
+						NetworkType: route.IPv4Network,
+						Peer:        peerID,
+						Metric:      9999,
+						Enabled:     true,
+					}))
+				}
+			},
+			expectedType: status.PreconditionFailed,
+		},
+		{
+			name: "overlapping routes of the same peer group",
+			modify: func(doc *gitops.Document) {
+				doc.Routes[0].Peer = ""
+				doc.Routes[0].PeerGroups = []string{"gateways"}
+				overlapping := doc.Routes[0]
+				overlapping.NetworkID = "office-copy"
+				doc.Routes = append(doc.Routes, overlapping)
+			},
+			expectedType: status.InvalidArgument,
+		},
+		{
+			name: "deleted group still used by a setup key",
+			setup: func(t *testing.T, manager *DefaultAccountManager, accountID, userID string, labels []string) {
+				_, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, testAccountConfig(labels[0], labels[1]), false)
+				require.NoError(t, err)
+
+				group, err := manager.GetGroupByName(context.Background(), "gateways", accountID)
+				require.NoError(t, err)
+				_, err = manager.CreateSetupKey(context.Background(), accountID, "gateways", types.SetupKeyReusable, time.Hour, []string{group.ID}, 0, userID, false, false)
+				require.NoError(t, err)
+			},
+			modify: func(doc *gitops.Document) {
+				doc.Groups = doc.Groups[:1]
+				doc.Networks[0].Resources[0].Groups = nil
+				doc.Networks[0].Routers[0].PeerGroups = nil
+				doc.Networks[0].Routers[0].Peer = doc.Routes[0].Peer
+				doc.DNS.DisabledManagementGroups = nil
+			},
+			expectedType: status.PreconditionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, accountID, userID, labels := setupAccountConfigTest(t)
+			if tt.setup != nil {
+				tt.setup(t, manager, accountID, userID, labels)
+			}
+			before, err := manager.Store.GetAccount(context.Background(), accountID)
+			require.NoError(t, err)
+
+			doc := testAccountConfig(labels[0], labels[1])
+			if tt.modify != nil {
+				tt.modify(doc)
+			}
+
+			for _, dryRun := range []bool{true, false} {
+				_, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, dryRun)
+				sErr, ok := status.FromError(err)
+				require.True(t, ok, "expected a status error, got %v", err)
+				assert.Equal(t, tt.expectedType, sErr.Type(), sErr.Message)
+			}
+
+			after, err := manager.Store.GetAccount(context.Background(), accountID)
+			require.NoError(t, err)
+			assert.Equal(t, before, after, "a failed apply should not change the account")
+		})
+	}
+}
+
+func TestApplyAccountConfig_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(doc *gitops.Document, labels []string)
+	}{
+		{
+			name: "routes",
+			modify: func(doc *gitops.Document, labels []string) {
+				doc.Routes = []gitops.Route{
+					{NetworkID: "office", Description: "office network", Network: "10.10.0.0/16", Peer: labels[1], Masquerade: true, Metric: 100, Enabled: true, Groups: []string{"devs"}, AccessControlGroups: []string{"devs"}},
+					{NetworkID: "saas", Domains: []string{"example.com", "*.example.org"}, KeepRoute: true, PeerGroups: []string{"gateways"}, Metric: 9999, Enabled: true, Groups: []string{"devs", "gateways"}},
+					{NetworkID: "lab", Network: "192.168.0.0/24", PeerGroups: []string{"gateways"}, Metric: 10, Groups: []string{"devs"}},
+				}
+			},
+		},
+		{
+			name: "dns",
+			modify: func(doc *gitops.Document, _ []string) {
+				doc.NameserverGroups = []gitops.NameserverGroup{
+					{Name: "google", Nameservers: []gitops.Nameserver{{IP: "8.8.8.8", NSType: "udp", Port: 53}, {IP: "8.8.4.4", NSType: "udp", Port: 53}}, Groups: []string{"devs"}, Primary: true, Enabled: true},
+					{Name: "corp", Description: "corporate resolvers", Nameservers: []gitops.Nameserver{{IP: "10.0.0.53", NSType: "udp", Port: 5353}}, Groups: []string{"devs", "gateways"}, Domains: []string{"corp.example.com"}, SearchDomainsEnabled: true},
+				}
+				doc.DNSZones = []gitops.DNSZone{
+					{
+						Name:                 "internal.example.com",
+						Description:          "internal services",
+						Enabled:              true,
+						SearchDomainsEnabled: true,
+						Groups:               []string{"devs", "gateways"},
+						Records: []gitops.DNSRecord{
+							{Name: "db.internal.example.com", Type: "A", TTL: 300, Content: "10.20.0.10"},
+							{Name: "db6.internal.example.com", Type: "AAAA", TTL: 300, Content: "fd00::10"},
+							{Name: "www.internal.example.com", Type: "CNAME", TTL: 60, Content: "db.internal.example.com."},
+						},
+					},
+				}
+				doc.DNS.DisabledManagementGroups = []string{"devs", "gateways"}
+			},
+		},
+		{
+			name: "posture checks",
+			modify: func(doc *gitops.Document, _ []string) {
+				doc.PostureChecks = []gitops.PostureChecks{
+					{Name: "min-version", Checks: posture.ChecksDefinition{NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.25.0"}}},
+					{
+						Name:        "hardened",
+						Description: "corporate devices only",
+						Checks: posture.ChecksDefinition{
+							OSVersionCheck:        &posture.OSVersionCheck{Linux: &posture.MinKernelVersionCheck{MinKernelVersion: "5.10.0"}, Darwin: &posture.MinVersionCheck{MinVersion: "14.0"}},
+							GeoLocationCheck:      &posture.GeoLocationCheck{Locations: []posture.Location{{CountryCode: "DE", CityName: "Berlin"}}, Action: posture.CheckActionAllow},
+							PeerNetworkRangeCheck: &posture.PeerNetworkRangeCheck{Ranges: []netip.Prefix{netip.MustParsePrefix("192.168.1.0/24")}, Action: posture.CheckActionDeny},
+							ProcessCheck:          &posture.ProcessCheck{Processes: []posture.Process{{LinuxPath: "/usr/bin/agent", WindowsPath: "C:\\agent.exe"}}},
+						},
+					},
+				}
+				doc.Policies[0].SourcePostureChecks = []string{"hardened", "min-version"}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, accountID, userID, labels := setupAccountConfigTest(t)
+			doc := testAccountConfig(labels[0], labels[1])
+			tt.modify(doc, labels)
+
+			_, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, doc, false)
+			require.NoError(t, err)
+
+			doc.Sort()
+			expected, err := gitops.Marshal(doc, gitops.FormatYAML)
+			require.NoError(t, err)
+			exported := exportedAccountConfig(t, manager, accountID, userID)
+			assert.Equal(t, string(expected), exported)
+
+			reimported, err := gitops.Unmarshal([]byte(exported), gitops.FormatYAML)
+			require.NoError(t, err)
+			plan, err := manager.ApplyAccountConfig(context.Background(), accountID, userID, reimported, false)
+			require.NoError(t, err)
+			assert.Empty(t, plan.Changes, "applying the exported configuration should not change the account")
+		})
+	}
+}
+
+func accountConfigPeerID(t *testing.T, manager *DefaultAccountManager, accountID, label string) string {
+	t.Helper()
+
+	peers, err := manager.Store.GetAccountPeers(context.Background(), store.LockingStrengthShare, accountID)
+	require.NoError(t, err)
+	for _, peer := range peers {
+		if peer.DNSLabel == label {
+			return peer.ID
+		}
+	}
+	t.Fatalf("peer %s not found", label)
+	return ""
+}
+
+func findChange(plan *gitops.Plan, kind gitops.Kind, name string) int {
+	for i, change := range plan.Changes {
+		if change.Kind == kind && change.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -169,6 +169,39 @@ const (
 
 	ResourceAddedToGroup     Activity = 82
 	ResourceRemovedFromGroup Activity = 83
+
+	CustomRoleCreated Activity = 84
+	CustomRoleUpdated Activity = 85
+	CustomRoleDeleted Activity = 86
+
+	UserCustomRoleUpdated Activity = 87
+
+	DNSZoneCreated Activity = 88
+	DNSZoneUpdated Activity = 89
+	DNSZoneDeleted Activity = 90
+
+	PeerSSHFeaturesUpdated Activity = 91
+	PeerSSHLoginAllowed    Activity = 92
+	PeerSSHLoginDenied     Activity = 93
+	PeerSSHSessionRecorded Activity = 94
+
+	AccountIPv6Enabled  Activity = 95
+	AccountIPv6Disabled Activity = 96
+
+	// PeerApprovalPending indicates that a newly registered peer waits for the approval of an administrator
+	PeerApprovalPending Activity = 97
+	// PeerApprovalRejected indicates that the user rejected a pending peer and the peer has been removed
+	PeerApprovalRejected Activity = 98
+	// PeerApprovalExpired indicates that a pending peer hasn't been approved in time and has been removed
+	PeerApprovalExpired Activity = 99
+	// AccountPeerApprovalSettingsUpdated indicates that the user updated the peer approval scope or timeout
+	AccountPeerApprovalSettingsUpdated Activity = 100
+	// EventSinkCreated indicates that the user created an activity event sink of the account
+	EventSinkCreated Activity = 101
+	// EventSinkUpdated indicates that the user updated an activity event sink of the account
+	EventSinkUpdated Activity = 102
+	// EventSinkDeleted indicates that the user deleted an activity event sink of the account
+	EventSinkDeleted Activity = 103
 )
 
 var activityMap = map[Activity]Code{
@@ -264,6 +297,33 @@ var activityMap = map[Activity]Code{
 
 	ResourceAddedToGroup:     {"Resource added to group", "resource.group.add"},
 	ResourceRemovedFromGroup: {"Resource removed from group", "resource.group.delete"},
+
+	CustomRoleCreated: {"Custom role created", "role.create"},
+	CustomRoleUpdated: {"Custom role updated", "role.update"},
+	CustomRoleDeleted: {"Custom role deleted", "role.delete"},
+
+	UserCustomRoleUpdated: {"User custom role updated", "user.custom_role.update"},
+
+	DNSZoneCreated: {"DNS zone created", "dns.zone.create"},
+	DNSZoneUpdated: {"DNS zone updated", "dns.zone.update"},
+	DNSZoneDeleted: {"DNS zone deleted", "dns.zone.delete"},
+
+	PeerSSHFeaturesUpdated: {"Peer SSH server features updated", "peer.ssh.features.update"},
+	PeerSSHLoginAllowed:    {"Peer SSH login allowed", "peer.ssh.login.allow"},
+	PeerSSHLoginDenied:     {"Peer SSH login denied", "peer.ssh.login.deny"},
+	PeerSSHSessionRecorded: {"Peer SSH session recorded", "peer.ssh.session.record"},
+
+	AccountIPv6Enabled:  {"Account IPv6 overlay addressing enabled", "account.setting.ipv6.enable"},
+	AccountIPv6Disabled: {"Account IPv6 overlay addressing disabled", "account.setting.ipv6.disable"},
+
+	PeerApprovalPending:                {"Peer pending approval", "peer.approval.pending"},
+	PeerApprovalRejected:               {"Peer approval rejected", "peer.approval.reject"},
+	PeerApprovalExpired:                {"Peer approval expired", "peer.approval.expire"},
+	AccountPeerApprovalSettingsUpdated: {"Account peer approval settings updated", "account.setting.peer.approval.update"},
+
+	EventSinkCreated: {"Event sink created", "event.sink.add"},
+	EventSinkUpdated: {"Event sink updated", "event.sink.update"},
+	EventSinkDeleted: {"Event sink deleted", "event.sink.delete"},
 }
 
 // StringCode returns a string code of the activity
@@ -286,3 +346,13 @@ func (a Activity) Message() string {
 func RegisterActivityMap(codes map[Activity]Code) {
 	maps.Copy(activityMap, codes)
 }
+
+// ActivityFromStringCode returns the activity with the given string code
+func ActivityFromStringCode(code string) (Activity, bool) {
+	for activity, c := range activityMap {
+		if c.Code == code {
+			return activity, true
+		}
+	}
+	return 0, false
+}
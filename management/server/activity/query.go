@@ -0,0 +1,123 @@
+package activity
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultQueryLimit is the number of events returned by a query without an explicit limit
+	DefaultQueryLimit = 100
+	// MaxQueryLimit is the maximum number of events that can be returned by a single query
+	MaxQueryLimit = 10000
+)
+
+// Query describes a filtered and cursor paginated lookup of the events of an account.
+// Zero values of the filter fields are ignored.
+type Query struct {
+	// Activities limits the result to the events of the given activity types
+	Activities []Activity
+	// InitiatorID limits the result to the events initiated by the given object
+	InitiatorID string
+	// TargetID limits the result to the events affecting the given object
+	TargetID string
+	// From limits the result to events that happened at or after the given time
+	From time.Time
+	// To limits the result to events that happened before the given time
+	To time.Time
+	// Search limits the result to events with a meta value containing the given text. Meta keys are not searched.
+	Search string
+	// Cursor is the ID of the last event of the previous page. Events after the cursor in the requested order are returned
+	Cursor uint64
+	// Limit is the maximum number of returned events
+	Limit int
+	// Descending orders the events from the newest to the oldest
+	Descending bool
+}
+
+// Validate checks the query fields and applies the default limit
+func (q *Query) Validate() error {
+	if q.Limit < 0 {
+		return fmt.Errorf("limit must not be negative")
+	}
+
+	if q.Limit == 0 {
+		q.Limit = DefaultQueryLimit
+	}
+
+	if q.Limit > MaxQueryLimit {
+		return fmt.Errorf("limit must not exceed %d", MaxQueryLimit)
+	}
+
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return fmt.Errorf("start of the time range must be before its end")
+	}
+
+	return nil
+}
+
+// Matches returns true if the event satisfies the query filters. The cursor is not taken into account.
+func (q *Query) Matches(event *Event) bool {
+	if len(q.Activities) > 0 && !slices.ContainsFunc(q.Activities, func(a Activity) bool {
+		return a.StringCode() == event.Activity.StringCode()
+	}) {
+		return false
+	}
+
+	if q.InitiatorID != "" && q.InitiatorID != event.InitiatorID {
+		return false
+	}
+
+	if q.TargetID != "" && q.TargetID != event.TargetID {
+		return false
+	}
+
+	if !q.From.IsZero() && event.Timestamp.Before(q.From) {
+		return false
+	}
+
+	if !q.To.IsZero() && !event.Timestamp.Before(q.To) {
+		return false
+	}
+
+	if q.Search == "" {
+		return true
+	}
+
+	return metaValueContains(event.Meta, strings.ToLower(q.Search))
+}
+
+// metaValueContains returns true if one of the scalar values nested in the meta value contains the lowercase search text
+func metaValueContains(value any, search string) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case map[string]any:
+		for _, item := range v {
+			if metaValueContains(item, search) {
+				return true
+			}
+		}
+		return false
+	case []any:
+		for _, item := range v {
+			if metaValueContains(item, search) {
+				return true
+			}
+		}
+		return false
+	default:
+		return strings.Contains(strings.ToLower(fmt.Sprint(v)), search)
+	}
+}
+
+// NextCursor returns the cursor of the page following the given events. Zero is returned when the events
+// don't fill a page and there is nothing left to fetch.
+func (q *Query) NextCursor(events []*Event) uint64 {
+	if len(events) == 0 || len(events) < q.Limit {
+		return 0
+	}
+	return events[len(events)-1].ID
+}
@@ -0,0 +1,179 @@
+package sink
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/netbirdio/netbird/util"
+)
+
+// Type of the sink destination
+type Type string
+
+const (
+	// TypeSyslog forwards events to a syslog server in the RFC 5424 format
+	TypeSyslog Type = "syslog"
+	// TypeWebhook posts events to an HTTP endpoint signing the body with HMAC-SHA256
+	TypeWebhook Type = "webhook"
+	// TypeFile appends events as line-delimited JSON to a local file
+	TypeFile Type = "file"
+)
+
+const (
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 5 * time.Minute
+	defaultMaxQueueSize   = 100000
+	defaultQueueDir       = "event-sinks"
+)
+
+// Config of the event streaming subsystem
+type Config struct {
+	// QueueDir is the directory holding the durable queues of the sinks. Relative paths are resolved against the
+	// management data directory
+	QueueDir string
+	// Destinations is the list of the configured sinks
+	Destinations []*DestinationConfig
+}
+
+// DestinationConfig of a single event sink
+type DestinationConfig struct {
+	// Name identifies the sink and its queue, it must be unique
+	Name string
+	// Type of the destination
+	Type Type
+	// AccountIDs limits the sink to the events of the given accounts. Events of all accounts are forwarded when empty
+	AccountIDs []string
+	// Activities limits the sink to the events with the given activity codes, e.g. peer.approval.pending to get
+	// notified about the peers waiting for approval. Events of all activities are forwarded when empty
+	Activities []string
+	// Retry configures the delivery retries of failed events
+	Retry RetryConfig
+	// MaxQueueSize is the maximum number of undelivered events kept on disk. The oldest events are dropped once the
+	// limit is reached
+	MaxQueueSize int
+
+	Syslog  *SyslogConfig
+	Webhook *WebhookConfig
+	File    *FileConfig
+}
+
+// RetryConfig of the delivery backoff
+type RetryConfig struct {
+	// InitialBackoff is the delay before the first retry, it doubles with every failed attempt
+	InitialBackoff util.Duration
+	// MaxBackoff caps the delay between retries
+	MaxBackoff util.Duration
+	// MaxAttempts is the number of delivery attempts before an event is dropped. Events are retried forever when zero
+	MaxAttempts int
+}
+
+// SyslogConfig of a syslog destination
+type SyslogConfig struct {
+	// Network is one of udp, tcp or tcp+tls
+	Network string
+	// Address of the syslog server in the host:port format
+	Address string
+	// AppName reported in the syslog messages, defaults to netbird
+	AppName string
+	// Facility code of the messages, defaults to 13 (log audit)
+	Facility *int
+}
+
+// WebhookConfig of an HTTP destination
+type WebhookConfig struct {
+	// URL the events are posted to
+	URL string
+	// Secret used to sign the request body with HMAC-SHA256
+	Secret string
+	// Headers added to every request
+	Headers map[string]string
+	// Timeout of a single request, defaults to 10 seconds
+	Timeout util.Duration
+}
+
+// FileConfig of a file destination
+type FileConfig struct {
+	// Path of the file the events are appended to
+	Path string
+}
+
+// Validate checks the destinations configuration
+func (c *Config) Validate() error {
+	names := make(map[string]struct{}, len(c.Destinations))
+	for _, d := range c.Destinations {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("event sink %q: %w", d.Name, err)
+		}
+		if _, ok := names[d.Name]; ok {
+			return fmt.Errorf("duplicate event sink name %q", d.Name)
+		}
+		names[d.Name] = struct{}{}
+	}
+	return nil
+}
+
+// Validate checks the destination configuration
+func (d *DestinationConfig) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if strings.ContainsAny(d.Name, `/\`) || d.Name == "." || d.Name == ".." {
+		return fmt.Errorf("name must be usable as a directory name")
+	}
+
+	if d.MaxQueueSize < 0 || d.Retry.MaxAttempts < 0 {
+		return fmt.Errorf("queue size and retry attempts must not be negative")
+	}
+
+	switch d.Type {
+	case TypeSyslog:
+		if d.Syslog == nil || d.Syslog.Address == "" {
+			return fmt.Errorf("syslog address is required")
+		}
+		if !slices.Contains([]string{"", "udp", "tcp", "tcp+tls"}, d.Syslog.Network) {
+			return fmt.Errorf("unsupported syslog network %q", d.Syslog.Network)
+		}
+		if d.Syslog.Facility != nil && (*d.Syslog.Facility < 0 || *d.Syslog.Facility > 23) {
+			return fmt.Errorf("syslog facility must be between 0 and 23")
+		}
+	case TypeWebhook:
+		if d.Webhook == nil || d.Webhook.URL == "" {
+			return fmt.Errorf("webhook URL is required")
+		}
+	case TypeFile:
+		if d.File == nil || d.File.Path == "" {
+			return fmt.Errorf("file path is required")
+		}
+	default:
+		return fmt.Errorf("unsupported type %q", d.Type)
+	}
+
+	return nil
+}
+
+// matchesAccount returns true if the events of the account are forwarded to the destination
+func (d *DestinationConfig) matchesAccount(accountID string) bool {
+	return len(d.AccountIDs) == 0 || slices.Contains(d.AccountIDs, accountID)
+}
+
+// matchesActivity returns true if the events with the activity code are forwarded to the destination
+func (d *DestinationConfig) matchesActivity(code string) bool {
+	return len(d.Activities) == 0 || slices.Contains(d.Activities, code)
+}
+
+func (r RetryConfig) initialBackoff() time.Duration {
+	if r.InitialBackoff.Duration <= 0 {
+		return defaultInitialBackoff
+	}
+	return r.InitialBackoff.Duration
+}
+
+func (r RetryConfig) maxBackoff() time.Duration {
+	if r.MaxBackoff.Duration <= 0 {
+		return defaultMaxBackoff
+	}
+	return r.MaxBackoff.Duration
+}
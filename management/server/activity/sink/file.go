@@ -0,0 +1,53 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// fileSink appends events to a file as line-delimited JSON
+type fileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func newFileSink(config *FileConfig) (*fileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(config.Path), 0750); err != nil {
+		return nil, fmt.Errorf("create event file directory: %w", err)
+	}
+
+	file, err := os.OpenFile(config.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, fmt.Errorf("open event file: %w", err)
+	}
+
+	return &fileSink{file: file}, nil
+}
+
+// Send appends the JSON encoded event followed by a new line to the file
+func (s *fileSink) Send(_ context.Context, event *Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err = s.file.Write(line); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+	return s.file.Sync()
+}
+
+// Close closes the file
+func (s *fileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
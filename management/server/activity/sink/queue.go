@@ -0,0 +1,160 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const queueFileExt = ".json"
+
+// queue is a durable FIFO of events persisting every item as a separate file named after its sequence number.
+// Items are written to a temporary file first and renamed so that a crash never leaves a partial item behind
+type queue struct {
+	dir     string
+	maxSize int
+
+	mu      sync.Mutex
+	items   []uint64
+	nextSeq uint64
+	// notify is signaled when an item is added to the queue
+	notify chan struct{}
+}
+
+// openQueue opens the queue in the given directory restoring items left by a previous run
+func openQueue(dir string, maxSize int) (*queue, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("create queue directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read queue directory: %w", err)
+	}
+
+	q := &queue{
+		dir:     dir,
+		maxSize: maxSize,
+		notify:  make(chan struct{}, 1),
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, queueFileExt) {
+			// leftovers of interrupted writes
+			if strings.HasSuffix(name, ".tmp") {
+				_ = os.Remove(filepath.Join(dir, name))
+			}
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, queueFileExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		q.items = append(q.items, seq)
+		if seq >= q.nextSeq {
+			q.nextSeq = seq + 1
+		}
+	}
+
+	sort.Slice(q.items, func(i, j int) bool { return q.items[i] < q.items[j] })
+
+	return q, nil
+}
+
+// push persists the event at the end of the queue, dropping the oldest items if the queue is full.
+// It returns the number of dropped items
+func (q *queue) push(event *Event) (int, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return 0, fmt.Errorf("marshal event: %w", err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	seq := q.nextSeq
+	tmp := filepath.Join(q.dir, fmt.Sprintf("%020d.tmp", seq))
+	if err = os.WriteFile(tmp, data, 0640); err != nil {
+		return 0, fmt.Errorf("write queue item: %w", err)
+	}
+	if err = os.Rename(tmp, q.path(seq)); err != nil {
+		_ = os.Remove(tmp)
+		return 0, fmt.Errorf("commit queue item: %w", err)
+	}
+
+	q.nextSeq++
+	q.items = append(q.items, seq)
+
+	dropped := 0
+	for q.maxSize > 0 && len(q.items) > q.maxSize {
+		_ = os.Remove(q.path(q.items[0]))
+		q.items = q.items[1:]
+		dropped++
+	}
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+
+	return dropped, nil
+}
+
+// peek returns the oldest event of the queue and its sequence number. A nil event is returned for an empty queue
+func (q *queue) peek() (uint64, *Event, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.items) > 0 {
+		seq := q.items[0]
+		data, err := os.ReadFile(q.path(seq))
+		if err != nil {
+			return 0, nil, fmt.Errorf("read queue item: %w", err)
+		}
+
+		event := &Event{}
+		if err = json.Unmarshal(data, event); err != nil {
+			// corrupted items can never be delivered
+			_ = os.Remove(q.path(seq))
+			q.items = q.items[1:]
+			continue
+		}
+		return seq, event, nil
+	}
+
+	return 0, nil, nil
+}
+
+// remove deletes the item with the given sequence number if it is still the head of the queue
+func (q *queue) remove(seq uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.items) == 0 || q.items[0] != seq {
+		return nil
+	}
+
+	if err := os.Remove(q.path(seq)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove queue item: %w", err)
+	}
+	q.items = q.items[1:]
+	return nil
+}
+
+// len returns the number of queued items
+func (q *queue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
+
+func (q *queue) path(seq uint64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", seq, queueFileExt))
+}
@@ -0,0 +1,66 @@
+package sink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue_PersistsItems(t *testing.T) {
+	dir := t.TempDir()
+
+	q, err := openQueue(dir, 0)
+	require.NoError(t, err)
+
+	for i := uint64(1); i <= 3; i++ {
+		_, err = q.push(&Event{ID: i})
+		require.NoError(t, err)
+	}
+
+	seq, event, err := q.peek()
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.Equal(t, uint64(1), event.ID)
+	require.NoError(t, q.remove(seq))
+
+	// reopening the queue restores the undelivered items in order
+	q, err = openQueue(dir, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, q.len())
+
+	_, event, err = q.peek()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), event.ID)
+
+	_, err = q.push(&Event{ID: 4})
+	require.NoError(t, err)
+	assert.Equal(t, 3, q.len())
+}
+
+func TestQueue_DropsOldestItems(t *testing.T) {
+	q, err := openQueue(t.TempDir(), 2)
+	require.NoError(t, err)
+
+	for i := uint64(1); i <= 3; i++ {
+		dropped, err := q.push(&Event{ID: i})
+		require.NoError(t, err)
+		if i == 3 {
+			assert.Equal(t, 1, dropped)
+		}
+	}
+
+	_, event, err := q.peek()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), event.ID)
+	assert.Equal(t, 2, q.len())
+}
+
+func TestQueue_Empty(t *testing.T) {
+	q, err := openQueue(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	_, event, err := q.peek()
+	require.NoError(t, err)
+	assert.Nil(t, event)
+}
@@ -0,0 +1,61 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+)
+
+// Sink delivers activity events to an external destination
+type Sink interface {
+	// Send delivers a single event. An error means the event should be retried
+	Send(ctx context.Context, event *Event) error
+	// Close releases the resources of the sink
+	Close() error
+}
+
+// Event is the representation of an activity event forwarded to the sinks
+type Event struct {
+	ID             uint64         `json:"id"`
+	Timestamp      time.Time      `json:"timestamp"`
+	Activity       string         `json:"activity"`
+	ActivityCode   string         `json:"activity_code"`
+	InitiatorID    string         `json:"initiator_id"`
+	InitiatorName  string         `json:"initiator_name,omitempty"`
+	InitiatorEmail string         `json:"initiator_email,omitempty"`
+	TargetID       string         `json:"target_id"`
+	AccountID      string         `json:"account_id"`
+	Meta           map[string]any `json:"meta,omitempty"`
+}
+
+// newEvent converts an activity event to its forwarded representation
+func newEvent(event *activity.Event) *Event {
+	return &Event{
+		ID:             event.ID,
+		Timestamp:      event.Timestamp.UTC(),
+		Activity:       event.Activity.Message(),
+		ActivityCode:   event.Activity.StringCode(),
+		InitiatorID:    event.InitiatorID,
+		InitiatorName:  event.InitiatorName,
+		InitiatorEmail: event.InitiatorEmail,
+		TargetID:       event.TargetID,
+		AccountID:      event.AccountID,
+		Meta:           event.Meta,
+	}
+}
+
+// newSink creates the sink of the destination
+func newSink(config *DestinationConfig) (Sink, error) {
+	switch config.Type {
+	case TypeSyslog:
+		return newSyslogSink(config.Syslog), nil
+	case TypeWebhook:
+		return newWebhookSink(config.Webhook), nil
+	case TypeFile:
+		return newFileSink(config.File)
+	default:
+		return nil, fmt.Errorf("unsupported event sink type %q", config.Type)
+	}
+}
@@ -0,0 +1,325 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+)
+
+const (
+	sendTimeout = 30 * time.Second
+
+	// accountQueueDir is the subdirectory of the queue directory holding the queues of the account managed sinks
+	accountQueueDir = "accounts"
+)
+
+// Store wraps an activity.Store and forwards every saved event to the configured sinks. Events are persisted in a
+// durable queue per sink first and delivered in the background, so that they survive restarts and sink outages.
+// Besides the sinks of the management configuration, accounts can manage their own sinks at runtime
+type Store struct {
+	activity.Store
+
+	queueDir  string
+	workerCtx context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+
+	mu             sync.RWMutex
+	workers        []*worker
+	accountWorkers map[string][]*worker
+}
+
+// worker delivers the queued events of a single destination
+type worker struct {
+	config *DestinationConfig
+	sink   Sink
+	queue  *queue
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewStore creates a Store forwarding the events saved in the given store to the destinations of the config.
+// Relative queue directories are resolved against dataDir. The config may be nil if only account managed sinks
+// are used
+func NewStore(ctx context.Context, store activity.Store, config *Config, dataDir string) (*Store, error) {
+	if config == nil {
+		config = &Config{}
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	queueDir := config.QueueDir
+	if queueDir == "" {
+		queueDir = defaultQueueDir
+	}
+	if !filepath.IsAbs(queueDir) {
+		queueDir = filepath.Join(dataDir, queueDir)
+	}
+
+	s := &Store{
+		Store:          store,
+		queueDir:       queueDir,
+		accountWorkers: make(map[string][]*worker),
+	}
+	for _, dest := range config.Destinations {
+		if dest.Name == accountQueueDir {
+			s.closeWorkers(ctx, s.workers)
+			return nil, fmt.Errorf("event sink name %q is reserved", dest.Name)
+		}
+
+		w, err := newWorker(dest, filepath.Join(queueDir, dest.Name))
+		if err != nil {
+			s.closeWorkers(ctx, s.workers)
+			return nil, fmt.Errorf("event sink %q: %w", dest.Name, err)
+		}
+		s.workers = append(s.workers, w)
+	}
+
+	s.workerCtx, s.cancel = context.WithCancel(ctx)
+	for _, w := range s.workers {
+		s.startWorker(w)
+		log.WithContext(ctx).Infof("streaming activity events to %s sink %s, %d events pending", w.config.Type, w.config.Name, w.queue.len())
+	}
+
+	return s, nil
+}
+
+func newWorker(config *DestinationConfig, queueDir string) (*worker, error) {
+	maxQueueSize := config.MaxQueueSize
+	if maxQueueSize == 0 {
+		maxQueueSize = defaultMaxQueueSize
+	}
+
+	q, err := openQueue(queueDir, maxQueueSize)
+	if err != nil {
+		return nil, err
+	}
+
+	sink, err := newSink(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &worker{config: config, sink: sink, queue: q, done: make(chan struct{})}, nil
+}
+
+// startWorker runs the delivery of the worker until it is stopped or the store is closed
+func (s *Store) startWorker(w *worker) {
+	var ctx context.Context
+	ctx, w.cancel = context.WithCancel(s.workerCtx)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(w.done)
+		w.run(ctx)
+	}()
+}
+
+// SetAccountDestinations replaces the sinks managed by the account with the given destinations. The events are
+// limited to the account regardless of the destinations account filter. Queues of the removed destinations are
+// deleted together with their undelivered events
+func (s *Store) SetAccountDestinations(ctx context.Context, accountID string, destinations []*DestinationConfig) error {
+	for _, dest := range destinations {
+		if err := dest.Validate(); err != nil {
+			return fmt.Errorf("event sink %q: %w", dest.Name, err)
+		}
+		dest.AccountIDs = []string{accountID}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// the workers are stopped first as the new workers reuse the queues of the destinations that are kept
+	previous := s.accountWorkers[accountID]
+	s.stopWorkers(ctx, previous)
+	delete(s.accountWorkers, accountID)
+
+	accountDir := filepath.Join(s.queueDir, accountQueueDir, accountID)
+	kept := make(map[string]struct{}, len(destinations))
+	var workers []*worker
+	for _, dest := range destinations {
+		w, err := newWorker(dest, filepath.Join(accountDir, dest.Name))
+		if err != nil {
+			s.closeWorkers(ctx, workers)
+			return fmt.Errorf("event sink %q: %w", dest.Name, err)
+		}
+		workers = append(workers, w)
+		kept[dest.Name] = struct{}{}
+	}
+
+	for _, w := range previous {
+		if _, ok := kept[w.config.Name]; ok {
+			continue
+		}
+		if err := os.RemoveAll(w.queue.dir); err != nil {
+			log.WithContext(ctx).Warnf("failed to remove the queue of the deleted event sink %s: %v", w.config.Name, err)
+		}
+	}
+
+	if len(workers) == 0 {
+		return nil
+	}
+
+	for _, w := range workers {
+		s.startWorker(w)
+	}
+	s.accountWorkers[accountID] = workers
+	log.WithContext(ctx).Debugf("streaming activity events of account %s to %d sinks", accountID, len(workers))
+
+	return nil
+}
+
+// Save stores the event in the underlying store and queues it for the sinks matching the event account and activity
+func (s *Store) Save(ctx context.Context, event *activity.Event) (*activity.Event, error) {
+	saved, err := s.Store.Save(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	forwarded := newEvent(saved)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	workers := append(slices.Clip(s.workers), s.accountWorkers[saved.AccountID]...)
+	for _, w := range workers {
+		if !w.config.matchesAccount(saved.AccountID) || !w.config.matchesActivity(forwarded.ActivityCode) {
+			continue
+		}
+
+		dropped, err := w.queue.push(forwarded)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to queue activity event %d for sink %s: %v", saved.ID, w.config.Name, err)
+			continue
+		}
+		if dropped > 0 {
+			log.WithContext(ctx).Warnf("event queue of sink %s is full, dropped %d oldest events", w.config.Name, dropped)
+		}
+	}
+
+	return saved, nil
+}
+
+// Close stops the delivery workers and closes the sinks and the underlying store. Undelivered events stay queued
+// on disk and are delivered after the next start
+func (s *Store) Close(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+
+	s.mu.Lock()
+	s.closeWorkers(ctx, s.workers)
+	for _, workers := range s.accountWorkers {
+		s.closeWorkers(ctx, workers)
+	}
+	s.mu.Unlock()
+
+	return s.Store.Close(ctx)
+}
+
+// stopWorkers stops the delivery of the running workers and closes their sinks
+func (s *Store) stopWorkers(ctx context.Context, workers []*worker) {
+	for _, w := range workers {
+		w.cancel()
+		<-w.done
+	}
+	s.closeWorkers(ctx, workers)
+}
+
+func (s *Store) closeWorkers(ctx context.Context, workers []*worker) {
+	for _, w := range workers {
+		if err := w.sink.Close(); err != nil {
+			log.WithContext(ctx).Warnf("failed to close event sink %s: %v", w.config.Name, err)
+		}
+	}
+}
+
+// run delivers the queued events in order until the context is canceled. Failed deliveries are retried with an
+// exponential backoff
+func (w *worker) run(ctx context.Context) {
+	attempt := 0
+	for {
+		seq, event, err := w.queue.peek()
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to read event queue of sink %s: %v", w.config.Name, err)
+			if !sleep(ctx, w.config.Retry.maxBackoff()) {
+				return
+			}
+			continue
+		}
+
+		if event == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.queue.notify:
+			}
+			continue
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		err = w.sink.Send(sendCtx, event)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err == nil {
+			attempt = 0
+			if err = w.queue.remove(seq); err != nil {
+				log.WithContext(ctx).Errorf("failed to remove delivered event %d from the queue of sink %s: %v", event.ID, w.config.Name, err)
+			}
+			continue
+		}
+
+		attempt++
+		if w.config.Retry.MaxAttempts > 0 && attempt >= w.config.Retry.MaxAttempts {
+			log.WithContext(ctx).Errorf("dropping activity event %d after %d failed deliveries to sink %s: %v", event.ID, attempt, w.config.Name, err)
+			attempt = 0
+			if err = w.queue.remove(seq); err != nil {
+				log.WithContext(ctx).Errorf("failed to remove event %d from the queue of sink %s: %v", event.ID, w.config.Name, err)
+			}
+			continue
+		}
+
+		delay := w.backoff(attempt)
+		log.WithContext(ctx).Warnf("failed to deliver activity event %d to sink %s, retrying in %s: %v", event.ID, w.config.Name, delay, err)
+		if !sleep(ctx, delay) {
+			return
+		}
+	}
+}
+
+// backoff returns the delay before the given retry attempt
+func (w *worker) backoff(attempt int) time.Duration {
+	delay := w.config.Retry.initialBackoff()
+	maxDelay := w.config.Retry.maxBackoff()
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// sleep waits for the given duration and returns false if the context was canceled in the meantime
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,241 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/util"
+)
+
+func saveEvent(t *testing.T, store activity.Store, accountID string) {
+	t.Helper()
+	_, err := store.Save(context.Background(), &activity.Event{
+		Timestamp:   time.Now().UTC(),
+		Activity:    activity.PolicyAdded,
+		InitiatorID: "user",
+		TargetID:    "policy",
+		AccountID:   accountID,
+		Meta:        map[string]any{"name": "policy"},
+	})
+	require.NoError(t, err)
+}
+
+func TestStore_FileSink(t *testing.T) {
+	dataDir := t.TempDir()
+	path := filepath.Join(dataDir, "events.log")
+
+	store, err := NewStore(context.Background(), &activity.InMemoryEventStore{}, &Config{
+		Destinations: []*DestinationConfig{
+			{Name: "file", Type: TypeFile, AccountIDs: []string{"account"}, File: &FileConfig{Path: path}},
+		},
+	}, dataDir)
+	require.NoError(t, err)
+
+	saveEvent(t, store, "account")
+	saveEvent(t, store, "other")
+	saveEvent(t, store, "account")
+
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(path)
+		return err == nil && strings.Count(string(data), "\n") == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, store.Close(context.Background()))
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		assert.Equal(t, "account", event.AccountID)
+		assert.Equal(t, activity.PolicyAdded.StringCode(), event.ActivityCode)
+		assert.Equal(t, "policy", event.Meta["name"])
+	}
+}
+
+func TestStore_WebhookSinkRetries(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		timestamp := r.Header.Get(TimestampHeader)
+		assert.Equal(t, "sha256="+Sign("secret", timestamp, body), r.Header.Get(SignatureHeader))
+		assert.Equal(t, "value", r.Header.Get("X-Custom"))
+
+		// the first delivery fails and has to be retried
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	store, err := NewStore(context.Background(), &activity.InMemoryEventStore{}, &Config{
+		Destinations: []*DestinationConfig{
+			{
+				Name:    "webhook",
+				Type:    TypeWebhook,
+				Retry:   RetryConfig{InitialBackoff: util.Duration{Duration: 10 * time.Millisecond}},
+				Webhook: &WebhookConfig{URL: server.URL, Secret: "secret", Headers: map[string]string{"X-Custom": "value"}},
+			},
+		},
+	}, t.TempDir())
+	require.NoError(t, err)
+	defer store.Close(context.Background()) //nolint
+
+	saveEvent(t, store, "account")
+
+	require.Eventually(t, func() bool {
+		return requests.Load() == 2 && store.workers[0].queue.len() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestStore_AccountDestinations(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		assert.Equal(t, "account", event.AccountID)
+		requests.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	dataDir := t.TempDir()
+	store, err := NewStore(context.Background(), &activity.InMemoryEventStore{}, nil, dataDir)
+	require.NoError(t, err)
+	defer store.Close(context.Background()) //nolint
+
+	// the account filter of the destination is replaced with the managing account
+	err = store.SetAccountDestinations(context.Background(), "account", []*DestinationConfig{
+		{Name: "sink", Type: TypeWebhook, AccountIDs: []string{"other"}, Webhook: &WebhookConfig{URL: server.URL}},
+	})
+	require.NoError(t, err)
+
+	saveEvent(t, store, "account")
+	saveEvent(t, store, "other")
+
+	require.Eventually(t, func() bool {
+		return requests.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	queueDir := filepath.Join(dataDir, defaultQueueDir, accountQueueDir, "account", "sink")
+	require.DirExists(t, queueDir)
+
+	require.NoError(t, store.SetAccountDestinations(context.Background(), "account", nil))
+	assert.NoDirExists(t, queueDir, "queue of the removed sink must be deleted")
+
+	saveEvent(t, store, "account")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(1), requests.Load())
+
+	err = store.SetAccountDestinations(context.Background(), "account", []*DestinationConfig{
+		{Name: "file", Type: TypeFile},
+	})
+	assert.Error(t, err, "invalid destinations must be rejected")
+}
+
+func TestStore_EventsSurviveRestart(t *testing.T) {
+	dataDir := t.TempDir()
+	config := &Config{
+		Destinations: []*DestinationConfig{
+			{
+				Name:    "webhook",
+				Type:    TypeWebhook,
+				Retry:   RetryConfig{InitialBackoff: util.Duration{Duration: time.Hour}},
+				Webhook: &WebhookConfig{URL: "http://127.0.0.1:1"},
+			},
+		},
+	}
+
+	store, err := NewStore(context.Background(), &activity.InMemoryEventStore{}, config, dataDir)
+	require.NoError(t, err)
+	saveEvent(t, store, "account")
+	saveEvent(t, store, "account")
+	require.NoError(t, store.Close(context.Background()))
+
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+	}))
+	defer server.Close()
+
+	config.Destinations[0].Webhook.URL = server.URL
+	store, err = NewStore(context.Background(), &activity.InMemoryEventStore{}, config, dataDir)
+	require.NoError(t, err)
+	defer store.Close(context.Background()) //nolint
+
+	require.Eventually(t, func() bool {
+		return received.Load() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSyslogSink(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink := newSyslogSink(&SyslogConfig{Address: conn.LocalAddr().String(), AppName: "nb"})
+	defer sink.Close()
+
+	err = sink.Send(context.Background(), &Event{
+		ID:           7,
+		Timestamp:    time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+		ActivityCode: "policy.add",
+		InitiatorID:  "user",
+		TargetID:     `target"]`,
+		AccountID:    "account",
+	})
+	require.NoError(t, err)
+
+	buf := make([]byte, 4096)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+
+	msg := string(buf[:n])
+	assert.True(t, strings.HasPrefix(msg, "<110>1 2025-01-06T10:00:00Z "), msg)
+	assert.Contains(t, msg, " nb ")
+	assert.Contains(t, msg, ` policy.add [netbird@32473 eventId="7" accountId="account" initiatorId="user" targetId="target\"\]"] {`)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	assert.Error(t, (&Config{Destinations: []*DestinationConfig{{Name: "a", Type: "kafka"}}}).Validate())
+	assert.Error(t, (&Config{Destinations: []*DestinationConfig{{Name: "../a", Type: TypeFile, File: &FileConfig{Path: "a"}}}}).Validate())
+	assert.Error(t, (&Config{Destinations: []*DestinationConfig{{Name: "a", Type: TypeWebhook}}}).Validate())
+	assert.Error(t, (&Config{Destinations: []*DestinationConfig{
+		{Name: "a", Type: TypeFile, File: &FileConfig{Path: "a"}},
+		{Name: "a", Type: TypeFile, File: &FileConfig{Path: "b"}},
+	}}).Validate())
+	assert.NoError(t, (&Config{Destinations: []*DestinationConfig{
+		{Name: "a", Type: TypeSyslog, Syslog: &SyslogConfig{Network: "tcp+tls", Address: "siem:6514"}},
+	}}).Validate())
+}
+
+func TestDestinationConfig_MatchesActivity(t *testing.T) {
+	all := &DestinationConfig{}
+	assert.True(t, all.matchesActivity(activity.PolicyAdded.StringCode()))
+
+	approvals := &DestinationConfig{Activities: []string{activity.PeerApprovalPending.StringCode()}}
+	assert.True(t, approvals.matchesActivity("peer.approval.pending"))
+	assert.False(t, approvals.matchesActivity(activity.PolicyAdded.StringCode()))
+}
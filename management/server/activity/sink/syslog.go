@@ -0,0 +1,136 @@
+package sink
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSyslogAppName  = "netbird"
+	defaultSyslogFacility = 13 // log audit
+	syslogSeverityInfo    = 6
+	// syslogSDID is the structured data ID of the event parameters, 32473 is the enterprise number reserved for examples
+	syslogSDID        = "netbird@32473"
+	syslogDialTimeout = 10 * time.Second
+	syslogMaxMsgIDLen = 32
+)
+
+var sdParamEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// syslogSink sends events to a syslog server as RFC 5424 messages. Messages sent over TCP are framed with the
+// octet counting method of RFC 6587
+type syslogSink struct {
+	config   *SyslogConfig
+	hostname string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func newSyslogSink(config *SyslogConfig) *syslogSink {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &syslogSink{config: config, hostname: hostname}
+}
+
+// Send formats the event and writes it to the syslog connection, reconnecting if necessary
+func (s *syslogSink) Send(ctx context.Context, event *Event) error {
+	msg, err := s.format(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		if s.conn, err = s.dial(ctx); err != nil {
+			return fmt.Errorf("connect to syslog server: %w", err)
+		}
+	}
+
+	if s.network() != "udp" {
+		msg = fmt.Sprintf("%d %s", len(msg), msg)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.conn.SetWriteDeadline(deadline)
+	}
+
+	if _, err = s.conn.Write([]byte(msg)); err != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+		return fmt.Errorf("write syslog message: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the syslog connection
+func (s *syslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+func (s *syslogSink) network() string {
+	if s.config.Network == "" {
+		return "udp"
+	}
+	return s.config.Network
+}
+
+func (s *syslogSink) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	if s.network() == "tcp+tls" {
+		tlsDialer := &tls.Dialer{NetDialer: dialer}
+		return tlsDialer.DialContext(ctx, "tcp", s.config.Address)
+	}
+	return dialer.DialContext(ctx, s.network(), s.config.Address)
+}
+
+// format returns the RFC 5424 representation of the event with the JSON encoded event as the message
+func (s *syslogSink) format(event *Event) (string, error) {
+	facility := defaultSyslogFacility
+	if s.config.Facility != nil {
+		facility = *s.config.Facility
+	}
+
+	appName := s.config.AppName
+	if appName == "" {
+		appName = defaultSyslogAppName
+	}
+
+	msgID := event.ActivityCode
+	if len(msgID) > syslogMaxMsgIDLen {
+		msgID = msgID[:syslogMaxMsgIDLen]
+	}
+	if msgID == "" {
+		msgID = "-"
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("marshal event: %w", err)
+	}
+
+	sd := fmt.Sprintf(`[%s eventId="%d" accountId="%s" initiatorId="%s" targetId="%s"]`, syslogSDID, event.ID,
+		sdParamEscaper.Replace(event.AccountID), sdParamEscaper.Replace(event.InitiatorID), sdParamEscaper.Replace(event.TargetID))
+
+	return fmt.Sprintf("<%d>1 %s %s %s %d %s %s %s", facility*8+syslogSeverityInfo,
+		event.Timestamp.UTC().Format(time.RFC3339Nano), s.hostname, appName, os.Getpid(), msgID, sd, body), nil
+}
@@ -13,6 +13,10 @@ func migrate(ctx context.Context, crypt *FieldEncrypt, db *sql.DB) error {
 		return err
 	}
 
+	if _, err := db.Exec(createAccountIndexQuery); err != nil {
+		return err
+	}
+
 	if _, err := db.Exec(creatTableDeletedUsersQuery); err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -29,7 +30,15 @@ const (
 
 	creatTableDeletedUsersQuery = `CREATE TABLE IF NOT EXISTS deleted_users (id TEXT NOT NULL, email TEXT NOT NULL, name TEXT, enc_algo TEXT NOT NULL);`
 
-	selectDescQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
+	// metaSearchCondition matches the events with a scalar meta value containing the search pattern. Events saved
+	// without meta have an empty meta column, which isn't valid JSON.
+	metaSearchCondition = `EXISTS (
+		SELECT 1 FROM json_tree(CASE WHEN json_valid(events.meta) THEN events.meta ELSE '{}' END)
+		WHERE type NOT IN ('object', 'array', 'null')
+		AND (CASE type WHEN 'true' THEN 'true' WHEN 'false' THEN 'false' ELSE CAST(atom AS TEXT) END) LIKE ? ESCAPE '\'
+	)`
+
+	selectEventsQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
 		FROM events 
 		LEFT JOIN (
 		    SELECT id, MAX(name) as name, MAX(email) as email 
@@ -41,24 +50,16 @@ const (
 		    FROM deleted_users
 		    GROUP BY id
 		) t ON events.target_id = t.id
-		WHERE account_id = ? 
+		`
+
+	selectDescQuery = selectEventsQuery + `WHERE account_id = ? 
 		ORDER BY timestamp DESC LIMIT ? OFFSET ?;`
 
-	selectAscQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
-		FROM events 
-		LEFT JOIN (
-		    SELECT id, MAX(name) as name, MAX(email) as email 
-		    FROM deleted_users
-		    GROUP BY id
-		) i ON events.initiator_id = i.id 
-		LEFT JOIN (
-		    SELECT id, MAX(name) as name, MAX(email) as email 
-		    FROM deleted_users
-		    GROUP BY id
-		) t ON events.target_id = t.id
-		WHERE account_id = ? 
+	selectAscQuery = selectEventsQuery + `WHERE account_id = ? 
 		ORDER BY timestamp ASC LIMIT ? OFFSET ?;`
 
+	createAccountIndexQuery = `CREATE INDEX IF NOT EXISTS idx_events_account_id_id ON events(account_id, id);`
+
 	insertQuery = "INSERT INTO events(activity, timestamp, initiator_id, target_id, account_id, meta) " +
 		"VALUES(?, ?, ?, ?, ?, ?)"
 
@@ -78,6 +79,9 @@ const (
 	gcmEncAlgo = "GCM"
 )
 
+// likeEscaper escapes the wildcard characters of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 // Store is the implementation of the activity.Store interface backed by SQLite
 type Store struct {
 	db           *sql.DB
@@ -216,6 +220,78 @@ func (store *Store) Get(ctx context.Context, accountID string, offset, limit int
 	return store.processResult(ctx, result)
 }
 
+// Query returns the events of the account matching the query filters. Events are ordered by their ID, which follows
+// the insertion order, so that the ID of the last returned event can be used as the cursor of the next page.
+// The meta search matches the scalar values of the meta, not its keys, and doesn't cover the encrypted names and
+// emails of deleted users.
+func (store *Store) Query(ctx context.Context, accountID string, query *activity.Query) ([]*activity.Event, error) {
+	conditions := []string{"account_id = ?"}
+	args := []any{accountID}
+
+	if len(query.Activities) > 0 {
+		placeholders := make([]string, 0, len(query.Activities))
+		for _, a := range query.Activities {
+			placeholders = append(placeholders, "?")
+			args = append(args, a)
+		}
+		conditions = append(conditions, fmt.Sprintf("activity IN (%s)", strings.Join(placeholders, ", ")))
+	}
+
+	if query.InitiatorID != "" {
+		conditions = append(conditions, "initiator_id = ?")
+		args = append(args, query.InitiatorID)
+	}
+
+	if query.TargetID != "" {
+		conditions = append(conditions, "target_id = ?")
+		args = append(args, query.TargetID)
+	}
+
+	if !query.From.IsZero() {
+		conditions = append(conditions, "timestamp >= ?")
+		args = append(args, query.From.UTC())
+	}
+
+	if !query.To.IsZero() {
+		conditions = append(conditions, "timestamp < ?")
+		args = append(args, query.To.UTC())
+	}
+
+	if query.Search != "" {
+		conditions = append(conditions, metaSearchCondition)
+		args = append(args, "%"+likeEscaper.Replace(query.Search)+"%")
+	}
+
+	order := "ASC"
+	if query.Descending {
+		order = "DESC"
+	}
+
+	if query.Cursor != 0 {
+		if query.Descending {
+			conditions = append(conditions, "events.id < ?")
+		} else {
+			conditions = append(conditions, "events.id > ?")
+		}
+		args = append(args, query.Cursor)
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = activity.DefaultQueryLimit
+	}
+	args = append(args, limit)
+
+	stmt := fmt.Sprintf("%sWHERE %s ORDER BY events.id %s LIMIT ?;", selectEventsQuery, strings.Join(conditions, " AND "), order)
+	result, err := store.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer result.Close() //nolint
+	return store.processResult(ctx, result)
+}
+
 // Save an event in the SQLite events table end encrypt the "email" element in meta map
 func (store *Store) Save(_ context.Context, event *activity.Event) (*activity.Event, error) {
 	var jsonMeta string
@@ -55,3 +55,127 @@ func TestNewSQLiteStore(t *testing.T) {
 	assert.Len(t, result, 5)
 	assert.True(t, result[0].Timestamp.After(result[len(result)-1].Timestamp))
 }
+
+func TestStore_Query(t *testing.T) {
+	key, _ := GenerateKey()
+	store, err := NewSQLiteStore(context.Background(), t.TempDir(), key)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer store.Close(context.Background()) //nolint
+
+	accountID := "account_1"
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 10; i++ {
+		code := activity.PeerAddedByUser
+		if i%2 == 0 {
+			code = activity.PolicyUpdated
+		}
+		_, err = store.Save(context.Background(), &activity.Event{
+			Timestamp:   start.Add(time.Duration(i) * time.Hour),
+			Activity:    code,
+			InitiatorID: "user_" + fmt.Sprint(i%3),
+			TargetID:    "target_" + fmt.Sprint(i),
+			AccountID:   accountID,
+			Meta:        map[string]any{"name": "policy_" + fmt.Sprint(i)},
+		})
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+	}
+
+	_, err = store.Save(context.Background(), &activity.Event{
+		Timestamp:   start,
+		Activity:    activity.PolicyUpdated,
+		InitiatorID: "user_0",
+		AccountID:   "account_2",
+	})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	tests := []struct {
+		name     string
+		query    activity.Query
+		expected []string
+	}{
+		{
+			name:     "activity filter",
+			query:    activity.Query{Activities: []activity.Activity{activity.PeerAddedByUser}},
+			expected: []string{"target_1", "target_3", "target_5", "target_7", "target_9"},
+		},
+		{
+			name:     "initiator and activity filter",
+			query:    activity.Query{Activities: []activity.Activity{activity.PolicyUpdated}, InitiatorID: "user_0"},
+			expected: []string{"target_0", "target_6"},
+		},
+		{
+			name:     "target filter",
+			query:    activity.Query{TargetID: "target_4"},
+			expected: []string{"target_4"},
+		},
+		{
+			name:     "time range",
+			query:    activity.Query{From: start.Add(2 * time.Hour), To: start.Add(4 * time.Hour), Descending: true},
+			expected: []string{"target_3", "target_2"},
+		},
+		{
+			name:     "meta search",
+			query:    activity.Query{Search: "POLICY_8"},
+			expected: []string{"target_8"},
+		},
+		{
+			name:     "search ignores meta keys",
+			query:    activity.Query{Search: "name"},
+			expected: []string{},
+		},
+		{
+			name:     "search escapes wildcards",
+			query:    activity.Query{Search: "policy%"},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := store.Query(context.Background(), accountID, &tt.query)
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+
+			targets := make([]string, 0, len(result))
+			for _, event := range result {
+				targets = append(targets, event.TargetID)
+			}
+			assert.Equal(t, tt.expected, targets)
+		})
+	}
+
+	t.Run("cursor pagination", func(t *testing.T) {
+		query := &activity.Query{Limit: 4, Descending: true}
+		var targets []string
+		for page := 0; page < 5; page++ {
+			result, err := store.Query(context.Background(), accountID, query)
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+			for _, event := range result {
+				targets = append(targets, event.TargetID)
+			}
+			query.Cursor = query.NextCursor(result)
+			if query.Cursor == 0 {
+				break
+			}
+		}
+
+		assert.Len(t, targets, 10)
+		assert.Equal(t, "target_9", targets[0])
+		assert.Equal(t, "target_0", targets[9])
+	})
+}
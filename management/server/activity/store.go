@@ -11,6 +11,8 @@ type Store interface {
 	Save(ctx context.Context, event *Event) (*Event, error)
 	// Get returns "limit" number of events from the "offset" index ordered descending or ascending by a timestamp
 	Get(ctx context.Context, accountID string, offset, limit int, descending bool) ([]*Event, error)
+	// Query returns the events of the account matching the query filters, starting after the query cursor
+	Query(ctx context.Context, accountID string, query *Query) ([]*Event, error)
 	// Close the sink flushing events if necessary
 	Close(ctx context.Context) error
 }
@@ -48,6 +50,33 @@ func (store *InMemoryEventStore) Get(_ context.Context, accountID string, offset
 	return events, nil
 }
 
+// Query returns the events of the given accountID matching the query, ordered by the event ID
+func (store *InMemoryEventStore) Query(_ context.Context, accountID string, query *Query) ([]*Event, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	events := make([]*Event, 0)
+	for i := range store.events {
+		event := store.events[i]
+		if query.Descending {
+			event = store.events[len(store.events)-1-i]
+		}
+
+		if event.AccountID != accountID || !query.Matches(event) {
+			continue
+		}
+
+		if query.Cursor != 0 && ((query.Descending && event.ID >= query.Cursor) || (!query.Descending && event.ID <= query.Cursor)) {
+			continue
+		}
+
+		events = append(events, event)
+		if query.Limit > 0 && len(events) == query.Limit {
+			break
+		}
+	}
+	return events, nil
+}
+
 // Close cleans up the event list
 func (store *InMemoryEventStore) Close(_ context.Context) error {
 	store.mu.Lock()
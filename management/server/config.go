@@ -3,6 +3,7 @@ package server
 import (
 	"net/netip"
 
+	"github.com/netbirdio/netbird/management/server/activity/sink"
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/util"
@@ -51,6 +52,9 @@ type Config struct {
 	StoreConfig StoreConfig
 
 	ReverseProxy ReverseProxy
+
+	// EventStreaming configures the external destinations activity events are forwarded to
+	EventStreaming *sink.Config
 }
 
 // GetAuthAudiences returns the audience from the http config and device authorization flow config
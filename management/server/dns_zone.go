@@ -0,0 +1,308 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+const maxDNSZoneRecords = 1000
+
+// GetDNSZone gets a custom DNS zone object from account and zone IDs
+func (am *DefaultAccountManager) GetDNSZone(ctx context.Context, accountID, userID, zoneID string) (*types.DNSZone, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.DNS, permissions.Read); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetDNSZoneByID(ctx, store.LockingStrengthShare, accountID, zoneID)
+}
+
+// ListDNSZones returns a list of custom DNS zones from account
+func (am *DefaultAccountManager) ListDNSZones(ctx context.Context, accountID, userID string) ([]*types.DNSZone, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.DNS, permissions.Read); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetAccountDNSZones(ctx, store.LockingStrengthShare, accountID)
+}
+
+// CreateDNSZone validates and saves a new custom DNS zone
+func (am *DefaultAccountManager) CreateDNSZone(ctx context.Context, accountID, userID string, zone *types.DNSZone) (*types.DNSZone, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if zone == nil {
+		return nil, status.Errorf(status.InvalidArgument, "dns zone provided is nil")
+	}
+
+	err := am.validateUserPermissions(ctx, accountID, userID, permissions.DNS, permissions.Write, zone.Groups...)
+	if err != nil {
+		return nil, err
+	}
+
+	newZone := zone.Copy()
+	newZone.ID = types.NewDNSZone(accountID).ID
+	newZone.AccountID = accountID
+
+	var updateAccountPeers bool
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err = am.validateDNSZone(ctx, transaction, accountID, newZone); err != nil {
+			return err
+		}
+
+		updateAccountPeers, err = anyGroupHasPeersOrResources(ctx, transaction, accountID, newZone.Groups)
+		if err != nil {
+			return err
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.SaveDNSZone(ctx, store.LockingStrengthUpdate, newZone)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, newZone.ID, accountID, activity.DNSZoneCreated, newZone.EventMeta())
+
+	if updateAccountPeers {
+		am.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return newZone.Copy(), nil
+}
+
+// SaveDNSZone validates and updates an existing custom DNS zone
+func (am *DefaultAccountManager) SaveDNSZone(ctx context.Context, accountID, userID string, zoneToSave *types.DNSZone) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if zoneToSave == nil {
+		return status.Errorf(status.InvalidArgument, "dns zone provided is nil")
+	}
+
+	oldZone, err := am.Store.GetDNSZoneByID(ctx, store.LockingStrengthShare, accountID, zoneToSave.ID)
+	if err != nil {
+		return err
+	}
+
+	// a zone can't be moved out of the groups the user is allowed to manage
+	err = am.validateUserPermissions(ctx, accountID, userID, permissions.DNS, permissions.Write, slices.Concat(zoneToSave.Groups, oldZone.Groups)...)
+	if err != nil {
+		return err
+	}
+
+	var updateAccountPeers bool
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		zoneToSave.AccountID = accountID
+
+		if err = am.validateDNSZone(ctx, transaction, accountID, zoneToSave); err != nil {
+			return err
+		}
+
+		updateAccountPeers, err = areDNSZoneChangesAffectPeers(ctx, transaction, zoneToSave, oldZone)
+		if err != nil {
+			return err
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.SaveDNSZone(ctx, store.LockingStrengthUpdate, zoneToSave)
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, zoneToSave.ID, accountID, activity.DNSZoneUpdated, zoneToSave.EventMeta())
+
+	if updateAccountPeers {
+		am.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return nil
+}
+
+// DeleteDNSZone deletes the custom DNS zone with zoneID
+func (am *DefaultAccountManager) DeleteDNSZone(ctx context.Context, accountID, zoneID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	zone, err := am.Store.GetDNSZoneByID(ctx, store.LockingStrengthShare, accountID, zoneID)
+	if err != nil {
+		return err
+	}
+
+	err = am.validateUserPermissions(ctx, accountID, userID, permissions.DNS, permissions.Write, zone.Groups...)
+	if err != nil {
+		return err
+	}
+
+	var updateAccountPeers bool
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if zone.Enabled {
+			updateAccountPeers, err = anyGroupHasPeersOrResources(ctx, transaction, accountID, zone.Groups)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.DeleteDNSZone(ctx, store.LockingStrengthUpdate, accountID, zoneID)
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, zone.ID, accountID, activity.DNSZoneDeleted, zone.EventMeta())
+
+	if updateAccountPeers {
+		am.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return nil
+}
+
+// validateDNSZone checks the zone domain, its distribution groups and records.
+// The domain must not overlap with the zones generated for the peers of the account.
+func (am *DefaultAccountManager) validateDNSZone(ctx context.Context, transaction store.Store, accountID string, zone *types.DNSZone) error {
+	if err := validateDomain(zone.Name); err != nil {
+		return status.Errorf(status.InvalidArgument, "invalid zone domain %s: %v", zone.Name, err)
+	}
+
+	if am.dnsDomain != "" && strings.EqualFold(dns.Fqdn(zone.Name), dns.Fqdn(am.dnsDomain)) {
+		return status.Errorf(status.InvalidArgument, "zone domain %s is reserved for the peers", zone.Name)
+	}
+
+	network, err := transaction.GetAccountNetwork(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+	if reverseZone, err := nbdns.GetReverseZoneName(&network.Net); err == nil && strings.EqualFold(dns.Fqdn(zone.Name), reverseZone) {
+		return status.Errorf(status.InvalidArgument, "zone domain %s is reserved for the reverse zone of the peers", zone.Name)
+	}
+
+	zones, err := transaction.GetAccountDNSZones(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+	for _, existing := range zones {
+		if existing.ID != zone.ID && strings.EqualFold(existing.Name, zone.Name) {
+			return status.Errorf(status.InvalidArgument, "dns zone with domain %s already exists", zone.Name)
+		}
+	}
+
+	if err = validateDNSZoneRecords(zone); err != nil {
+		return err
+	}
+
+	groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthShare, accountID, zone.Groups)
+	if err != nil {
+		return err
+	}
+
+	return validateGroups(zone.Groups, groups)
+}
+
+// validateDNSZoneRecords checks that the records are supported, belong to the zone and can be parsed.
+// A name with a CNAME record can't have other records.
+func validateDNSZoneRecords(zone *types.DNSZone) error {
+	if len(zone.Records) > maxDNSZoneRecords {
+		return status.Errorf(status.InvalidArgument, "dns zone %s has %d records, the maximum is %d", zone.Name, len(zone.Records), maxDNSZoneRecords)
+	}
+
+	zoneDomain := dns.Fqdn(zone.Name)
+	cnames := make(map[string]struct{})
+	names := make(map[string]int)
+
+	for _, record := range zone.Records {
+		if !isDNSZoneRecordType(record.Type) {
+			return status.Errorf(status.InvalidArgument, "record %s has an unsupported type %s", record.Name, dns.Type(record.Type))
+		}
+
+		if record.Class != nbdns.DefaultClass {
+			return status.Errorf(status.InvalidArgument, "record %s has an unsupported class %s", record.Name, record.Class)
+		}
+
+		if record.TTL < 0 {
+			return status.Errorf(status.InvalidArgument, "record %s has a negative TTL", record.Name)
+		}
+
+		name := dns.Fqdn(record.Name)
+		if strings.Contains(strings.TrimPrefix(name, "*."), "*") {
+			return status.Errorf(status.InvalidArgument, "record %s: a wildcard is only allowed as the first label", record.Name)
+		}
+
+		if !dns.IsSubDomain(zoneDomain, name) {
+			return status.Errorf(status.InvalidArgument, "record %s is not in the zone %s", record.Name, zone.Name)
+		}
+
+		if record.RData == "" {
+			return status.Errorf(status.InvalidArgument, "record %s has no content", record.Name)
+		}
+
+		rr, err := dns.NewRR(record.String())
+		if err != nil || rr == nil || rr.Header().Rrtype != uint16(record.Type) {
+			return status.Errorf(status.InvalidArgument, "record %s has invalid %s content %q", record.Name, dns.Type(record.Type), record.RData)
+		}
+
+		key := strings.ToLower(name)
+		if record.Type == int(dns.TypeCNAME) {
+			cnames[key] = struct{}{}
+		}
+		names[key]++
+	}
+
+	for name := range cnames {
+		if names[name] > 1 {
+			return status.Errorf(status.InvalidArgument, "record %s has a CNAME record and can't have other records", strings.TrimSuffix(name, "."))
+		}
+	}
+
+	return nil
+}
+
+func isDNSZoneRecordType(recordType int) bool {
+	for _, t := range types.DNSZoneRecordTypes {
+		if int(t) == recordType {
+			return true
+		}
+	}
+	return false
+}
+
+// areDNSZoneChangesAffectPeers checks if the changes in the DNS zone affect the peers.
+func areDNSZoneChangesAffectPeers(ctx context.Context, transaction store.Store, newZone, oldZone *types.DNSZone) (bool, error) {
+	if !newZone.Enabled && !oldZone.Enabled {
+		return false, nil
+	}
+
+	hasPeers, err := anyGroupHasPeersOrResources(ctx, transaction, newZone.AccountID, newZone.Groups)
+	if err != nil {
+		return false, err
+	}
+
+	if hasPeers {
+		return true, nil
+	}
+
+	return anyGroupHasPeersOrResources(ctx, transaction, oldZone.AccountID, oldZone.Groups)
+}
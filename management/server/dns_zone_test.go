@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func newTestDNSZone(name string, groups []string, records ...nbdns.SimpleRecord) *types.DNSZone {
+	return &types.DNSZone{
+		Name:    name,
+		Enabled: true,
+		Groups:  groups,
+		Records: records,
+	}
+}
+
+func zoneRecord(name string, recordType uint16, rData string) nbdns.SimpleRecord {
+	return nbdns.SimpleRecord{
+		Name:  dns.Fqdn(name),
+		Type:  int(recordType),
+		Class: nbdns.DefaultClass,
+		TTL:   300,
+		RData: rData,
+	}
+}
+
+func TestValidateDNSZoneRecords(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []nbdns.SimpleRecord
+		wantErr bool
+	}{
+		{
+			name: "supported records",
+			records: []nbdns.SimpleRecord{
+				zoneRecord("internal.example.com", dns.TypeA, "10.0.0.1"),
+				zoneRecord("internal.example.com", dns.TypeAAAA, "fd00::1"),
+				zoneRecord("internal.example.com", dns.TypeTXT, "v=spf1 -all"),
+				zoneRecord("internal.example.com", dns.TypeMX, "10 mail.internal.example.com."),
+				zoneRecord("_https._tcp.internal.example.com", dns.TypeSRV, "10 5 443 web.internal.example.com."),
+				zoneRecord("*.apps.internal.example.com", dns.TypeCNAME, "web.internal.example.com."),
+				zoneRecord("1.internal.example.com", dns.TypePTR, "web.internal.example.com."),
+			},
+		},
+		{
+			name:    "record outside of the zone",
+			records: []nbdns.SimpleRecord{zoneRecord("web.example.org", dns.TypeA, "10.0.0.1")},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			records: []nbdns.SimpleRecord{zoneRecord("web.internal.example.com", dns.TypeNS, "ns.example.com.")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid A content",
+			records: []nbdns.SimpleRecord{zoneRecord("web.internal.example.com", dns.TypeA, "fd00::1")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid MX content",
+			records: []nbdns.SimpleRecord{zoneRecord("internal.example.com", dns.TypeMX, "mail.internal.example.com.")},
+			wantErr: true,
+		},
+		{
+			name:    "wildcard not in the first label",
+			records: []nbdns.SimpleRecord{zoneRecord("web.*.internal.example.com", dns.TypeA, "10.0.0.1")},
+			wantErr: true,
+		},
+		{
+			name: "CNAME with other records",
+			records: []nbdns.SimpleRecord{
+				zoneRecord("web.internal.example.com", dns.TypeCNAME, "app.internal.example.com."),
+				zoneRecord("web.internal.example.com", dns.TypeTXT, "text"),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "empty content",
+			records: []nbdns.SimpleRecord{zoneRecord("web.internal.example.com", dns.TypeTXT, "")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDNSZoneRecords(newTestDNSZone("internal.example.com", nil, tt.records...))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateDNSZone(t *testing.T) {
+	am, err := createNSManager(t)
+	require.NoError(t, err)
+
+	account, err := initTestNSAccount(t, am)
+	require.NoError(t, err)
+
+	reverseZone, err := nbdns.GetReverseZoneName(&account.Network.Net)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		zone    *types.DNSZone
+		wantErr bool
+	}{
+		{
+			name: "valid zone",
+			zone: newTestDNSZone("internal.example.com", []string{group1ID}, zoneRecord("web.internal.example.com", dns.TypeA, "10.0.0.1")),
+		},
+		{
+			name:    "peers domain",
+			zone:    newTestDNSZone("netbird.selfhosted", []string{group1ID}),
+			wantErr: true,
+		},
+		{
+			name:    "peers reverse zone",
+			zone:    newTestDNSZone(reverseZone, []string{group1ID}),
+			wantErr: true,
+		},
+		{
+			name:    "invalid domain",
+			zone:    newTestDNSZone("internal", []string{group1ID}),
+			wantErr: true,
+		},
+		{
+			name:    "missing group",
+			zone:    newTestDNSZone("missing.example.com", []string{"missing"}),
+			wantErr: true,
+		},
+		{
+			name:    "no groups",
+			zone:    newTestDNSZone("nogroups.example.com", nil),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone, err := am.CreateDNSZone(context.Background(), account.Id, testUserID, tt.zone)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, zone.ID)
+			assert.Equal(t, account.Id, zone.AccountID)
+		})
+	}
+
+	_, err = am.CreateDNSZone(context.Background(), account.Id, testUserID, newTestDNSZone("internal.example.com", []string{group2ID}))
+	assert.Error(t, err, "zone domains should be unique")
+}
+
+func TestDNSZoneDistribution(t *testing.T) {
+	am, err := createNSManager(t)
+	require.NoError(t, err)
+
+	account, err := initTestNSAccount(t, am)
+	require.NoError(t, err)
+
+	account, err = am.Store.GetAccount(context.Background(), account.Id)
+	require.NoError(t, err)
+
+	var peerID string
+	for id := range account.Peers {
+		peerID = id
+		break
+	}
+	require.NoError(t, am.GroupAddPeer(context.Background(), account.Id, group1ID, peerID))
+
+	zone, err := am.CreateDNSZone(context.Background(), account.Id, testUserID,
+		newTestDNSZone("internal.example.com", []string{group1ID}, zoneRecord("*.internal.example.com", dns.TypeA, "10.0.0.1")))
+	require.NoError(t, err)
+
+	networkMap, err := am.GetNetworkMap(context.Background(), peerID)
+	require.NoError(t, err)
+
+	zones := make(map[string]nbdns.CustomZone)
+	for _, customZone := range networkMap.DNSConfig.CustomZones {
+		zones[customZone.Domain] = customZone
+	}
+
+	require.Contains(t, zones, "internal.example.com.")
+	assert.True(t, zones["internal.example.com."].SearchDomainDisabled)
+	assert.Len(t, zones["internal.example.com."].Records, 1)
+
+	reverseZone, err := nbdns.GetReverseZoneName(&account.Network.Net)
+	require.NoError(t, err)
+	require.Contains(t, zones, reverseZone)
+	assert.Len(t, zones[reverseZone].Records, len(account.Peers))
+	for _, record := range zones[reverseZone].Records {
+		assert.Equal(t, int(dns.TypePTR), record.Type)
+		assert.True(t, dns.IsSubDomain(reverseZone, record.Name))
+	}
+
+	zone.Enabled = false
+	require.NoError(t, am.SaveDNSZone(context.Background(), account.Id, testUserID, zone))
+
+	networkMap, err = am.GetNetworkMap(context.Background(), peerID)
+	require.NoError(t, err)
+	for _, customZone := range networkMap.DNSConfig.CustomZones {
+		assert.NotEqual(t, "internal.example.com.", customZone.Domain, "disabled zones should not be distributed")
+	}
+
+	err = am.DeleteGroup(context.Background(), account.Id, testUserID, group1ID)
+	assert.Error(t, err, "groups of a zone should not be deleted")
+
+	require.NoError(t, am.DeleteDNSZone(context.Background(), account.Id, zone.ID, testUserID))
+	_, err = am.GetDNSZone(context.Background(), account.Id, testUserID, zone.ID)
+	assert.Error(t, err)
+}
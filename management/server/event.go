@@ -9,6 +9,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -19,27 +20,29 @@ func isEnabled() bool {
 
 // GetEvents returns a list of activity events of an account
 func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error) {
+	events, _, err := am.QueryEvents(ctx, accountID, userID, &activity.Query{Limit: activity.MaxQueryLimit, Descending: true})
+	return events, err
+}
+
+// QueryEvents returns a page of activity events of an account matching the query and the cursor of the next page.
+// The returned cursor is zero when there are no more events to fetch.
+func (am *DefaultAccountManager) QueryEvents(ctx context.Context, accountID, userID string, query *activity.Query) ([]*activity.Event, uint64, error) {
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	account, err := am.Store.GetAccount(ctx, accountID)
-	if err != nil {
-		return nil, err
-	}
-
-	user, err := account.FindUser(userID)
-	if err != nil {
-		return nil, err
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Events, permissions.Read); err != nil {
+		return nil, 0, err
 	}
 
-	if !(user.HasAdminPower() || user.IsServiceUser) {
-		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can view events")
+	if err := query.Validate(); err != nil {
+		return nil, 0, status.Errorf(status.InvalidArgument, "invalid events query: %v", err)
 	}
 
-	events, err := am.eventStore.Get(ctx, accountID, 0, 10000, true)
+	events, err := am.eventStore.Query(ctx, accountID, query)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
+	nextCursor := query.NextCursor(events)
 
 	// this is a workaround for duplicate activity.UserJoined events that might occur when a user redeems invite.
 	// we will need to find a better way to handle this.
@@ -58,7 +61,7 @@ func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userI
 		filtered = append(filtered, event)
 	}
 
-	return filtered, nil
+	return filtered, nextCursor, nil
 }
 
 func (am *DefaultAccountManager) StoreEvent(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any) {
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"context"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/activity/sink"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// accountEventSinks is implemented by the event stores streaming activity events to the sinks managed by accounts
+type accountEventSinks interface {
+	SetAccountDestinations(ctx context.Context, accountID string, destinations []*sink.DestinationConfig) error
+}
+
+// ListEventSinks returns the event sinks of the account
+func (am *DefaultAccountManager) ListEventSinks(ctx context.Context, accountID, userID string) ([]*types.EventSink, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Events, permissions.Read); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetAccountEventSinks(ctx, store.LockingStrengthShare, accountID)
+}
+
+// GetEventSink returns the event sink of the account with the given ID
+func (am *DefaultAccountManager) GetEventSink(ctx context.Context, accountID, sinkID, userID string) (*types.EventSink, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Events, permissions.Read); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetEventSinkByID(ctx, store.LockingStrengthShare, accountID, sinkID)
+}
+
+// SaveEventSink creates a new event sink when the sink ID is empty or updates an existing one. The webhook secret
+// of an existing sink is kept when the update doesn't set a new one
+func (am *DefaultAccountManager) SaveEventSink(ctx context.Context, accountID, userID string, eventSink *types.EventSink) (*types.EventSink, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	// event sinks stream the events of the whole account, so they can't be modified with group scoped permissions
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Events, permissions.Write); err != nil {
+		return nil, err
+	}
+
+	if _, ok := am.eventStore.(accountEventSinks); !ok {
+		return nil, status.Errorf(status.PreconditionFailed, "activity event streaming is not available")
+	}
+
+	isUpdate := eventSink.ID != ""
+	action := activity.EventSinkCreated
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if isUpdate {
+			existing, err := transaction.GetEventSinkByID(ctx, store.LockingStrengthUpdate, accountID, eventSink.ID)
+			if err != nil {
+				return err
+			}
+			if eventSink.Webhook != nil && eventSink.Webhook.Secret == "" && existing.Webhook != nil {
+				eventSink.Webhook.Secret = existing.Webhook.Secret
+			}
+			action = activity.EventSinkUpdated
+		} else {
+			eventSink.ID = xid.New().String()
+		}
+
+		eventSink.AccountID = accountID
+		if err := eventSink.Validate(); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid event sink: %v", err)
+		}
+
+		return transaction.SaveEventSink(ctx, store.LockingStrengthUpdate, eventSink)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, eventSink.ID, accountID, action, eventSink.EventMeta())
+
+	if err = am.applyAccountEventSinks(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	return eventSink, nil
+}
+
+// DeleteEventSink deletes the event sink of the account together with its undelivered events
+func (am *DefaultAccountManager) DeleteEventSink(ctx context.Context, accountID, sinkID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	// event sinks stream the events of the whole account, so they can't be modified with group scoped permissions
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Events, permissions.Write); err != nil {
+		return err
+	}
+
+	eventSink, err := am.Store.GetEventSinkByID(ctx, store.LockingStrengthShare, accountID, sinkID)
+	if err != nil {
+		return err
+	}
+
+	if err = am.Store.DeleteEventSink(ctx, store.LockingStrengthUpdate, accountID, sinkID); err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, eventSink.ID, accountID, activity.EventSinkDeleted, eventSink.EventMeta())
+
+	return am.applyAccountEventSinks(ctx, accountID)
+}
+
+// applyAccountEventSinks starts streaming the events of the account to its enabled sinks
+func (am *DefaultAccountManager) applyAccountEventSinks(ctx context.Context, accountID string) error {
+	eventSinks, ok := am.eventStore.(accountEventSinks)
+	if !ok {
+		return nil
+	}
+
+	accountSinks, err := am.Store.GetAccountEventSinks(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	destinations := make([]*sink.DestinationConfig, 0, len(accountSinks))
+	for _, eventSink := range accountSinks {
+		if eventSink.Enabled {
+			destinations = append(destinations, eventSink.DestinationConfig())
+		}
+	}
+
+	if err = eventSinks.SetAccountDestinations(ctx, accountID, destinations); err != nil {
+		return status.Errorf(status.Internal, "failed to apply event sinks: %v", err)
+	}
+
+	return nil
+}
+
+// loadEventSinks starts streaming the events of all accounts to their enabled sinks. It is called once on startup
+func (am *DefaultAccountManager) loadEventSinks(ctx context.Context) {
+	eventSinks, ok := am.eventStore.(accountEventSinks)
+	if !ok {
+		return
+	}
+
+	allSinks, err := am.Store.GetAllEventSinks(ctx, store.LockingStrengthShare)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to load the account event sinks: %v", err)
+		return
+	}
+
+	destinations := make(map[string][]*sink.DestinationConfig)
+	for _, eventSink := range allSinks {
+		if eventSink.Enabled {
+			destinations[eventSink.AccountID] = append(destinations[eventSink.AccountID], eventSink.DestinationConfig())
+		}
+	}
+
+	for accountID, accountDestinations := range destinations {
+		if err = eventSinks.SetAccountDestinations(ctx, accountID, accountDestinations); err != nil {
+			log.WithContext(ctx).Errorf("failed to start the event sinks of account %s: %v", accountID, err)
+		}
+	}
+}
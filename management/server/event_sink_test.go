@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/activity/sink"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestDefaultAccountManager_EventSinks(t *testing.T) {
+	var delivered atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event sink.Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		assert.Equal(t, "policy.add", event.ActivityCode)
+		assert.NotEmpty(t, r.Header.Get(sink.SignatureHeader))
+		delivered.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	eventStore, err := sink.NewStore(context.Background(), &activity.InMemoryEventStore{}, nil, t.TempDir())
+	require.NoError(t, err)
+	defer eventStore.Close(context.Background()) //nolint
+	am.eventStore = eventStore
+
+	account := initTestCustomRolesAccount(t, am)
+
+	_, err = am.SaveEventSink(context.Background(), account.Id, regularUserID, &types.EventSink{
+		Name: "regular", Enabled: true, Type: sink.TypeWebhook, Webhook: &sink.WebhookConfig{URL: server.URL},
+	})
+	assert.Error(t, err, "regular users must not manage event sinks")
+
+	_, err = am.SaveEventSink(context.Background(), account.Id, adminUserID, &types.EventSink{
+		Name: "file", Enabled: true, Type: sink.TypeFile,
+	})
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.InvalidArgument, sErr.Type(), "file sinks are reserved to the management configuration")
+
+	eventSink, err := am.SaveEventSink(context.Background(), account.Id, adminUserID, &types.EventSink{
+		Name:       "siem",
+		Enabled:    true,
+		Type:       sink.TypeWebhook,
+		Activities: []string{"policy.add"},
+		Webhook:    &sink.WebhookConfig{URL: server.URL, Secret: "secret"},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, eventSink.ID)
+
+	am.StoreEvent(context.Background(), adminUserID, "policy", account.Id, activity.PolicyAdded, nil)
+	am.StoreEvent(context.Background(), adminUserID, "policy", "otherAccount", activity.PolicyAdded, nil)
+	am.StoreEvent(context.Background(), adminUserID, "group", account.Id, activity.GroupCreated, nil)
+
+	require.Eventually(t, func() bool {
+		return delivered.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// an update without a secret keeps the current one
+	eventSink, err = am.SaveEventSink(context.Background(), account.Id, adminUserID, &types.EventSink{
+		ID:         eventSink.ID,
+		Name:       "siem",
+		Enabled:    false,
+		Type:       sink.TypeWebhook,
+		Activities: []string{"policy.add"},
+		Webhook:    &sink.WebhookConfig{URL: server.URL},
+	})
+	require.NoError(t, err)
+
+	stored, err := am.GetEventSink(context.Background(), account.Id, eventSink.ID, adminUserID)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", stored.Webhook.Secret)
+	assert.False(t, stored.Enabled)
+
+	// disabled sinks don't receive events
+	am.StoreEvent(context.Background(), adminUserID, "policy", account.Id, activity.PolicyAdded, nil)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), delivered.Load())
+
+	require.NoError(t, am.DeleteEventSink(context.Background(), account.Id, eventSink.ID, adminUserID))
+	sinks, err := am.ListEventSinks(context.Background(), account.Id, adminUserID)
+	require.NoError(t, err)
+	assert.Empty(t, sinks)
+}
@@ -0,0 +1,302 @@
+package gitops
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/netbirdio/netbird/management/server/posture"
+)
+
+// Document is the declarative configuration of an account. Objects reference each other by name instead of the
+// generated IDs so the document can be kept under version control and applied to the account repeatedly.
+type Document struct {
+	Groups           []Group           `json:"groups"`
+	PostureChecks    []PostureChecks   `json:"posture_checks"`
+	Policies         []Policy          `json:"policies"`
+	Routes           []Route           `json:"routes"`
+	Networks         []Network         `json:"networks"`
+	NameserverGroups []NameserverGroup `json:"nameserver_groups"`
+	DNSZones         []DNSZone         `json:"dns_zones"`
+	DNS              DNSSettings       `json:"dns"`
+}
+
+// Group is a group managed by the document. Groups issued by JWT or integrations and the All group can be
+// referenced by name but are not managed.
+type Group struct {
+	Name string `json:"name"`
+	// Peers are the DNS labels of the group peers
+	Peers []string `json:"peers,omitempty"`
+	// ConnectionStrategy defines how the group peers connect to other peers, empty uses the default strategy
+	ConnectionStrategy string `json:"connection_strategy,omitempty"`
+	// Rule makes the group dynamic, its peers are evaluated from the rule and can't be listed
+	Rule string `json:"rule,omitempty"`
+}
+
+// PostureChecks is a set of posture checks that policies can reference as source posture checks
+type PostureChecks struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Checks      posture.ChecksDefinition `json:"checks"`
+}
+
+// Policy is an access control policy
+type Policy struct {
+	Name                string       `json:"name"`
+	Description         string       `json:"description,omitempty"`
+	Enabled             bool         `json:"enabled"`
+	SourcePostureChecks []string     `json:"source_posture_checks,omitempty"`
+	Rules               []PolicyRule `json:"rules,omitempty"`
+}
+
+// PolicyRule is a rule of a policy. Sources and destinations are group names, resources are network resource names
+type PolicyRule struct {
+	Name                string      `json:"name"`
+	Description         string      `json:"description,omitempty"`
+	Enabled             bool        `json:"enabled"`
+	Action              string      `json:"action"`
+	Protocol            string      `json:"protocol"`
+	Bidirectional       bool        `json:"bidirectional"`
+	Sources             []string    `json:"sources,omitempty"`
+	Destinations        []string    `json:"destinations,omitempty"`
+	SourceResource      string      `json:"source_resource,omitempty"`
+	DestinationResource string      `json:"destination_resource,omitempty"`
+	Ports               []string    `json:"ports,omitempty"`
+	PortRanges          []PortRange `json:"port_ranges,omitempty"`
+	Schedule            *Schedule   `json:"schedule,omitempty"`
+	// SSHAuthorizations are the local OS users the sources may log in as with the SSH server of the destinations
+	SSHAuthorizations []SSHAuthorization `json:"ssh_authorizations,omitempty"`
+}
+
+// SSHAuthorization binds the source peers in the groups, or owned by the NetBird users, to local OS users.
+// Users are referenced by their IDs. It applies to all source peers when both groups and users are empty
+type SSHAuthorization struct {
+	Groups     []string `json:"groups,omitempty"`
+	Users      []string `json:"users,omitempty"`
+	LocalUsers []string `json:"local_users"`
+}
+
+// PortRange is an inclusive range of ports
+type PortRange struct {
+	Start uint16 `json:"start"`
+	End   uint16 `json:"end"`
+}
+
+// Schedule restricts a policy rule to recurring time windows and an optional validity period
+type Schedule struct {
+	TimeZone string       `json:"time_zone,omitempty"`
+	Windows  []TimeWindow `json:"windows,omitempty"`
+	StartsAt *time.Time   `json:"starts_at,omitempty"`
+	EndsAt   *time.Time   `json:"ends_at,omitempty"`
+}
+
+// TimeWindow is a recurring weekly window. Days are lower case week day names
+type TimeWindow struct {
+	Days  []string `json:"days,omitempty"`
+	Start string   `json:"start"`
+	End   string   `json:"end"`
+}
+
+// Route is a network route. A route is identified by its network identifier together with its routing peer or
+// peer groups, so changing the routing peers of a route replaces it.
+type Route struct {
+	NetworkID           string   `json:"network_id"`
+	Description         string   `json:"description,omitempty"`
+	Network             string   `json:"network,omitempty"`
+	Domains             []string `json:"domains,omitempty"`
+	KeepRoute           bool     `json:"keep_route,omitempty"`
+	Peer                string   `json:"peer,omitempty"`
+	PeerGroups          []string `json:"peer_groups,omitempty"`
+	Masquerade          bool     `json:"masquerade"`
+	Metric              int      `json:"metric"`
+	Enabled             bool     `json:"enabled"`
+	Groups              []string `json:"groups,omitempty"`
+	AccessControlGroups []string `json:"access_control_groups,omitempty"`
+}
+
+// Key returns the identity of the route in the document
+func (r *Route) Key() string {
+	return r.NetworkID + "@" + routingPeersKey(r.Peer, r.PeerGroups)
+}
+
+// Network is a network with its resources and routers
+type Network struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Resources   []NetworkResource `json:"resources,omitempty"`
+	Routers     []NetworkRouter   `json:"routers,omitempty"`
+}
+
+// NetworkResource is a host, subnet or domain resource of a network. Resource names are unique in the account
+type NetworkResource struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Address     string   `json:"address"`
+	Enabled     bool     `json:"enabled"`
+	Groups      []string `json:"groups,omitempty"`
+}
+
+// NetworkRouter is a routing peer or a group of routing peers of a network
+type NetworkRouter struct {
+	Peer       string   `json:"peer,omitempty"`
+	PeerGroups []string `json:"peer_groups,omitempty"`
+	Masquerade bool     `json:"masquerade"`
+	Metric     int      `json:"metric"`
+	Enabled    bool     `json:"enabled"`
+}
+
+// Key returns the identity of the router in its network
+func (r *NetworkRouter) Key() string {
+	return routingPeersKey(r.Peer, r.PeerGroups)
+}
+
+// NameserverGroup is a group of nameservers distributed to the peers of the groups
+type NameserverGroup struct {
+	Name                 string       `json:"name"`
+	Description          string       `json:"description,omitempty"`
+	Nameservers          []Nameserver `json:"nameservers,omitempty"`
+	Groups               []string     `json:"groups,omitempty"`
+	Primary              bool         `json:"primary"`
+	Domains              []string     `json:"domains,omitempty"`
+	SearchDomainsEnabled bool         `json:"search_domains_enabled"`
+	Enabled              bool         `json:"enabled"`
+}
+
+// Nameserver is a single nameserver of a nameserver group
+type Nameserver struct {
+	IP       string `json:"ip"`
+	NSType   string `json:"ns_type"`
+	Port     int    `json:"port"`
+	Hostname string `json:"hostname,omitempty"`
+	Path     string `json:"path,omitempty"`
+}
+
+// DNSZone is a custom DNS zone resolved by the peers of the groups. The zone is identified by its domain
+type DNSZone struct {
+	Name                 string      `json:"name"`
+	Description          string      `json:"description,omitempty"`
+	Enabled              bool        `json:"enabled"`
+	SearchDomainsEnabled bool        `json:"search_domains_enabled"`
+	Groups               []string    `json:"groups,omitempty"`
+	Records              []DNSRecord `json:"records,omitempty"`
+}
+
+// DNSRecord is a record of a custom DNS zone. Content is the record data in zone file format
+type DNSRecord struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	TTL     int    `json:"ttl"`
+	Content string `json:"content"`
+}
+
+// DNSSettings are the account DNS settings
+type DNSSettings struct {
+	DisabledManagementGroups []string `json:"disabled_management_groups,omitempty"`
+}
+
+// Validate checks that the objects of the document can be identified by their names
+func (d *Document) Validate() error {
+	if err := checkUnique("group", d.Groups, func(g Group) string { return g.Name }); err != nil {
+		return err
+	}
+
+	for _, group := range d.Groups {
+		if group.Rule != "" && len(group.Peers) > 0 {
+			return fmt.Errorf("dynamic group %s can't list peers", group.Name)
+		}
+	}
+
+	if err := checkUnique("posture checks", d.PostureChecks, func(p PostureChecks) string { return p.Name }); err != nil {
+		return err
+	}
+
+	if err := checkUnique("policy", d.Policies, func(p Policy) string { return p.Name }); err != nil {
+		return err
+	}
+
+	for _, policy := range d.Policies {
+		if err := checkUnique(fmt.Sprintf("rule of policy %s", policy.Name), policy.Rules, func(r PolicyRule) string { return r.Name }); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range d.Routes {
+		if r.NetworkID == "" {
+			return fmt.Errorf("route network identifier shouldn't be empty")
+		}
+	}
+
+	if err := checkUnique("route", d.Routes, func(r Route) string { return r.Key() }); err != nil {
+		return err
+	}
+
+	if err := checkUnique("network", d.Networks, func(n Network) string { return n.Name }); err != nil {
+		return err
+	}
+
+	var resources []NetworkResource
+	for _, network := range d.Networks {
+		resources = append(resources, network.Resources...)
+		for _, router := range network.Routers {
+			if router.Key() == "" {
+				return fmt.Errorf("router of network %s has neither a peer nor peer groups", network.Name)
+			}
+		}
+		if err := checkUnique(fmt.Sprintf("router of network %s", network.Name), network.Routers, func(r NetworkRouter) string { return r.Key() }); err != nil {
+			return err
+		}
+	}
+
+	if err := checkUnique("network resource", resources, func(r NetworkResource) string { return r.Name }); err != nil {
+		return err
+	}
+
+	if err := checkUnique("nameserver group", d.NameserverGroups, func(n NameserverGroup) string { return n.Name }); err != nil {
+		return err
+	}
+
+	return checkUnique("dns zone", d.DNSZones, func(z DNSZone) string { return strings.ToLower(strings.TrimSuffix(z.Name, ".")) })
+}
+
+// Sort orders the objects of the document so that exports of the same configuration are identical
+func (d *Document) Sort() {
+	slices.SortFunc(d.Groups, func(a, b Group) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.PostureChecks, func(a, b PostureChecks) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.Policies, func(a, b Policy) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.Routes, func(a, b Route) int { return strings.Compare(a.Key(), b.Key()) })
+	slices.SortFunc(d.Networks, func(a, b Network) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.NameserverGroups, func(a, b NameserverGroup) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.DNSZones, func(a, b DNSZone) int { return strings.Compare(a.Name, b.Name) })
+
+	for _, network := range d.Networks {
+		slices.SortFunc(network.Resources, func(a, b NetworkResource) int { return strings.Compare(a.Name, b.Name) })
+		slices.SortFunc(network.Routers, func(a, b NetworkRouter) int { return strings.Compare(a.Key(), b.Key()) })
+	}
+}
+
+// routingPeersKey identifies routing peers given either as a single peer or as peer groups
+func routingPeersKey(peer string, peerGroups []string) string {
+	if peer != "" {
+		return peer
+	}
+
+	groups := slices.Clone(peerGroups)
+	slices.Sort(groups)
+	return strings.Join(groups, ",")
+}
+
+func checkUnique[T any](kind string, objects []T, name func(T) string) error {
+	names := make(map[string]struct{}, len(objects))
+	for _, object := range objects {
+		n := name(object)
+		if n == "" {
+			return fmt.Errorf("%s without name", kind)
+		}
+		if _, ok := names[n]; ok {
+			return fmt.Errorf("%s %q is defined more than once", kind, n)
+		}
+		names[n] = struct{}{}
+	}
+	return nil
+}
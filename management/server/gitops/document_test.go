@@ -0,0 +1,153 @@
+package gitops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/posture"
+)
+
+func testDocument() *Document {
+	startsAt := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+	return &Document{
+		Groups: []Group{
+			{Name: "devs", Peers: []string{"laptop-1", "laptop-2"}},
+			{Name: "servers"},
+		},
+		PostureChecks: []PostureChecks{
+			{
+				Name:   "min-version",
+				Checks: posture.ChecksDefinition{NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.25"}},
+			},
+		},
+		Policies: []Policy{
+			{
+				Name:                "devs-to-servers",
+				Enabled:             true,
+				SourcePostureChecks: []string{"min-version"},
+				Rules: []PolicyRule{
+					{
+						Name:         "ssh",
+						Enabled:      true,
+						Action:       "accept",
+						Protocol:     "tcp",
+						Sources:      []string{"devs"},
+						Destinations: []string{"servers"},
+						Ports:        []string{"22"},
+						Schedule: &Schedule{
+							TimeZone: "Europe/Berlin",
+							Windows:  []TimeWindow{{Days: []string{"monday"}, Start: "08:00", End: "18:00"}},
+							StartsAt: &startsAt,
+						},
+					},
+				},
+			},
+		},
+		Routes: []Route{
+			{NetworkID: "office", Network: "10.0.0.0/24", Peer: "gateway", Metric: 9999, Enabled: true, Groups: []string{"devs"}},
+		},
+		Networks: []Network{
+			{
+				Name:      "prod",
+				Resources: []NetworkResource{{Name: "db", Address: "10.1.0.10", Enabled: true, Groups: []string{"servers"}}},
+				Routers:   []NetworkRouter{{PeerGroups: []string{"servers"}, Metric: 9999, Enabled: true}},
+			},
+		},
+		NameserverGroups: []NameserverGroup{
+			{
+				Name:        "google",
+				Nameservers: []Nameserver{{IP: "8.8.8.8", NSType: "udp", Port: 53}},
+				Groups:      []string{"devs"},
+				Primary:     true,
+				Enabled:     true,
+			},
+		},
+		DNS: DNSSettings{DisabledManagementGroups: []string{"servers"}},
+	}
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	doc := testDocument()
+
+	for _, format := range []Format{FormatJSON, FormatYAML} {
+		t.Run(string(format), func(t *testing.T) {
+			data, err := Marshal(doc, format)
+			require.NoError(t, err)
+
+			parsed, err := Unmarshal(data, format)
+			require.NoError(t, err)
+			assert.Equal(t, doc, parsed)
+
+			again, err := Marshal(parsed, format)
+			require.NoError(t, err)
+			assert.Equal(t, string(data), string(again), "serialization should be stable")
+		})
+	}
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	data := `
+groups:
+  - name: devs
+    peers: [laptop-1]
+policies:
+  - name: devs
+    enabled: true
+    rules:
+      - name: all
+        enabled: true
+        action: accept
+        protocol: all
+        bidirectional: true
+        sources: [devs]
+        destinations: [devs]
+dns:
+  disabled_management_groups: [devs]
+`
+	doc, err := Unmarshal([]byte(data), FormatYAML)
+	require.NoError(t, err)
+	require.Len(t, doc.Groups, 1)
+	assert.Equal(t, []string{"laptop-1"}, doc.Groups[0].Peers)
+	require.Len(t, doc.Policies, 1)
+	assert.Equal(t, []string{"devs"}, doc.Policies[0].Rules[0].Sources)
+	assert.Equal(t, []string{"devs"}, doc.DNS.DisabledManagementGroups)
+
+	_, err = Unmarshal([]byte("groups:\n  - name: devs\n    peer: [laptop-1]\n"), FormatYAML)
+	assert.Error(t, err, "unknown fields should be rejected")
+}
+
+func TestDocument_Validate(t *testing.T) {
+	assert.NoError(t, testDocument().Validate())
+
+	doc := testDocument()
+	doc.Groups = append(doc.Groups, Group{Name: "devs"})
+	assert.Error(t, doc.Validate(), "duplicate group names")
+
+	doc = testDocument()
+	doc.Groups = append(doc.Groups, Group{Name: "linux", Rule: "os == linux"})
+	assert.NoError(t, doc.Validate(), "dynamic group")
+
+	doc = testDocument()
+	doc.Groups = append(doc.Groups, Group{Name: "linux", Rule: "os == linux", Peers: []string{"gateway"}})
+	assert.Error(t, doc.Validate(), "dynamic group with peers")
+
+	doc = testDocument()
+	doc.Routes = append(doc.Routes, doc.Routes[0])
+	assert.Error(t, doc.Validate(), "duplicate routes")
+
+	doc = testDocument()
+	doc.Routes = append(doc.Routes, doc.Routes[0])
+	doc.Routes[1].Peer = "other-gateway"
+	assert.NoError(t, doc.Validate(), "routes of the same network with different peers")
+
+	doc = testDocument()
+	doc.Networks = append(doc.Networks, Network{Name: "staging", Resources: []NetworkResource{{Name: "db", Address: "10.2.0.10"}}})
+	assert.Error(t, doc.Validate(), "resource names are unique in the account")
+
+	doc = testDocument()
+	doc.Networks[0].Routers = append(doc.Networks[0].Routers, NetworkRouter{})
+	assert.Error(t, doc.Validate(), "router without peers")
+}
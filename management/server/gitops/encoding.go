@@ -0,0 +1,94 @@
+package gitops
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the serialization format of a document
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// ParseFormat returns the format with the given name. JSON is used when the name is empty
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case "", FormatJSON:
+		return FormatJSON, nil
+	case FormatYAML, "yml":
+		return FormatYAML, nil
+	default:
+		return "", fmt.Errorf("unsupported document format %s", name)
+	}
+}
+
+// Marshal serializes the document in the given format. Both formats share the field names of the JSON encoding
+// and keep the field order of the document types.
+func Marshal(doc *Document, format Format) ([]byte, error) {
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal document: %w", err)
+	}
+
+	if format != FormatYAML {
+		return data, nil
+	}
+
+	// JSON is valid YAML, decoding it into a node keeps the key order while clearing the
+	// node styles switches the output from the JSON flow style to the YAML block style
+	var node yaml.Node
+	if err = yaml.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("convert document to yaml: %w", err)
+	}
+	clearStyle(&node)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(&node); err != nil {
+		return nil, fmt.Errorf("marshal document: %w", err)
+	}
+	if err = encoder.Close(); err != nil {
+		return nil, fmt.Errorf("marshal document: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal parses a document in the given format. Unknown fields are rejected to catch typos in hand written documents
+func Unmarshal(data []byte, format Format) (*Document, error) {
+	if format == FormatYAML {
+		var content any
+		if err := yaml.Unmarshal(data, &content); err != nil {
+			return nil, fmt.Errorf("parse yaml document: %w", err)
+		}
+
+		var err error
+		if data, err = json.Marshal(content); err != nil {
+			return nil, fmt.Errorf("parse yaml document: %w", err)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	doc := &Document{}
+	if err := decoder.Decode(doc); err != nil {
+		return nil, fmt.Errorf("parse document: %w", err)
+	}
+
+	return doc, nil
+}
+
+func clearStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearStyle(child)
+	}
+}
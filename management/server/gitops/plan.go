@@ -0,0 +1,61 @@
+package gitops
+
+// Kind is the type of the object affected by a change
+type Kind string
+
+const (
+	KindGroup           Kind = "group"
+	KindPostureChecks   Kind = "posture_checks"
+	KindPolicy          Kind = "policy"
+	KindRoute           Kind = "route"
+	KindNetwork         Kind = "network"
+	KindNetworkResource Kind = "network_resource"
+	KindNetworkRouter   Kind = "network_router"
+	KindNameserverGroup Kind = "nameserver_group"
+	KindDNSZone         Kind = "dns_zone"
+	KindDNSSettings     Kind = "dns_settings"
+)
+
+// Action is the operation performed on an object
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is a single difference between a document and the account state
+type Change struct {
+	Kind   Kind   `json:"kind"`
+	Action Action `json:"action"`
+	// Name of the object as referenced in the document
+	Name string `json:"name"`
+	// ID of the object in the account. Objects created by a dry run get a temporary ID
+	ID string `json:"id"`
+}
+
+// Plan lists the changes required to bring the account in line with a document
+type Plan struct {
+	// DryRun is true when the changes have been computed but not applied
+	DryRun  bool     `json:"dry_run"`
+	Changes []Change `json:"changes"`
+}
+
+// NewPlan returns an empty plan
+func NewPlan(dryRun bool) *Plan {
+	return &Plan{
+		DryRun:  dryRun,
+		Changes: []Change{},
+	}
+}
+
+// Add records a change
+func (p *Plan) Add(kind Kind, action Action, name, id string) {
+	p.Changes = append(p.Changes, Change{Kind: kind, Action: action, Name: name, ID: id})
+}
+
+// HasChanges returns true if the account differs from the document
+func (p *Plan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
@@ -10,12 +10,14 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	nbdns "github.com/netbirdio/netbird/dns"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/management/server/util"
 	"github.com/netbirdio/netbird/route"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -30,20 +32,7 @@ func (e *GroupLinkError) Error() string {
 
 // CheckGroupPermissions validates if a user has the necessary permissions to view groups
 func (am *DefaultAccountManager) CheckGroupPermissions(ctx context.Context, accountID, userID string) error {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
-		return err
-	}
-
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return status.NewAdminPermissionError()
-	}
-
-	return nil
+	return am.validateUserPermissions(ctx, accountID, userID, permissions.Groups, permissions.Read)
 }
 
 // GetGroup returns a specific group by groupID in an account
@@ -78,17 +67,14 @@ func (am *DefaultAccountManager) SaveGroup(ctx context.Context, accountID, userI
 // Note: This function does not acquire the global lock.
 // It is the caller's responsibility to ensure proper locking is in place before invoking this method.
 func (am *DefaultAccountManager) SaveGroups(ctx context.Context, accountID, userID string, groups []*types.Group) error {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
-		return err
-	}
-
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
+	scopeGroupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		scopeGroupIDs = append(scopeGroupIDs, group.ID)
 	}
 
-	if user.IsRegularUser() {
-		return status.NewAdminPermissionError()
+	err := am.validateUserPermissions(ctx, accountID, userID, permissions.Groups, permissions.Write, scopeGroupIDs...)
+	if err != nil {
+		return err
 	}
 
 	var eventsToStore []func()
@@ -96,6 +82,7 @@ func (am *DefaultAccountManager) SaveGroups(ctx context.Context, accountID, user
 	var updateAccountPeers bool
 
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var strategyAffectsPeers bool
 		groupIDs := make([]string, 0, len(groups))
 		for _, newGroup := range groups {
 			if err = validateNewGroup(ctx, transaction, accountID, newGroup); err != nil {
@@ -105,6 +92,7 @@ func (am *DefaultAccountManager) SaveGroups(ctx context.Context, accountID, user
 			newGroup.AccountID = accountID
 			groupsToSave = append(groupsToSave, newGroup)
 			groupIDs = append(groupIDs, newGroup.ID)
+			strategyAffectsPeers = strategyAffectsPeers || (newGroup.ConnectionStrategy != "" && newGroup.HasPeers())
 
 			events := am.prepareGroupEvents(ctx, transaction, accountID, userID, newGroup)
 			eventsToStore = append(eventsToStore, events...)
@@ -114,6 +102,7 @@ func (am *DefaultAccountManager) SaveGroups(ctx context.Context, accountID, user
 		if err != nil {
 			return err
 		}
+		updateAccountPeers = updateAccountPeers || strategyAffectsPeers
 
 		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
 			return err
@@ -210,19 +199,11 @@ func (am *DefaultAccountManager) DeleteGroup(ctx context.Context, accountID, use
 // If an error occurs while deleting a group, the function skips it and continues deleting other groups.
 // Errors are collected and returned at the end.
 func (am *DefaultAccountManager) DeleteGroups(ctx context.Context, accountID, userID string, groupIDs []string) error {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, permissions.Groups, permissions.Write, groupIDs...)
 	if err != nil {
 		return err
 	}
 
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return status.NewAdminPermissionError()
-	}
-
 	var allErrors error
 	var groupIDsToDelete []string
 	var deletedGroups []*types.Group
@@ -276,6 +257,10 @@ func (am *DefaultAccountManager) GroupAddPeer(ctx context.Context, accountID, gr
 			return err
 		}
 
+		if group.IsDynamic() {
+			return status.Errorf(status.PreconditionFailed, "peers of dynamic group %s are managed by its rule", group.Name)
+		}
+
 		if updated := group.AddPeer(peerID); !updated {
 			return nil
 		}
@@ -358,6 +343,10 @@ func (am *DefaultAccountManager) GroupDeletePeer(ctx context.Context, accountID,
 			return err
 		}
 
+		if group.IsDynamic() {
+			return status.Errorf(status.PreconditionFailed, "peers of dynamic group %s are managed by its rule", group.Name)
+		}
+
 		if updated := group.RemovePeer(peerID); !updated {
 			return nil
 		}
@@ -448,6 +437,25 @@ func validateNewGroup(ctx context.Context, transaction store.Store, accountID st
 		newGroup.ID = xid.New().String()
 	}
 
+	if err := types.ValidateConnectionStrategy(newGroup.ConnectionStrategy); err != nil {
+		return status.Errorf(status.InvalidArgument, "invalid group %s: %v", newGroup.Name, err)
+	}
+
+	if newGroup.IsDynamic() {
+		if newGroup.Issued == types.GroupIssuedJWT || newGroup.Issued == types.GroupIssuedIntegration || newGroup.IsGroupAll() {
+			return status.Errorf(status.InvalidArgument, "%s group %s can't be dynamic", newGroup.Issued, newGroup.Name)
+		}
+
+		peers, err := transaction.GetAccountPeers(ctx, store.LockingStrengthShare, accountID)
+		if err != nil {
+			return err
+		}
+
+		if err = newGroup.ApplyRule(peers); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid rule of group %s: %v", newGroup.Name, err)
+		}
+	}
+
 	for _, peerID := range newGroup.Peers {
 		_, err := transaction.GetPeerByID(ctx, store.LockingStrengthShare, accountID, peerID)
 		if err != nil {
@@ -486,6 +494,10 @@ func validateDeleteGroup(ctx context.Context, transaction store.Store, group *ty
 		return &GroupLinkError{"name server groups", linkedDns.Name}
 	}
 
+	if isLinked, linkedZone := isGroupLinkedToDNSZone(ctx, transaction, group.AccountID, group.ID); isLinked {
+		return &GroupLinkError{"dns zone", linkedZone.Name}
+	}
+
 	if isLinked, linkedPolicy := isGroupLinkedToPolicy(ctx, transaction, group.AccountID, group.ID); isLinked {
 		return &GroupLinkError{"policy", linkedPolicy.Name}
 	}
@@ -553,10 +565,8 @@ func isGroupLinkedToPolicy(ctx context.Context, transaction store.Store, account
 	}
 
 	for _, policy := range policies {
-		for _, rule := range policy.Rules {
-			if slices.Contains(rule.Sources, groupID) || slices.Contains(rule.Destinations, groupID) {
-				return true, policy
-			}
+		if slices.Contains(policy.RuleGroups(), groupID) {
+			return true, policy
 		}
 	}
 	return false, nil
@@ -581,6 +591,23 @@ func isGroupLinkedToDns(ctx context.Context, transaction store.Store, accountID
 	return false, nil
 }
 
+// isGroupLinkedToDNSZone checks if a group is linked to any custom DNS zone in the account.
+func isGroupLinkedToDNSZone(ctx context.Context, transaction store.Store, accountID string, groupID string) (bool, *types.DNSZone) {
+	zones, err := transaction.GetAccountDNSZones(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("error retrieving dns zones while checking group linkage: %v", err)
+		return false, nil
+	}
+
+	for _, zone := range zones {
+		if slices.Contains(zone.Groups, groupID) {
+			return true, zone
+		}
+	}
+
+	return false, nil
+}
+
 // isGroupLinkedToSetupKey checks if a group is linked to any setup key in the account.
 func isGroupLinkedToSetupKey(ctx context.Context, transaction store.Store, accountID string, groupID string) (bool, *types.SetupKey) {
 	setupKeys, err := transaction.GetAccountSetupKeys(ctx, store.LockingStrengthShare, accountID)
@@ -637,11 +664,74 @@ func areGroupChangesAffectPeers(ctx context.Context, transaction store.Store, ac
 		if linked, _ := isGroupLinkedToRoute(ctx, transaction, accountID, groupID); linked {
 			return true, nil
 		}
+		if hasConnectionStrategy(ctx, transaction, accountID, groupID) {
+			return true, nil
+		}
 	}
 
 	return false, nil
 }
 
+// hasConnectionStrategy checks if the stored group changes the connection strategy of its peers.
+func hasConnectionStrategy(ctx context.Context, transaction store.Store, accountID string, groupID string) bool {
+	group, err := transaction.GetGroupByID(ctx, store.LockingStrengthShare, accountID, groupID)
+	if err != nil {
+		return false
+	}
+	return group.ConnectionStrategy != "" && group.ConnectionStrategy != types.ConnectionStrategyDefault
+}
+
+// updatePeerDynamicGroups re-evaluates the dynamic group rules against the peer and updates the group members.
+// It returns true if a membership change affects other peers.
+func updatePeerDynamicGroups(ctx context.Context, transaction store.Store, accountID string, peer *nbpeer.Peer) (bool, error) {
+	groups, err := transaction.GetAccountGroups(ctx, store.LockingStrengthUpdate, accountID)
+	if err != nil {
+		return false, err
+	}
+
+	var changedGroups []*types.Group
+	var changedGroupIDs []string
+	for _, group := range groups {
+		if !group.IsDynamic() {
+			continue
+		}
+
+		rule, err := types.ParseGroupRule(group.Rule)
+		if err != nil {
+			log.WithContext(ctx).Warnf("skipping invalid rule of dynamic group %s: %v", group.ID, err)
+			continue
+		}
+
+		var changed bool
+		if rule.Match(peer) {
+			changed = group.AddPeer(peer.ID)
+		} else {
+			changed = group.RemovePeer(peer.ID)
+		}
+
+		if changed {
+			changedGroups = append(changedGroups, group)
+			changedGroupIDs = append(changedGroupIDs, group.ID)
+		}
+	}
+
+	if len(changedGroups) == 0 {
+		return false, nil
+	}
+
+	log.WithContext(ctx).Debugf("peer %s membership changed in dynamic groups %v", peer.ID, changedGroupIDs)
+
+	if err = transaction.SaveGroups(ctx, store.LockingStrengthUpdate, changedGroups); err != nil {
+		return false, err
+	}
+
+	if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+		return false, err
+	}
+
+	return areGroupChangesAffectPeers(ctx, transaction, accountID, changedGroupIDs)
+}
+
 func (am *DefaultAccountManager) anyGroupHasPeers(account *types.Account, groupIDs []string) bool {
 	for _, groupID := range groupIDs {
 		if group, exists := account.Groups[groupID]; exists && group.HasPeers() {
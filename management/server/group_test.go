@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	nbdns "github.com/netbirdio/netbird/dns"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/route"
@@ -623,7 +624,7 @@ func TestGroupAccountPeersUpdate(t *testing.T) {
 		_, err := manager.CreateRoute(
 			context.Background(), account.Id, newRoute.Network, newRoute.NetworkType, newRoute.Domains, newRoute.Peer,
 			newRoute.PeerGroups, newRoute.Description, newRoute.NetID, newRoute.Masquerade, newRoute.Metric,
-			newRoute.Groups, []string{}, true, userID, newRoute.KeepRoute,
+			newRoute.Groups, []string{}, true, userID, newRoute.KeepRoute, nil,
 		)
 		require.NoError(t, err)
 
@@ -673,4 +674,156 @@ func TestGroupAccountPeersUpdate(t *testing.T) {
 			t.Error("timeout waiting for peerShouldReceiveUpdate")
 		}
 	})
+
+	// Saving a group with a connection strategy should update account peers and send peer update
+	t.Run("saving group with connection strategy", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			peerShouldReceiveUpdate(t, updMsg)
+			close(done)
+		}()
+
+		err := manager.SaveGroup(context.Background(), account.Id, userID, &types.Group{
+			ID:                 "groupE",
+			Name:               "GroupE",
+			Peers:              []string{peer1.ID},
+			ConnectionStrategy: types.ConnectionStrategyRelayOnly,
+		})
+		assert.NoError(t, err)
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Error("timeout waiting for peerShouldReceiveUpdate")
+		}
+	})
+
+	// Removing the connection strategy of a group should update account peers and send peer update
+	t.Run("removing connection strategy of group", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			peerShouldReceiveUpdate(t, updMsg)
+			close(done)
+		}()
+
+		err := manager.SaveGroup(context.Background(), account.Id, userID, &types.Group{
+			ID:    "groupE",
+			Name:  "GroupE",
+			Peers: []string{peer1.ID},
+		})
+		assert.NoError(t, err)
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Error("timeout waiting for peerShouldReceiveUpdate")
+		}
+	})
+
+	t.Run("saving group with invalid connection strategy", func(t *testing.T) {
+		err := manager.SaveGroup(context.Background(), account.Id, userID, &types.Group{
+			ID:                 "groupE",
+			Name:               "GroupE",
+			Peers:              []string{peer1.ID},
+			ConnectionStrategy: "turn_only",
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestDynamicGroupMembership(t *testing.T) {
+	manager, account, peer1, peer2, _ := setupNetworkMapTest(t)
+
+	err := manager.SaveGroup(context.Background(), account.Id, userID, &types.Group{
+		ID:    "dynamicGroup",
+		Name:  "DynamicGroup",
+		Peers: []string{peer1.ID},
+		Rule:  "os == linux",
+	})
+	require.NoError(t, err)
+
+	group, err := manager.GetGroup(context.Background(), account.Id, "dynamicGroup", userID)
+	require.NoError(t, err)
+	assert.Empty(t, group.Peers, "peers of a dynamic group should be evaluated from the rule")
+
+	err = manager.GroupAddPeer(context.Background(), account.Id, "dynamicGroup", peer1.ID)
+	assert.Error(t, err, "peers of a dynamic group shouldn't be added manually")
+
+	_, err = manager.SavePolicy(context.Background(), account.Id, userID, &types.Policy{
+		Enabled: true,
+		Rules: []*types.PolicyRule{
+			{
+				Enabled:       true,
+				Sources:       []string{"dynamicGroup"},
+				Destinations:  []string{"dynamicGroup"},
+				Bidirectional: true,
+				Action:        types.PolicyTrafficActionAccept,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	updMsg := manager.peersUpdateManager.CreateChannel(context.Background(), peer1.ID)
+	t.Cleanup(func() {
+		manager.peersUpdateManager.CloseChannel(context.Background(), peer1.ID)
+	})
+
+	// A peer reporting matching metadata should join the dynamic group and update account peers
+	t.Run("peer meta matching rule", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			peerShouldReceiveUpdate(t, updMsg)
+			close(done)
+		}()
+
+		_, _, _, err := manager.SyncPeer(context.Background(), PeerSync{
+			WireGuardPubKey: peer2.Key,
+			Meta:            nbpeer.PeerSystemMeta{Hostname: peer2.Meta.Hostname, GoOS: "linux"},
+		}, account.Id)
+		require.NoError(t, err)
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Error("timeout waiting for peerShouldReceiveUpdate")
+		}
+
+		group, err := manager.GetGroup(context.Background(), account.Id, "dynamicGroup", userID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{peer2.ID}, group.Peers)
+	})
+
+	// A peer that no longer matches the rule should leave the dynamic group
+	t.Run("peer meta not matching rule", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			peerShouldReceiveUpdate(t, updMsg)
+			close(done)
+		}()
+
+		_, _, _, err := manager.SyncPeer(context.Background(), PeerSync{
+			WireGuardPubKey: peer2.Key,
+			Meta:            nbpeer.PeerSystemMeta{Hostname: peer2.Meta.Hostname, GoOS: "windows"},
+		}, account.Id)
+		require.NoError(t, err)
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Error("timeout waiting for peerShouldReceiveUpdate")
+		}
+
+		group, err := manager.GetGroup(context.Background(), account.Id, "dynamicGroup", userID)
+		require.NoError(t, err)
+		assert.Empty(t, group.Peers)
+	})
+
+	t.Run("saving group with invalid rule", func(t *testing.T) {
+		err := manager.SaveGroup(context.Background(), account.Id, userID, &types.Group{
+			ID:   "dynamicGroup",
+			Name: "DynamicGroup",
+			Rule: "color == blue",
+		})
+		assert.Error(t, err)
+	})
 }
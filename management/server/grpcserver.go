@@ -184,7 +184,7 @@ func (s *GRPCServer) Sync(req *proto.EncryptedMessage, srv proto.ManagementServi
 
 	s.ephemeralManager.OnPeerConnected(ctx, peer)
 
-	s.secretsManager.SetupRefresh(ctx, peer.ID)
+	s.secretsManager.SetupRefresh(ctx, accountID, peer.ID)
 
 	if s.appMetrics != nil {
 		s.appMetrics.GRPCMetrics().CountSyncRequestDuration(time.Since(reqStart))
@@ -388,7 +388,8 @@ func extractPeerMeta(ctx context.Context, meta *proto.PeerSystemMeta) nbpeer.Pee
 			Cloud:    meta.GetEnvironment().GetCloud(),
 			Platform: meta.GetEnvironment().GetPlatform(),
 		},
-		Files: files,
+		Files:         files,
+		IPv6Supported: meta.GetFlags().GetIpv6Supported(),
 	}
 }
 
@@ -478,7 +479,7 @@ func (s *GRPCServer) Login(ctx context.Context, req *proto.EncryptedMessage) (*p
 
 	var relayToken *Token
 	if s.config.Relay != nil && len(s.config.Relay.Addresses) > 0 {
-		relayToken, err = s.secretsManager.GenerateRelayToken()
+		relayToken, err = s.secretsManager.GenerateRelayToken(ctx, peer.AccountID)
 		if err != nil {
 			log.Errorf("failed generating Relay token: %v", err)
 		}
@@ -589,6 +590,8 @@ func toNetbirdConfig(config *Config, turnCredentials *Token, relayToken *Token)
 		if relayToken != nil {
 			relayCfg.TokenPayload = relayToken.Payload
 			relayCfg.TokenSignature = relayToken.Signature
+			relayCfg.LimitsTokenPayload = relayToken.LimitsPayload
+			relayCfg.LimitsTokenSignature = relayToken.LimitsSignature
 		}
 	}
 
@@ -606,12 +609,29 @@ func toNetbirdConfig(config *Config, turnCredentials *Token, relayToken *Token)
 func toPeerConfig(peer *nbpeer.Peer, network *types.Network, dnsName string, dnsResolutionOnRoutingPeerEnabled bool) *proto.PeerConfig {
 	netmask, _ := network.Net.Mask.Size()
 	fqdn := peer.FQDN(dnsName)
-	return &proto.PeerConfig{
+	config := &proto.PeerConfig{
 		Address:                         fmt.Sprintf("%s/%d", peer.IP.String(), netmask), // take it from the network
-		SshConfig:                       &proto.SSHConfig{SshEnabled: peer.SSHEnabled},
+		SshConfig:                       toSSHConfig(peer),
 		Fqdn:                            fqdn,
 		RoutingPeerDnsResolutionEnabled: dnsResolutionOnRoutingPeerEnabled,
 	}
+
+	if ipv6 := network.PeerIPv6(peer.IP); ipv6 != nil {
+		netmaskV6, _ := network.NetV6.Mask.Size()
+		config.AddressV6 = fmt.Sprintf("%s/%d", ipv6.String(), netmaskV6)
+	}
+
+	return config
+}
+
+func toSSHConfig(peer *nbpeer.Peer) *proto.SSHConfig {
+	return &proto.SSHConfig{
+		SshEnabled:                  peer.SSHEnabled,
+		SftpEnabled:                 peer.SSHSFTPEnabled,
+		LocalPortForwardingEnabled:  peer.SSHLocalPortForwardingEnabled,
+		RemotePortForwardingEnabled: peer.SSHRemotePortForwardingEnabled,
+		SessionRecordingEnabled:     peer.SSHSessionRecordingEnabled,
+	}
 }
 
 func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turnCredentials *Token, relayCredentials *Token, networkMap *types.NetworkMap, dnsName string, checks []*posture.Checks, dnsCache *DNSConfigCache, dnsResolutionOnRoutingPeerEnbled bool) *proto.SyncResponse {
@@ -626,16 +646,17 @@ func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turn
 		Checks: toProtocolChecks(ctx, checks),
 	}
 
+	response.PeerConfig.SshConfig.AuthorizedUsersEnforced = networkMap.SSHAuthorizedUsers != nil
 	response.NetworkMap.PeerConfig = response.PeerConfig
 
 	allPeers := make([]*proto.RemotePeerConfig, 0, len(networkMap.Peers)+len(networkMap.OfflinePeers))
-	allPeers = appendRemotePeerConfig(allPeers, networkMap.Peers, dnsName)
+	allPeers = appendRemotePeerConfig(allPeers, networkMap.Peers, networkMap.Network, dnsName, networkMap.SSHAuthorizedUsers, networkMap.ConnectionStrategies)
 	response.RemotePeers = allPeers
 	response.NetworkMap.RemotePeers = allPeers
 	response.RemotePeersIsEmpty = len(allPeers) == 0
 	response.NetworkMap.RemotePeersIsEmpty = response.RemotePeersIsEmpty
 
-	response.NetworkMap.OfflinePeers = appendRemotePeerConfig(nil, networkMap.OfflinePeers, dnsName)
+	response.NetworkMap.OfflinePeers = appendRemotePeerConfig(nil, networkMap.OfflinePeers, networkMap.Network, dnsName, networkMap.SSHAuthorizedUsers, networkMap.ConnectionStrategies)
 
 	firewallRules := toProtocolFirewallRules(networkMap.FirewallRules)
 	response.NetworkMap.FirewallRules = firewallRules
@@ -648,18 +669,39 @@ func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turn
 	return response
 }
 
-func appendRemotePeerConfig(dst []*proto.RemotePeerConfig, peers []*nbpeer.Peer, dnsName string) []*proto.RemotePeerConfig {
+func appendRemotePeerConfig(dst []*proto.RemotePeerConfig, peers []*nbpeer.Peer, network *types.Network, dnsName string, sshAuthorizedUsers map[string][]string, connectionStrategies map[string]string) []*proto.RemotePeerConfig {
 	for _, rPeer := range peers {
+		allowedIPs := []string{fmt.Sprintf(types.AllowedIPsFormat, rPeer.IP.String())}
+		if ipv6 := network.RemotePeerIPv6(rPeer); ipv6 != nil {
+			allowedIPs = append(allowedIPs, fmt.Sprintf(types.AllowedIPsFormatV6, ipv6.String()))
+		}
+
 		dst = append(dst, &proto.RemotePeerConfig{
-			WgPubKey:   rPeer.Key,
-			AllowedIps: []string{rPeer.IP.String() + "/32"},
-			SshConfig:  &proto.SSHConfig{SshPubKey: []byte(rPeer.SSHKey)},
-			Fqdn:       rPeer.FQDN(dnsName),
+			WgPubKey:           rPeer.Key,
+			AllowedIps:         allowedIPs,
+			SshConfig:          &proto.SSHConfig{SshPubKey: []byte(rPeer.SSHKey), AuthorizedUsers: sshAuthorizedUsers[rPeer.ID]},
+			Fqdn:               rPeer.FQDN(dnsName),
+			ConnectionStrategy: toProtocolConnectionStrategy(connectionStrategies[rPeer.ID]),
 		})
 	}
 	return dst
 }
 
+func toProtocolConnectionStrategy(strategy string) proto.RemotePeerConfig_ConnectionStrategy {
+	switch strategy {
+	case types.ConnectionStrategyRelayOnly:
+		return proto.RemotePeerConfig_RELAY_ONLY
+	case types.ConnectionStrategyDirectOnly:
+		return proto.RemotePeerConfig_DIRECT_ONLY
+	case types.ConnectionStrategyPreferRelay:
+		return proto.RemotePeerConfig_PREFER_RELAY
+	case types.ConnectionStrategyLazy:
+		return proto.RemotePeerConfig_LAZY
+	default:
+		return proto.RemotePeerConfig_DEFAULT
+	}
+}
+
 // IsHealthy indicates whether the service is healthy
 func (s *GRPCServer) IsHealthy(ctx context.Context, req *proto.Empty) (*proto.Empty, error) {
 	return &proto.Empty{}, nil
@@ -679,7 +721,7 @@ func (s *GRPCServer) sendInitialSync(ctx context.Context, peerKey wgtypes.Key, p
 
 	var relayToken *Token
 	if s.config.Relay != nil && len(s.config.Relay.Addresses) > 0 {
-		relayToken, err = s.secretsManager.GenerateRelayToken()
+		relayToken, err = s.secretsManager.GenerateRelayToken(ctx, peer.AccountID)
 		if err != nil {
 			log.Errorf("failed generating Relay token: %v", err)
 		}
@@ -847,6 +889,65 @@ func (s *GRPCServer) SyncMeta(ctx context.Context, req *proto.EncryptedMessage)
 	return &proto.Empty{}, nil
 }
 
+// ReportSSHLogins endpoint is used by peers to report the logins to their SSH server,
+// which are recorded as activity events.
+func (s *GRPCServer) ReportSSHLogins(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error) {
+	log.WithContext(ctx).Tracef("SSH logins report from peer [%s]", req.WgPubKey)
+
+	report := &proto.SSHLoginReport{}
+	peerKey, err := s.parseRequest(ctx, req, report)
+	if err != nil {
+		return nil, err
+	}
+
+	logins := make([]nbpeer.SSHLogin, 0, len(report.GetLogins()))
+	for _, login := range report.GetLogins() {
+		logins = append(logins, nbpeer.SSHLogin{
+			RemotePeerKey: login.GetRemotePeerPubKey(),
+			LocalUser:     login.GetLocalUser(),
+			Allowed:       login.GetAllowed(),
+			Reason:        login.GetReason(),
+			Timestamp:     login.GetTimestamp().AsTime(),
+		})
+	}
+
+	if err = s.accountManager.RecordPeerSSHLogins(ctx, peerKey.String(), logins); err != nil {
+		return nil, mapError(ctx, err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// ReportSSHSessions endpoint is used by peers to report the terminal sessions recorded by their SSH server,
+// which are recorded as activity events.
+func (s *GRPCServer) ReportSSHSessions(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error) {
+	log.WithContext(ctx).Tracef("SSH sessions report from peer [%s]", req.WgPubKey)
+
+	report := &proto.SSHSessionReport{}
+	peerKey, err := s.parseRequest(ctx, req, report)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]nbpeer.SSHSession, 0, len(report.GetSessions()))
+	for _, session := range report.GetSessions() {
+		sessions = append(sessions, nbpeer.SSHSession{
+			RemotePeerKey: session.GetRemotePeerPubKey(),
+			LocalUser:     session.GetLocalUser(),
+			StartedAt:     session.GetStartedAt().AsTime(),
+			EndedAt:       session.GetEndedAt().AsTime(),
+			RecordingID:   session.GetRecordingID(),
+			Truncated:     session.GetTruncated(),
+		})
+	}
+
+	if err = s.accountManager.RecordPeerSSHSessions(ctx, peerKey.String(), sessions); err != nil {
+		return nil, mapError(ctx, err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
 // toProtocolChecks converts posture checks to protocol checks.
 func toProtocolChecks(ctx context.Context, postureChecks []*posture.Checks) []*proto.Checks {
 	protoChecks := make([]*proto.Checks, 0, len(postureChecks))
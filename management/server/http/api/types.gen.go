@@ -12,6 +12,38 @@ const (
 	TokenAuthScopes  = "TokenAuth.Scopes"
 )
 
+// Defines values for AccountConfigChangeAction.
+const (
+	AccountConfigChangeActionCreate AccountConfigChangeAction = "create"
+	AccountConfigChangeActionDelete AccountConfigChangeAction = "delete"
+	AccountConfigChangeActionUpdate AccountConfigChangeAction = "update"
+)
+
+// Defines values for AccountConfigChangeKind.
+const (
+	AccountConfigChangeKindDnsSettings     AccountConfigChangeKind = "dns_settings"
+	AccountConfigChangeKindDnsZone         AccountConfigChangeKind = "dns_zone"
+	AccountConfigChangeKindGroup           AccountConfigChangeKind = "group"
+	AccountConfigChangeKindNameserverGroup AccountConfigChangeKind = "nameserver_group"
+	AccountConfigChangeKindNetwork         AccountConfigChangeKind = "network"
+	AccountConfigChangeKindNetworkResource AccountConfigChangeKind = "network_resource"
+	AccountConfigChangeKindNetworkRouter   AccountConfigChangeKind = "network_router"
+	AccountConfigChangeKindPolicy          AccountConfigChangeKind = "policy"
+	AccountConfigChangeKindPostureChecks   AccountConfigChangeKind = "posture_checks"
+	AccountConfigChangeKindRoute           AccountConfigChangeKind = "route"
+)
+
+// Defines values for DNSRecordType.
+const (
+	DNSRecordTypeA     DNSRecordType = "A"
+	DNSRecordTypeAAAA  DNSRecordType = "AAAA"
+	DNSRecordTypeCNAME DNSRecordType = "CNAME"
+	DNSRecordTypeMX    DNSRecordType = "MX"
+	DNSRecordTypePTR   DNSRecordType = "PTR"
+	DNSRecordTypeSRV   DNSRecordType = "SRV"
+	DNSRecordTypeTXT   DNSRecordType = "TXT"
+)
+
 // Defines values for EventActivityCode.
 const (
 	EventActivityCodeAccountCreate                            EventActivityCode = "account.create"
@@ -63,12 +95,33 @@ const (
 	EventActivityCodeUserUnblock                              EventActivityCode = "user.unblock"
 )
 
+// Defines values for EventSinkRequestType.
+const (
+	EventSinkRequestTypeSyslog  EventSinkRequestType = "syslog"
+	EventSinkRequestTypeWebhook EventSinkRequestType = "webhook"
+)
+
+// Defines values for EventSinkType.
+const (
+	EventSinkTypeSyslog  EventSinkType = "syslog"
+	EventSinkTypeWebhook EventSinkType = "webhook"
+)
+
 // Defines values for GeoLocationCheckAction.
 const (
 	GeoLocationCheckActionAllow GeoLocationCheckAction = "allow"
 	GeoLocationCheckActionDeny  GeoLocationCheckAction = "deny"
 )
 
+// Defines values for GroupConnectionStrategy.
+const (
+	GroupConnectionStrategyDefault     GroupConnectionStrategy = "default"
+	GroupConnectionStrategyDirectOnly  GroupConnectionStrategy = "direct_only"
+	GroupConnectionStrategyLazy        GroupConnectionStrategy = "lazy"
+	GroupConnectionStrategyPreferRelay GroupConnectionStrategy = "prefer_relay"
+	GroupConnectionStrategyRelayOnly   GroupConnectionStrategy = "relay_only"
+)
+
 // Defines values for GroupIssued.
 const (
 	GroupIssuedApi         GroupIssued = "api"
@@ -85,7 +138,9 @@ const (
 
 // Defines values for NameserverNsType.
 const (
-	NameserverNsTypeUdp NameserverNsType = "udp"
+	NameserverNsTypeHttps NameserverNsType = "https"
+	NameserverNsTypeTls   NameserverNsType = "tls"
+	NameserverNsTypeUdp   NameserverNsType = "udp"
 )
 
 // Defines values for NetworkResourceType.
@@ -101,6 +156,14 @@ const (
 	PeerNetworkRangeCheckActionDeny  PeerNetworkRangeCheckAction = "deny"
 )
 
+// Defines values for PolicyExplainRequestProtocol.
+const (
+	PolicyExplainRequestProtocolAll  PolicyExplainRequestProtocol = "all"
+	PolicyExplainRequestProtocolIcmp PolicyExplainRequestProtocol = "icmp"
+	PolicyExplainRequestProtocolTcp  PolicyExplainRequestProtocol = "tcp"
+	PolicyExplainRequestProtocolUdp  PolicyExplainRequestProtocol = "udp"
+)
+
 // Defines values for PolicyRuleAction.
 const (
 	PolicyRuleActionAccept PolicyRuleAction = "accept"
@@ -115,6 +178,12 @@ const (
 	PolicyRuleProtocolUdp  PolicyRuleProtocol = "udp"
 )
 
+// Defines values for PolicyRuleMatchAction.
+const (
+	PolicyRuleMatchActionAccept PolicyRuleMatchAction = "accept"
+	PolicyRuleMatchActionDrop   PolicyRuleMatchAction = "drop"
+)
+
 // Defines values for PolicyRuleMinimumAction.
 const (
 	PolicyRuleMinimumActionAccept PolicyRuleMinimumAction = "accept"
@@ -129,6 +198,17 @@ const (
 	PolicyRuleMinimumProtocolUdp  PolicyRuleMinimumProtocol = "udp"
 )
 
+// Defines values for PolicyRuleTimeWindowDays.
+const (
+	PolicyRuleTimeWindowDaysFriday    PolicyRuleTimeWindowDays = "friday"
+	PolicyRuleTimeWindowDaysMonday    PolicyRuleTimeWindowDays = "monday"
+	PolicyRuleTimeWindowDaysSaturday  PolicyRuleTimeWindowDays = "saturday"
+	PolicyRuleTimeWindowDaysSunday    PolicyRuleTimeWindowDays = "sunday"
+	PolicyRuleTimeWindowDaysThursday  PolicyRuleTimeWindowDays = "thursday"
+	PolicyRuleTimeWindowDaysTuesday   PolicyRuleTimeWindowDays = "tuesday"
+	PolicyRuleTimeWindowDaysWednesday PolicyRuleTimeWindowDays = "wednesday"
+)
+
 // Defines values for PolicyRuleUpdateAction.
 const (
 	PolicyRuleUpdateActionAccept PolicyRuleUpdateAction = "accept"
@@ -150,6 +230,27 @@ const (
 	ResourceTypeSubnet ResourceType = "subnet"
 )
 
+// Defines values for RolePermissionModule.
+const (
+	RolePermissionModuleDns           RolePermissionModule = "dns"
+	RolePermissionModuleEvents        RolePermissionModule = "events"
+	RolePermissionModuleGroups        RolePermissionModule = "groups"
+	RolePermissionModuleNetworks      RolePermissionModule = "networks"
+	RolePermissionModulePeers         RolePermissionModule = "peers"
+	RolePermissionModulePolicies      RolePermissionModule = "policies"
+	RolePermissionModulePostureChecks RolePermissionModule = "posture_checks"
+	RolePermissionModuleRoutes        RolePermissionModule = "routes"
+	RolePermissionModuleSetupKeys     RolePermissionModule = "setup_keys"
+	RolePermissionModuleUsers         RolePermissionModule = "users"
+)
+
+// Defines values for RouteHealthCheckType.
+const (
+	RouteHealthCheckTypeHttp RouteHealthCheckType = "http"
+	RouteHealthCheckTypeIcmp RouteHealthCheckType = "icmp"
+	RouteHealthCheckTypeTcp  RouteHealthCheckType = "tcp"
+)
+
 // Defines values for UserStatus.
 const (
 	UserStatusActive  UserStatus = "active"
@@ -164,6 +265,18 @@ const (
 	UserPermissionsDashboardViewLimited UserPermissionsDashboardView = "limited"
 )
 
+// Defines values for GetApiAccountsAccountIdConfigParamsFormat.
+const (
+	GetApiAccountsAccountIdConfigParamsFormatJson GetApiAccountsAccountIdConfigParamsFormat = "json"
+	GetApiAccountsAccountIdConfigParamsFormatYaml GetApiAccountsAccountIdConfigParamsFormat = "yaml"
+)
+
+// Defines values for GetApiEventsParamsOrder.
+const (
+	GetApiEventsParamsOrderAsc  GetApiEventsParamsOrder = "asc"
+	GetApiEventsParamsOrderDesc GetApiEventsParamsOrder = "desc"
+)
+
 // AccessiblePeer defines model for AccessiblePeer.
 type AccessiblePeer struct {
 	// CityName Commonly used English name of the city
@@ -207,6 +320,39 @@ type Account struct {
 	Settings AccountSettings `json:"settings"`
 }
 
+// AccountConfig Declarative configuration of an account. Objects reference each other by name, see the documentation of the netbird-mgmt config command for the format.
+type AccountConfig map[string]interface{}
+
+// AccountConfigChange defines model for AccountConfigChange.
+type AccountConfigChange struct {
+	// Action Operation performed on the object
+	Action AccountConfigChangeAction `json:"action"`
+
+	// Id ID of the object. Objects created by a dry run get a temporary ID
+	Id string `json:"id"`
+
+	// Kind Type of the changed object
+	Kind AccountConfigChangeKind `json:"kind"`
+
+	// Name Name of the object as referenced in the configuration
+	Name string `json:"name"`
+}
+
+// AccountConfigChangeAction Operation performed on the object
+type AccountConfigChangeAction string
+
+// AccountConfigChangeKind Type of the changed object
+type AccountConfigChangeKind string
+
+// AccountConfigPlan defines model for AccountConfigPlan.
+type AccountConfigPlan struct {
+	// Changes Changes required to bring the account in line with the configuration
+	Changes []AccountConfigChange `json:"changes"`
+
+	// DryRun Indicates that the changes have been computed but not applied
+	DryRun bool `json:"dry_run"`
+}
+
 // AccountExtraSettings defines model for AccountExtraSettings.
 type AccountExtraSettings struct {
 	// PeerApprovalEnabled (Cloud only) Enables or disables peer approval globally. If enabled, all peers added will be in pending state until approved by an admin.
@@ -225,6 +371,9 @@ type AccountSettings struct {
 	// GroupsPropagationEnabled Allows propagate the new user auto groups to peers that belongs to the user
 	GroupsPropagationEnabled *bool `json:"groups_propagation_enabled,omitempty"`
 
+	// Ipv6Enabled Assigns each peer an IPv6 address from a unique local prefix of the account network in addition to its IPv4 address
+	Ipv6Enabled *bool `json:"ipv6_enabled,omitempty"`
+
 	// JwtAllowGroups List of groups to which users are allowed access
 	JwtAllowGroups *[]string `json:"jwt_allow_groups,omitempty"`
 
@@ -234,6 +383,18 @@ type AccountSettings struct {
 	// JwtGroupsEnabled Allows extract groups from JWT claim and add it to account groups.
 	JwtGroupsEnabled *bool `json:"jwt_groups_enabled,omitempty"`
 
+	// PeerApprovalRequired Puts newly registered peers into a pending state until an administrator approves them. Pending peers get no access to the network.
+	PeerApprovalRequired *bool `json:"peer_approval_required,omitempty"`
+
+	// PeerApprovalSetupKeys Limits the peer approval to the peers registered with the given setup key IDs. All new peers require approval if neither setup keys nor users are set.
+	PeerApprovalSetupKeys *[]string `json:"peer_approval_setup_keys,omitempty"`
+
+	// PeerApprovalTimeout Period of time after which a pending peer is rejected and removed (seconds). Pending peers don't expire when 0.
+	PeerApprovalTimeout *int `json:"peer_approval_timeout,omitempty"`
+
+	// PeerApprovalUsers Limits the peer approval to the peers registered by the given user IDs. All new peers require approval if neither setup keys nor users are set.
+	PeerApprovalUsers *[]string `json:"peer_approval_users,omitempty"`
+
 	// PeerInactivityExpiration Period of time of inactivity after which peer session expires (seconds).
 	PeerInactivityExpiration int `json:"peer_inactivity_expiration"`
 
@@ -246,6 +407,12 @@ type AccountSettings struct {
 	// PeerLoginExpirationEnabled Enables or disables peer login expiration globally. After peer's login has expired the user has to log in (authenticate). Applies only to peers that were added by a user (interactive SSO login).
 	PeerLoginExpirationEnabled bool `json:"peer_login_expiration_enabled"`
 
+	// RelayBytesPerSecond Limits the bandwidth of each peer of the account on the relay servers (bytes per second). It can only lower the per-peer limit of the relay servers, which applies alone when 0.
+	RelayBytesPerSecond *int64 `json:"relay_bytes_per_second,omitempty"`
+
+	// RelayMessagesPerSecond Limits the message rate of each peer of the account on the relay servers. It can only lower the per-peer limit of the relay servers, which applies alone when 0.
+	RelayMessagesPerSecond *int64 `json:"relay_messages_per_second,omitempty"`
+
 	// RegularUsersViewBlocked Allows blocking regular users from viewing parts of the system.
 	RegularUsersViewBlocked bool `json:"regular_users_view_blocked"`
 
@@ -319,12 +486,75 @@ type CreateSetupKeyRequest struct {
 	UsageLimit int `json:"usage_limit"`
 }
 
+// DNSRecord defines model for DNSRecord.
+type DNSRecord struct {
+	// Content Record data in zone file format, e.g. "10 mail.example.com" for MX or "10 5 443 web.example.com" for SRV records. TXT content can be plain text.
+	Content string `json:"content"`
+
+	// Name Fully qualified name of the record, the zone domain or a subdomain of it. The first label can be a wildcard.
+	Name string `json:"name"`
+
+	// Ttl Time to live of the record in seconds
+	Ttl int `json:"ttl"`
+
+	// Type Record type
+	Type DNSRecordType `json:"type"`
+}
+
+// DNSRecordType Record type
+type DNSRecordType string
+
 // DNSSettings defines model for DNSSettings.
 type DNSSettings struct {
 	// DisabledManagementGroups Groups whose DNS management is disabled
 	DisabledManagementGroups []string `json:"disabled_management_groups"`
 }
 
+// DNSZone defines model for DNSZone.
+type DNSZone struct {
+	// Description Description of the zone
+	Description string `json:"description"`
+
+	// Enabled Zone status
+	Enabled bool `json:"enabled"`
+
+	// Groups Distribution group IDs that defines group of peers that will resolve this zone
+	Groups []string `json:"groups"`
+
+	// Id DNS zone ID
+	Id string `json:"id"`
+
+	// Name Domain of the zone
+	Name string `json:"name"`
+
+	// Records Records of the zone
+	Records []DNSRecord `json:"records"`
+
+	// SearchDomainsEnabled Defines if the zone is added to the search domains of the peers
+	SearchDomainsEnabled bool `json:"search_domains_enabled"`
+}
+
+// DNSZoneRequest defines model for DNSZoneRequest.
+type DNSZoneRequest struct {
+	// Description Description of the zone
+	Description string `json:"description"`
+
+	// Enabled Zone status
+	Enabled bool `json:"enabled"`
+
+	// Groups Distribution group IDs that defines group of peers that will resolve this zone
+	Groups []string `json:"groups"`
+
+	// Name Domain of the zone
+	Name string `json:"name"`
+
+	// Records Records of the zone
+	Records []DNSRecord `json:"records"`
+
+	// SearchDomainsEnabled Defines if the zone is added to the search domains of the peers
+	SearchDomainsEnabled bool `json:"search_domains_enabled"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	// Activity The activity that occurred during the event
@@ -358,6 +588,76 @@ type Event struct {
 // EventActivityCode The string code of the activity that occurred during the event
 type EventActivityCode string
 
+// EventSink defines model for EventSink.
+type EventSink struct {
+	// Activities Activity codes of the forwarded events. Events of all activities are forwarded when empty
+	Activities []string `json:"activities"`
+
+	// Enabled Defines if the events are streamed to the sink
+	Enabled bool `json:"enabled"`
+
+	// Id Event sink ID
+	Id string `json:"id"`
+
+	// Name Event sink name
+	Name   string           `json:"name"`
+	Syslog *EventSinkSyslog `json:"syslog,omitempty"`
+
+	// Type Type of the destination
+	Type    EventSinkType     `json:"type"`
+	Webhook *EventSinkWebhook `json:"webhook,omitempty"`
+}
+
+// EventSinkType Type of the destination
+type EventSinkType string
+
+// EventSinkRequest defines model for EventSinkRequest.
+type EventSinkRequest struct {
+	// Activities Activity codes of the forwarded events. Events of all activities are forwarded when empty
+	Activities *[]string `json:"activities,omitempty"`
+
+	// Enabled Defines if the events are streamed to the sink
+	Enabled bool `json:"enabled"`
+
+	// Name Event sink name
+	Name   string           `json:"name"`
+	Syslog *EventSinkSyslog `json:"syslog,omitempty"`
+
+	// Type Type of the destination
+	Type    EventSinkRequestType `json:"type"`
+	Webhook *EventSinkWebhook    `json:"webhook,omitempty"`
+}
+
+// EventSinkRequestType Type of the destination
+type EventSinkRequestType string
+
+// EventSinkSyslog defines model for EventSinkSyslog.
+type EventSinkSyslog struct {
+	// Address Address of the syslog server in the host:port format
+	Address string `json:"address"`
+
+	// AppName Application name reported in the syslog messages, defaults to netbird
+	AppName *string `json:"app_name,omitempty"`
+
+	// Facility Facility code of the syslog messages, defaults to 13 (log audit)
+	Facility *int `json:"facility,omitempty"`
+
+	// Network Transport of the syslog messages, one of udp, tcp or tcp+tls. Defaults to udp
+	Network *string `json:"network,omitempty"`
+}
+
+// EventSinkWebhook defines model for EventSinkWebhook.
+type EventSinkWebhook struct {
+	// Headers Headers added to every request
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Secret Secret used to sign the request body with HMAC-SHA256. It is never returned, an update without a secret keeps the current one
+	Secret *string `json:"secret,omitempty"`
+
+	// Url URL the events are posted to
+	Url string `json:"url"`
+}
+
 // GeoLocationCheck Posture check for geo location
 type GeoLocationCheck struct {
 	// Action Action to take upon policy match
@@ -372,6 +672,11 @@ type GeoLocationCheckAction string
 
 // Group defines model for Group.
 type Group struct {
+	// ConnectionStrategy How the peers of the group connect to other peers. relay_only connects through the relay only, direct_only never
+	// relays, prefer_relay uses ICE only when the relay is not available and lazy connects only when traffic shows up.
+	// The most restrictive strategy of the groups of both peers applies to a connection.
+	ConnectionStrategy *GroupConnectionStrategy `json:"connection_strategy,omitempty"`
+
 	// Id Group ID
 	Id string `json:"id"`
 
@@ -390,13 +695,26 @@ type Group struct {
 
 	// ResourcesCount Count of resources associated to the group
 	ResourcesCount int `json:"resources_count"`
+
+	// Rule Rule over peer attributes that defines the peers of a dynamic group. Peers of a dynamic group are managed by the rule.
+	Rule *string `json:"rule,omitempty"`
 }
 
+// GroupConnectionStrategy How the peers of the group connect to other peers. relay_only connects through the relay only, direct_only never
+// relays, prefer_relay uses ICE only when the relay is not available and lazy connects only when traffic shows up.
+// The most restrictive strategy of the groups of both peers applies to a connection.
+type GroupConnectionStrategy string
+
 // GroupIssued How the group was issued (api, integration, jwt)
 type GroupIssued string
 
 // GroupMinimum defines model for GroupMinimum.
 type GroupMinimum struct {
+	// ConnectionStrategy How the peers of the group connect to other peers. relay_only connects through the relay only, direct_only never
+	// relays, prefer_relay uses ICE only when the relay is not available and lazy connects only when traffic shows up.
+	// The most restrictive strategy of the groups of both peers applies to a connection.
+	ConnectionStrategy *GroupConnectionStrategy `json:"connection_strategy,omitempty"`
+
 	// Id Group ID
 	Id string `json:"id"`
 
@@ -411,6 +729,9 @@ type GroupMinimum struct {
 
 	// ResourcesCount Count of resources associated to the group
 	ResourcesCount int `json:"resources_count"`
+
+	// Rule Rule over peer attributes that defines the peers of a dynamic group. Peers of a dynamic group are managed by the rule.
+	Rule *string `json:"rule,omitempty"`
 }
 
 // GroupMinimumIssued How the group was issued (api, integration, jwt)
@@ -418,12 +739,23 @@ type GroupMinimumIssued string
 
 // GroupRequest defines model for GroupRequest.
 type GroupRequest struct {
+	// ConnectionStrategy How the peers of the group connect to other peers. relay_only connects through the relay only, direct_only never
+	// relays, prefer_relay uses ICE only when the relay is not available and lazy connects only when traffic shows up.
+	// The most restrictive strategy of the groups of both peers applies to a connection.
+	ConnectionStrategy *GroupConnectionStrategy `json:"connection_strategy,omitempty"`
+
 	// Name Group name identifier
 	Name string `json:"name"`
 
 	// Peers List of peers ids
 	Peers     *[]string   `json:"peers,omitempty"`
 	Resources *[]Resource `json:"resources,omitempty"`
+
+	// Rule Rule over peer attributes that makes the group dynamic, e.g. `os == linux && hostname =~ "web-*"`.
+	// Supported attributes are os, os_version, kernel_version, version, hostname, country, city, user, ephemeral,
+	// product, manufacturer, cloud and platform. The peers of a dynamic group are evaluated from the rule and the
+	// peers field is ignored. An empty rule turns the group into a static group.
+	Rule *string `json:"rule,omitempty"`
 }
 
 // Location Describe geographical location information
@@ -452,17 +784,23 @@ type NBVersionCheck = MinVersionCheck
 
 // Nameserver defines model for Nameserver.
 type Nameserver struct {
+	// Hostname TLS server name used to verify the certificate of tls and https nameservers. The IP address is verified if it is empty.
+	Hostname *string `json:"hostname,omitempty"`
+
 	// Ip Nameserver IP
 	Ip string `json:"ip"`
 
-	// NsType Nameserver Type
+	// NsType Nameserver Type. tls and https nameservers resolve queries over DNS-over-TLS and DNS-over-HTTPS.
 	NsType NameserverNsType `json:"ns_type"`
 
+	// Path URL path of https nameservers, /dns-query if empty
+	Path *string `json:"path,omitempty"`
+
 	// Port Nameserver Port
 	Port int `json:"port"`
 }
 
-// NameserverNsType Nameserver Type
+// NameserverNsType Nameserver Type. tls and https nameservers resolve queries over DNS-over-TLS and DNS-over-HTTPS.
 type NameserverNsType string
 
 // NameserverGroup defines model for NameserverGroup.
@@ -620,6 +958,9 @@ type NetworkRouter struct {
 	// Enabled Network router status
 	Enabled bool `json:"enabled"`
 
+	// HealthCheck Active probe the clients run through every routing peer to fail over to a healthy one
+	HealthCheck *RouteHealthCheck `json:"health_check,omitempty"`
+
 	// Id Network Router Id
 	Id string `json:"id"`
 
@@ -641,6 +982,9 @@ type NetworkRouterRequest struct {
 	// Enabled Network router status
 	Enabled bool `json:"enabled"`
 
+	// HealthCheck Active probe the clients run through every routing peer to fail over to a healthy one
+	HealthCheck *RouteHealthCheck `json:"health_check,omitempty"`
+
 	// Masquerade Indicate if peer should masquerade traffic to this route's prefix
 	Masquerade bool `json:"masquerade"`
 
@@ -740,6 +1084,18 @@ type Peer struct {
 	// SshEnabled Indicates whether SSH server is enabled on this peer
 	SshEnabled bool `json:"ssh_enabled"`
 
+	// SshLocalPortForwardingEnabled Indicates whether the SSH server of the peer allows local port forwarding
+	SshLocalPortForwardingEnabled bool `json:"ssh_local_port_forwarding_enabled"`
+
+	// SshRemotePortForwardingEnabled Indicates whether the SSH server of the peer allows remote port forwarding to its loopback interface
+	SshRemotePortForwardingEnabled bool `json:"ssh_remote_port_forwarding_enabled"`
+
+	// SshSessionRecordingEnabled Indicates whether the SSH server of the peer records the terminal sessions
+	SshSessionRecordingEnabled bool `json:"ssh_session_recording_enabled"`
+
+	// SshSftpEnabled Indicates whether the SSH server of the peer serves SFTP
+	SshSftpEnabled bool `json:"ssh_sftp_enabled"`
+
 	// UiVersion Peer's desktop UI version
 	UiVersion string `json:"ui_version"`
 
@@ -750,6 +1106,21 @@ type Peer struct {
 	Version string `json:"version"`
 }
 
+// PeerAccessPair defines model for PeerAccessPair.
+type PeerAccessPair struct {
+	// PeerId Peer ID
+	PeerId string `json:"peer_id"`
+
+	// PeerName Peer name
+	PeerName string `json:"peer_name"`
+
+	// RemotePeerId Remote peer ID
+	RemotePeerId string `json:"remote_peer_id"`
+
+	// RemotePeerName Remote peer name
+	RemotePeerName string `json:"remote_peer_name"`
+}
+
 // PeerBatch defines model for PeerBatch.
 type PeerBatch struct {
 	// AccessiblePeersCount Number of accessible peers
@@ -860,6 +1231,51 @@ type PeerRequest struct {
 	LoginExpirationEnabled      bool   `json:"login_expiration_enabled"`
 	Name                        string `json:"name"`
 	SshEnabled                  bool   `json:"ssh_enabled"`
+
+	// SshLocalPortForwardingEnabled Indicates whether the SSH server of the peer allows local port forwarding. The current value is kept if omitted
+	SshLocalPortForwardingEnabled *bool `json:"ssh_local_port_forwarding_enabled,omitempty"`
+
+	// SshRemotePortForwardingEnabled Indicates whether the SSH server of the peer allows remote port forwarding to its loopback interface. The current value is kept if omitted
+	SshRemotePortForwardingEnabled *bool `json:"ssh_remote_port_forwarding_enabled,omitempty"`
+
+	// SshSessionRecordingEnabled Indicates whether the SSH server of the peer records the terminal sessions. The current value is kept if omitted
+	SshSessionRecordingEnabled *bool `json:"ssh_session_recording_enabled,omitempty"`
+
+	// SshSftpEnabled Indicates whether the SSH server of the peer serves SFTP. The current value is kept if omitted
+	SshSftpEnabled *bool `json:"ssh_sftp_enabled,omitempty"`
+}
+
+// PendingPeer defines model for PendingPeer.
+type PendingPeer struct {
+	// Connected Peer to Management connection status
+	Connected bool `json:"connected"`
+
+	// ConnectionIp Peer's public connection IP address
+	ConnectionIp string `json:"connection_ip"`
+
+	// CreatedAt Time the peer registered and started waiting for approval
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt Time the peer is rejected if it isn't approved until then. Not set if pending peers don't expire
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Hostname Hostname of the machine
+	Hostname string `json:"hostname"`
+
+	// Id Peer ID
+	Id string `json:"id"`
+
+	// Ip Peer's IP address
+	Ip string `json:"ip"`
+
+	// Name Peer's hostname
+	Name string `json:"name"`
+
+	// Os Peer's operating system and version
+	Os string `json:"os"`
+
+	// UserId User ID of the user that enrolled this peer, empty if the peer was added with a setup key
+	UserId string `json:"user_id"`
 }
 
 // PersonalAccessToken defines model for PersonalAccessToken.
@@ -939,6 +1355,62 @@ type PolicyCreate struct {
 	SourcePostureChecks *[]string `json:"source_posture_checks,omitempty"`
 }
 
+// PolicyDryRunRequest defines model for PolicyDryRunRequest.
+type PolicyDryRunRequest struct {
+	Policy *PolicyUpdate `json:"policy,omitempty"`
+
+	// PolicyId ID of the existing policy to replace or remove. A new policy is simulated when omitted
+	PolicyId *string `json:"policy_id,omitempty"`
+}
+
+// PolicyDryRunResponse defines model for PolicyDryRunResponse.
+type PolicyDryRunResponse struct {
+	// Gained Peer pairs that gain access with the proposed change
+	Gained []PeerAccessPair `json:"gained"`
+
+	// Lost Peer pairs that lose access with the proposed change
+	Lost []PeerAccessPair `json:"lost"`
+}
+
+// PolicyExplainRequest defines model for PolicyExplainRequest.
+type PolicyExplainRequest struct {
+	// DestinationPeerId ID of the peer receiving the connection. Either destination_peer_id or destination_resource_id is required
+	DestinationPeerId *string `json:"destination_peer_id,omitempty"`
+
+	// DestinationResourceId ID of the network resource receiving the connection. Either destination_peer_id or destination_resource_id is required
+	DestinationResourceId *string `json:"destination_resource_id,omitempty"`
+
+	// Port Destination port of the connection. Ignored for the all and icmp protocols
+	Port *int `json:"port,omitempty"`
+
+	// Protocol Protocol of the connection
+	Protocol PolicyExplainRequestProtocol `json:"protocol"`
+
+	// SourcePeerId ID of the peer initiating the connection
+	SourcePeerId string `json:"source_peer_id"`
+}
+
+// PolicyExplainRequestProtocol Protocol of the connection
+type PolicyExplainRequestProtocol string
+
+// PolicyExplanation defines model for PolicyExplanation.
+type PolicyExplanation struct {
+	// Allowed Final verdict, true if the connection is allowed
+	Allowed bool `json:"allowed"`
+
+	// FailedPostureChecks Posture check IDs failed by the peers of the matched rules
+	FailedPostureChecks []string `json:"failed_posture_checks"`
+
+	// MatchedRules Active policy rules matching the connection peers, protocol and port
+	MatchedRules []PolicyRuleMatch `json:"matched_rules"`
+
+	// Reason Explanation of the verdict
+	Reason string `json:"reason"`
+
+	// RouteFirewallRules Firewall rules the routing peers of the destination resource apply to the source peer
+	RouteFirewallRules []RouteFirewallRule `json:"route_firewall_rules"`
+}
+
 // PolicyMinimum defines model for PolicyMinimum.
 type PolicyMinimum struct {
 	// Description Policy friendly description
@@ -982,11 +1454,15 @@ type PolicyRule struct {
 	Ports *[]string `json:"ports,omitempty"`
 
 	// Protocol Policy rule type of the traffic
-	Protocol       PolicyRuleProtocol `json:"protocol"`
-	SourceResource *Resource          `json:"sourceResource,omitempty"`
+	Protocol       PolicyRuleProtocol  `json:"protocol"`
+	Schedule       *PolicyRuleSchedule `json:"schedule,omitempty"`
+	SourceResource *Resource           `json:"sourceResource,omitempty"`
 
 	// Sources Policy rule source group IDs
 	Sources *[]GroupMinimum `json:"sources,omitempty"`
+
+	// SshAuthorizations Local OS users the source peers may log in as with the NetBird SSH server of the destination peers. When any rule applying to a destination peer has SSH authorizations, logins not authorized by them are denied
+	SshAuthorizations *[]PolicyRuleSSHAuthorization `json:"ssh_authorizations,omitempty"`
 }
 
 // PolicyRuleAction Policy rule accept or drops packets
@@ -995,6 +1471,30 @@ type PolicyRuleAction string
 // PolicyRuleProtocol Policy rule type of the traffic
 type PolicyRuleProtocol string
 
+// PolicyRuleMatch defines model for PolicyRuleMatch.
+type PolicyRuleMatch struct {
+	// Action Policy rule accept or drops packets
+	Action PolicyRuleMatchAction `json:"action"`
+
+	// FailedPostureChecks Posture check IDs of the policy that the rule source peer doesn't pass
+	FailedPostureChecks []string `json:"failed_posture_checks"`
+
+	// PolicyId Policy ID
+	PolicyId string `json:"policy_id"`
+
+	// PolicyName Policy name identifier
+	PolicyName string `json:"policy_name"`
+
+	// RuleId Policy rule ID
+	RuleId string `json:"rule_id"`
+
+	// RuleName Policy rule name identifier
+	RuleName string `json:"rule_name"`
+}
+
+// PolicyRuleMatchAction Policy rule accept or drops packets
+type PolicyRuleMatchAction string
+
 // PolicyRuleMinimum defines model for PolicyRuleMinimum.
 type PolicyRuleMinimum struct {
 	// Action Policy rule accept or drops packets
@@ -1020,6 +1520,10 @@ type PolicyRuleMinimum struct {
 
 	// Protocol Policy rule type of the traffic
 	Protocol PolicyRuleMinimumProtocol `json:"protocol"`
+	Schedule *PolicyRuleSchedule       `json:"schedule,omitempty"`
+
+	// SshAuthorizations Local OS users the source peers may log in as with the NetBird SSH server of the destination peers. When any rule applying to a destination peer has SSH authorizations, logins not authorized by them are denied
+	SshAuthorizations *[]PolicyRuleSSHAuthorization `json:"ssh_authorizations,omitempty"`
 }
 
 // PolicyRuleMinimumAction Policy rule accept or drops packets
@@ -1028,6 +1532,48 @@ type PolicyRuleMinimumAction string
 // PolicyRuleMinimumProtocol Policy rule type of the traffic
 type PolicyRuleMinimumProtocol string
 
+// PolicyRuleSSHAuthorization Binds source peers of a policy rule to the local OS users they may log in as
+type PolicyRuleSSHAuthorization struct {
+	// Groups Source group IDs the authorization applies to
+	Groups *[]string `json:"groups,omitempty"`
+
+	// LocalUsers Local OS users the peers may log in as. The `${user}` placeholder maps the NetBird user owning the source peer to their own local OS user, derived from the user email or name
+	LocalUsers []string `json:"local_users"`
+
+	// Users IDs of the NetBird users whose source peers the authorization applies to. The authorization applies to all source peers of the rule when both groups and users are empty
+	Users *[]string `json:"users,omitempty"`
+}
+
+// PolicyRuleSchedule Recurring time windows and an optional validity period in which a policy rule is applied
+type PolicyRuleSchedule struct {
+	// EndsAt Time from which the rule is not applied anymore
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+
+	// StartsAt Time before which the rule is not applied
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+
+	// Timezone IANA time zone name the windows are evaluated in. UTC is used when empty
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Windows Recurring weekly windows. The rule is applied for the whole validity period when empty
+	Windows *[]PolicyRuleTimeWindow `json:"windows,omitempty"`
+}
+
+// PolicyRuleTimeWindow Recurring weekly time window
+type PolicyRuleTimeWindow struct {
+	// Days Days of the week the window starts on. Every day if empty
+	Days *[]PolicyRuleTimeWindowDays `json:"days,omitempty"`
+
+	// End Window end time in HH:MM format. If it is not after the start time, the window ends on the next day
+	End string `json:"end"`
+
+	// Start Window start time in HH:MM format
+	Start string `json:"start"`
+}
+
+// PolicyRuleTimeWindowDays defines model for PolicyRuleTimeWindow.Days.
+type PolicyRuleTimeWindowDays string
+
 // PolicyRuleUpdate defines model for PolicyRuleUpdate.
 type PolicyRuleUpdate struct {
 	// Action Policy rule accept or drops packets
@@ -1060,10 +1606,14 @@ type PolicyRuleUpdate struct {
 
 	// Protocol Policy rule type of the traffic
 	Protocol       PolicyRuleUpdateProtocol `json:"protocol"`
+	Schedule       *PolicyRuleSchedule      `json:"schedule,omitempty"`
 	SourceResource *Resource                `json:"sourceResource,omitempty"`
 
 	// Sources Policy rule source group IDs
 	Sources *[]string `json:"sources,omitempty"`
+
+	// SshAuthorizations Local OS users the source peers may log in as with the NetBird SSH server of the destination peers. When any rule applying to a destination peer has SSH authorizations, logins not authorized by them are denied
+	SshAuthorizations *[]PolicyRuleSSHAuthorization `json:"ssh_authorizations,omitempty"`
 }
 
 // PolicyRuleUpdateAction Policy rule accept or drops packets
@@ -1144,6 +1694,51 @@ type Resource struct {
 // ResourceType defines model for ResourceType.
 type ResourceType string
 
+// Role defines model for Role.
+type Role struct {
+	// Description Role description
+	Description string `json:"description"`
+
+	// Id Role ID
+	Id string `json:"id"`
+
+	// Name Role name
+	Name string `json:"name"`
+
+	// Permissions Permissions granted by the role per module
+	Permissions []RolePermission `json:"permissions"`
+}
+
+// RolePermission defines model for RolePermission.
+type RolePermission struct {
+	// Groups Group IDs limiting write access to objects associated with these groups. Write access is not limited when empty
+	Groups *[]string `json:"groups,omitempty"`
+
+	// Module Module the permission applies to
+	Module RolePermissionModule `json:"module"`
+
+	// Read Allows viewing the objects of the module
+	Read bool `json:"read"`
+
+	// Write Allows creating, updating and deleting the objects of the module. Implies read access
+	Write bool `json:"write"`
+}
+
+// RolePermissionModule Module the permission applies to
+type RolePermissionModule string
+
+// RoleRequest defines model for RoleRequest.
+type RoleRequest struct {
+	// Description Role description
+	Description *string `json:"description,omitempty"`
+
+	// Name Role name
+	Name string `json:"name"`
+
+	// Permissions Permissions granted by the role per module
+	Permissions []RolePermission `json:"permissions"`
+}
+
 // Route defines model for Route.
 type Route struct {
 	// AccessControlGroups Access control group identifier associated with route.
@@ -1161,6 +1756,9 @@ type Route struct {
 	// Groups Group IDs containing routing peers
 	Groups []string `json:"groups"`
 
+	// HealthCheck Active probe the clients run through every routing peer to fail over to a healthy one
+	HealthCheck *RouteHealthCheck `json:"health_check,omitempty"`
+
 	// Id Route Id
 	Id string `json:"id"`
 
@@ -1189,6 +1787,51 @@ type Route struct {
 	PeerGroups *[]string `json:"peer_groups,omitempty"`
 }
 
+// RouteFirewallRule defines model for RouteFirewallRule.
+type RouteFirewallRule struct {
+	// Action Firewall rule action
+	Action string `json:"action"`
+
+	// Destination Routed network prefix
+	Destination string `json:"destination"`
+
+	// Domains Routed network domains
+	Domains *[]string `json:"domains,omitempty"`
+
+	// Port Port of the traffic
+	Port *int `json:"port,omitempty"`
+
+	// PortRange Policy rule affected ports range
+	PortRange *RulePortRange `json:"port_range,omitempty"`
+
+	// Protocol Protocol of the traffic
+	Protocol string `json:"protocol"`
+
+	// SourceRanges Source IP ranges the routing peer accepts or drops traffic from
+	SourceRanges []string `json:"source_ranges"`
+}
+
+// RouteHealthCheck Active probe the clients run through every routing peer to fail over to a healthy one
+type RouteHealthCheck struct {
+	// FailureThreshold Number of consecutive failed probes after which the routing peer is considered unhealthy, 3 if not set
+	FailureThreshold *int `json:"failure_threshold,omitempty"`
+
+	// Interval Seconds between two probes, 10 if not set
+	Interval *int `json:"interval,omitempty"`
+
+	// Target Probe target within the routed network. An IP address for icmp, an IP:port for tcp and a http(s) URL with an IP host for http
+	Target string `json:"target"`
+
+	// Timeout Probe timeout in seconds, 3 if not set. Must not exceed the interval
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Type Probe protocol
+	Type RouteHealthCheckType `json:"type"`
+}
+
+// RouteHealthCheckType Probe protocol
+type RouteHealthCheckType string
+
 // RouteRequest defines model for RouteRequest.
 type RouteRequest struct {
 	// AccessControlGroups Access control group identifier associated with route.
@@ -1206,6 +1849,9 @@ type RouteRequest struct {
 	// Groups Group IDs containing routing peers
 	Groups []string `json:"groups"`
 
+	// HealthCheck Active probe the clients run through every routing peer to fail over to a healthy one
+	HealthCheck *RouteHealthCheck `json:"health_check,omitempty"`
+
 	// KeepRoute Indicate if the route should be kept after a domain doesn't resolve that IP anymore
 	KeepRoute bool `json:"keep_route"`
 
@@ -1392,6 +2038,9 @@ type User struct {
 	// AutoGroups Group IDs to auto-assign to peers registered by this user
 	AutoGroups []string `json:"auto_groups"`
 
+	// CustomRoleId ID of the custom role granting the user additional permissions
+	CustomRoleId *string `json:"custom_role_id,omitempty"`
+
 	// Email User's email address
 	Email string `json:"email"`
 
@@ -1459,6 +2108,9 @@ type UserRequest struct {
 	// AutoGroups Group IDs to auto-assign to peers registered by this user
 	AutoGroups []string `json:"auto_groups"`
 
+	// CustomRoleId ID of the custom role granting the user additional permissions. Can only be assigned to users with the user role
+	CustomRoleId *string `json:"custom_role_id,omitempty"`
+
 	// IsBlocked If set to true then user is blocked and can't use the system
 	IsBlocked bool `json:"is_blocked"`
 
@@ -1466,6 +2118,54 @@ type UserRequest struct {
 	Role string `json:"role"`
 }
 
+// GetApiAccountsAccountIdConfigParams defines parameters for GetApiAccountsAccountIdConfig.
+type GetApiAccountsAccountIdConfigParams struct {
+	// Format Format of the exported configuration, defaults to json
+	Format *GetApiAccountsAccountIdConfigParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApiAccountsAccountIdConfigParamsFormat defines parameters for GetApiAccountsAccountIdConfig.
+type GetApiAccountsAccountIdConfigParamsFormat string
+
+// PutApiAccountsAccountIdConfigParams defines parameters for PutApiAccountsAccountIdConfig.
+type PutApiAccountsAccountIdConfigParams struct {
+	// DryRun Returns the changes without applying them
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetApiEventsParams defines parameters for GetApiEvents.
+type GetApiEventsParams struct {
+	// ActivityCode Filters events by activity code, can be repeated to match any of the given codes
+	ActivityCode *[]string `form:"activity_code,omitempty" json:"activity_code,omitempty"`
+
+	// InitiatorId Filters events by the ID of the initiator
+	InitiatorId *string `form:"initiator_id,omitempty" json:"initiator_id,omitempty"`
+
+	// TargetId Filters events by the ID of the affected object
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
+
+	// StartDate Returns events that happened at or after the given time
+	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Returns events that happened before the given time
+	EndDate *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Search Returns events with a meta value containing the given text, meta keys are not searched
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Cursor Cursor returned in the X-Next-Cursor header of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of returned events, defaults to 100
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Order Order of the events by creation, defaults to desc
+	Order *GetApiEventsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetApiEventsParamsOrder defines parameters for GetApiEvents.
+type GetApiEventsParamsOrder string
+
 // GetApiUsersParams defines parameters for GetApiUsers.
 type GetApiUsersParams struct {
 	// ServiceUser Filters users and returns either regular users or service users
@@ -1475,6 +2175,9 @@ type GetApiUsersParams struct {
 // PutApiAccountsAccountIdJSONRequestBody defines body for PutApiAccountsAccountId for application/json ContentType.
 type PutApiAccountsAccountIdJSONRequestBody = AccountRequest
 
+// PutApiAccountsAccountIdConfigJSONRequestBody defines body for PutApiAccountsAccountIdConfig for application/json ContentType.
+type PutApiAccountsAccountIdConfigJSONRequestBody = AccountConfig
+
 // PostApiDnsNameserversJSONRequestBody defines body for PostApiDnsNameservers for application/json ContentType.
 type PostApiDnsNameserversJSONRequestBody = NameserverGroupRequest
 
@@ -1484,6 +2187,18 @@ type PutApiDnsNameserversNsgroupIdJSONRequestBody = NameserverGroupRequest
 // PutApiDnsSettingsJSONRequestBody defines body for PutApiDnsSettings for application/json ContentType.
 type PutApiDnsSettingsJSONRequestBody = DNSSettings
 
+// PostApiDnsZonesJSONRequestBody defines body for PostApiDnsZones for application/json ContentType.
+type PostApiDnsZonesJSONRequestBody = DNSZoneRequest
+
+// PutApiDnsZonesZoneIdJSONRequestBody defines body for PutApiDnsZonesZoneId for application/json ContentType.
+type PutApiDnsZonesZoneIdJSONRequestBody = DNSZoneRequest
+
+// PostApiEventsSinksJSONRequestBody defines body for PostApiEventsSinks for application/json ContentType.
+type PostApiEventsSinksJSONRequestBody = EventSinkRequest
+
+// PutApiEventsSinksSinkIdJSONRequestBody defines body for PutApiEventsSinksSinkId for application/json ContentType.
+type PutApiEventsSinksSinkIdJSONRequestBody = EventSinkRequest
+
 // PostApiGroupsJSONRequestBody defines body for PostApiGroups for application/json ContentType.
 type PostApiGroupsJSONRequestBody = GroupRequest
 
@@ -1514,6 +2229,12 @@ type PutApiPeersPeerIdJSONRequestBody = PeerRequest
 // PostApiPoliciesJSONRequestBody defines body for PostApiPolicies for application/json ContentType.
 type PostApiPoliciesJSONRequestBody = PolicyUpdate
 
+// PostApiPoliciesDryRunJSONRequestBody defines body for PostApiPoliciesDryRun for application/json ContentType.
+type PostApiPoliciesDryRunJSONRequestBody = PolicyDryRunRequest
+
+// PostApiPoliciesExplainJSONRequestBody defines body for PostApiPoliciesExplain for application/json ContentType.
+type PostApiPoliciesExplainJSONRequestBody = PolicyExplainRequest
+
 // PutApiPoliciesPolicyIdJSONRequestBody defines body for PutApiPoliciesPolicyId for application/json ContentType.
 type PutApiPoliciesPolicyIdJSONRequestBody = PolicyCreate
 
@@ -1523,6 +2244,12 @@ type PostApiPostureChecksJSONRequestBody = PostureCheckUpdate
 // PutApiPostureChecksPostureCheckIdJSONRequestBody defines body for PutApiPostureChecksPostureCheckId for application/json ContentType.
 type PutApiPostureChecksPostureCheckIdJSONRequestBody = PostureCheckUpdate
 
+// PostApiRolesJSONRequestBody defines body for PostApiRoles for application/json ContentType.
+type PostApiRolesJSONRequestBody = RoleRequest
+
+// PutApiRolesRoleIdJSONRequestBody defines body for PutApiRolesRoleId for application/json ContentType.
+type PutApiRolesRoleIdJSONRequestBody = RoleRequest
+
 // PostApiRoutesJSONRequestBody defines body for PostApiRoutes for application/json ContentType.
 type PostApiRoutesJSONRequestBody = RouteRequest
 
@@ -2,7 +2,10 @@ package accounts
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -10,6 +13,7 @@ import (
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/account"
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/gitops"
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/http/util"
 	"github.com/netbirdio/netbird/management/server/status"
@@ -26,6 +30,8 @@ func AddEndpoints(accountManager server.AccountManager, router *mux.Router) {
 	router.HandleFunc("/accounts/{accountId}", accountsHandler.updateAccount).Methods("PUT", "OPTIONS")
 	router.HandleFunc("/accounts/{accountId}", accountsHandler.deleteAccount).Methods("DELETE", "OPTIONS")
 	router.HandleFunc("/accounts", accountsHandler.getAllAccounts).Methods("GET", "OPTIONS")
+	router.HandleFunc("/accounts/{accountId}/config", accountsHandler.exportAccountConfig).Methods("GET", "OPTIONS")
+	router.HandleFunc("/accounts/{accountId}/config", accountsHandler.applyAccountConfig).Methods("PUT", "OPTIONS")
 }
 
 // newHandler creates a new handler HTTP handler
@@ -107,6 +113,27 @@ func (h *handler) updateAccount(w http.ResponseWriter, r *http.Request) {
 	if req.Settings.RoutingPeerDnsResolutionEnabled != nil {
 		settings.RoutingPeerDNSResolutionEnabled = *req.Settings.RoutingPeerDnsResolutionEnabled
 	}
+	if req.Settings.Ipv6Enabled != nil {
+		settings.IPv6Enabled = *req.Settings.Ipv6Enabled
+	}
+	if req.Settings.PeerApprovalRequired != nil {
+		settings.PeerApprovalRequired = *req.Settings.PeerApprovalRequired
+	}
+	if req.Settings.PeerApprovalSetupKeys != nil {
+		settings.PeerApprovalSetupKeys = *req.Settings.PeerApprovalSetupKeys
+	}
+	if req.Settings.PeerApprovalUsers != nil {
+		settings.PeerApprovalUsers = *req.Settings.PeerApprovalUsers
+	}
+	if req.Settings.PeerApprovalTimeout != nil {
+		settings.PeerApprovalTimeout = time.Duration(*req.Settings.PeerApprovalTimeout) * time.Second
+	}
+	if req.Settings.RelayBytesPerSecond != nil {
+		settings.RelayBytesPerSecond = *req.Settings.RelayBytesPerSecond
+	}
+	if req.Settings.RelayMessagesPerSecond != nil {
+		settings.RelayMessagesPerSecond = *req.Settings.RelayMessagesPerSecond
+	}
 
 	updatedAccount, err := h.accountManager.UpdateAccountSettings(r.Context(), accountID, userID, settings)
 	if err != nil {
@@ -143,12 +170,132 @@ func (h *handler) deleteAccount(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
 }
 
+// exportAccountConfig is HTTP GET handler that returns the declarative configuration of the account
+func (h *handler) exportAccountConfig(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID := mux.Vars(r)["accountId"]
+	if len(accountID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid account ID"), w)
+		return
+	}
+
+	format, err := gitops.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "%v", err), w)
+		return
+	}
+
+	doc, err := h.accountManager.ExportAccountConfig(r.Context(), accountID, userAuth.UserId)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	if format == gitops.FormatJSON {
+		util.WriteJSONObject(r.Context(), w, doc)
+		return
+	}
+
+	data, err := gitops.Marshal(doc, format)
+	if err != nil {
+		util.WriteError(r.Context(), status.Errorf(status.Internal, "failed to export account configuration"), w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// applyAccountConfig is HTTP PUT handler that brings the account in line with the provided declarative configuration.
+// The configuration is parsed as YAML when the request content type is YAML and as JSON otherwise.
+func (h *handler) applyAccountConfig(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID := mux.Vars(r)["accountId"]
+	if len(accountID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid account ID"), w)
+		return
+	}
+
+	var dryRun bool
+	if value := r.URL.Query().Get("dry_run"); value != "" {
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid dry_run value %s", value), w)
+			return
+		}
+	}
+
+	format := gitops.FormatJSON
+	if strings.Contains(r.Header.Get("Content-Type"), "yaml") {
+		format = gitops.FormatYAML
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		util.WriteErrorResponse("couldn't read request body", http.StatusBadRequest, w)
+		return
+	}
+
+	doc, err := gitops.Unmarshal(data, format)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse account configuration: "+err.Error(), http.StatusBadRequest, w)
+		return
+	}
+
+	plan, err := h.accountManager.ApplyAccountConfig(r.Context(), accountID, userAuth.UserId, doc, dryRun)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccountConfigPlanResponse(plan))
+}
+
+func toAccountConfigPlanResponse(plan *gitops.Plan) *api.AccountConfigPlan {
+	changes := make([]api.AccountConfigChange, 0, len(plan.Changes))
+	for _, change := range plan.Changes {
+		changes = append(changes, api.AccountConfigChange{
+			Kind:   api.AccountConfigChangeKind(change.Kind),
+			Action: api.AccountConfigChangeAction(change.Action),
+			Name:   change.Name,
+			Id:     change.ID,
+		})
+	}
+
+	return &api.AccountConfigPlan{
+		DryRun:  plan.DryRun,
+		Changes: changes,
+	}
+}
+
 func toAccountResponse(accountID string, settings *types.Settings) *api.Account {
 	jwtAllowGroups := settings.JWTAllowGroups
 	if jwtAllowGroups == nil {
 		jwtAllowGroups = []string{}
 	}
 
+	peerApprovalSetupKeys := settings.PeerApprovalSetupKeys
+	if peerApprovalSetupKeys == nil {
+		peerApprovalSetupKeys = []string{}
+	}
+
+	peerApprovalUsers := settings.PeerApprovalUsers
+	if peerApprovalUsers == nil {
+		peerApprovalUsers = []string{}
+	}
+	peerApprovalTimeout := int(settings.PeerApprovalTimeout.Seconds())
+
 	apiSettings := api.AccountSettings{
 		PeerLoginExpiration:             int(settings.PeerLoginExpiration.Seconds()),
 		PeerLoginExpirationEnabled:      settings.PeerLoginExpirationEnabled,
@@ -160,6 +307,13 @@ func toAccountResponse(accountID string, settings *types.Settings) *api.Account
 		JwtAllowGroups:                  &jwtAllowGroups,
 		RegularUsersViewBlocked:         settings.RegularUsersViewBlocked,
 		RoutingPeerDnsResolutionEnabled: &settings.RoutingPeerDNSResolutionEnabled,
+		Ipv6Enabled:                     &settings.IPv6Enabled,
+		PeerApprovalRequired:            &settings.PeerApprovalRequired,
+		PeerApprovalSetupKeys:           &peerApprovalSetupKeys,
+		PeerApprovalUsers:               &peerApprovalUsers,
+		PeerApprovalTimeout:             &peerApprovalTimeout,
+		RelayBytesPerSecond:             &settings.RelayBytesPerSecond,
+		RelayMessagesPerSecond:          &settings.RelayMessagesPerSecond,
 	}
 
 	if settings.Extra != nil {
@@ -12,8 +12,10 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/gitops"
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/mock_server"
 	"github.com/netbirdio/netbird/management/server/status"
@@ -50,6 +52,8 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 
 	sr := func(v string) *string { return &v }
 	br := func(v bool) *bool { return &v }
+	ir := func(v int) *int { return &v }
+	i64r := func(v int64) *int64 { return &v }
 
 	handler := initAccountsTestData(&types.Account{
 		Id:      accountID,
@@ -91,6 +95,13 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtAllowGroups:                  &[]string{},
 				RegularUsersViewBlocked:         true,
 				RoutingPeerDnsResolutionEnabled: br(false),
+				Ipv6Enabled:                     br(false),
+				PeerApprovalRequired:            br(false),
+				PeerApprovalSetupKeys:           &[]string{},
+				PeerApprovalTimeout:             ir(0),
+				PeerApprovalUsers:               &[]string{},
+				RelayBytesPerSecond:             i64r(0),
+				RelayMessagesPerSecond:          i64r(0),
 			},
 			expectedArray: true,
 			expectedID:    accountID,
@@ -111,6 +122,13 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtAllowGroups:                  &[]string{},
 				RegularUsersViewBlocked:         false,
 				RoutingPeerDnsResolutionEnabled: br(false),
+				Ipv6Enabled:                     br(false),
+				PeerApprovalRequired:            br(false),
+				PeerApprovalSetupKeys:           &[]string{},
+				PeerApprovalTimeout:             ir(0),
+				PeerApprovalUsers:               &[]string{},
+				RelayBytesPerSecond:             i64r(0),
+				RelayMessagesPerSecond:          i64r(0),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -131,6 +149,13 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtAllowGroups:                  &[]string{"test"},
 				RegularUsersViewBlocked:         true,
 				RoutingPeerDnsResolutionEnabled: br(false),
+				Ipv6Enabled:                     br(false),
+				PeerApprovalRequired:            br(false),
+				PeerApprovalSetupKeys:           &[]string{},
+				PeerApprovalTimeout:             ir(0),
+				PeerApprovalUsers:               &[]string{},
+				RelayBytesPerSecond:             i64r(0),
+				RelayMessagesPerSecond:          i64r(0),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -151,6 +176,40 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtAllowGroups:                  &[]string{},
 				RegularUsersViewBlocked:         true,
 				RoutingPeerDnsResolutionEnabled: br(false),
+				Ipv6Enabled:                     br(false),
+				PeerApprovalRequired:            br(false),
+				PeerApprovalSetupKeys:           &[]string{},
+				PeerApprovalTimeout:             ir(0),
+				PeerApprovalUsers:               &[]string{},
+				RelayBytesPerSecond:             i64r(0),
+				RelayMessagesPerSecond:          i64r(0),
+			},
+			expectedArray: false,
+			expectedID:    accountID,
+		},
+		{
+			name:           "PutAccount OK with relay limits",
+			expectedBody:   true,
+			requestType:    http.MethodPut,
+			requestPath:    "/api/accounts/" + accountID,
+			requestBody:    bytes.NewBufferString("{\"settings\": {\"peer_login_expiration\": 15552000,\"peer_login_expiration_enabled\": true,\"regular_users_view_blocked\":false,\"relay_bytes_per_second\":1048576,\"relay_messages_per_second\":1000}}"),
+			expectedStatus: http.StatusOK,
+			expectedSettings: api.AccountSettings{
+				PeerLoginExpiration:             15552000,
+				PeerLoginExpirationEnabled:      true,
+				GroupsPropagationEnabled:        br(false),
+				JwtGroupsClaimName:              sr(""),
+				JwtGroupsEnabled:                br(false),
+				JwtAllowGroups:                  &[]string{},
+				RegularUsersViewBlocked:         false,
+				RoutingPeerDnsResolutionEnabled: br(false),
+				Ipv6Enabled:                     br(false),
+				PeerApprovalRequired:            br(false),
+				PeerApprovalSetupKeys:           &[]string{},
+				PeerApprovalTimeout:             ir(0),
+				PeerApprovalUsers:               &[]string{},
+				RelayBytesPerSecond:             i64r(1048576),
+				RelayMessagesPerSecond:          i64r(1000),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -232,3 +291,138 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestAccounts_AccountConfig(t *testing.T) {
+	accountID := "test_account"
+	adminUser := types.NewAdminUser("test_user")
+
+	var applied *gitops.Document
+	handler := &handler{
+		accountManager: &mock_server.MockAccountManager{
+			ExportAccountConfigFunc: func(ctx context.Context, accountID, userID string) (*gitops.Document, error) {
+				return &gitops.Document{Groups: []gitops.Group{{Name: "devs", Peers: []string{"laptop"}}}}, nil
+			},
+			ApplyAccountConfigFunc: func(ctx context.Context, accountID, userID string, doc *gitops.Document, dryRun bool) (*gitops.Plan, error) {
+				if len(doc.Groups) == 0 {
+					return nil, status.Errorf(status.PreconditionFailed, "group devs can't be deleted")
+				}
+				applied = doc
+				plan := gitops.NewPlan(dryRun)
+				plan.Add(gitops.KindGroup, gitops.ActionCreate, doc.Groups[0].Name, "group-id")
+				return plan, nil
+			},
+		},
+	}
+
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		contentType    string
+		requestBody    string
+		expectedStatus int
+		expectedBody   string
+		expectedPlan   *api.AccountConfigPlan
+	}{
+		{
+			name:           "export json",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/accounts/" + accountID + "/config",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"groups":[{"name":"devs","peers":["laptop"]}]`,
+		},
+		{
+			name:           "export yaml",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/accounts/" + accountID + "/config?format=yaml",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "groups:\n  - name: devs\n    peers:\n      - laptop\n",
+		},
+		{
+			name:           "export unknown format",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/accounts/" + accountID + "/config?format=xml",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "apply yaml dry run",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/accounts/" + accountID + "/config?dry_run=true",
+			contentType:    "application/yaml",
+			requestBody:    "groups:\n  - name: devs\n",
+			expectedStatus: http.StatusOK,
+			expectedPlan: &api.AccountConfigPlan{
+				DryRun:  true,
+				Changes: []api.AccountConfigChange{{Kind: api.AccountConfigChangeKindGroup, Action: api.AccountConfigChangeActionCreate, Name: "devs", Id: "group-id"}},
+			},
+		},
+		{
+			name:           "apply json",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/accounts/" + accountID + "/config",
+			requestBody:    `{"groups":[{"name":"devs"}]}`,
+			expectedStatus: http.StatusOK,
+			expectedPlan: &api.AccountConfigPlan{
+				Changes: []api.AccountConfigChange{{Kind: api.AccountConfigChangeKindGroup, Action: api.AccountConfigChangeActionCreate, Name: "devs", Id: "group-id"}},
+			},
+		},
+		{
+			name:           "apply unknown field",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/accounts/" + accountID + "/config",
+			requestBody:    `{"groups":[{"name":"devs","members":[]}]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "apply failed precondition",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/accounts/" + accountID + "/config",
+			requestBody:    `{}`,
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			applied = nil
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, bytes.NewBufferString(tc.requestBody))
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+			req = nbcontext.SetUserAuthInRequest(req, nbcontext.UserAuth{
+				UserId:    adminUser.Id,
+				AccountId: accountID,
+			})
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/accounts/{accountId}/config", handler.exportAccountConfig).Methods("GET")
+			router.HandleFunc("/api/accounts/{accountId}/config", handler.applyAccountConfig).Methods("PUT")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			content, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			if tc.expectedBody != "" {
+				assert.Contains(t, string(content), tc.expectedBody)
+			}
+
+			if tc.expectedPlan != nil {
+				var plan api.AccountConfigPlan
+				require.NoError(t, json.Unmarshal(content, &plan))
+				assert.Equal(t, *tc.expectedPlan, plan)
+				require.NotNil(t, applied)
+				assert.Equal(t, "devs", applied.Groups[0].Name)
+			}
+		})
+	}
+}
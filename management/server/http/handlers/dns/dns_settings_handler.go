@@ -22,6 +22,7 @@ type dnsSettingsHandler struct {
 func AddEndpoints(accountManager server.AccountManager, router *mux.Router) {
 	addDNSSettingEndpoint(accountManager, router)
 	addDNSNameserversEndpoint(accountManager, router)
+	addDNSZonesEndpoint(accountManager, router)
 }
 
 func addDNSSettingEndpoint(accountManager server.AccountManager, router *mux.Router) {
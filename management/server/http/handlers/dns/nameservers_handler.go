@@ -205,6 +205,12 @@ func toServerNSList(apiNSList []api.Nameserver) ([]nbdns.NameServer, error) {
 		if err != nil {
 			return nil, err
 		}
+		if apiNS.Hostname != nil {
+			parsed.Hostname = *apiNS.Hostname
+		}
+		if apiNS.Path != nil {
+			parsed.Path = *apiNS.Path
+		}
 		nsList = append(nsList, parsed)
 	}
 
@@ -219,6 +225,12 @@ func toNameserverGroupResponse(serverNSGroup *nbdns.NameServerGroup) *api.Namese
 			NsType: api.NameserverNsType(ns.NSType.String()),
 			Port:   ns.Port,
 		}
+		if ns.Hostname != "" {
+			apiNS.Hostname = &ns.Hostname
+		}
+		if ns.Path != "" {
+			apiNS.Path = &ns.Path
+		}
 		nsList = append(nsList, apiNS)
 	}
 
@@ -0,0 +1,182 @@
+package dns
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// zonesHandler is the custom DNS zone handler of the account
+type zonesHandler struct {
+	accountManager server.AccountManager
+}
+
+func addDNSZonesEndpoint(accountManager server.AccountManager, router *mux.Router) {
+	zonesHandler := newZonesHandler(accountManager)
+	router.HandleFunc("/dns/zones", zonesHandler.getAllZones).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/zones", zonesHandler.createZone).Methods("POST", "OPTIONS")
+	router.HandleFunc("/dns/zones/{zoneId}", zonesHandler.updateZone).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/dns/zones/{zoneId}", zonesHandler.getZone).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/zones/{zoneId}", zonesHandler.deleteZone).Methods("DELETE", "OPTIONS")
+}
+
+// newZonesHandler returns a new instance of zonesHandler handler
+func newZonesHandler(accountManager server.AccountManager) *zonesHandler {
+	return &zonesHandler{accountManager: accountManager}
+}
+
+// getAllZones returns the list of custom DNS zones for the account
+func (h *zonesHandler) getAllZones(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	zones, err := h.accountManager.ListDNSZones(r.Context(), userAuth.AccountId, userAuth.UserId)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	apiZones := make([]*api.DNSZone, 0, len(zones))
+	for _, zone := range zones {
+		apiZones = append(apiZones, zone.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, apiZones)
+}
+
+// createZone handles custom DNS zone creation request
+func (h *zonesHandler) createZone(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiDnsZonesJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	if err = validateRecordTypes(req.Records); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	zone := &types.DNSZone{}
+	zone.FromAPIRequest(&req)
+
+	zone, err = h.accountManager.CreateDNSZone(r.Context(), userAuth.AccountId, userAuth.UserId, zone)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, zone.ToAPIResponse())
+}
+
+// updateZone handles update to a custom DNS zone identified by a given ID
+func (h *zonesHandler) updateZone(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	zoneID := mux.Vars(r)["zoneId"]
+	if len(zoneID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid dns zone ID"), w)
+		return
+	}
+
+	var req api.PutApiDnsZonesZoneIdJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	if err = validateRecordTypes(req.Records); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	zone := &types.DNSZone{ID: zoneID}
+	zone.FromAPIRequest(&req)
+
+	err = h.accountManager.SaveDNSZone(r.Context(), userAuth.AccountId, userAuth.UserId, zone)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, zone.ToAPIResponse())
+}
+
+// deleteZone handles custom DNS zone deletion request
+func (h *zonesHandler) deleteZone(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	zoneID := mux.Vars(r)["zoneId"]
+	if len(zoneID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid dns zone ID"), w)
+		return
+	}
+
+	err = h.accountManager.DeleteDNSZone(r.Context(), userAuth.AccountId, zoneID, userAuth.UserId)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+// getZone handles a custom DNS zone Get request identified by ID
+func (h *zonesHandler) getZone(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	zoneID := mux.Vars(r)["zoneId"]
+	if len(zoneID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid dns zone ID"), w)
+		return
+	}
+
+	zone, err := h.accountManager.GetDNSZone(r.Context(), userAuth.AccountId, userAuth.UserId, zoneID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, zone.ToAPIResponse())
+}
+
+func validateRecordTypes(records []api.DNSRecord) error {
+	for _, record := range records {
+		if _, ok := types.DNSZoneRecordTypes[strings.ToUpper(string(record.Type))]; !ok {
+			return status.Errorf(status.InvalidArgument, "record %s has an unsupported type %s", record.Name, record.Type)
+		}
+	}
+	return nil
+}
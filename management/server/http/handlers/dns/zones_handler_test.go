@@ -0,0 +1,178 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+const (
+	existingZoneID = "existingZoneID"
+	notFoundZoneID = "notFoundZoneID"
+)
+
+var baseExistingZone = &types.DNSZone{
+	ID:      existingZoneID,
+	Name:    "internal.example.com",
+	Enabled: true,
+	Groups:  []string{"testing"},
+	Records: []nbdns.SimpleRecord{
+		{Name: "web.internal.example.com.", Type: 1, Class: nbdns.DefaultClass, TTL: 300, RData: "10.0.0.1"},
+	},
+}
+
+func initZonesTestData() *zonesHandler {
+	return &zonesHandler{
+		accountManager: &mock_server.MockAccountManager{
+			GetDNSZoneFunc: func(_ context.Context, _, _, zoneID string) (*types.DNSZone, error) {
+				if zoneID == existingZoneID {
+					return baseExistingZone.Copy(), nil
+				}
+				return nil, status.NewDNSZoneNotFoundError(zoneID)
+			},
+			CreateDNSZoneFunc: func(_ context.Context, accountID, _ string, zone *types.DNSZone) (*types.DNSZone, error) {
+				created := zone.Copy()
+				created.ID = existingZoneID
+				created.AccountID = accountID
+				return created, nil
+			},
+			SaveDNSZoneFunc: func(_ context.Context, _, _ string, zone *types.DNSZone) error {
+				if zone.ID == existingZoneID {
+					return nil
+				}
+				return status.NewDNSZoneNotFoundError(zone.ID)
+			},
+			DeleteDNSZoneFunc: func(_ context.Context, _, _, _ string) error {
+				return nil
+			},
+		},
+	}
+}
+
+func TestZonesHandlers(t *testing.T) {
+	const zoneRequest = `{"name":"Internal.Example.com.","description":"internal","enabled":true,"search_domains_enabled":false,"groups":["group"],
+		"records":[{"name":"Web.internal.example.com","type":"A","ttl":300,"content":"10.0.0.1"},{"name":"internal.example.com","type":"TXT","ttl":60,"content":"v=spf1 -all"}]}`
+
+	expectedZone := &api.DNSZone{
+		Id:          existingZoneID,
+		Name:        "internal.example.com",
+		Description: "internal",
+		Enabled:     true,
+		Groups:      []string{"group"},
+		Records: []api.DNSRecord{
+			{Name: "web.internal.example.com", Type: api.DNSRecordTypeA, Ttl: 300, Content: "10.0.0.1"},
+			{Name: "internal.example.com", Type: api.DNSRecordTypeTXT, Ttl: 60, Content: "v=spf1 -all"},
+		},
+	}
+
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		requestBody    io.Reader
+		expectedStatus int
+		expectedZone   *api.DNSZone
+	}{
+		{
+			name:           "Get Existing Zone",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/dns/zones/" + existingZoneID,
+			expectedStatus: http.StatusOK,
+			expectedZone:   baseExistingZone.ToAPIResponse(),
+		},
+		{
+			name:           "Get Not Existing Zone",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/dns/zones/" + notFoundZoneID,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "POST OK",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/dns/zones",
+			requestBody:    bytes.NewBufferString(zoneRequest),
+			expectedStatus: http.StatusOK,
+			expectedZone:   expectedZone,
+		},
+		{
+			name:        "POST Unsupported Record Type",
+			requestType: http.MethodPost,
+			requestPath: "/api/dns/zones",
+			requestBody: bytes.NewBufferString(`{"name":"internal.example.com","groups":["group"],
+				"records":[{"name":"internal.example.com","type":"NS","ttl":300,"content":"ns.example.com"}]}`),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "PUT OK",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/dns/zones/" + existingZoneID,
+			requestBody:    bytes.NewBufferString(zoneRequest),
+			expectedStatus: http.StatusOK,
+			expectedZone:   expectedZone,
+		},
+		{
+			name:           "PUT Not Existing Zone",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/dns/zones/" + notFoundZoneID,
+			requestBody:    bytes.NewBufferString(zoneRequest),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "DELETE OK",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/dns/zones/" + existingZoneID,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	p := initZonesTestData()
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, tc.requestBody)
+			req = nbcontext.SetUserAuthInRequest(req, nbcontext.UserAuth{
+				UserId:    "test_user",
+				AccountId: testNSGroupAccountID,
+				Domain:    "hotmail.com",
+			})
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/dns/zones/{zoneId}", p.getZone).Methods("GET")
+			router.HandleFunc("/api/dns/zones", p.createZone).Methods("POST")
+			router.HandleFunc("/api/dns/zones/{zoneId}", p.deleteZone).Methods("DELETE")
+			router.HandleFunc("/api/dns/zones/{zoneId}", p.updateZone).Methods("PUT")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			content, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, recorder.Code, "content: %s", string(content))
+
+			if tc.expectedZone == nil {
+				return
+			}
+
+			got := &api.DNSZone{}
+			require.NoError(t, json.Unmarshal(content, got))
+			assert.Equal(t, tc.expectedZone, got)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server/activity/sink"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// getAllEventSinks lists all event sinks of the account
+func (h *handler) getAllEventSinks(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	eventSinks, err := h.accountManager.ListEventSinks(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	apiSinks := make([]*api.EventSink, 0, len(eventSinks))
+	for _, eventSink := range eventSinks {
+		apiSinks = append(apiSinks, toEventSinkResponse(eventSink))
+	}
+
+	util.WriteJSONObject(r.Context(), w, apiSinks)
+}
+
+// getEventSink handles an event sink Get request identified by ID
+func (h *handler) getEventSink(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	sinkID := mux.Vars(r)["sinkId"]
+	if len(sinkID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid event sink ID"), w)
+		return
+	}
+
+	eventSink, err := h.accountManager.GetEventSink(r.Context(), accountID, sinkID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toEventSinkResponse(eventSink))
+}
+
+// createEventSink handles event sink creation request
+func (h *handler) createEventSink(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.saveEventSink(w, r, userAuth.AccountId, userAuth.UserId, "")
+}
+
+// updateEventSink handles update to an event sink identified by a given ID
+func (h *handler) updateEventSink(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	sinkID := mux.Vars(r)["sinkId"]
+	if len(sinkID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid event sink ID"), w)
+		return
+	}
+
+	h.saveEventSink(w, r, userAuth.AccountId, userAuth.UserId, sinkID)
+}
+
+// deleteEventSink handles event sink deletion request
+func (h *handler) deleteEventSink(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	sinkID := mux.Vars(r)["sinkId"]
+	if len(sinkID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid event sink ID"), w)
+		return
+	}
+
+	if err = h.accountManager.DeleteEventSink(r.Context(), accountID, sinkID, userID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+// saveEventSink handles event sink create and update
+func (h *handler) saveEventSink(w http.ResponseWriter, r *http.Request, accountID, userID, sinkID string) {
+	var req api.EventSinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	eventSink := &types.EventSink{
+		ID:      sinkID,
+		Name:    req.Name,
+		Enabled: req.Enabled,
+		Type:    sink.Type(req.Type),
+	}
+	if req.Activities != nil {
+		eventSink.Activities = *req.Activities
+	}
+
+	if req.Syslog != nil {
+		eventSink.Syslog = &sink.SyslogConfig{
+			Address:  req.Syslog.Address,
+			Facility: req.Syslog.Facility,
+		}
+		if req.Syslog.Network != nil {
+			eventSink.Syslog.Network = *req.Syslog.Network
+		}
+		if req.Syslog.AppName != nil {
+			eventSink.Syslog.AppName = *req.Syslog.AppName
+		}
+	}
+
+	if req.Webhook != nil {
+		eventSink.Webhook = &sink.WebhookConfig{URL: req.Webhook.Url}
+		if req.Webhook.Secret != nil {
+			eventSink.Webhook.Secret = *req.Webhook.Secret
+		}
+		if req.Webhook.Headers != nil {
+			eventSink.Webhook.Headers = *req.Webhook.Headers
+		}
+	}
+
+	eventSink, err := h.accountManager.SaveEventSink(r.Context(), accountID, userID, eventSink)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toEventSinkResponse(eventSink))
+}
+
+// toEventSinkResponse converts the event sink to its API representation. The webhook secret is never returned
+func toEventSinkResponse(eventSink *types.EventSink) *api.EventSink {
+	resp := &api.EventSink{
+		Id:         eventSink.ID,
+		Name:       eventSink.Name,
+		Enabled:    eventSink.Enabled,
+		Type:       api.EventSinkType(eventSink.Type),
+		Activities: eventSink.Activities,
+	}
+	if resp.Activities == nil {
+		resp.Activities = []string{}
+	}
+
+	if eventSink.Syslog != nil {
+		resp.Syslog = &api.EventSinkSyslog{
+			Address:  eventSink.Syslog.Address,
+			Facility: eventSink.Syslog.Facility,
+		}
+		if eventSink.Syslog.Network != "" {
+			resp.Syslog.Network = &eventSink.Syslog.Network
+		}
+		if eventSink.Syslog.AppName != "" {
+			resp.Syslog.AppName = &eventSink.Syslog.AppName
+		}
+	}
+
+	if eventSink.Webhook != nil {
+		resp.Webhook = &api.EventSinkWebhook{Url: eventSink.Webhook.URL}
+		if len(eventSink.Webhook.Headers) > 0 {
+			headers := eventSink.Webhook.Headers
+			resp.Webhook.Headers = &headers
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,66 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity/sink"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestEventSinks_SaveEventSink(t *testing.T) {
+	var saved *types.EventSink
+	h := &handler{
+		accountManager: &mock_server.MockAccountManager{
+			SaveEventSinkFunc: func(_ context.Context, accountID, userID string, eventSink *types.EventSink) (*types.EventSink, error) {
+				saved = eventSink.Copy()
+				if eventSink.ID == "" {
+					eventSink.ID = "sink-id"
+				}
+				eventSink.AccountID = accountID
+				return eventSink, nil
+			},
+		},
+	}
+
+	body := `{"name":"siem","enabled":true,"type":"webhook","activities":["peer.approval.pending"],` +
+		`"webhook":{"url":"https://hooks.example.com","secret":"secret","headers":{"X-Custom":"value"}}}`
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/events/sinks", bytes.NewBufferString(body))
+	req = nbcontext.SetUserAuthInRequest(req, nbcontext.UserAuth{
+		UserId:    "test_user",
+		Domain:    "hotmail.com",
+		AccountId: "test_account",
+	})
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/events/sinks", h.createEventSink).Methods("POST")
+	router.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.NotNil(t, saved)
+	assert.Equal(t, sink.TypeWebhook, saved.Type)
+	assert.Equal(t, []string{"peer.approval.pending"}, saved.Activities)
+	assert.Equal(t, "secret", saved.Webhook.Secret)
+	assert.Equal(t, map[string]string{"X-Custom": "value"}, saved.Webhook.Headers)
+
+	var resp api.EventSink
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Equal(t, "sink-id", resp.Id)
+	assert.Equal(t, api.EventSinkTypeWebhook, resp.Type)
+	require.NotNil(t, resp.Webhook)
+	assert.Nil(t, resp.Webhook.Secret, "the webhook secret must not be returned")
+	assert.NotContains(t, recorder.Body.String(), "secret\"")
+}
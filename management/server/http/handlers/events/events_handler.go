@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
@@ -13,8 +16,12 @@ import (
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/status"
 )
 
+// nextCursorHeader is the response header carrying the cursor of the next page of events
+const nextCursorHeader = "X-Next-Cursor"
+
 // handler HTTP handler
 type handler struct {
 	accountManager server.AccountManager
@@ -23,6 +30,11 @@ type handler struct {
 func AddEndpoints(accountManager server.AccountManager, router *mux.Router) {
 	eventsHandler := newHandler(accountManager)
 	router.HandleFunc("/events", eventsHandler.getAllEvents).Methods("GET", "OPTIONS")
+	router.HandleFunc("/events/sinks", eventsHandler.getAllEventSinks).Methods("GET", "OPTIONS")
+	router.HandleFunc("/events/sinks", eventsHandler.createEventSink).Methods("POST", "OPTIONS")
+	router.HandleFunc("/events/sinks/{sinkId}", eventsHandler.getEventSink).Methods("GET", "OPTIONS")
+	router.HandleFunc("/events/sinks/{sinkId}", eventsHandler.updateEventSink).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/events/sinks/{sinkId}", eventsHandler.deleteEventSink).Methods("DELETE", "OPTIONS")
 }
 
 // newHandler creates a new events handler
@@ -41,7 +53,13 @@ func (h *handler) getAllEvents(w http.ResponseWriter, r *http.Request) {
 
 	accountID, userID := userAuth.AccountId, userAuth.UserId
 
-	accountEvents, err := h.accountManager.GetEvents(r.Context(), accountID, userID)
+	query, err := parseEventsQuery(r.URL.Query())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountEvents, nextCursor, err := h.accountManager.QueryEvents(r.Context(), accountID, userID, query)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -57,9 +75,66 @@ func (h *handler) getAllEvents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if nextCursor != 0 {
+		w.Header().Set(nextCursorHeader, strconv.FormatUint(nextCursor, 10))
+	}
+
 	util.WriteJSONObject(r.Context(), w, events)
 }
 
+// parseEventsQuery converts the query parameters of the events request to an activity query
+func parseEventsQuery(values url.Values) (*activity.Query, error) {
+	query := &activity.Query{
+		InitiatorID: values.Get("initiator_id"),
+		TargetID:    values.Get("target_id"),
+		Search:      values.Get("search"),
+		Descending:  true,
+	}
+
+	for _, code := range values["activity_code"] {
+		a, ok := activity.ActivityFromStringCode(code)
+		if !ok {
+			return nil, status.Errorf(status.InvalidArgument, "unknown activity code: %s", code)
+		}
+		query.Activities = append(query.Activities, a)
+	}
+
+	var err error
+	if v := values.Get("start_date"); v != "" {
+		if query.From, err = time.Parse(time.RFC3339, v); err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid start_date query parameter")
+		}
+	}
+
+	if v := values.Get("end_date"); v != "" {
+		if query.To, err = time.Parse(time.RFC3339, v); err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid end_date query parameter")
+		}
+	}
+
+	if v := values.Get("cursor"); v != "" {
+		if query.Cursor, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid cursor query parameter")
+		}
+	}
+
+	if v := values.Get("limit"); v != "" {
+		if query.Limit, err = strconv.Atoi(v); err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid limit query parameter")
+		}
+	}
+
+	switch values.Get("order") {
+	case "", "desc":
+	case "asc":
+		query.Descending = false
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "invalid order query parameter")
+	}
+
+	return query, nil
+}
+
 func (h *handler) fillEventsWithUserInfo(ctx context.Context, events []*api.Event, accountId, userId string) error {
 	// build email, name maps based on users
 	userInfos, err := h.accountManager.GetUsersFromAccount(ctx, accountId, userId)
@@ -18,17 +18,27 @@ import (
 	"github.com/netbirdio/netbird/management/server/activity"
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/types"
 )
 
 func initEventsTestData(account string, events ...*activity.Event) *handler {
 	return &handler{
 		accountManager: &mock_server.MockAccountManager{
-			GetEventsFunc: func(_ context.Context, accountID, userID string) ([]*activity.Event, error) {
-				if accountID == account {
-					return events, nil
+			QueryEventsFunc: func(_ context.Context, accountID, userID string, query *activity.Query) ([]*activity.Event, uint64, error) {
+				if err := query.Validate(); err != nil {
+					return nil, 0, status.Errorf(status.InvalidArgument, "invalid events query: %v", err)
 				}
-				return []*activity.Event{}, nil
+				result := []*activity.Event{}
+				if accountID != account {
+					return result, 0, nil
+				}
+				for _, event := range events {
+					if query.Matches(event) && event.ID > query.Cursor && len(result) < query.Limit {
+						result = append(result, event)
+					}
+				}
+				return result, query.NextCursor(result), nil
 			},
 			GetUsersFromAccountFunc: func(_ context.Context, accountID, userID string) (map[string]*types.UserInfo, error) {
 				return make(map[string]*types.UserInfo), nil
@@ -240,3 +250,88 @@ func TestEvents_GetEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestEvents_GetEventsQuery(t *testing.T) {
+	tt := []struct {
+		name           string
+		requestPath    string
+		expectedStatus int
+		expectedIDs    []string
+		expectedCursor string
+	}{
+		{
+			name:           "filter by activity code and target",
+			requestPath:    "/api/events/?order=asc&activity_code=setupkey.update&activity_code=setupkey.revoke&target_id=setup-key-id",
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"4", "5", "6"},
+		},
+		{
+			name:           "first page",
+			requestPath:    "/api/events/?order=asc&activity_code=setupkey.update&limit=1",
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"4"},
+			expectedCursor: "4",
+		},
+		{
+			name:           "next page",
+			requestPath:    "/api/events/?order=asc&activity_code=setupkey.update&limit=1&cursor=4",
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"5"},
+			expectedCursor: "5",
+		},
+		{
+			name:           "unknown activity code",
+			requestPath:    "/api/events/?activity_code=unknown",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid start date",
+			requestPath:    "/api/events/?start_date=yesterday",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "limit above maximum",
+			requestPath:    "/api/events/?limit=100000",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	accountID := "test_account"
+	adminUser := types.NewAdminUser("test_user")
+	events := generateEvents(accountID, adminUser.Id)
+	handler := initEventsTestData(accountID, events...)
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.requestPath, nil)
+			req = nbcontext.SetUserAuthInRequest(req, nbcontext.UserAuth{
+				UserId:    "test_user",
+				Domain:    "hotmail.com",
+				AccountId: "test_account",
+			})
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/events/", handler.getAllEvents).Methods("GET")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var got []*api.Event
+			err := json.NewDecoder(res.Body).Decode(&got)
+			assert.NoError(t, err)
+
+			ids := make([]string, 0, len(got))
+			for _, event := range got {
+				ids = append(ids, event.Id)
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+			assert.Equal(t, tc.expectedCursor, res.Header.Get(nextCursorHeader))
+		})
+	}
+}
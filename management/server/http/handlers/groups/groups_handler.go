@@ -134,6 +134,18 @@ func (h *handler) updateGroup(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// keep the connection strategy when the request omits it
+	connectionStrategy := existingGroup.ConnectionStrategy
+	if req.ConnectionStrategy != nil {
+		connectionStrategy = string(*req.ConnectionStrategy)
+	}
+
+	// keep the rule when the request omits it, an empty rule makes the group static
+	rule := existingGroup.Rule
+	if req.Rule != nil {
+		rule = *req.Rule
+	}
+
 	group := types.Group{
 		ID:                   groupID,
 		Name:                 req.Name,
@@ -141,6 +153,8 @@ func (h *handler) updateGroup(w http.ResponseWriter, r *http.Request) {
 		Resources:            resources,
 		Issued:               existingGroup.Issued,
 		IntegrationReference: existingGroup.IntegrationReference,
+		ConnectionStrategy:   connectionStrategy,
+		Rule:                 rule,
 	}
 
 	if err := h.accountManager.SaveGroup(r.Context(), accountID, userID, &group); err != nil {
@@ -202,6 +216,12 @@ func (h *handler) createGroup(w http.ResponseWriter, r *http.Request) {
 		Resources: resources,
 		Issued:    types.GroupIssuedAPI,
 	}
+	if req.ConnectionStrategy != nil {
+		group.ConnectionStrategy = string(*req.ConnectionStrategy)
+	}
+	if req.Rule != nil {
+		group.Rule = *req.Rule
+	}
 
 	err = h.accountManager.SaveGroup(r.Context(), accountID, userID, &group)
 	if err != nil {
@@ -292,6 +312,12 @@ func toGroupResponse(peers []*nbpeer.Peer, group *types.Group) *api.Group {
 		Name:   group.Name,
 		Issued: (*api.GroupIssued)(&group.Issued),
 	}
+	if group.ConnectionStrategy != "" {
+		gr.ConnectionStrategy = (*api.GroupConnectionStrategy)(&group.ConnectionStrategy)
+	}
+	if group.IsDynamic() {
+		gr.Rule = &group.Rule
+	}
 
 	for _, pid := range group.Peers {
 		_, ok := peerCache[pid]
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
@@ -27,9 +28,13 @@ type Handler struct {
 func AddEndpoints(accountManager server.AccountManager, router *mux.Router) {
 	peersHandler := NewHandler(accountManager)
 	router.HandleFunc("/peers", peersHandler.GetAllPeers).Methods("GET", "OPTIONS")
+	// registered before the peer path, otherwise "pending" would match as a peer ID
+	router.HandleFunc("/peers/pending", peersHandler.GetPendingPeers).Methods("GET", "OPTIONS")
 	router.HandleFunc("/peers/{peerId}", peersHandler.HandlePeer).
 		Methods("GET", "PUT", "DELETE", "OPTIONS")
 	router.HandleFunc("/peers/{peerId}/accessible-peers", peersHandler.GetAccessiblePeers).Methods("GET", "OPTIONS")
+	router.HandleFunc("/peers/{peerId}/approve", peersHandler.ApprovePeer).Methods("POST", "OPTIONS")
+	router.HandleFunc("/peers/{peerId}/reject", peersHandler.RejectPeer).Methods("POST", "OPTIONS")
 }
 
 // NewHandler creates a new peers Handler
@@ -97,6 +102,32 @@ func (h *Handler) updatePeer(ctx context.Context, accountID, userID, peerID stri
 		InactivityExpirationEnabled: req.InactivityExpirationEnabled,
 	}
 
+	if req.SshSftpEnabled == nil || req.SshLocalPortForwardingEnabled == nil || req.SshRemotePortForwardingEnabled == nil ||
+		req.SshSessionRecordingEnabled == nil {
+		// omitted SSH features keep their current values
+		peer, err := h.accountManager.GetPeer(ctx, accountID, peerID, userID)
+		if err != nil {
+			util.WriteError(ctx, err, w)
+			return
+		}
+		update.SSHSFTPEnabled = peer.SSHSFTPEnabled
+		update.SSHLocalPortForwardingEnabled = peer.SSHLocalPortForwardingEnabled
+		update.SSHRemotePortForwardingEnabled = peer.SSHRemotePortForwardingEnabled
+		update.SSHSessionRecordingEnabled = peer.SSHSessionRecordingEnabled
+	}
+	if req.SshSftpEnabled != nil {
+		update.SSHSFTPEnabled = *req.SshSftpEnabled
+	}
+	if req.SshLocalPortForwardingEnabled != nil {
+		update.SSHLocalPortForwardingEnabled = *req.SshLocalPortForwardingEnabled
+	}
+	if req.SshRemotePortForwardingEnabled != nil {
+		update.SSHRemotePortForwardingEnabled = *req.SshRemotePortForwardingEnabled
+	}
+	if req.SshSessionRecordingEnabled != nil {
+		update.SSHSessionRecordingEnabled = *req.SshSessionRecordingEnabled
+	}
+
 	if req.ApprovalRequired != nil {
 		// todo: looks like that we reset all status property, is it right?
 		update.Status = &nbpeer.PeerStatus{
@@ -224,6 +255,88 @@ func (h *Handler) setApprovalRequiredFlag(respBody []*api.PeerBatch, approvedPee
 	}
 }
 
+// GetPendingPeers returns a list of the peers waiting for approval
+func (h *Handler) GetPendingPeers(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	peers, err := h.accountManager.GetPendingPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	settings, err := h.accountManager.GetAccountSettings(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	respBody := make([]*api.PendingPeer, 0, len(peers))
+	for _, peer := range peers {
+		peerToReturn, err := h.checkPeerStatus(peer)
+		if err != nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+
+		respBody = append(respBody, toPendingPeerResponse(peerToReturn, settings.PeerApprovalTimeout))
+	}
+
+	util.WriteJSONObject(r.Context(), w, respBody)
+}
+
+// ApprovePeer approves a pending peer and returns it
+func (h *Handler) ApprovePeer(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	peerID := mux.Vars(r)["peerId"]
+	if len(peerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid peer ID"), w)
+		return
+	}
+
+	if _, err = h.accountManager.ApprovePeer(r.Context(), accountID, userID, peerID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.getPeer(r.Context(), accountID, peerID, userID, w)
+}
+
+// RejectPeer rejects a pending peer, the peer is removed from the account
+func (h *Handler) RejectPeer(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	peerID := mux.Vars(r)["peerId"]
+	if len(peerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid peer ID"), w)
+		return
+	}
+
+	if err = h.accountManager.RejectPeer(r.Context(), accountID, userID, peerID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
 // GetAccessiblePeers returns a list of all peers that the specified peer can connect to within the network.
 func (h *Handler) GetAccessiblePeers(w http.ResponseWriter, r *http.Request) {
 	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
@@ -277,8 +390,8 @@ func (h *Handler) GetAccessiblePeers(w http.ResponseWriter, r *http.Request) {
 
 	dnsDomain := h.accountManager.GetDNSDomain()
 
-	customZone := account.GetPeersCustomZone(r.Context(), dnsDomain)
-	netMap := account.GetPeerNetworkMap(r.Context(), peerID, customZone, validPeers, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), nil)
+	customZones := account.GetPeersCustomZones(r.Context(), dnsDomain)
+	netMap := account.GetPeerNetworkMap(r.Context(), peerID, customZones, validPeers, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), nil)
 
 	util.WriteJSONObject(r.Context(), w, toAccessiblePeers(netMap, dnsDomain))
 }
@@ -319,31 +432,35 @@ func toSinglePeerResponse(peer *nbpeer.Peer, groupsInfo []api.GroupMinimum, dnsD
 	}
 
 	return &api.Peer{
-		Id:                          peer.ID,
-		Name:                        peer.Name,
-		Ip:                          peer.IP.String(),
-		ConnectionIp:                peer.Location.ConnectionIP.String(),
-		Connected:                   peer.Status.Connected,
-		LastSeen:                    peer.Status.LastSeen,
-		Os:                          fmt.Sprintf("%s %s", peer.Meta.OS, osVersion),
-		KernelVersion:               peer.Meta.KernelVersion,
-		GeonameId:                   int(peer.Location.GeoNameID),
-		Version:                     peer.Meta.WtVersion,
-		Groups:                      groupsInfo,
-		SshEnabled:                  peer.SSHEnabled,
-		Hostname:                    peer.Meta.Hostname,
-		UserId:                      peer.UserID,
-		UiVersion:                   peer.Meta.UIVersion,
-		DnsLabel:                    fqdn(peer, dnsDomain),
-		ExtraDnsLabels:              fqdnList(peer.ExtraDNSLabels, dnsDomain),
-		LoginExpirationEnabled:      peer.LoginExpirationEnabled,
-		LastLogin:                   peer.GetLastLogin(),
-		LoginExpired:                peer.Status.LoginExpired,
-		ApprovalRequired:            !approved,
-		CountryCode:                 peer.Location.CountryCode,
-		CityName:                    peer.Location.CityName,
-		SerialNumber:                peer.Meta.SystemSerialNumber,
-		InactivityExpirationEnabled: peer.InactivityExpirationEnabled,
+		Id:                             peer.ID,
+		Name:                           peer.Name,
+		Ip:                             peer.IP.String(),
+		ConnectionIp:                   peer.Location.ConnectionIP.String(),
+		Connected:                      peer.Status.Connected,
+		LastSeen:                       peer.Status.LastSeen,
+		Os:                             fmt.Sprintf("%s %s", peer.Meta.OS, osVersion),
+		KernelVersion:                  peer.Meta.KernelVersion,
+		GeonameId:                      int(peer.Location.GeoNameID),
+		Version:                        peer.Meta.WtVersion,
+		Groups:                         groupsInfo,
+		SshEnabled:                     peer.SSHEnabled,
+		SshSftpEnabled:                 peer.SSHSFTPEnabled,
+		SshLocalPortForwardingEnabled:  peer.SSHLocalPortForwardingEnabled,
+		SshRemotePortForwardingEnabled: peer.SSHRemotePortForwardingEnabled,
+		SshSessionRecordingEnabled:     peer.SSHSessionRecordingEnabled,
+		Hostname:                       peer.Meta.Hostname,
+		UserId:                         peer.UserID,
+		UiVersion:                      peer.Meta.UIVersion,
+		DnsLabel:                       fqdn(peer, dnsDomain),
+		ExtraDnsLabels:                 fqdnList(peer.ExtraDNSLabels, dnsDomain),
+		LoginExpirationEnabled:         peer.LoginExpirationEnabled,
+		LastLogin:                      peer.GetLastLogin(),
+		LoginExpired:                   peer.Status.LoginExpired,
+		ApprovalRequired:               !approved,
+		CountryCode:                    peer.Location.CountryCode,
+		CityName:                       peer.Location.CityName,
+		SerialNumber:                   peer.Meta.SystemSerialNumber,
+		InactivityExpirationEnabled:    peer.InactivityExpirationEnabled,
 	}
 }
 
@@ -399,3 +516,29 @@ func fqdnList(extraLabels []string, dnsDomain string) []string {
 	}
 	return fqdnList
 }
+
+func toPendingPeerResponse(peer *nbpeer.Peer, approvalTimeout time.Duration) *api.PendingPeer {
+	osVersion := peer.Meta.OSVersion
+	if osVersion == "" {
+		osVersion = peer.Meta.Core
+	}
+
+	pendingPeer := &api.PendingPeer{
+		Id:           peer.ID,
+		Name:         peer.Name,
+		Ip:           peer.IP.String(),
+		Hostname:     peer.Meta.Hostname,
+		UserId:       peer.UserID,
+		Os:           fmt.Sprintf("%s %s", peer.Meta.OS, osVersion),
+		ConnectionIp: peer.Location.ConnectionIP.String(),
+		Connected:    peer.Status.Connected,
+		CreatedAt:    peer.CreatedAt,
+	}
+
+	if approvalTimeout > 0 {
+		expiresAt := peer.CreatedAt.Add(approvalTimeout)
+		pendingPeer.ExpiresAt = &expiresAt
+	}
+
+	return pendingPeer
+}
@@ -108,6 +108,10 @@ func initTestMetaData(peers ...*nbpeer.Peer) *Handler {
 					}
 				}
 				p.SSHEnabled = update.SSHEnabled
+				p.SSHSFTPEnabled = update.SSHSFTPEnabled
+				p.SSHLocalPortForwardingEnabled = update.SSHLocalPortForwardingEnabled
+				p.SSHRemotePortForwardingEnabled = update.SSHRemotePortForwardingEnabled
+				p.SSHSessionRecordingEnabled = update.SSHSessionRecordingEnabled
 				p.LoginExpirationEnabled = update.LoginExpirationEnabled
 				p.Name = update.Name
 				return p, nil
@@ -195,6 +199,11 @@ func TestGetPeers(t *testing.T) {
 	expectedUpdatedPeer.SSHEnabled = true
 	expectedUpdatedPeer.Name = "New Name"
 
+	expectedSSHFeaturesPeer := peer.Copy()
+	expectedSSHFeaturesPeer.SSHEnabled = true
+	expectedSSHFeaturesPeer.SSHSFTPEnabled = true
+	expectedSSHFeaturesPeer.SSHSessionRecordingEnabled = true
+
 	expectedPeer1 := peer1.Copy()
 	expectedPeer1.Status.Connected = false
 
@@ -240,6 +249,15 @@ func TestGetPeers(t *testing.T) {
 			requestBody:    bytes.NewBufferString("{\"login_expiration_enabled\":true,\"name\":\"New Name\",\"ssh_enabled\":true}"),
 			expectedPeer:   expectedUpdatedPeer,
 		},
+		{
+			name:           "PutPeer SSH features",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/peers/" + testPeerID,
+			expectedStatus: http.StatusOK,
+			expectedArray:  false,
+			requestBody:    bytes.NewBufferString("{\"name\":\"PeerName\",\"ssh_enabled\":true,\"ssh_sftp_enabled\":true,\"ssh_session_recording_enabled\":true}"),
+			expectedPeer:   expectedSSHFeaturesPeer,
+		},
 	}
 
 	rr := httptest.NewRecorder()
@@ -311,6 +329,10 @@ func TestGetPeers(t *testing.T) {
 			assert.Equal(t, got.Os, "OS core")
 			assert.Equal(t, got.LoginExpirationEnabled, tc.expectedPeer.LoginExpirationEnabled)
 			assert.Equal(t, got.SshEnabled, tc.expectedPeer.SSHEnabled)
+			assert.Equal(t, got.SshSftpEnabled, tc.expectedPeer.SSHSFTPEnabled)
+			assert.Equal(t, got.SshLocalPortForwardingEnabled, tc.expectedPeer.SSHLocalPortForwardingEnabled)
+			assert.Equal(t, got.SshRemotePortForwardingEnabled, tc.expectedPeer.SSHRemotePortForwardingEnabled)
+			assert.Equal(t, got.SshSessionRecordingEnabled, tc.expectedPeer.SSHSessionRecordingEnabled)
 			assert.Equal(t, got.Connected, tc.expectedPeer.Status.Connected)
 			assert.Equal(t, got.SerialNumber, tc.expectedPeer.Meta.SystemSerialNumber)
 		})
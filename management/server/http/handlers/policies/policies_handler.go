@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -25,6 +27,8 @@ func AddEndpoints(accountManager server.AccountManager, locationManager geolocat
 	policiesHandler := newHandler(accountManager)
 	router.HandleFunc("/policies", policiesHandler.getAllPolicies).Methods("GET", "OPTIONS")
 	router.HandleFunc("/policies", policiesHandler.createPolicy).Methods("POST", "OPTIONS")
+	router.HandleFunc("/policies/explain", policiesHandler.explainPolicy).Methods("POST", "OPTIONS")
+	router.HandleFunc("/policies/dry-run", policiesHandler.dryRunPolicy).Methods("POST", "OPTIONS")
 	router.HandleFunc("/policies/{policyId}", policiesHandler.updatePolicy).Methods("PUT", "OPTIONS")
 	router.HandleFunc("/policies/{policyId}", policiesHandler.getPolicy).Methods("GET", "OPTIONS")
 	router.HandleFunc("/policies/{policyId}", policiesHandler.deletePolicy).Methods("DELETE", "OPTIONS")
@@ -120,16 +124,147 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		return
 	}
 
-	if req.Name == "" {
-		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "policy name shouldn't be empty"), w)
+	policy, err := toPolicy(req, accountID, policyID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
 		return
 	}
 
-	if len(req.Rules) == 0 {
-		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "policy rules shouldn't be empty"), w)
+	policy, err = h.accountManager.SavePolicy(r.Context(), accountID, userID, policy)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	allGroups, err := h.accountManager.GetAllGroups(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
 		return
 	}
 
+	resp := toPolicyResponse(allGroups, policy)
+	if len(resp.Rules) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.Internal, "no rules in the policy"), w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// explainPolicy handles the evaluation of a connection between a peer and a destination peer or network resource
+func (h *handler) explainPolicy(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	var req api.PostApiPoliciesExplainJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	explainRequest := &types.PolicyExplainRequest{
+		SourcePeerID: req.SourcePeerId,
+		Protocol:     types.PolicyRuleProtocolType(req.Protocol),
+	}
+
+	if req.DestinationPeerId != nil {
+		explainRequest.DestinationPeerID = *req.DestinationPeerId
+	}
+
+	if req.DestinationResourceId != nil {
+		explainRequest.DestinationResourceID = *req.DestinationResourceId
+	}
+
+	if (explainRequest.DestinationPeerID == "") == (explainRequest.DestinationResourceID == "") {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either destination peer or destination resource"), w)
+		return
+	}
+
+	if req.Port != nil {
+		if *req.Port < 1 || *req.Port > 65535 {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range"), w)
+			return
+		}
+		explainRequest.Port = uint16(*req.Port)
+	}
+
+	explanation, err := h.accountManager.ExplainPolicyAccess(r.Context(), accountID, userID, explainRequest)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toPolicyExplanationResponse(explanation))
+}
+
+// dryRunPolicy handles the simulation of a policy change
+func (h *handler) dryRunPolicy(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	var req api.PostApiPoliciesDryRunJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	var policyID string
+	if req.PolicyId != nil {
+		policyID = *req.PolicyId
+	}
+
+	var policy *types.Policy
+	if req.Policy != nil {
+		policy, err = toPolicy(api.PutApiPoliciesPolicyIdJSONRequestBody(*req.Policy), accountID, policyID)
+		if err != nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+	}
+
+	diff, err := h.accountManager.DryRunPolicy(r.Context(), accountID, userID, policyID, policy)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	peers, err := h.accountManager.GetPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	peerNames := make(map[string]string, len(peers))
+	for _, peer := range peers {
+		peerNames[peer.ID] = peer.Name
+	}
+
+	util.WriteJSONObject(r.Context(), w, &api.PolicyDryRunResponse{
+		Gained: toPeerAccessPairsResponse(diff.Gained, peerNames),
+		Lost:   toPeerAccessPairsResponse(diff.Lost, peerNames),
+	})
+}
+
+// toPolicy converts the policy request to a policy object and validates its rules
+func toPolicy(req api.PutApiPoliciesPolicyIdJSONRequestBody, accountID, policyID string) (*types.Policy, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(status.InvalidArgument, "policy name shouldn't be empty")
+	}
+
+	if len(req.Rules) == 0 {
+		return nil, status.Errorf(status.InvalidArgument, "policy rules shouldn't be empty")
+	}
+
 	description := ""
 	if req.Description != nil {
 		description = *req.Description
@@ -155,18 +290,15 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		hasDestinationResource := rule.DestinationResource != nil
 
 		if hasSources && hasSourceResource {
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either sources or  source resources, not both"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "specify either sources or  source resources, not both")
 		}
 
 		if hasDestinations && hasDestinationResource {
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either destinations or  destination resources, not both"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "specify either destinations or  destination resources, not both")
 		}
 
 		if !(hasSources || hasSourceResource) || !(hasDestinations || hasDestinationResource) {
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either sources or source resources and destinations or destination resources"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "specify either sources or source resources and destinations or destination resources")
 		}
 
 		pr := types.PolicyRule{
@@ -209,8 +341,7 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		case api.PolicyRuleUpdateActionDrop:
 			pr.Action = types.PolicyTrafficActionDrop
 		default:
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "unknown action type"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "unknown action type")
 		}
 
 		switch rule.Protocol {
@@ -223,20 +354,17 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		case api.PolicyRuleUpdateProtocolIcmp:
 			pr.Protocol = types.PolicyRuleProtocolICMP
 		default:
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "unknown protocol type: %v", rule.Protocol), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "unknown protocol type: %v", rule.Protocol)
 		}
 
 		if (rule.Ports != nil && len(*rule.Ports) != 0) && (rule.PortRanges != nil && len(*rule.PortRanges) != 0) {
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either individual ports or port ranges, not both"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "specify either individual ports or port ranges, not both")
 		}
 
 		if rule.Ports != nil && len(*rule.Ports) != 0 {
 			for _, v := range *rule.Ports {
 				if port, err := strconv.Atoi(v); err != nil || port < 1 || port > 65535 {
-					util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range"), w)
-					return
+					return nil, status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range")
 				}
 				pr.Ports = append(pr.Ports, v)
 			}
@@ -245,8 +373,7 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		if rule.PortRanges != nil && len(*rule.PortRanges) != 0 {
 			for _, portRange := range *rule.PortRanges {
 				if portRange.Start < 1 || portRange.End > 65535 {
-					util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range"), w)
-					return
+					return nil, status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range")
 				}
 				pr.PortRanges = append(pr.PortRanges, types.RulePortRange{
 					Start: uint16(portRange.Start),
@@ -255,21 +382,30 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 			}
 		}
 
+		if rule.Schedule != nil {
+			schedule, err := toPolicyRuleSchedule(rule.Schedule)
+			if err != nil {
+				return nil, status.Errorf(status.InvalidArgument, "invalid rule schedule: %v", err)
+			}
+			pr.Schedule = schedule
+		}
+
+		if rule.SshAuthorizations != nil {
+			pr.SSHAuthorizations = toSSHAuthorizations(*rule.SshAuthorizations)
+		}
+
 		// validate policy object
 		switch pr.Protocol {
 		case types.PolicyRuleProtocolALL, types.PolicyRuleProtocolICMP:
 			if len(pr.Ports) != 0 || len(pr.PortRanges) != 0 {
-				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol ports is not allowed"), w)
-				return
+				return nil, status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol ports is not allowed")
 			}
 			if !pr.Bidirectional {
-				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol type flow can be only bi-directional"), w)
-				return
+				return nil, status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol type flow can be only bi-directional")
 			}
 		case types.PolicyRuleProtocolTCP, types.PolicyRuleProtocolUDP:
 			if !pr.Bidirectional && (len(pr.Ports) == 0 || len(pr.PortRanges) != 0) {
-				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol type flow can be only bi-directional"), w)
-				return
+				return nil, status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol type flow can be only bi-directional")
 			}
 		}
 
@@ -280,25 +416,7 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		policy.SourcePostureChecks = *req.SourcePostureChecks
 	}
 
-	policy, err := h.accountManager.SavePolicy(r.Context(), accountID, userID, policy)
-	if err != nil {
-		util.WriteError(r.Context(), err, w)
-		return
-	}
-
-	allGroups, err := h.accountManager.GetAllGroups(r.Context(), accountID, userID)
-	if err != nil {
-		util.WriteError(r.Context(), err, w)
-		return
-	}
-
-	resp := toPolicyResponse(allGroups, policy)
-	if len(resp.Rules) == 0 {
-		util.WriteError(r.Context(), status.Errorf(status.Internal, "no rules in the policy"), w)
-		return
-	}
-
-	util.WriteJSONObject(r.Context(), w, resp)
+	return policy, nil
 }
 
 // deletePolicy handles policy deletion request
@@ -408,6 +526,14 @@ func toPolicyResponse(groups []*types.Group, policy *types.Policy) *api.Policy {
 			rule.PortRanges = &portRanges
 		}
 
+		if r.Schedule != nil {
+			rule.Schedule = toPolicyRuleScheduleResponse(r.Schedule)
+		}
+
+		if len(r.SSHAuthorizations) != 0 {
+			rule.SshAuthorizations = toSSHAuthorizationsResponse(r.SSHAuthorizations)
+		}
+
 		var sources []api.GroupMinimum
 		for _, gid := range r.Sources {
 			_, ok := cache[gid]
@@ -450,3 +576,164 @@ func toPolicyResponse(groups []*types.Group, policy *types.Policy) *api.Policy {
 	}
 	return ap
 }
+
+var weekdaysByAPIDay = map[api.PolicyRuleTimeWindowDays]time.Weekday{
+	api.PolicyRuleTimeWindowDaysSunday:    time.Sunday,
+	api.PolicyRuleTimeWindowDaysMonday:    time.Monday,
+	api.PolicyRuleTimeWindowDaysTuesday:   time.Tuesday,
+	api.PolicyRuleTimeWindowDaysWednesday: time.Wednesday,
+	api.PolicyRuleTimeWindowDaysThursday:  time.Thursday,
+	api.PolicyRuleTimeWindowDaysFriday:    time.Friday,
+	api.PolicyRuleTimeWindowDaysSaturday:  time.Saturday,
+}
+
+func toPolicyRuleSchedule(schedule *api.PolicyRuleSchedule) (*types.PolicyRuleSchedule, error) {
+	s := &types.PolicyRuleSchedule{
+		StartsAt: schedule.StartsAt,
+		EndsAt:   schedule.EndsAt,
+	}
+	if schedule.Timezone != nil {
+		s.TimeZone = *schedule.Timezone
+	}
+
+	if schedule.Windows != nil {
+		for _, window := range *schedule.Windows {
+			w := types.PolicyRuleTimeWindow{
+				Start: window.Start,
+				End:   window.End,
+			}
+			if window.Days != nil {
+				for _, day := range *window.Days {
+					weekday, ok := weekdaysByAPIDay[day]
+					if !ok {
+						return nil, status.Errorf(status.InvalidArgument, "unknown day: %s", day)
+					}
+					w.Days = append(w.Days, weekday)
+				}
+			}
+			s.Windows = append(s.Windows, w)
+		}
+	}
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func toSSHAuthorizations(authorizations []api.PolicyRuleSSHAuthorization) []types.SSHAuthorization {
+	result := make([]types.SSHAuthorization, 0, len(authorizations))
+	for _, authorization := range authorizations {
+		a := types.SSHAuthorization{LocalUsers: authorization.LocalUsers}
+		if authorization.Groups != nil {
+			a.Groups = *authorization.Groups
+		}
+		if authorization.Users != nil {
+			a.Users = *authorization.Users
+		}
+		result = append(result, a)
+	}
+	return result
+}
+
+func toSSHAuthorizationsResponse(authorizations []types.SSHAuthorization) *[]api.PolicyRuleSSHAuthorization {
+	result := make([]api.PolicyRuleSSHAuthorization, 0, len(authorizations))
+	for _, authorization := range authorizations {
+		groups := append([]string{}, authorization.Groups...)
+		users := append([]string{}, authorization.Users...)
+		result = append(result, api.PolicyRuleSSHAuthorization{
+			Groups:     &groups,
+			Users:      &users,
+			LocalUsers: append([]string{}, authorization.LocalUsers...),
+		})
+	}
+	return &result
+}
+
+func toPolicyRuleScheduleResponse(schedule *types.PolicyRuleSchedule) *api.PolicyRuleSchedule {
+	timeZone := schedule.TimeZone
+	windows := make([]api.PolicyRuleTimeWindow, 0, len(schedule.Windows))
+	for _, w := range schedule.Windows {
+		days := make([]api.PolicyRuleTimeWindowDays, 0, len(w.Days))
+		for _, day := range w.Days {
+			days = append(days, api.PolicyRuleTimeWindowDays(strings.ToLower(day.String())))
+		}
+		windows = append(windows, api.PolicyRuleTimeWindow{
+			Days:  &days,
+			Start: w.Start,
+			End:   w.End,
+		})
+	}
+
+	return &api.PolicyRuleSchedule{
+		Timezone: &timeZone,
+		Windows:  &windows,
+		StartsAt: schedule.StartsAt,
+		EndsAt:   schedule.EndsAt,
+	}
+}
+
+func toPolicyExplanationResponse(explanation *types.PolicyExplanation) *api.PolicyExplanation {
+	resp := &api.PolicyExplanation{
+		Allowed:             explanation.Allowed,
+		Reason:              explanation.Reason,
+		MatchedRules:        make([]api.PolicyRuleMatch, 0, len(explanation.MatchedRules)),
+		FailedPostureChecks: make([]string, 0, len(explanation.FailedPostureChecks)),
+		RouteFirewallRules:  make([]api.RouteFirewallRule, 0, len(explanation.RouteFirewallRules)),
+	}
+
+	for _, match := range explanation.MatchedRules {
+		failedChecks := make([]string, 0, len(match.FailedPostureChecks))
+		failedChecks = append(failedChecks, match.FailedPostureChecks...)
+		resp.MatchedRules = append(resp.MatchedRules, api.PolicyRuleMatch{
+			PolicyId:            match.PolicyID,
+			PolicyName:          match.PolicyName,
+			RuleId:              match.RuleID,
+			RuleName:            match.RuleName,
+			Action:              api.PolicyRuleMatchAction(match.Action),
+			FailedPostureChecks: failedChecks,
+		})
+	}
+
+	resp.FailedPostureChecks = append(resp.FailedPostureChecks, explanation.FailedPostureChecks...)
+
+	for _, rule := range explanation.RouteFirewallRules {
+		fwRule := api.RouteFirewallRule{
+			SourceRanges: rule.SourceRanges,
+			Action:       rule.Action,
+			Destination:  rule.Destination,
+			Protocol:     rule.Protocol,
+		}
+		if rule.Port != 0 {
+			port := int(rule.Port)
+			fwRule.Port = &port
+		}
+		if rule.PortRange.Start != 0 || rule.PortRange.End != 0 {
+			fwRule.PortRange = &api.RulePortRange{
+				Start: int(rule.PortRange.Start),
+				End:   int(rule.PortRange.End),
+			}
+		}
+		if len(rule.Domains) != 0 {
+			domains := rule.Domains.ToSafeStringList()
+			fwRule.Domains = &domains
+		}
+		resp.RouteFirewallRules = append(resp.RouteFirewallRules, fwRule)
+	}
+
+	return resp
+}
+
+func toPeerAccessPairsResponse(pairs []types.PeerAccessPair, peerNames map[string]string) []api.PeerAccessPair {
+	resp := make([]api.PeerAccessPair, 0, len(pairs))
+	for _, pair := range pairs {
+		resp = append(resp, api.PeerAccessPair{
+			PeerId:         pair.PeerID,
+			PeerName:       peerNames[pair.PeerID],
+			RemotePeerId:   pair.RemotePeerID,
+			RemotePeerName: peerNames[pair.RemotePeerID],
+		})
+	}
+	return resp
+}
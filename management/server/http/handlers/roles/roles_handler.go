@@ -0,0 +1,200 @@
+package roles
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// handler is a handler that returns custom roles of the account
+type handler struct {
+	accountManager server.AccountManager
+}
+
+func AddEndpoints(accountManager server.AccountManager, router *mux.Router) {
+	rolesHandler := newHandler(accountManager)
+	router.HandleFunc("/roles", rolesHandler.getAllRoles).Methods("GET", "OPTIONS")
+	router.HandleFunc("/roles", rolesHandler.createRole).Methods("POST", "OPTIONS")
+	router.HandleFunc("/roles/{roleId}", rolesHandler.getRole).Methods("GET", "OPTIONS")
+	router.HandleFunc("/roles/{roleId}", rolesHandler.updateRole).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/roles/{roleId}", rolesHandler.deleteRole).Methods("DELETE", "OPTIONS")
+}
+
+// newHandler creates a new custom roles handler
+func newHandler(accountManager server.AccountManager) *handler {
+	return &handler{
+		accountManager: accountManager,
+	}
+}
+
+// getAllRoles lists all custom roles of the account
+func (h *handler) getAllRoles(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	roles, err := h.accountManager.ListCustomRoles(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	apiRoles := make([]*api.Role, 0, len(roles))
+	for _, role := range roles {
+		apiRoles = append(apiRoles, toRoleResponse(role))
+	}
+
+	util.WriteJSONObject(r.Context(), w, apiRoles)
+}
+
+// getRole handles a custom role Get request identified by ID
+func (h *handler) getRole(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	roleID := mux.Vars(r)["roleId"]
+	if len(roleID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid role ID"), w)
+		return
+	}
+
+	role, err := h.accountManager.GetCustomRole(r.Context(), accountID, roleID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toRoleResponse(role))
+}
+
+// createRole handles custom role creation request
+func (h *handler) createRole(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.saveRole(w, r, userAuth.AccountId, userAuth.UserId, "")
+}
+
+// updateRole handles update to a custom role identified by a given ID
+func (h *handler) updateRole(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	roleID := mux.Vars(r)["roleId"]
+	if len(roleID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid role ID"), w)
+		return
+	}
+
+	h.saveRole(w, r, userAuth.AccountId, userAuth.UserId, roleID)
+}
+
+// deleteRole handles custom role deletion request
+func (h *handler) deleteRole(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	roleID := mux.Vars(r)["roleId"]
+	if len(roleID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid role ID"), w)
+		return
+	}
+
+	if err = h.accountManager.DeleteCustomRole(r.Context(), accountID, roleID, userID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+// saveRole handles custom role create and update
+func (h *handler) saveRole(w http.ResponseWriter, r *http.Request, accountID, userID, roleID string) {
+	var req api.RoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	if req.Name == "" {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "role name shouldn't be empty"), w)
+		return
+	}
+
+	role := &types.CustomRole{
+		ID:          roleID,
+		Name:        req.Name,
+		Permissions: make([]types.RolePermission, 0, len(req.Permissions)),
+	}
+	if req.Description != nil {
+		role.Description = *req.Description
+	}
+
+	for _, permission := range req.Permissions {
+		rolePermission := types.RolePermission{
+			Module: string(permission.Module),
+			Read:   permission.Read,
+			Write:  permission.Write,
+		}
+		if permission.Groups != nil {
+			rolePermission.Groups = *permission.Groups
+		}
+		role.Permissions = append(role.Permissions, rolePermission)
+	}
+
+	role, err := h.accountManager.SaveCustomRole(r.Context(), accountID, userID, role)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toRoleResponse(role))
+}
+
+func toRoleResponse(role *types.CustomRole) *api.Role {
+	permissions := make([]api.RolePermission, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		apiPermission := api.RolePermission{
+			Module: api.RolePermissionModule(permission.Module),
+			Read:   permission.Read,
+			Write:  permission.Write,
+		}
+		if len(permission.Groups) > 0 {
+			groups := permission.Groups
+			apiPermission.Groups = &groups
+		}
+		permissions = append(permissions, apiPermission)
+	}
+
+	return &api.Role{
+		Id:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissions,
+	}
+}
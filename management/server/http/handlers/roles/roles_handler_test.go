@@ -0,0 +1,187 @@
+package roles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+const (
+	existingRoleID = "existingRoleID"
+	notFoundRoleID = "notFoundRoleID"
+	newRoleID      = "newRoleID"
+)
+
+func initRolesTestData(existingRole *types.CustomRole) *handler {
+	return &handler{
+		accountManager: &mock_server.MockAccountManager{
+			ListCustomRolesFunc: func(_ context.Context, _, _ string) ([]*types.CustomRole, error) {
+				return []*types.CustomRole{existingRole}, nil
+			},
+			GetCustomRoleFunc: func(_ context.Context, _, roleID, _ string) (*types.CustomRole, error) {
+				if roleID != existingRole.ID {
+					return nil, status.NewCustomRoleNotFoundError(roleID)
+				}
+				return existingRole, nil
+			},
+			SaveCustomRoleFunc: func(_ context.Context, _, _ string, role *types.CustomRole) (*types.CustomRole, error) {
+				switch role.ID {
+				case "":
+					role.ID = newRoleID
+				case existingRole.ID:
+				default:
+					return nil, status.NewCustomRoleNotFoundError(role.ID)
+				}
+				return role, nil
+			},
+			DeleteCustomRoleFunc: func(_ context.Context, _, roleID, _ string) error {
+				if roleID != existingRole.ID {
+					return status.NewCustomRoleNotFoundError(roleID)
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func TestRolesHandlers(t *testing.T) {
+	existingRole := &types.CustomRole{
+		ID:          existingRoleID,
+		Name:        "Network team",
+		Description: "Manages routes",
+		Permissions: []types.RolePermission{
+			{Module: "routes", Read: true, Write: true, Groups: []string{"networkTeam"}},
+		},
+	}
+
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		requestBody    io.Reader
+		expectedStatus int
+		expectedRoles  []*api.Role
+		expectedRole   *api.Role
+	}{
+		{
+			name:           "Get Roles",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/roles",
+			expectedStatus: http.StatusOK,
+			expectedRoles:  []*api.Role{toRoleResponse(existingRole)},
+		},
+		{
+			name:           "Get Existing Role",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/roles/" + existingRoleID,
+			expectedStatus: http.StatusOK,
+			expectedRole:   toRoleResponse(existingRole),
+		},
+		{
+			name:           "Get Not Existing Role",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/roles/" + notFoundRoleID,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Create Role",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/roles",
+			requestBody:    bytes.NewBufferString(`{"name":"Auditors","permissions":[{"module":"events","read":true,"write":false}]}`),
+			expectedStatus: http.StatusOK,
+			expectedRole: &api.Role{
+				Id:          newRoleID,
+				Name:        "Auditors",
+				Permissions: []api.RolePermission{{Module: api.RolePermissionModuleEvents, Read: true}},
+			},
+		},
+		{
+			name:           "Create Role Without Name",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/roles",
+			requestBody:    bytes.NewBufferString(`{"permissions":[]}`),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Update Role",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/roles/" + existingRoleID,
+			requestBody:    bytes.NewBufferString(`{"name":"Network team","description":"Routes","permissions":[{"module":"routes","read":true,"write":true,"groups":["networkTeam"]}]}`),
+			expectedStatus: http.StatusOK,
+			expectedRole: &api.Role{
+				Id:          existingRoleID,
+				Name:        "Network team",
+				Description: "Routes",
+				Permissions: []api.RolePermission{{Module: api.RolePermissionModuleRoutes, Read: true, Write: true, Groups: &[]string{"networkTeam"}}},
+			},
+		},
+		{
+			name:           "Update Not Existing Role",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/roles/" + notFoundRoleID,
+			requestBody:    bytes.NewBufferString(`{"name":"Network team","permissions":[]}`),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Delete Role",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/roles/" + existingRoleID,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	h := initRolesTestData(existingRole)
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, tc.requestBody)
+			req = nbcontext.SetUserAuthInRequest(req, nbcontext.UserAuth{
+				UserId:    "test_user",
+				Domain:    "hotmail.com",
+				AccountId: "testAccountId",
+			})
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/roles", h.getAllRoles).Methods("GET")
+			router.HandleFunc("/api/roles", h.createRole).Methods("POST")
+			router.HandleFunc("/api/roles/{roleId}", h.getRole).Methods("GET")
+			router.HandleFunc("/api/roles/{roleId}", h.updateRole).Methods("PUT")
+			router.HandleFunc("/api/roles/{roleId}", h.deleteRole).Methods("DELETE")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			content, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, recorder.Code, string(content))
+
+			if tc.expectedRole != nil {
+				got := &api.Role{}
+				require.NoError(t, json.Unmarshal(content, got))
+				assert.Equal(t, tc.expectedRole, got)
+			}
+
+			if tc.expectedRoles != nil {
+				var got []*api.Role
+				require.NoError(t, json.Unmarshal(content, &got))
+				assert.Equal(t, tc.expectedRoles, got)
+			}
+		})
+	}
+}
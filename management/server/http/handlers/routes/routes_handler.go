@@ -13,6 +13,7 @@ import (
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/http/util"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/route"
 )
@@ -125,7 +126,7 @@ func (h *handler) createRoute(w http.ResponseWriter, r *http.Request) {
 	}
 
 	newRoute, err := h.accountManager.CreateRoute(r.Context(), accountID, newPrefix, networkType, domains, peerId, peerGroupIds,
-		req.Description, route.NetID(req.NetworkId), req.Masquerade, req.Metric, req.Groups, accessControlGroupIds, req.Enabled, userID, req.KeepRoute)
+		req.Description, route.NetID(req.NetworkId), req.Masquerade, req.Metric, req.Groups, accessControlGroupIds, req.Enabled, userID, req.KeepRoute, routerTypes.HealthCheckFromAPIRequest(req.HealthCheck))
 
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
@@ -214,6 +215,7 @@ func (h *handler) updateRoute(w http.ResponseWriter, r *http.Request) {
 		Enabled:     req.Enabled,
 		Groups:      req.Groups,
 		KeepRoute:   req.KeepRoute,
+		HealthCheck: routerTypes.HealthCheckFromAPIRequest(req.HealthCheck),
 	}
 
 	if req.Domains != nil {
@@ -333,6 +335,7 @@ func toRouteResponse(serverRoute *route.Route) (*api.Route, error) {
 		Metric:      serverRoute.Metric,
 		Groups:      serverRoute.Groups,
 		KeepRoute:   serverRoute.KeepRoute,
+		HealthCheck: routerTypes.HealthCheckToAPIResponse(serverRoute.HealthCheck),
 	}
 
 	if len(serverRoute.PeerGroups) > 0 {
@@ -84,6 +84,14 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// keep the assigned custom role unless it is explicitly changed or the user no longer has the user role
+	customRoleID := existingUser.CustomRoleID
+	if req.CustomRoleId != nil {
+		customRoleID = *req.CustomRoleId
+	} else if userRole != types.UserRoleUser {
+		customRoleID = ""
+	}
+
 	newUser, err := h.accountManager.SaveUser(r.Context(), accountID, userID, &types.User{
 		Id:                   targetUserID,
 		Role:                 userRole,
@@ -91,6 +99,7 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		Blocked:              req.IsBlocked,
 		Issued:               existingUser.Issued,
 		IntegrationReference: existingUser.IntegrationReference,
+		CustomRoleID:         customRoleID,
 	})
 
 	if err != nil {
@@ -279,6 +288,11 @@ func toUserResponse(user *types.UserInfo, currenUserID string) *api.User {
 		userStatus = api.UserStatusBlocked
 	}
 
+	var customRoleID *string
+	if user.CustomRoleID != "" {
+		customRoleID = &user.CustomRoleID
+	}
+
 	isCurrent := user.ID == currenUserID
 	return &api.User{
 		Id:            user.ID,
@@ -292,6 +306,7 @@ func toUserResponse(user *types.UserInfo, currenUserID string) *api.User {
 		IsBlocked:     user.IsBlocked,
 		LastLogin:     &user.LastLogin,
 		Issued:        &user.Issued,
+		CustomRoleId:  customRoleID,
 		Permissions: &api.UserPermissions{
 			DashboardView: (*api.UserPermissionsDashboardView)(&user.Permissions.DashboardView),
 		},
@@ -17,7 +17,8 @@ import (
 // GetUser function defines a function to fetch user from Account by jwtclaims.AuthorizationClaims
 type GetUser func(ctx context.Context, userAuth nbcontext.UserAuth) (*types.User, error)
 
-// AccessControl middleware to restrict to make POST/PUT/DELETE requests by admin only
+// AccessControl middleware to restrict to make POST/PUT/DELETE requests by admin only, except for the requests
+// to the resources that are protected by the permissions manager
 type AccessControl struct {
 	getUser GetUser
 }
@@ -31,6 +32,11 @@ func NewAccessControl(getUser GetUser) *AccessControl {
 
 var tokenPathRegexp = regexp.MustCompile(`^.*/api/users/.*/tokens.*$`)
 
+// permissionsPathRegexp matches the API paths of the modules custom roles can grant write access to, see
+// permissions.WritableModules. Their handlers validate the user permissions scoped to the groups of the modified
+// objects, so modify requests to these paths are passed through for users with custom roles
+var permissionsPathRegexp = regexp.MustCompile(`^.*/api/(groups|policies|posture-checks|routes|dns|setup-keys|users|events)(/.*)?$`)
+
 // Handler method of the middleware which forbids all modify requests for non admin users
 func (a *AccessControl) Handler(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -67,6 +73,11 @@ func (a *AccessControl) Handler(h http.Handler) http.Handler {
 					return
 				}
 
+				if permissionsPathRegexp.MatchString(r.URL.Path) {
+					h.ServeHTTP(w, r)
+					return
+				}
+
 				util.WriteError(r.Context(), status.Errorf(status.PermissionDenied, "only users with admin power can perform this operation"), w)
 				return
 			}
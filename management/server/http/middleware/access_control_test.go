@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestAccessControl_Handler(t *testing.T) {
+	users := map[string]*types.User{
+		"admin":   {Id: "admin", Role: types.UserRoleAdmin},
+		"user":    {Id: "user", Role: types.UserRoleUser},
+		"scoped":  {Id: "scoped", Role: types.UserRoleUser, CustomRoleID: "role"},
+		"blocked": {Id: "blocked", Role: types.UserRoleAdmin, Blocked: true},
+	}
+
+	accessControl := NewAccessControl(func(_ context.Context, userAuth nbcontext.UserAuth) (*types.User, error) {
+		return users[userAuth.UserId], nil
+	})
+	handler := accessControl.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tt := []struct {
+		name           string
+		userID         string
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{name: "admin modifies peers", userID: "admin", method: http.MethodPut, path: "/api/peers/peer", expectedStatus: http.StatusOK},
+		{name: "blocked user", userID: "blocked", method: http.MethodGet, path: "/api/peers", expectedStatus: http.StatusForbidden},
+		{name: "user reads peers", userID: "user", method: http.MethodGet, path: "/api/peers", expectedStatus: http.StatusOK},
+		{name: "user creates token", userID: "user", method: http.MethodPost, path: "/api/users/user/tokens", expectedStatus: http.StatusOK},
+		{name: "scoped user modifies peers", userID: "scoped", method: http.MethodPut, path: "/api/peers/peer", expectedStatus: http.StatusForbidden},
+		{name: "scoped user modifies networks", userID: "scoped", method: http.MethodPost, path: "/api/networks", expectedStatus: http.StatusForbidden},
+		{name: "scoped user modifies accounts", userID: "scoped", method: http.MethodPut, path: "/api/accounts/account", expectedStatus: http.StatusForbidden},
+		{name: "scoped user modifies roles", userID: "scoped", method: http.MethodPost, path: "/api/roles", expectedStatus: http.StatusForbidden},
+		{name: "scoped user modifies groups", userID: "scoped", method: http.MethodPut, path: "/api/groups/group", expectedStatus: http.StatusOK},
+		{name: "scoped user creates routes", userID: "scoped", method: http.MethodPost, path: "/api/routes", expectedStatus: http.StatusOK},
+		{name: "scoped user deletes users", userID: "scoped", method: http.MethodDelete, path: "/api/users/user", expectedStatus: http.StatusOK},
+		{name: "scoped user creates policy", userID: "scoped", method: http.MethodPost, path: "/api/policies", expectedStatus: http.StatusOK},
+		{name: "scoped user modifies setup keys", userID: "scoped", method: http.MethodPost, path: "/api/setup-keys", expectedStatus: http.StatusOK},
+		{name: "scoped user modifies nameservers", userID: "scoped", method: http.MethodPut, path: "/api/dns/nameservers/ns", expectedStatus: http.StatusOK},
+		{name: "scoped user modifies posture checks", userID: "scoped", method: http.MethodDelete, path: "/api/posture-checks/check", expectedStatus: http.StatusOK},
+		{name: "scoped user creates event sinks", userID: "scoped", method: http.MethodPost, path: "/api/events/sinks", expectedStatus: http.StatusOK},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, "http://testing"+tc.path, nil)
+			req = nbcontext.SetUserAuthInRequest(req, nbcontext.UserAuth{UserId: tc.userID, AccountId: "account"})
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -194,12 +194,13 @@ func startServer(
 		t.Fatalf("failed creating an account manager: %v", err)
 	}
 
-	secretsManager := server.NewTimeBasedAuthSecretsManager(peersUpdateManager, config.TURNConfig, config.Relay)
+	settingsManager := settings.NewManager(str)
+	secretsManager := server.NewTimeBasedAuthSecretsManager(peersUpdateManager, config.TURNConfig, config.Relay, settingsManager)
 	mgmtServer, err := server.NewServer(
 		context.Background(),
 		config,
 		accountManager,
-		settings.NewManager(str),
+		settingsManager,
 		peersUpdateManager,
 		secretsManager,
 		nil,
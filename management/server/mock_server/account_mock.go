@@ -14,6 +14,7 @@ import (
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/activity"
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/gitops"
 	"github.com/netbirdio/netbird/management/server/idp"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
@@ -37,6 +38,9 @@ type MockAccountManager struct {
 	MarkPeerConnectedFunc               func(ctx context.Context, peerKey string, connected bool, realIP net.IP) error
 	SyncAndMarkPeerFunc                 func(ctx context.Context, accountID string, peerPubKey string, meta nbpeer.PeerSystemMeta, realIP net.IP) (*nbpeer.Peer, *types.NetworkMap, []*posture.Checks, error)
 	DeletePeerFunc                      func(ctx context.Context, accountID, peerKey, userID string) error
+	GetPendingPeersFunc                 func(ctx context.Context, accountID, userID string) ([]*nbpeer.Peer, error)
+	ApprovePeerFunc                     func(ctx context.Context, accountID, userID, peerID string) (*nbpeer.Peer, error)
+	RejectPeerFunc                      func(ctx context.Context, accountID, userID, peerID string) error
 	GetNetworkMapFunc                   func(ctx context.Context, peerKey string) (*types.NetworkMap, error)
 	GetPeerNetworkFunc                  func(ctx context.Context, peerKey string) (*types.Network, error)
 	AddPeerFunc                         func(ctx context.Context, setupKey string, userId string, peer *nbpeer.Peer) (*nbpeer.Peer, *types.NetworkMap, []*posture.Checks, error)
@@ -55,10 +59,12 @@ type MockAccountManager struct {
 	SavePolicyFunc                      func(ctx context.Context, accountID, userID string, policy *types.Policy) (*types.Policy, error)
 	DeletePolicyFunc                    func(ctx context.Context, accountID, policyID, userID string) error
 	ListPoliciesFunc                    func(ctx context.Context, accountID, userID string) ([]*types.Policy, error)
+	ExplainPolicyAccessFunc             func(ctx context.Context, accountID, userID string, req *types.PolicyExplainRequest) (*types.PolicyExplanation, error)
+	DryRunPolicyFunc                    func(ctx context.Context, accountID, userID, policyID string, policy *types.Policy) (*types.PolicyAccessDiff, error)
 	GetUsersFromAccountFunc             func(ctx context.Context, accountID, userID string) (map[string]*types.UserInfo, error)
 	UpdatePeerMetaFunc                  func(ctx context.Context, peerID string, meta nbpeer.PeerSystemMeta) error
 	UpdatePeerFunc                      func(ctx context.Context, accountID, userID string, peer *nbpeer.Peer) (*nbpeer.Peer, error)
-	CreateRouteFunc                     func(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peer string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool) (*route.Route, error)
+	CreateRouteFunc                     func(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peer string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, healthCheck *route.HealthCheck) (*route.Route, error)
 	GetRouteFunc                        func(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error)
 	SaveRouteFunc                       func(ctx context.Context, accountID string, userID string, route *route.Route) error
 	DeleteRouteFunc                     func(ctx context.Context, accountID string, routeID route.ID, userID string) error
@@ -79,12 +85,18 @@ type MockAccountManager struct {
 	SaveNameServerGroupFunc             func(ctx context.Context, accountID, userID string, nsGroupToSave *nbdns.NameServerGroup) error
 	DeleteNameServerGroupFunc           func(ctx context.Context, accountID, nsGroupID, userID string) error
 	ListNameServerGroupsFunc            func(ctx context.Context, accountID string, userID string) ([]*nbdns.NameServerGroup, error)
+	GetDNSZoneFunc                      func(ctx context.Context, accountID, userID, zoneID string) (*types.DNSZone, error)
+	CreateDNSZoneFunc                   func(ctx context.Context, accountID, userID string, zone *types.DNSZone) (*types.DNSZone, error)
+	SaveDNSZoneFunc                     func(ctx context.Context, accountID, userID string, zoneToSave *types.DNSZone) error
+	DeleteDNSZoneFunc                   func(ctx context.Context, accountID, zoneID, userID string) error
+	ListDNSZonesFunc                    func(ctx context.Context, accountID, userID string) ([]*types.DNSZone, error)
 	CreateUserFunc                      func(ctx context.Context, accountID, userID string, key *types.UserInfo) (*types.UserInfo, error)
 	GetAccountIDFromUserAuthFunc        func(ctx context.Context, userAuth nbcontext.UserAuth) (string, string, error)
 	DeleteAccountFunc                   func(ctx context.Context, accountID, userID string) error
 	GetDNSDomainFunc                    func() string
 	StoreEventFunc                      func(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any)
 	GetEventsFunc                       func(ctx context.Context, accountID, userID string) ([]*activity.Event, error)
+	QueryEventsFunc                     func(ctx context.Context, accountID, userID string, query *activity.Query) ([]*activity.Event, uint64, error)
 	GetDNSSettingsFunc                  func(ctx context.Context, accountID, userID string) (*types.DNSSettings, error)
 	SaveDNSSettingsFunc                 func(ctx context.Context, accountID, userID string, dnsSettingsToSave *types.DNSSettings) error
 	GetPeerFunc                         func(ctx context.Context, accountID, peerID, userID string) (*nbpeer.Peer, error)
@@ -103,6 +115,8 @@ type MockAccountManager struct {
 	UpdateIntegratedValidatorGroupsFunc func(ctx context.Context, accountID string, userID string, groups []string) error
 	GroupValidationFunc                 func(ctx context.Context, accountId string, groups []string) (bool, error)
 	SyncPeerMetaFunc                    func(ctx context.Context, peerPubKey string, meta nbpeer.PeerSystemMeta) error
+	RecordPeerSSHLoginsFunc             func(ctx context.Context, peerPubKey string, logins []nbpeer.SSHLogin) error
+	RecordPeerSSHSessionsFunc           func(ctx context.Context, peerPubKey string, sessions []nbpeer.SSHSession) error
 	FindExistingPostureCheckFunc        func(accountID string, checks *posture.ChecksDefinition) (*posture.Checks, error)
 	GetAccountIDForPeerKeyFunc          func(ctx context.Context, peerKey string) (string, error)
 	GetAccountByIDFunc                  func(ctx context.Context, accountID string, userID string) (*types.Account, error)
@@ -110,6 +124,16 @@ type MockAccountManager struct {
 	GetAccountSettingsFunc              func(ctx context.Context, accountID string, userID string) (*types.Settings, error)
 	DeleteSetupKeyFunc                  func(ctx context.Context, accountID, userID, keyID string) error
 	BuildUserInfosForAccountFunc        func(ctx context.Context, accountID, initiatorUserID string, accountUsers []*types.User) (map[string]*types.UserInfo, error)
+	GetCustomRoleFunc                   func(ctx context.Context, accountID, roleID, userID string) (*types.CustomRole, error)
+	ListCustomRolesFunc                 func(ctx context.Context, accountID, userID string) ([]*types.CustomRole, error)
+	SaveCustomRoleFunc                  func(ctx context.Context, accountID, userID string, role *types.CustomRole) (*types.CustomRole, error)
+	DeleteCustomRoleFunc                func(ctx context.Context, accountID, roleID, userID string) error
+	ListEventSinksFunc                  func(ctx context.Context, accountID, userID string) ([]*types.EventSink, error)
+	GetEventSinkFunc                    func(ctx context.Context, accountID, sinkID, userID string) (*types.EventSink, error)
+	SaveEventSinkFunc                   func(ctx context.Context, accountID, userID string, eventSink *types.EventSink) (*types.EventSink, error)
+	DeleteEventSinkFunc                 func(ctx context.Context, accountID, sinkID, userID string) error
+	ExportAccountConfigFunc             func(ctx context.Context, accountID, userID string) (*gitops.Document, error)
+	ApplyAccountConfigFunc              func(ctx context.Context, accountID, userID string, doc *gitops.Document, dryRun bool) (*gitops.Plan, error)
 }
 
 func (am *MockAccountManager) UpdateAccountPeers(ctx context.Context, accountID string) {
@@ -180,6 +204,30 @@ func (am *MockAccountManager) DeletePeer(ctx context.Context, accountID, peerID,
 	return status.Errorf(codes.Unimplemented, "method DeletePeer is not implemented")
 }
 
+// GetPendingPeers mock implementation of GetPendingPeers from server.AccountManager interface
+func (am *MockAccountManager) GetPendingPeers(ctx context.Context, accountID, userID string) ([]*nbpeer.Peer, error) {
+	if am.GetPendingPeersFunc != nil {
+		return am.GetPendingPeersFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPeers is not implemented")
+}
+
+// ApprovePeer mock implementation of ApprovePeer from server.AccountManager interface
+func (am *MockAccountManager) ApprovePeer(ctx context.Context, accountID, userID, peerID string) (*nbpeer.Peer, error) {
+	if am.ApprovePeerFunc != nil {
+		return am.ApprovePeerFunc(ctx, accountID, userID, peerID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePeer is not implemented")
+}
+
+// RejectPeer mock implementation of RejectPeer from server.AccountManager interface
+func (am *MockAccountManager) RejectPeer(ctx context.Context, accountID, userID, peerID string) error {
+	if am.RejectPeerFunc != nil {
+		return am.RejectPeerFunc(ctx, accountID, userID, peerID)
+	}
+	return status.Errorf(codes.Unimplemented, "method RejectPeer is not implemented")
+}
+
 // GetOrCreateAccountByUser mock implementation of GetOrCreateAccountByUser from server.AccountManager interface
 func (am *MockAccountManager) GetOrCreateAccountByUser(
 	ctx context.Context, userId, domain string,
@@ -404,6 +452,22 @@ func (am *MockAccountManager) ListPolicies(ctx context.Context, accountID, userI
 	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies is not implemented")
 }
 
+// ExplainPolicyAccess mock implementation of ExplainPolicyAccess from server.AccountManager interface
+func (am *MockAccountManager) ExplainPolicyAccess(ctx context.Context, accountID, userID string, req *types.PolicyExplainRequest) (*types.PolicyExplanation, error) {
+	if am.ExplainPolicyAccessFunc != nil {
+		return am.ExplainPolicyAccessFunc(ctx, accountID, userID, req)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPolicyAccess is not implemented")
+}
+
+// DryRunPolicy mock implementation of DryRunPolicy from server.AccountManager interface
+func (am *MockAccountManager) DryRunPolicy(ctx context.Context, accountID, userID, policyID string, policy *types.Policy) (*types.PolicyAccessDiff, error) {
+	if am.DryRunPolicyFunc != nil {
+		return am.DryRunPolicyFunc(ctx, accountID, userID, policyID, policy)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunPolicy is not implemented")
+}
+
 // UpdatePeerMeta mock implementation of UpdatePeerMeta from server.AccountManager interface
 func (am *MockAccountManager) UpdatePeerMeta(ctx context.Context, peerID string, meta nbpeer.PeerSystemMeta) error {
 	if am.UpdatePeerMetaFunc != nil {
@@ -436,9 +500,9 @@ func (am *MockAccountManager) UpdatePeer(ctx context.Context, accountID, userID
 }
 
 // CreateRoute mock implementation of CreateRoute from server.AccountManager interface
-func (am *MockAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupID []string, enabled bool, userID string, keepRoute bool) (*route.Route, error) {
+func (am *MockAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupID []string, enabled bool, userID string, keepRoute bool, healthCheck *route.HealthCheck) (*route.Route, error) {
 	if am.CreateRouteFunc != nil {
-		return am.CreateRouteFunc(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupID, enabled, userID, keepRoute)
+		return am.CreateRouteFunc(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupID, enabled, userID, keepRoute, healthCheck)
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRoute is not implemented")
 }
@@ -589,6 +653,46 @@ func (am *MockAccountManager) ListNameServerGroups(ctx context.Context, accountI
 	return nil, nil
 }
 
+// GetDNSZone mocks GetDNSZone of the AccountManager interface
+func (am *MockAccountManager) GetDNSZone(ctx context.Context, accountID, userID, zoneID string) (*types.DNSZone, error) {
+	if am.GetDNSZoneFunc != nil {
+		return am.GetDNSZoneFunc(ctx, accountID, userID, zoneID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSZone is not implemented")
+}
+
+// CreateDNSZone mocks CreateDNSZone of the AccountManager interface
+func (am *MockAccountManager) CreateDNSZone(ctx context.Context, accountID, userID string, zone *types.DNSZone) (*types.DNSZone, error) {
+	if am.CreateDNSZoneFunc != nil {
+		return am.CreateDNSZoneFunc(ctx, accountID, userID, zone)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDNSZone is not implemented")
+}
+
+// SaveDNSZone mocks SaveDNSZone of the AccountManager interface
+func (am *MockAccountManager) SaveDNSZone(ctx context.Context, accountID, userID string, zoneToSave *types.DNSZone) error {
+	if am.SaveDNSZoneFunc != nil {
+		return am.SaveDNSZoneFunc(ctx, accountID, userID, zoneToSave)
+	}
+	return status.Errorf(codes.Unimplemented, "method SaveDNSZone is not implemented")
+}
+
+// DeleteDNSZone mocks DeleteDNSZone of the AccountManager interface
+func (am *MockAccountManager) DeleteDNSZone(ctx context.Context, accountID, zoneID, userID string) error {
+	if am.DeleteDNSZoneFunc != nil {
+		return am.DeleteDNSZoneFunc(ctx, accountID, zoneID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteDNSZone is not implemented")
+}
+
+// ListDNSZones mocks ListDNSZones of the AccountManager interface
+func (am *MockAccountManager) ListDNSZones(ctx context.Context, accountID, userID string) ([]*types.DNSZone, error) {
+	if am.ListDNSZonesFunc != nil {
+		return am.ListDNSZonesFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListDNSZones is not implemented")
+}
+
 // CreateUser mocks CreateUser of the AccountManager interface
 func (am *MockAccountManager) CreateUser(ctx context.Context, accountID, userID string, invite *types.UserInfo) (*types.UserInfo, error) {
 	if am.CreateUserFunc != nil {
@@ -628,6 +732,14 @@ func (am *MockAccountManager) GetEvents(ctx context.Context, accountID, userID s
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents is not implemented")
 }
 
+// QueryEvents mocks QueryEvents of the AccountManager interface
+func (am *MockAccountManager) QueryEvents(ctx context.Context, accountID, userID string, query *activity.Query) ([]*activity.Event, uint64, error) {
+	if am.QueryEventsFunc != nil {
+		return am.QueryEventsFunc(ctx, accountID, userID, query)
+	}
+	return nil, 0, status.Errorf(codes.Unimplemented, "method QueryEvents is not implemented")
+}
+
 // GetDNSSettings mocks GetDNSSettings of the AccountManager interface
 func (am *MockAccountManager) GetDNSSettings(ctx context.Context, accountID string, userID string) (*types.DNSSettings, error) {
 	if am.GetDNSSettingsFunc != nil {
@@ -773,6 +885,22 @@ func (am *MockAccountManager) SyncPeerMeta(ctx context.Context, peerPubKey strin
 	return status.Errorf(codes.Unimplemented, "method SyncPeerMeta is not implemented")
 }
 
+// RecordPeerSSHLogins mocks RecordPeerSSHLogins of the AccountManager interface
+func (am *MockAccountManager) RecordPeerSSHLogins(ctx context.Context, peerPubKey string, logins []nbpeer.SSHLogin) error {
+	if am.RecordPeerSSHLoginsFunc != nil {
+		return am.RecordPeerSSHLoginsFunc(ctx, peerPubKey, logins)
+	}
+	return status.Errorf(codes.Unimplemented, "method RecordPeerSSHLogins is not implemented")
+}
+
+// RecordPeerSSHSessions mocks RecordPeerSSHSessions of the AccountManager interface
+func (am *MockAccountManager) RecordPeerSSHSessions(ctx context.Context, peerPubKey string, sessions []nbpeer.SSHSession) error {
+	if am.RecordPeerSSHSessionsFunc != nil {
+		return am.RecordPeerSSHSessionsFunc(ctx, peerPubKey, sessions)
+	}
+	return status.Errorf(codes.Unimplemented, "method RecordPeerSSHSessions is not implemented")
+}
+
 // FindExistingPostureCheck mocks FindExistingPostureCheck of the AccountManager interface
 func (am *MockAccountManager) FindExistingPostureCheck(accountID string, checks *posture.ChecksDefinition) (*posture.Checks, error) {
 	if am.FindExistingPostureCheckFunc != nil {
@@ -838,3 +966,83 @@ func (am *MockAccountManager) BuildUserInfosForAccount(ctx context.Context, acco
 func (am *MockAccountManager) SyncUserJWTGroups(ctx context.Context, userAuth nbcontext.UserAuth) error {
 	return status.Errorf(codes.Unimplemented, "method SyncUserJWTGroups is not implemented")
 }
+
+// GetCustomRole mocks GetCustomRole of the AccountManager interface
+func (am *MockAccountManager) GetCustomRole(ctx context.Context, accountID, roleID, userID string) (*types.CustomRole, error) {
+	if am.GetCustomRoleFunc != nil {
+		return am.GetCustomRoleFunc(ctx, accountID, roleID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomRole is not implemented")
+}
+
+// ListCustomRoles mocks ListCustomRoles of the AccountManager interface
+func (am *MockAccountManager) ListCustomRoles(ctx context.Context, accountID, userID string) ([]*types.CustomRole, error) {
+	if am.ListCustomRolesFunc != nil {
+		return am.ListCustomRolesFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomRoles is not implemented")
+}
+
+// SaveCustomRole mocks SaveCustomRole of the AccountManager interface
+func (am *MockAccountManager) SaveCustomRole(ctx context.Context, accountID, userID string, role *types.CustomRole) (*types.CustomRole, error) {
+	if am.SaveCustomRoleFunc != nil {
+		return am.SaveCustomRoleFunc(ctx, accountID, userID, role)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCustomRole is not implemented")
+}
+
+// DeleteCustomRole mocks DeleteCustomRole of the AccountManager interface
+func (am *MockAccountManager) DeleteCustomRole(ctx context.Context, accountID, roleID, userID string) error {
+	if am.DeleteCustomRoleFunc != nil {
+		return am.DeleteCustomRoleFunc(ctx, accountID, roleID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteCustomRole is not implemented")
+}
+
+// ExportAccountConfig mocks ExportAccountConfig of the AccountManager interface
+func (am *MockAccountManager) ExportAccountConfig(ctx context.Context, accountID, userID string) (*gitops.Document, error) {
+	if am.ExportAccountConfigFunc != nil {
+		return am.ExportAccountConfigFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccountConfig is not implemented")
+}
+
+// ApplyAccountConfig mocks ApplyAccountConfig of the AccountManager interface
+func (am *MockAccountManager) ApplyAccountConfig(ctx context.Context, accountID, userID string, doc *gitops.Document, dryRun bool) (*gitops.Plan, error) {
+	if am.ApplyAccountConfigFunc != nil {
+		return am.ApplyAccountConfigFunc(ctx, accountID, userID, doc, dryRun)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyAccountConfig is not implemented")
+}
+
+// ListEventSinks mocks ListEventSinks of the AccountManager interface
+func (am *MockAccountManager) ListEventSinks(ctx context.Context, accountID, userID string) ([]*types.EventSink, error) {
+	if am.ListEventSinksFunc != nil {
+		return am.ListEventSinksFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventSinks is not implemented")
+}
+
+// GetEventSink mocks GetEventSink of the AccountManager interface
+func (am *MockAccountManager) GetEventSink(ctx context.Context, accountID, sinkID, userID string) (*types.EventSink, error) {
+	if am.GetEventSinkFunc != nil {
+		return am.GetEventSinkFunc(ctx, accountID, sinkID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventSink is not implemented")
+}
+
+// SaveEventSink mocks SaveEventSink of the AccountManager interface
+func (am *MockAccountManager) SaveEventSink(ctx context.Context, accountID, userID string, eventSink *types.EventSink) (*types.EventSink, error) {
+	if am.SaveEventSinkFunc != nil {
+		return am.SaveEventSinkFunc(ctx, accountID, userID, eventSink)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveEventSink is not implemented")
+}
+
+// DeleteEventSink mocks DeleteEventSink of the AccountManager interface
+func (am *MockAccountManager) DeleteEventSink(ctx context.Context, accountID, sinkID, userID string) error {
+	if am.DeleteEventSinkFunc != nil {
+		return am.DeleteEventSinkFunc(ctx, accountID, sinkID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteEventSink is not implemented")
+}
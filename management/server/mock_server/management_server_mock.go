@@ -19,6 +19,8 @@ type ManagementServiceServerMock struct {
 	GetDeviceAuthorizationFlowFunc func(ctx context.Context, req *proto.EncryptedMessage) (*proto.EncryptedMessage, error)
 	GetPKCEAuthorizationFlowFunc   func(ctx context.Context, req *proto.EncryptedMessage) (*proto.EncryptedMessage, error)
 	SyncMetaFunc                   func(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error)
+	ReportSSHLoginsFunc            func(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error)
+	ReportSSHSessionsFunc          func(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error)
 }
 
 func (m ManagementServiceServerMock) Login(ctx context.Context, req *proto.EncryptedMessage) (*proto.EncryptedMessage, error) {
@@ -69,3 +71,17 @@ func (m ManagementServiceServerMock) SyncMeta(ctx context.Context, req *proto.En
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method SyncMeta not implemented")
 }
+
+func (m ManagementServiceServerMock) ReportSSHLogins(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error) {
+	if m.ReportSSHLoginsFunc != nil {
+		return m.ReportSSHLoginsFunc(ctx, req)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSSHLogins not implemented")
+}
+
+func (m ManagementServiceServerMock) ReportSSHSessions(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error) {
+	if m.ReportSSHSessionsFunc != nil {
+		return m.ReportSSHSessionsFunc(ctx, req)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSSHSessions not implemented")
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"regexp"
+	"slices"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/miekg/dns"
@@ -11,6 +13,7 @@ import (
 
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
@@ -20,19 +23,10 @@ const domainPattern = `^(?i)[a-z0-9]+([\-\.]{1}[a-z0-9]+)*\.[a-z]{2,}$`
 
 // GetNameServerGroup gets a nameserver group object from account and nameserver group IDs
 func (am *DefaultAccountManager) GetNameServerGroup(ctx context.Context, accountID, userID, nsGroupID string) (*nbdns.NameServerGroup, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.DNS, permissions.Read); err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
-	}
-
 	return am.Store.GetNameServerGroupByID(ctx, store.LockingStrengthShare, accountID, nsGroupID)
 }
 
@@ -41,15 +35,11 @@ func (am *DefaultAccountManager) CreateNameServerGroup(ctx context.Context, acco
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, permissions.DNS, permissions.Write, groups...)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
-
 	newNSGroup := &nbdns.NameServerGroup{
 		ID:                   xid.New().String(),
 		AccountID:            accountID,
@@ -103,22 +93,20 @@ func (am *DefaultAccountManager) SaveNameServerGroup(ctx context.Context, accoun
 		return status.Errorf(status.InvalidArgument, "nameserver group provided is nil")
 	}
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	oldNSGroup, err := am.Store.GetNameServerGroupByID(ctx, store.LockingStrengthShare, accountID, nsGroupToSave.ID)
 	if err != nil {
 		return err
 	}
 
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
+	// a nameserver group can't be moved out of the groups the user is allowed to manage
+	err = am.validateUserPermissions(ctx, accountID, userID, permissions.DNS, permissions.Write, slices.Concat(nsGroupToSave.Groups, oldNSGroup.Groups)...)
+	if err != nil {
+		return err
 	}
 
 	var updateAccountPeers bool
 
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
-		oldNSGroup, err := transaction.GetNameServerGroupByID(ctx, store.LockingStrengthShare, accountID, nsGroupToSave.ID)
-		if err != nil {
-			return err
-		}
 		nsGroupToSave.AccountID = accountID
 
 		if err = validateNameServerGroup(ctx, transaction, accountID, nsGroupToSave); err != nil {
@@ -154,24 +142,19 @@ func (am *DefaultAccountManager) DeleteNameServerGroup(ctx context.Context, acco
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	nsGroup, err := am.Store.GetNameServerGroupByID(ctx, store.LockingStrengthShare, accountID, nsGroupID)
 	if err != nil {
 		return err
 	}
 
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
+	err = am.validateUserPermissions(ctx, accountID, userID, permissions.DNS, permissions.Write, nsGroup.Groups...)
+	if err != nil {
+		return err
 	}
 
-	var nsGroup *nbdns.NameServerGroup
 	var updateAccountPeers bool
 
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
-		nsGroup, err = transaction.GetNameServerGroupByID(ctx, store.LockingStrengthUpdate, accountID, nsGroupID)
-		if err != nil {
-			return err
-		}
-
 		updateAccountPeers, err = anyGroupHasPeersOrResources(ctx, transaction, accountID, nsGroup.Groups)
 		if err != nil {
 			return err
@@ -198,19 +181,10 @@ func (am *DefaultAccountManager) DeleteNameServerGroup(ctx context.Context, acco
 
 // ListNameServerGroups returns a list of nameserver groups from account
 func (am *DefaultAccountManager) ListNameServerGroups(ctx context.Context, accountID string, userID string) ([]*nbdns.NameServerGroup, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.DNS, permissions.Read); err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
-	}
-
 	return am.Store.GetAccountNameServerGroups(ctx, store.LockingStrengthShare, accountID)
 }
 
@@ -303,6 +277,42 @@ func validateNSList(list []nbdns.NameServer) error {
 	if nsListLength == 0 || nsListLength > 3 {
 		return status.Errorf(status.InvalidArgument, "the list of nameservers should be 1 or 3, got %d", len(list))
 	}
+
+	for _, ns := range list {
+		if err := validateNameServer(ns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateNameServer(ns nbdns.NameServer) error {
+	switch ns.NSType {
+	case nbdns.UDPNameServerType:
+		if ns.Hostname != "" || ns.Path != "" {
+			return status.Errorf(status.InvalidArgument, "nameserver %s: hostname and path are only supported by %s and %s nameservers",
+				ns.IP, nbdns.TLSNameServerTypeString, nbdns.HTTPSNameServerTypeString)
+		}
+	case nbdns.TLSNameServerType, nbdns.HTTPSNameServerType:
+		if ns.NSType == nbdns.TLSNameServerType && ns.Path != "" {
+			return status.Errorf(status.InvalidArgument, "nameserver %s: path is only supported by %s nameservers", ns.IP, nbdns.HTTPSNameServerTypeString)
+		}
+		if ns.Path != "" && !strings.HasPrefix(ns.Path, "/") {
+			return status.Errorf(status.InvalidArgument, "nameserver %s: path %s should start with /", ns.IP, ns.Path)
+		}
+		if ns.Hostname != "" {
+			if err := validateDomain(ns.Hostname); err != nil {
+				return status.Errorf(status.InvalidArgument, "nameserver %s: invalid hostname %s: %v", ns.IP, ns.Hostname, err)
+			}
+		}
+	default:
+		return status.Errorf(status.InvalidArgument, "nameserver %s: invalid type %s", ns.IP, ns.NSType)
+	}
+
+	if ns.Port <= 0 || ns.Port > 65535 {
+		return status.Errorf(status.InvalidArgument, "nameserver %s: invalid port %d", ns.IP, ns.Port)
+	}
+
 	return nil
 }
 
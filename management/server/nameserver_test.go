@@ -940,6 +940,68 @@ func TestValidateDomain(t *testing.T) {
 
 }
 
+func TestValidateNameServer(t *testing.T) {
+	ip := netip.MustParseAddr("1.1.1.1")
+
+	testCases := []struct {
+		name       string
+		nameServer nbdns.NameServer
+		errFunc    require.ErrorAssertionFunc
+	}{
+		{
+			name:       "Valid udp nameserver",
+			nameServer: nbdns.NameServer{IP: ip, NSType: nbdns.UDPNameServerType, Port: 53},
+			errFunc:    require.NoError,
+		},
+		{
+			name:       "Valid tls nameserver with hostname",
+			nameServer: nbdns.NameServer{IP: ip, NSType: nbdns.TLSNameServerType, Port: 853, Hostname: "one.one.one.one"},
+			errFunc:    require.NoError,
+		},
+		{
+			name:       "Valid https nameserver with path",
+			nameServer: nbdns.NameServer{IP: ip, NSType: nbdns.HTTPSNameServerType, Port: 443, Hostname: "cloudflare-dns.com", Path: "/dns-query"},
+			errFunc:    require.NoError,
+		},
+		{
+			name:       "Invalid udp nameserver with hostname",
+			nameServer: nbdns.NameServer{IP: ip, NSType: nbdns.UDPNameServerType, Port: 53, Hostname: "one.one.one.one"},
+			errFunc:    require.Error,
+		},
+		{
+			name:       "Invalid tls nameserver with path",
+			nameServer: nbdns.NameServer{IP: ip, NSType: nbdns.TLSNameServerType, Port: 853, Path: "/dns-query"},
+			errFunc:    require.Error,
+		},
+		{
+			name:       "Invalid https nameserver with relative path",
+			nameServer: nbdns.NameServer{IP: ip, NSType: nbdns.HTTPSNameServerType, Port: 443, Path: "dns-query"},
+			errFunc:    require.Error,
+		},
+		{
+			name:       "Invalid https nameserver with invalid hostname",
+			nameServer: nbdns.NameServer{IP: ip, NSType: nbdns.HTTPSNameServerType, Port: 443, Hostname: "cloudflare dns"},
+			errFunc:    require.Error,
+		},
+		{
+			name:       "Invalid nameserver type",
+			nameServer: nbdns.NameServer{IP: ip, NSType: nbdns.InvalidNameServerType, Port: 53},
+			errFunc:    require.Error,
+		},
+		{
+			name:       "Invalid port",
+			nameServer: nbdns.NameServer{IP: ip, NSType: nbdns.TLSNameServerType, Port: 0},
+			errFunc:    require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.errFunc(t, validateNameServer(testCase.nameServer))
+		})
+	}
+}
+
 func TestNameServerAccountPeersUpdate(t *testing.T) {
 	manager, account, peer1, peer2, peer3 := setupNetworkMapTest(t)
 
@@ -132,6 +132,13 @@ func (n *NetworkResource) ToRoute(peer *nbpeer.Peer, router *routerTypes.Network
 		if n.Prefix.Addr().Is6() {
 			r.NetworkType = route.IPv6Network
 		}
+
+		// the router probe only tells about the resources its target belongs to
+		if router.HealthCheck != nil {
+			if target, err := router.HealthCheck.TargetAddr(); err == nil && n.Prefix.Contains(target) {
+				r.HealthCheck = router.HealthCheck.Copy()
+			}
+		}
 	}
 
 	if n.Type == domain {
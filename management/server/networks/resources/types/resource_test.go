@@ -3,6 +3,10 @@ package types
 import (
 	"net/netip"
 	"testing"
+
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/route"
 )
 
 func TestGetResourceType(t *testing.T) {
@@ -51,3 +55,48 @@ func TestGetResourceType(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkResource_ToRouteHealthCheck(t *testing.T) {
+	router := &routerTypes.NetworkRouter{
+		Metric:      100,
+		HealthCheck: &route.HealthCheck{Type: route.HealthCheckICMP, Target: "192.168.1.10"},
+	}
+	peer := &nbpeer.Peer{ID: "peer-1", Key: "key-1"}
+
+	tests := []struct {
+		name        string
+		resource    *NetworkResource
+		expectProbe bool
+	}{
+		{
+			name:        "subnet containing the target",
+			resource:    &NetworkResource{ID: "r1", Type: subnet, Prefix: netip.MustParsePrefix("192.168.1.0/24")},
+			expectProbe: true,
+		},
+		{
+			name:        "host not matching the target",
+			resource:    &NetworkResource{ID: "r2", Type: host, Prefix: netip.MustParsePrefix("192.168.2.1/32")},
+			expectProbe: false,
+		},
+		{
+			name:        "domain",
+			resource:    &NetworkResource{ID: "r3", Type: domain, Domain: "example.com"},
+			expectProbe: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.resource.ToRoute(peer, router)
+			if r == nil {
+				t.Fatal("expected a route")
+			}
+			if tt.expectProbe != (r.HealthCheck != nil) {
+				t.Errorf("expected health check %v, got %v", tt.expectProbe, r.HealthCheck)
+			}
+			if r.HealthCheck != nil && r.HealthCheck == router.HealthCheck {
+				t.Error("expected the health check to be copied")
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/netip"
 
 	"github.com/rs/xid"
 
@@ -86,6 +87,12 @@ func (m *managerImpl) CreateRouter(ctx context.Context, userID string, router *t
 		return nil, status.NewPermissionDeniedError()
 	}
 
+	if router.HealthCheck != nil {
+		if err = router.HealthCheck.Validate(netip.Prefix{}); err != nil {
+			return nil, err
+		}
+	}
+
 	unlock := m.store.AcquireWriteLockByUID(ctx, router.AccountID)
 	defer unlock()
 
@@ -155,6 +162,12 @@ func (m *managerImpl) UpdateRouter(ctx context.Context, userID string, router *t
 		return nil, status.NewPermissionDeniedError()
 	}
 
+	if router.HealthCheck != nil {
+		if err = router.HealthCheck.Validate(netip.Prefix{}); err != nil {
+			return nil, err
+		}
+	}
+
 	unlock := m.store.AcquireWriteLockByUID(ctx, router.AccountID)
 	defer unlock()
 
@@ -2,11 +2,13 @@ package types
 
 import (
 	"errors"
+	"time"
 
 	"github.com/rs/xid"
 
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/networks/types"
+	"github.com/netbirdio/netbird/route"
 )
 
 type NetworkRouter struct {
@@ -18,6 +20,8 @@ type NetworkRouter struct {
 	Masquerade bool
 	Metric     int
 	Enabled    bool
+	// HealthCheck is applied to the routes of the network resources that contain its target
+	HealthCheck *route.HealthCheck `gorm:"serializer:json"`
 }
 
 func NewNetworkRouter(accountID string, networkID string, peer string, peerGroups []string, masquerade bool, metric int, enabled bool) (*NetworkRouter, error) {
@@ -39,12 +43,13 @@ func NewNetworkRouter(accountID string, networkID string, peer string, peerGroup
 
 func (n *NetworkRouter) ToAPIResponse() *api.NetworkRouter {
 	return &api.NetworkRouter{
-		Id:         n.ID,
-		Peer:       &n.Peer,
-		PeerGroups: &n.PeerGroups,
-		Masquerade: n.Masquerade,
-		Metric:     n.Metric,
-		Enabled:    n.Enabled,
+		Id:          n.ID,
+		Peer:        &n.Peer,
+		PeerGroups:  &n.PeerGroups,
+		Masquerade:  n.Masquerade,
+		Metric:      n.Metric,
+		Enabled:     n.Enabled,
+		HealthCheck: HealthCheckToAPIResponse(n.HealthCheck),
 	}
 }
 
@@ -60,21 +65,63 @@ func (n *NetworkRouter) FromAPIRequest(req *api.NetworkRouterRequest) {
 	n.Masquerade = req.Masquerade
 	n.Metric = req.Metric
 	n.Enabled = req.Enabled
+	n.HealthCheck = HealthCheckFromAPIRequest(req.HealthCheck)
 }
 
 func (n *NetworkRouter) Copy() *NetworkRouter {
 	return &NetworkRouter{
-		ID:         n.ID,
-		NetworkID:  n.NetworkID,
-		AccountID:  n.AccountID,
-		Peer:       n.Peer,
-		PeerGroups: n.PeerGroups,
-		Masquerade: n.Masquerade,
-		Metric:     n.Metric,
-		Enabled:    n.Enabled,
+		ID:          n.ID,
+		NetworkID:   n.NetworkID,
+		AccountID:   n.AccountID,
+		Peer:        n.Peer,
+		PeerGroups:  n.PeerGroups,
+		Masquerade:  n.Masquerade,
+		Metric:      n.Metric,
+		Enabled:     n.Enabled,
+		HealthCheck: n.HealthCheck.Copy(),
 	}
 }
 
 func (n *NetworkRouter) EventMeta(network *types.Network) map[string]any {
 	return map[string]any{"network_name": network.Name, "network_id": network.ID, "peer": n.Peer, "peer_groups": n.PeerGroups}
 }
+
+// HealthCheckFromAPIRequest converts the API representation of a route health check
+func HealthCheckFromAPIRequest(req *api.RouteHealthCheck) *route.HealthCheck {
+	if req == nil {
+		return nil
+	}
+
+	healthCheck := &route.HealthCheck{
+		Type:   route.HealthCheckType(req.Type),
+		Target: req.Target,
+	}
+	if req.Interval != nil {
+		healthCheck.Interval = time.Duration(*req.Interval) * time.Second
+	}
+	if req.Timeout != nil {
+		healthCheck.Timeout = time.Duration(*req.Timeout) * time.Second
+	}
+	if req.FailureThreshold != nil {
+		healthCheck.FailureThreshold = *req.FailureThreshold
+	}
+	return healthCheck
+}
+
+// HealthCheckToAPIResponse converts a route health check to its API representation
+func HealthCheckToAPIResponse(healthCheck *route.HealthCheck) *api.RouteHealthCheck {
+	if healthCheck == nil {
+		return nil
+	}
+
+	interval := int(healthCheck.GetInterval() / time.Second)
+	timeout := int(healthCheck.GetTimeout() / time.Second)
+	failureThreshold := healthCheck.GetFailureThreshold()
+	return &api.RouteHealthCheck{
+		Type:             api.RouteHealthCheckType(healthCheck.Type),
+		Target:           healthCheck.Target,
+		Interval:         &interval,
+		Timeout:          &timeout,
+		FailureThreshold: &failureThreshold,
+	}
+}
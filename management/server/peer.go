@@ -26,6 +26,7 @@ import (
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/activity"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -132,6 +133,7 @@ func (am *DefaultAccountManager) MarkPeerConnected(ctx context.Context, peerPubK
 	var peer *nbpeer.Peer
 	var settings *types.Settings
 	var expired bool
+	var dynamicGroupsChanged bool
 	var err error
 
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
@@ -140,7 +142,16 @@ func (am *DefaultAccountManager) MarkPeerConnected(ctx context.Context, peerPubK
 			return err
 		}
 
+		oldLocation := peer.Location
 		expired, err = updatePeerStatusAndLocation(ctx, am.geo, transaction, peer, connected, realIP, accountID)
+		if err != nil {
+			return err
+		}
+
+		// country and city are dynamic group attributes
+		if oldLocation.CountryCode != peer.Location.CountryCode || oldLocation.CityName != peer.Location.CityName {
+			dynamicGroupsChanged, err = updatePeerDynamicGroups(ctx, transaction, accountID, peer)
+		}
 		return err
 	})
 	if err != nil {
@@ -162,9 +173,10 @@ func (am *DefaultAccountManager) MarkPeerConnected(ctx context.Context, peerPubK
 		}
 	}
 
-	if expired {
+	if expired || dynamicGroupsChanged {
 		// we need to update other peers because when peer login expires all other peers are notified to disconnect from
 		// the expired one. Here we notify them that connection is now allowed again.
+		// A location change that moved the peer between dynamic groups changes the network maps as well.
 		am.UpdateAccountPeers(ctx, accountID)
 	}
 
@@ -208,26 +220,23 @@ func updatePeerStatusAndLocation(ctx context.Context, geo geolocation.Geolocatio
 	return oldStatus.LoginExpired, nil
 }
 
-// UpdatePeer updates peer. Only Peer.Name, Peer.SSHEnabled, Peer.LoginExpirationEnabled and Peer.InactivityExpirationEnabled can be updated.
+// UpdatePeer updates peer. Only Peer.Name, Peer.SSHEnabled, the SSH server features, Peer.LoginExpirationEnabled and Peer.InactivityExpirationEnabled can be updated.
 func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, userID string, update *nbpeer.Peer) (*nbpeer.Peer, error) {
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, permissions.Peers, permissions.Write)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
-
 	var peer *nbpeer.Peer
 	var settings *types.Settings
 	var peerGroupList []string
 	var requiresPeerUpdates bool
 	var peerLabelChanged bool
 	var sshChanged bool
+	var sshFeaturesChanged bool
 	var loginExpirationChanged bool
 	var inactivityExpirationChanged bool
 
@@ -273,6 +282,17 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 			sshChanged = true
 		}
 
+		if peer.SSHSFTPEnabled != update.SSHSFTPEnabled ||
+			peer.SSHLocalPortForwardingEnabled != update.SSHLocalPortForwardingEnabled ||
+			peer.SSHRemotePortForwardingEnabled != update.SSHRemotePortForwardingEnabled ||
+			peer.SSHSessionRecordingEnabled != update.SSHSessionRecordingEnabled {
+			peer.SSHSFTPEnabled = update.SSHSFTPEnabled
+			peer.SSHLocalPortForwardingEnabled = update.SSHLocalPortForwardingEnabled
+			peer.SSHRemotePortForwardingEnabled = update.SSHRemotePortForwardingEnabled
+			peer.SSHSessionRecordingEnabled = update.SSHSessionRecordingEnabled
+			sshFeaturesChanged = true
+		}
+
 		if peer.LoginExpirationEnabled != update.LoginExpirationEnabled {
 			if !peer.AddedWithSSOLogin() {
 				return status.Errorf(status.PreconditionFailed, "this peer hasn't been added with the SSO login, therefore the login expiration can't be updated")
@@ -303,6 +323,15 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 		am.StoreEvent(ctx, userID, peer.IP.String(), accountID, event, peer.EventMeta(am.GetDNSDomain()))
 	}
 
+	if sshFeaturesChanged {
+		meta := peer.EventMeta(am.GetDNSDomain())
+		meta["sftp_enabled"] = peer.SSHSFTPEnabled
+		meta["local_port_forwarding_enabled"] = peer.SSHLocalPortForwardingEnabled
+		meta["remote_port_forwarding_enabled"] = peer.SSHRemotePortForwardingEnabled
+		meta["session_recording_enabled"] = peer.SSHSessionRecordingEnabled
+		am.StoreEvent(ctx, userID, peer.IP.String(), accountID, activity.PeerSSHFeaturesUpdated, meta)
+	}
+
 	if peerLabelChanged {
 		am.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerRenamed, peer.EventMeta(am.GetDNSDomain()))
 	}
@@ -333,27 +362,105 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 
 	if peerLabelChanged || requiresPeerUpdates {
 		am.UpdateAccountPeers(ctx, accountID)
-	} else if sshChanged {
+	} else if sshChanged || sshFeaturesChanged {
 		am.UpdateAccountPeer(ctx, accountID, peer.ID)
 	}
 
 	return peer, nil
 }
 
+// RecordPeerSSHLogins stores the logins to the SSH server of the peer reported by the peer as activity events.
+// The initiator of an event is the user owning the remote peer, or the remote peer itself if it has no owner
+func (am *DefaultAccountManager) RecordPeerSSHLogins(ctx context.Context, peerPubKey string, logins []nbpeer.SSHLogin) error {
+	peer, err := am.Store.GetPeerByPeerPubKey(ctx, store.LockingStrengthShare, peerPubKey)
+	if err != nil {
+		return err
+	}
+
+	for _, login := range logins {
+		meta := map[string]any{
+			"name": peer.Name, "fqdn": peer.FQDN(am.GetDNSDomain()), "ip": peer.IP,
+			"local_user": login.LocalUser, "login_time": login.Timestamp,
+		}
+		if !login.Allowed {
+			meta["reason"] = login.Reason
+		}
+
+		initiatorID, ok := am.getSSHRemotePeerInitiator(ctx, peer, login.RemotePeerKey, meta)
+		if !ok {
+			continue
+		}
+
+		event := activity.PeerSSHLoginAllowed
+		if !login.Allowed {
+			event = activity.PeerSSHLoginDenied
+		}
+		am.StoreEvent(ctx, initiatorID, peer.ID, peer.AccountID, event, meta)
+	}
+
+	return nil
+}
+
+// RecordPeerSSHSessions stores the terminal sessions recorded by the SSH server of the peer as activity events.
+// The recordings themselves stay on the peer.
+func (am *DefaultAccountManager) RecordPeerSSHSessions(ctx context.Context, peerPubKey string, sessions []nbpeer.SSHSession) error {
+	peer, err := am.Store.GetPeerByPeerPubKey(ctx, store.LockingStrengthShare, peerPubKey)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		meta := map[string]any{
+			"name": peer.Name, "fqdn": peer.FQDN(am.GetDNSDomain()), "ip": peer.IP,
+			"local_user": session.LocalUser, "recording_id": session.RecordingID,
+			"started_at": session.StartedAt, "ended_at": session.EndedAt,
+			"duration":  session.EndedAt.Sub(session.StartedAt).Round(time.Second).String(),
+			"truncated": session.Truncated,
+		}
+
+		initiatorID, ok := am.getSSHRemotePeerInitiator(ctx, peer, session.RemotePeerKey, meta)
+		if !ok {
+			continue
+		}
+
+		am.StoreEvent(ctx, initiatorID, peer.ID, peer.AccountID, activity.PeerSSHSessionRecorded, meta)
+	}
+
+	return nil
+}
+
+// getSSHRemotePeerInitiator returns the initiator of an SSH activity event of the peer that came from the remote peer
+// and adds the remote peer details to the event meta. The initiator is the owner of the remote peer, the remote peer
+// itself if it has no owner, or the remote peer key if the remote peer is unknown. It returns false if the remote peer
+// belongs to another account, in which case the event shouldn't be stored
+func (am *DefaultAccountManager) getSSHRemotePeerInitiator(ctx context.Context, peer *nbpeer.Peer, remotePeerKey string, meta map[string]any) (string, bool) {
+	remotePeer, err := am.Store.GetPeerByPeerPubKey(ctx, store.LockingStrengthShare, remotePeerKey)
+	switch {
+	case err == nil && remotePeer.AccountID == peer.AccountID:
+		meta["remote_peer_name"] = remotePeer.Name
+		meta["remote_peer_ip"] = remotePeer.IP
+		if remotePeer.UserID != "" {
+			return remotePeer.UserID, true
+		}
+		return remotePeer.ID, true
+	case err == nil:
+		log.WithContext(ctx).Warnf("peer %s reported SSH activity from peer %s of another account", peer.ID, remotePeer.ID)
+		return "", false
+	default:
+		log.WithContext(ctx).Debugf("failed to get remote peer of SSH activity reported by peer %s: %v", peer.ID, err)
+		return remotePeerKey, true
+	}
+}
+
 // DeletePeer removes peer from the account by its IP
 func (am *DefaultAccountManager) DeletePeer(ctx context.Context, accountID, peerID, userID string) error {
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
 	if userID != activity.SystemInitiator {
-		user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-		if err != nil {
+		if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Peers, permissions.Write); err != nil {
 			return err
 		}
-
-		if user.AccountID != accountID {
-			return status.NewUserNotPartOfAccountError()
-		}
 	}
 
 	peerAccountID, err := am.Store.GetAccountIDByPeerID(ctx, store.LockingStrengthShare, peerID)
@@ -365,16 +472,30 @@ func (am *DefaultAccountManager) DeletePeer(ctx context.Context, accountID, peer
 		return status.NewPeerNotPartOfAccountError()
 	}
 
+	_, err = am.deleteAccountPeer(ctx, accountID, peerID, userID, nil)
+	return err
+}
+
+// deleteAccountPeer removes the peer from the account and returns it, the caller must hold the account write lock.
+// If check is set, the peer is only removed if check doesn't return an error
+func (am *DefaultAccountManager) deleteAccountPeer(ctx context.Context, accountID, peerID, userID string, check func(peer *nbpeer.Peer) error) (*nbpeer.Peer, error) {
 	var peer *nbpeer.Peer
 	var updateAccountPeers bool
 	var eventsToStore []func()
 
-	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
 		peer, err = transaction.GetPeerByID(ctx, store.LockingStrengthUpdate, accountID, peerID)
 		if err != nil {
 			return err
 		}
 
+		if check != nil {
+			if err = check(peer); err != nil {
+				return err
+			}
+		}
+
 		updateAccountPeers, err = isPeerInActiveGroup(ctx, transaction, accountID, peerID)
 		if err != nil {
 			return err
@@ -401,7 +522,7 @@ func (am *DefaultAccountManager) DeletePeer(ctx context.Context, accountID, peer
 		return err
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, storeEvent := range eventsToStore {
@@ -412,7 +533,7 @@ func (am *DefaultAccountManager) DeletePeer(ctx context.Context, accountID, peer
 		am.UpdateAccountPeers(ctx, accountID)
 	}
 
-	return nil
+	return peer, nil
 }
 
 // GetNetworkMap returns Network map for a given peer (omits original peer from the Peers result)
@@ -436,8 +557,8 @@ func (am *DefaultAccountManager) GetNetworkMap(ctx context.Context, peerID strin
 	if err != nil {
 		return nil, err
 	}
-	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
-	return account.GetPeerNetworkMap(ctx, peer.ID, customZone, validatedPeers, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), nil), nil
+	customZones := account.GetPeersCustomZones(ctx, am.dnsDomain)
+	return account.GetPeerNetworkMap(ctx, peer.ID, customZones, validatedPeers, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), nil), nil
 }
 
 // GetPeerNetwork returns the Network for a given peer
@@ -503,6 +624,7 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 
 	var newPeer *nbpeer.Peer
 	var updateAccountPeers bool
+	var approvalTimeout time.Duration
 
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
 		var setupKeyID string
@@ -605,6 +727,10 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 			return fmt.Errorf("failed to get account settings: %w", err)
 		}
 		newPeer = am.integratedPeerValidator.PreparePeer(ctx, accountID, newPeer, groupsToAdd, settings.Extra)
+		if settings.PeerRequiresApproval(setupKeyID, userID) {
+			newPeer.Status.RequiresApproval = true
+			approvalTimeout = settings.PeerApprovalTimeout
+		}
 
 		err = transaction.AddPeerToAllGroup(ctx, store.LockingStrengthUpdate, accountID, newPeer.ID)
 		if err != nil {
@@ -642,10 +768,16 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 			}
 		}
 
+		dynamicGroupsChanged, err := updatePeerDynamicGroups(ctx, transaction, accountID, newPeer)
+		if err != nil {
+			return fmt.Errorf("failed to update dynamic groups: %w", err)
+		}
+
 		updateAccountPeers, err = isPeerInActiveGroup(ctx, transaction, accountID, newPeer.ID)
 		if err != nil {
 			return err
 		}
+		updateAccountPeers = updateAccountPeers || dynamicGroupsChanged
 
 		log.WithContext(ctx).Debugf("Peer %s added to account %s", newPeer.ID, accountID)
 		return nil
@@ -661,6 +793,13 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 
 	am.StoreEvent(ctx, opEvent.InitiatorID, opEvent.TargetID, opEvent.AccountID, opEvent.Activity, opEvent.Meta)
 
+	if newPeer.Status.RequiresApproval {
+		am.StoreEvent(ctx, opEvent.InitiatorID, opEvent.TargetID, opEvent.AccountID, activity.PeerApprovalPending, opEvent.Meta)
+		if approvalTimeout > 0 {
+			am.checkAndSchedulePeerApprovalExpiration(ctx, accountID)
+		}
+	}
+
 	unlock()
 	unlock = nil
 
@@ -668,7 +807,7 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 		am.UpdateAccountPeers(ctx, accountID)
 	}
 
-	return am.getValidatedPeerWithMap(ctx, false, accountID, newPeer)
+	return am.getValidatedPeerWithMap(ctx, newPeer.Status.RequiresApproval, accountID, newPeer)
 }
 
 func getFreeIP(ctx context.Context, transaction store.Store, accountID string) (net.IP, error) {
@@ -703,6 +842,8 @@ func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync PeerSync, ac
 	var updated bool
 	var err error
 	var postureChecks []*posture.Checks
+	var dynamicGroupsChanged bool
+	var ipv6Changed bool
 
 	settings, err := am.Store.GetAccountSettings(ctx, store.LockingStrengthShare, accountID)
 	if err != nil {
@@ -740,8 +881,11 @@ func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync PeerSync, ac
 			return err
 		}
 
+		ipv6Supported := peer.Meta.IPv6Supported
 		updated = peer.UpdateMetaIfNew(sync.Meta)
 		if updated {
+			// the other peers reach the peer over IPv6 only when it configured its IPv6 address
+			ipv6Changed = ipv6Supported != peer.Meta.IPv6Supported
 			am.metrics.AccountManagerMetrics().CountPeerMetUpdate()
 			log.WithContext(ctx).Tracef("peer %s metadata updated", peer.ID)
 			if err = transaction.SavePeer(ctx, store.LockingStrengthUpdate, accountID, peer); err != nil {
@@ -752,6 +896,11 @@ func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync PeerSync, ac
 			if err != nil {
 				return err
 			}
+
+			dynamicGroupsChanged, err = updatePeerDynamicGroups(ctx, transaction, accountID, peer)
+			if err != nil {
+				return err
+			}
 		}
 		return nil
 	})
@@ -759,7 +908,7 @@ func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync PeerSync, ac
 		return nil, nil, nil, err
 	}
 
-	if isStatusChanged || sync.UpdateAccountPeers || (updated && len(postureChecks) > 0) {
+	if isStatusChanged || sync.UpdateAccountPeers || dynamicGroupsChanged || ipv6Changed || (updated && len(postureChecks) > 0) {
 		am.UpdateAccountPeers(ctx, accountID)
 	}
 
@@ -816,6 +965,7 @@ func (am *DefaultAccountManager) LoginPeer(ctx context.Context, login PeerLogin)
 	var isRequiresApproval bool
 	var isStatusChanged bool
 	var isPeerUpdated bool
+	var dynamicGroupsChanged bool
 	var postureChecks []*posture.Checks
 
 	settings, err := am.Store.GetAccountSettings(ctx, store.LockingStrengthShare, accountID)
@@ -859,6 +1009,8 @@ func (am *DefaultAccountManager) LoginPeer(ctx context.Context, login PeerLogin)
 			return err
 		}
 
+		// the peer knows whether it can configure its IPv6 address only after the login, it reports it with the sync
+		login.Meta.IPv6Supported = peer.Meta.IPv6Supported
 		isPeerUpdated = peer.UpdateMetaIfNew(login.Meta)
 		if isPeerUpdated {
 			am.metrics.AccountManagerMetrics().CountPeerMetUpdate()
@@ -895,6 +1047,13 @@ func (am *DefaultAccountManager) LoginPeer(ctx context.Context, login PeerLogin)
 			}
 		}
 
+		if isPeerUpdated {
+			dynamicGroupsChanged, err = updatePeerDynamicGroups(ctx, transaction, accountID, peer)
+			if err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -904,7 +1063,7 @@ func (am *DefaultAccountManager) LoginPeer(ctx context.Context, login PeerLogin)
 	unlockPeer()
 	unlockPeer = nil
 
-	if updateRemotePeers || isStatusChanged || (isPeerUpdated && len(postureChecks) > 0) {
+	if updateRemotePeers || isStatusChanged || dynamicGroupsChanged || (isPeerUpdated && len(postureChecks) > 0) {
 		am.UpdateAccountPeers(ctx, accountID)
 	}
 
@@ -1033,8 +1192,8 @@ func (am *DefaultAccountManager) getValidatedPeerWithMap(ctx context.Context, is
 		return nil, nil, nil, err
 	}
 
-	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
-	return peer, account.GetPeerNetworkMap(ctx, peer.ID, customZone, approvedPeersMap, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), am.metrics.AccountManagerMetrics()), postureChecks, nil
+	customZones := account.GetPeersCustomZones(ctx, am.dnsDomain)
+	return peer, account.GetPeerNetworkMap(ctx, peer.ID, customZones, approvedPeersMap, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), am.metrics.AccountManagerMetrics()), postureChecks, nil
 }
 
 func (am *DefaultAccountManager) handleExpiredPeer(ctx context.Context, transaction store.Store, user *types.User, peer *nbpeer.Peer) error {
@@ -1170,7 +1329,7 @@ func (am *DefaultAccountManager) UpdateAccountPeers(ctx context.Context, account
 	semaphore := make(chan struct{}, 10)
 
 	dnsCache := &DNSConfigCache{}
-	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
+	customZones := account.GetPeersCustomZones(ctx, am.dnsDomain)
 	resourcePolicies := account.GetResourcePoliciesMap()
 	routers := account.GetResourceRoutersMap()
 
@@ -1192,7 +1351,7 @@ func (am *DefaultAccountManager) UpdateAccountPeers(ctx context.Context, account
 				return
 			}
 
-			remotePeerNetworkMap := account.GetPeerNetworkMap(ctx, p.ID, customZone, approvedPeersMap, resourcePolicies, routers, am.metrics.AccountManagerMetrics())
+			remotePeerNetworkMap := account.GetPeerNetworkMap(ctx, p.ID, customZones, approvedPeersMap, resourcePolicies, routers, am.metrics.AccountManagerMetrics())
 			update := toSyncResponse(ctx, nil, p, nil, nil, remotePeerNetworkMap, am.GetDNSDomain(), postureChecks, dnsCache, account.Settings.RoutingPeerDNSResolutionEnabled)
 			am.peersUpdateManager.SendUpdate(ctx, p.ID, &UpdateMessage{Update: update, NetworkMap: remotePeerNetworkMap})
 		}(peer)
@@ -1231,7 +1390,7 @@ func (am *DefaultAccountManager) UpdateAccountPeer(ctx context.Context, accountI
 	}
 
 	dnsCache := &DNSConfigCache{}
-	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
+	customZones := account.GetPeersCustomZones(ctx, am.dnsDomain)
 	resourcePolicies := account.GetResourcePoliciesMap()
 	routers := account.GetResourceRoutersMap()
 
@@ -1241,7 +1400,7 @@ func (am *DefaultAccountManager) UpdateAccountPeer(ctx context.Context, accountI
 		return
 	}
 
-	remotePeerNetworkMap := account.GetPeerNetworkMap(ctx, peerId, customZone, approvedPeersMap, resourcePolicies, routers, am.metrics.AccountManagerMetrics())
+	remotePeerNetworkMap := account.GetPeerNetworkMap(ctx, peerId, customZones, approvedPeersMap, resourcePolicies, routers, am.metrics.AccountManagerMetrics())
 	update := toSyncResponse(ctx, nil, peer, nil, nil, remotePeerNetworkMap, am.GetDNSDomain(), postureChecks, dnsCache, account.Settings.RoutingPeerDNSResolutionEnabled)
 	am.peersUpdateManager.SendUpdate(ctx, peer.ID, &UpdateMessage{Update: update, NetworkMap: remotePeerNetworkMap})
 }
@@ -36,6 +36,14 @@ type Peer struct {
 	SSHKey string
 	// SSHEnabled indicates whether SSH server is enabled on the peer
 	SSHEnabled bool
+	// SSHSFTPEnabled indicates whether the SSH server of the peer serves the sftp subsystem
+	SSHSFTPEnabled bool
+	// SSHLocalPortForwardingEnabled indicates whether the SSH server of the peer allows local port forwarding
+	SSHLocalPortForwardingEnabled bool
+	// SSHRemotePortForwardingEnabled indicates whether the SSH server of the peer allows remote port forwarding
+	SSHRemotePortForwardingEnabled bool
+	// SSHSessionRecordingEnabled indicates whether the SSH server of the peer records the terminal sessions
+	SSHSessionRecordingEnabled bool
 	// LoginExpirationEnabled indicates whether peer's login expiration is enabled and once expired the peer has to re-login.
 	// Works with LastLogin
 	LoginExpirationEnabled bool
@@ -112,6 +120,8 @@ type PeerSystemMeta struct { //nolint:revive
 	SystemManufacturer string
 	Environment        Environment `gorm:"serializer:json"`
 	Files              []File      `gorm:"serializer:json"`
+	// IPv6Supported is set when the peer configured its IPv6 overlay address, which requires filtering IPv6 traffic
+	IPv6Supported bool `gorm:"column:ipv6_supported"`
 }
 
 func (p PeerSystemMeta) isEqual(other PeerSystemMeta) bool {
@@ -155,7 +165,8 @@ func (p PeerSystemMeta) isEqual(other PeerSystemMeta) bool {
 		p.SystemProductName == other.SystemProductName &&
 		p.SystemManufacturer == other.SystemManufacturer &&
 		p.Environment.Cloud == other.Environment.Cloud &&
-		p.Environment.Platform == other.Environment.Platform
+		p.Environment.Platform == other.Environment.Platform &&
+		p.IPv6Supported == other.IPv6Supported
 }
 
 func (p PeerSystemMeta) isEmpty() bool {
@@ -190,25 +201,29 @@ func (p *Peer) Copy() *Peer {
 		peerStatus = p.Status.Copy()
 	}
 	return &Peer{
-		ID:                          p.ID,
-		AccountID:                   p.AccountID,
-		Key:                         p.Key,
-		IP:                          p.IP,
-		Meta:                        p.Meta,
-		Name:                        p.Name,
-		DNSLabel:                    p.DNSLabel,
-		Status:                      peerStatus,
-		UserID:                      p.UserID,
-		SSHKey:                      p.SSHKey,
-		SSHEnabled:                  p.SSHEnabled,
-		LoginExpirationEnabled:      p.LoginExpirationEnabled,
-		LastLogin:                   p.LastLogin,
-		CreatedAt:                   p.CreatedAt,
-		Ephemeral:                   p.Ephemeral,
-		Location:                    p.Location,
-		InactivityExpirationEnabled: p.InactivityExpirationEnabled,
-		ExtraDNSLabels:              slices.Clone(p.ExtraDNSLabels),
-		AllowExtraDNSLabels:         p.AllowExtraDNSLabels,
+		ID:                             p.ID,
+		AccountID:                      p.AccountID,
+		Key:                            p.Key,
+		IP:                             p.IP,
+		Meta:                           p.Meta,
+		Name:                           p.Name,
+		DNSLabel:                       p.DNSLabel,
+		Status:                         peerStatus,
+		UserID:                         p.UserID,
+		SSHKey:                         p.SSHKey,
+		SSHEnabled:                     p.SSHEnabled,
+		SSHSFTPEnabled:                 p.SSHSFTPEnabled,
+		SSHLocalPortForwardingEnabled:  p.SSHLocalPortForwardingEnabled,
+		SSHRemotePortForwardingEnabled: p.SSHRemotePortForwardingEnabled,
+		SSHSessionRecordingEnabled:     p.SSHSessionRecordingEnabled,
+		LoginExpirationEnabled:         p.LoginExpirationEnabled,
+		LastLogin:                      p.LastLogin,
+		CreatedAt:                      p.CreatedAt,
+		Ephemeral:                      p.Ephemeral,
+		Location:                       p.Location,
+		InactivityExpirationEnabled:    p.InactivityExpirationEnabled,
+		ExtraDNSLabels:                 slices.Clone(p.ExtraDNSLabels),
+		AllowExtraDNSLabels:            p.AllowExtraDNSLabels,
 	}
 }
 
@@ -315,3 +330,33 @@ func (p *Peer) UpdateLastLogin() *Peer {
 	p.Status = newStatus
 	return p
 }
+
+// SSHLogin is a login attempt from a remote peer to the SSH server of a peer
+type SSHLogin struct {
+	// RemotePeerKey is the WireGuard public key of the peer the login came from
+	RemotePeerKey string
+	// LocalUser is the local OS user the remote peer tried to log in as
+	LocalUser string
+	// Allowed indicates whether the login was allowed by the SSH authorizations of the policies
+	Allowed bool
+	// Reason the login was denied
+	Reason string
+	// Timestamp of the login on the peer
+	Timestamp time.Time
+}
+
+// SSHSession is a terminal session of a remote peer recorded by the SSH server of a peer
+type SSHSession struct {
+	// RemotePeerKey is the WireGuard public key of the peer the session came from
+	RemotePeerKey string
+	// LocalUser is the local OS user of the session
+	LocalUser string
+	// StartedAt is the time the session started on the peer
+	StartedAt time.Time
+	// EndedAt is the time the session ended on the peer
+	EndedAt time.Time
+	// RecordingID identifies the recording on the peer
+	RecordingID string
+	// Truncated indicates whether the recording was stopped because it reached the size limit
+	Truncated bool
+}
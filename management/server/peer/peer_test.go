@@ -82,4 +82,9 @@ func TestIsEqual(t *testing.T) {
 	if !meta1.isEqual(meta2) {
 		t.Error("meta1 should be equal to meta2")
 	}
+
+	meta2.IPv6Supported = true
+	if meta1.isEqual(meta2) {
+		t.Error("meta1 should not be equal to meta2 with IPv6 support")
+	}
 }
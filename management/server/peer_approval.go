@@ -0,0 +1,259 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/integrated_validator"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// peerApprovalValidator extends the integrated validator with the peers pending approval.
+// Pending peers are not valid, they get an empty network map and are excluded from the network maps of the other peers
+type peerApprovalValidator struct {
+	integrated_validator.IntegratedValidator
+}
+
+func newPeerApprovalValidator(validator integrated_validator.IntegratedValidator) *peerApprovalValidator {
+	return &peerApprovalValidator{IntegratedValidator: validator}
+}
+
+// IsNotValidPeer returns true if the integrated validator rejects the peer or the peer is pending approval
+func (v *peerApprovalValidator) IsNotValidPeer(ctx context.Context, accountID string, peer *nbpeer.Peer, peersGroup []string, extraSettings *account.ExtraSettings) (bool, bool, error) {
+	notValid, statusChanged, err := v.IntegratedValidator.IsNotValidPeer(ctx, accountID, peer, peersGroup, extraSettings)
+	if err != nil {
+		return false, false, err
+	}
+	return notValid || isPeerPendingApproval(peer), statusChanged, nil
+}
+
+// GetValidatedPeers returns the peers validated by the integrated validator that are not pending approval
+func (v *peerApprovalValidator) GetValidatedPeers(accountID string, groups []*types.Group, peers []*nbpeer.Peer, extraSettings *account.ExtraSettings) (map[string]struct{}, error) {
+	validatedPeers, err := v.IntegratedValidator.GetValidatedPeers(accountID, groups, peers, extraSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, peer := range peers {
+		if isPeerPendingApproval(peer) {
+			delete(validatedPeers, peer.ID)
+		}
+	}
+
+	return validatedPeers, nil
+}
+
+func isPeerPendingApproval(peer *nbpeer.Peer) bool {
+	return peer.Status != nil && peer.Status.RequiresApproval
+}
+
+// GetPendingPeers returns the peers of the account waiting for approval
+func (am *DefaultAccountManager) GetPendingPeers(ctx context.Context, accountID, userID string) ([]*nbpeer.Peer, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Peers, permissions.Read); err != nil {
+		return nil, err
+	}
+
+	peers, err := am.Store.GetAccountPeers(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingPeers := make([]*nbpeer.Peer, 0)
+	for _, peer := range peers {
+		if isPeerPendingApproval(peer) {
+			pendingPeers = append(pendingPeers, peer)
+		}
+	}
+
+	return pendingPeers, nil
+}
+
+// ApprovePeer approves a pending peer, the peer gets its network map and becomes visible to the other peers
+func (am *DefaultAccountManager) ApprovePeer(ctx context.Context, accountID, userID, peerID string) (*nbpeer.Peer, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Peers, permissions.Write); err != nil {
+		return nil, err
+	}
+
+	var peer *nbpeer.Peer
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		peer, err = transaction.GetPeerByID(ctx, store.LockingStrengthUpdate, accountID, peerID)
+		if err != nil {
+			return err
+		}
+
+		if !isPeerPendingApproval(peer) {
+			return status.Errorf(status.PreconditionFailed, "peer %s is not pending approval", peerID)
+		}
+
+		peer.Status.RequiresApproval = false
+		if err = transaction.SavePeerStatus(ctx, store.LockingStrengthUpdate, accountID, peerID, *peer.Status); err != nil {
+			return err
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerApproved, peer.EventMeta(am.GetDNSDomain()))
+
+	am.UpdateAccountPeers(ctx, accountID)
+
+	return peer, nil
+}
+
+// RejectPeer removes a pending peer from the account. The peer has to register again to be reconsidered
+func (am *DefaultAccountManager) RejectPeer(ctx context.Context, accountID, userID, peerID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Peers, permissions.Write); err != nil {
+		return err
+	}
+
+	peer, err := am.deleteAccountPeer(ctx, accountID, peerID, userID, checkPeerPendingApproval)
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerApprovalRejected, peer.EventMeta(am.GetDNSDomain()))
+
+	return nil
+}
+
+func checkPeerPendingApproval(peer *nbpeer.Peer) error {
+	if !isPeerPendingApproval(peer) {
+		return status.Errorf(status.PreconditionFailed, "peer %s is not pending approval", peer.ID)
+	}
+	return nil
+}
+
+// peerApprovalExpirationJob rejects the pending peers that exceeded the approval timeout and returns the duration
+// until the next pending peer of the account expires if found
+func (am *DefaultAccountManager) peerApprovalExpirationJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		defer unlock()
+
+		expiredPeers, err := am.getExpiredPendingPeers(ctx, accountID)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed getting expired pending peers for account %s: %v", accountID, err)
+			return peerSchedulerRetryInterval, true
+		}
+
+		log.WithContext(ctx).Debugf("discovered %d pending peers to reject for account %s", len(expiredPeers), accountID)
+
+		for _, expired := range expiredPeers {
+			peer, err := am.deleteAccountPeer(ctx, accountID, expired.ID, activity.SystemInitiator, checkPeerPendingApproval)
+			if err != nil {
+				log.WithContext(ctx).Errorf("failed rejecting expired pending peer %s of account %s: %v", expired.ID, accountID, err)
+				continue
+			}
+			am.StoreEvent(ctx, activity.SystemInitiator, peer.ID, accountID, activity.PeerApprovalExpired, peer.EventMeta(am.GetDNSDomain()))
+		}
+
+		return am.getNextPeerApprovalExpiration(ctx, accountID)
+	}
+}
+
+// checkAndSchedulePeerApprovalExpiration schedules the rejection of the next pending peer exceeding the approval timeout
+func (am *DefaultAccountManager) checkAndSchedulePeerApprovalExpiration(ctx context.Context, accountID string) {
+	am.peerApprovalExpiry.Cancel(ctx, []string{accountID})
+	if nextRun, ok := am.getNextPeerApprovalExpiration(ctx, accountID); ok {
+		go am.peerApprovalExpiry.Schedule(ctx, nextRun, accountID, am.peerApprovalExpirationJob(ctx, accountID))
+	}
+}
+
+// schedulePeerApprovalExpirations schedules the rejection of the pending peers of all accounts with an approval
+// timeout. It is called once on startup, later the rejection is scheduled again when a pending peer is added or the
+// approval timeout changes
+func (am *DefaultAccountManager) schedulePeerApprovalExpirations(ctx context.Context) {
+	accountIDs, err := am.Store.GetAccountIDsWithExpiringPendingPeers(ctx, store.LockingStrengthShare)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get accounts with pending peers: %v", err)
+		return
+	}
+
+	for _, accountID := range accountIDs {
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		am.checkAndSchedulePeerApprovalExpiration(ctx, accountID)
+		unlock()
+	}
+}
+
+// getExpiredPendingPeers returns the pending peers registered longer than the approval timeout ago
+func (am *DefaultAccountManager) getExpiredPendingPeers(ctx context.Context, accountID string) ([]*nbpeer.Peer, error) {
+	settings, err := am.Store.GetAccountSettings(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.PeerApprovalTimeout <= 0 {
+		return nil, nil
+	}
+
+	peers, err := am.Store.GetAccountPeers(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiredPeers []*nbpeer.Peer
+	for _, peer := range peers {
+		if isPeerPendingApproval(peer) && time.Since(peer.CreatedAt) >= settings.PeerApprovalTimeout {
+			expiredPeers = append(expiredPeers, peer)
+		}
+	}
+
+	return expiredPeers, nil
+}
+
+// getNextPeerApprovalExpiration returns the duration until the next pending peer of the account exceeds the approval
+// timeout. It returns false if no pending peer expires
+func (am *DefaultAccountManager) getNextPeerApprovalExpiration(ctx context.Context, accountID string) (time.Duration, bool) {
+	settings, err := am.Store.GetAccountSettings(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get account settings of account %s: %v", accountID, err)
+		return peerSchedulerRetryInterval, true
+	}
+
+	if settings.PeerApprovalTimeout <= 0 {
+		return 0, false
+	}
+
+	peers, err := am.Store.GetAccountPeers(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get peers of account %s: %v", accountID, err)
+		return peerSchedulerRetryInterval, true
+	}
+
+	var nextExpiration *time.Duration
+	for _, peer := range peers {
+		if !isPeerPendingApproval(peer) {
+			continue
+		}
+
+		expiresIn := max(settings.PeerApprovalTimeout-time.Since(peer.CreatedAt), time.Second)
+		if nextExpiration == nil || expiresIn < *nextExpiration {
+			nextExpiration = &expiresIn
+		}
+	}
+
+	if nextExpiration == nil {
+		return 0, false
+	}
+
+	return *nextExpiration, true
+}
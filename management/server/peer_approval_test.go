@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestSettings_PeerRequiresApproval(t *testing.T) {
+	testCases := []struct {
+		name       string
+		settings   *types.Settings
+		setupKeyID string
+		userID     string
+		expected   bool
+	}{
+		{
+			name:       "approval disabled",
+			settings:   &types.Settings{},
+			setupKeyID: "key",
+			expected:   false,
+		},
+		{
+			name:       "all peers",
+			settings:   &types.Settings{PeerApprovalRequired: true},
+			setupKeyID: "key",
+			expected:   true,
+		},
+		{
+			name:       "matching setup key",
+			settings:   &types.Settings{PeerApprovalRequired: true, PeerApprovalSetupKeys: []string{"key"}},
+			setupKeyID: "key",
+			expected:   true,
+		},
+		{
+			name:       "other setup key",
+			settings:   &types.Settings{PeerApprovalRequired: true, PeerApprovalSetupKeys: []string{"key"}},
+			setupKeyID: "other",
+			expected:   false,
+		},
+		{
+			name:     "matching user",
+			settings: &types.Settings{PeerApprovalRequired: true, PeerApprovalSetupKeys: []string{"key"}, PeerApprovalUsers: []string{"user"}},
+			userID:   "user",
+			expected: true,
+		},
+		{
+			name:     "other user",
+			settings: &types.Settings{PeerApprovalRequired: true, PeerApprovalUsers: []string{"user"}},
+			userID:   "other",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.settings.PeerRequiresApproval(tc.setupKeyID, tc.userID))
+		})
+	}
+}
+
+func TestDefaultAccountManager_PeerApproval(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := createAccount(manager, "test_account", userID, "netbird.cloud")
+	require.NoError(t, err)
+
+	approvalKey, err := manager.CreateSetupKey(context.Background(), account.Id, "approval-key", types.SetupKeyReusable, time.Hour, nil, 999, userID, false, false)
+	require.NoError(t, err)
+	trustedKey, err := manager.CreateSetupKey(context.Background(), account.Id, "trusted-key", types.SetupKeyReusable, time.Hour, nil, 999, userID, false, false)
+	require.NoError(t, err)
+
+	settings := account.Settings.Copy()
+	settings.PeerApprovalRequired = true
+	settings.PeerApprovalSetupKeys = []string{approvalKey.Id}
+	_, err = manager.UpdateAccountSettings(context.Background(), account.Id, userID, settings)
+	require.NoError(t, err)
+
+	addPeer := func(setupKey string) (*nbpeer.Peer, *types.NetworkMap) {
+		key, err := wgtypes.GeneratePrivateKey()
+		require.NoError(t, err)
+		peer, networkMap, _, err := manager.AddPeer(context.Background(), setupKey, "", &nbpeer.Peer{
+			Key:  key.PublicKey().String(),
+			Meta: nbpeer.PeerSystemMeta{Hostname: key.PublicKey().String()},
+		})
+		require.NoError(t, err)
+		return peer, networkMap
+	}
+
+	trustedPeer, _ := addPeer(trustedKey.Key)
+	assert.False(t, trustedPeer.Status.RequiresApproval, "peers of other setup keys must not require approval")
+
+	pendingPeer, networkMap := addPeer(approvalKey.Key)
+	assert.True(t, pendingPeer.Status.RequiresApproval)
+	assert.Empty(t, networkMap.Peers, "pending peer must get an empty network map")
+	assert.NotNil(t, getEvent(t, account.Id, manager, activity.PeerApprovalPending))
+
+	validatedPeers, err := manager.GetValidatedPeers(context.Background(), account.Id)
+	require.NoError(t, err)
+	assert.Contains(t, validatedPeers, trustedPeer.ID)
+	assert.NotContains(t, validatedPeers, pendingPeer.ID)
+
+	pendingPeers, err := manager.GetPendingPeers(context.Background(), account.Id, userID)
+	require.NoError(t, err)
+	require.Len(t, pendingPeers, 1)
+	assert.Equal(t, pendingPeer.ID, pendingPeers[0].ID)
+
+	approvedPeer, err := manager.ApprovePeer(context.Background(), account.Id, userID, pendingPeer.ID)
+	require.NoError(t, err)
+	assert.False(t, approvedPeer.Status.RequiresApproval)
+	assert.NotNil(t, getEvent(t, account.Id, manager, activity.PeerApproved))
+
+	validatedPeers, err = manager.GetValidatedPeers(context.Background(), account.Id)
+	require.NoError(t, err)
+	assert.Contains(t, validatedPeers, pendingPeer.ID)
+
+	_, err = manager.ApprovePeer(context.Background(), account.Id, userID, pendingPeer.ID)
+	assert.Error(t, err, "approved peer must not be approved again")
+
+	assert.Error(t, manager.RejectPeer(context.Background(), account.Id, userID, trustedPeer.ID), "only pending peers can be rejected")
+
+	rejectedPeer, _ := addPeer(approvalKey.Key)
+	require.NoError(t, manager.RejectPeer(context.Background(), account.Id, userID, rejectedPeer.ID))
+	assert.NotNil(t, getEvent(t, account.Id, manager, activity.PeerApprovalRejected))
+
+	_, err = manager.Store.GetPeerByID(context.Background(), "", account.Id, rejectedPeer.ID)
+	assert.Error(t, err, "rejected peer must be removed")
+}
+
+func TestDefaultAccountManager_PeerApprovalExpiration(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := createAccount(manager, "test_account", userID, "netbird.cloud")
+	require.NoError(t, err)
+
+	settings := account.Settings.Copy()
+	settings.PeerApprovalRequired = true
+	settings.PeerApprovalTimeout = time.Hour
+	_, err = manager.UpdateAccountSettings(context.Background(), account.Id, userID, settings)
+	require.NoError(t, err)
+
+	key, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	peer, _, _, err := manager.AddPeer(context.Background(), "", userID, &nbpeer.Peer{
+		Key:  key.PublicKey().String(),
+		Meta: nbpeer.PeerSystemMeta{Hostname: "pending-peer"},
+	})
+	require.NoError(t, err)
+	require.True(t, peer.Status.RequiresApproval)
+
+	nextRun, ok := manager.getNextPeerApprovalExpiration(context.Background(), account.Id)
+	require.True(t, ok)
+	assert.LessOrEqual(t, nextRun, time.Hour)
+	assert.Greater(t, nextRun, 59*time.Minute)
+
+	expiredPeers, err := manager.getExpiredPendingPeers(context.Background(), account.Id)
+	require.NoError(t, err)
+	assert.Empty(t, expiredPeers)
+
+	// the rejection is scheduled again after a restart of the management service
+	scheduled := make(chan string, 1)
+	manager.peerApprovalExpiry = &MockScheduler{
+		CancelFunc: func(context.Context, []string) {},
+		ScheduleFunc: func(_ context.Context, _ time.Duration, ID string, _ func() (time.Duration, bool)) {
+			scheduled <- ID
+		},
+	}
+	manager.schedulePeerApprovalExpirations(context.Background())
+	select {
+	case scheduledID := <-scheduled:
+		assert.Equal(t, account.Id, scheduledID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the rejection of the pending peer wasn't scheduled")
+	}
+
+	// register the peer two hours ago
+	peer.CreatedAt = time.Now().UTC().Add(-2 * time.Hour)
+	require.NoError(t, manager.Store.SavePeer(context.Background(), "", account.Id, peer))
+
+	_, reschedule := manager.peerApprovalExpirationJob(context.Background(), account.Id)()
+	assert.False(t, reschedule, "no pending peers must be left")
+
+	_, err = manager.Store.GetPeerByID(context.Background(), "", account.Id, peer.ID)
+	assert.Error(t, err, "expired pending peer must be removed")
+	assert.NotNil(t, getEvent(t, account.Id, manager, activity.PeerApprovalExpired))
+}
@@ -1029,9 +1029,10 @@ func TestToSyncResponse(t *testing.T) {
 		Signature: "turn-pass",
 	}
 	networkMap := &types.NetworkMap{
-		Network:      &types.Network{Net: *ipnet, Serial: 1000},
-		Peers:        []*nbpeer.Peer{{IP: net.ParseIP("192.168.1.2"), Key: "peer2-key", DNSLabel: "peer2", SSHEnabled: true, SSHKey: "peer2-ssh-key"}},
-		OfflinePeers: []*nbpeer.Peer{{IP: net.ParseIP("192.168.1.3"), Key: "peer3-key", DNSLabel: "peer3", SSHEnabled: true, SSHKey: "peer3-ssh-key"}},
+		Network:              &types.Network{Net: *ipnet, Serial: 1000},
+		Peers:                []*nbpeer.Peer{{ID: "peer2", IP: net.ParseIP("192.168.1.2"), Key: "peer2-key", DNSLabel: "peer2", SSHEnabled: true, SSHKey: "peer2-ssh-key"}},
+		OfflinePeers:         []*nbpeer.Peer{{ID: "peer3", IP: net.ParseIP("192.168.1.3"), Key: "peer3-key", DNSLabel: "peer3", SSHEnabled: true, SSHKey: "peer3-ssh-key"}},
+		ConnectionStrategies: map[string]string{"peer3": types.ConnectionStrategyRelayOnly},
 		Routes: []*nbroute.Route{
 			{
 				ID:          "route1",
@@ -1130,6 +1131,8 @@ func TestToSyncResponse(t *testing.T) {
 	assert.Equal(t, "peer3-key", response.NetworkMap.OfflinePeers[0].WgPubKey)
 	assert.Equal(t, "peer3.example.com", response.NetworkMap.OfflinePeers[0].GetFqdn())
 	assert.Equal(t, []byte("peer3-ssh-key"), response.NetworkMap.OfflinePeers[0].GetSshConfig().GetSshPubKey())
+	assert.Equal(t, proto.RemotePeerConfig_RELAY_ONLY, response.NetworkMap.OfflinePeers[0].GetConnectionStrategy())
+	assert.Equal(t, proto.RemotePeerConfig_DEFAULT, response.NetworkMap.RemotePeers[0].GetConnectionStrategy())
 	// assert network map Routes
 	assert.Equal(t, 1, len(response.NetworkMap.Routes))
 	assert.Equal(t, "10.0.0.0/24", response.NetworkMap.Routes[0].Network)
@@ -1507,6 +1510,31 @@ func TestPeerAccountPeersUpdate(t *testing.T) {
 		}
 	})
 
+	// Updating the SSH server features of a peer should send the peer an update
+	t.Run("updating peer SSH features", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			peerShouldReceiveUpdate(t, updMsg)
+			close(done)
+		}()
+
+		peer1.SSHSFTPEnabled = true
+		peer1.SSHLocalPortForwardingEnabled = true
+		peer1.SSHSessionRecordingEnabled = true
+		updated, err := manager.UpdatePeer(context.Background(), account.Id, userID, peer1)
+		require.NoError(t, err)
+		assert.True(t, updated.SSHSFTPEnabled)
+		assert.True(t, updated.SSHLocalPortForwardingEnabled)
+		assert.False(t, updated.SSHRemotePortForwardingEnabled)
+		assert.True(t, updated.SSHSessionRecordingEnabled)
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Error("timeout waiting for peerShouldReceiveUpdate")
+		}
+	})
+
 	t.Run("validator requires update", func(t *testing.T) {
 		requireUpdateFunc := func(_ context.Context, update *nbpeer.Peer, peer *nbpeer.Peer, userID string, accountID string, dnsDomain string, peersGroup []string, extraSettings *nbAccount.ExtraSettings) (*nbpeer.Peer, bool, error) {
 			return update, true, nil
@@ -1628,7 +1656,7 @@ func TestPeerAccountPeersUpdate(t *testing.T) {
 		_, err := manager.CreateRoute(
 			context.Background(), account.Id, route.Network, route.NetworkType, route.Domains, route.Peer,
 			route.PeerGroups, route.Description, route.NetID, route.Masquerade, route.Metric,
-			route.Groups, []string{}, true, userID, route.KeepRoute,
+			route.Groups, []string{}, true, userID, route.KeepRoute, nil,
 		)
 		require.NoError(t, err)
 
@@ -1778,3 +1806,32 @@ func Test_DeletePeer(t *testing.T) {
 	assert.NotContains(t, group.Peers, "peer1")
 
 }
+
+func TestToSyncResponseWithIPv6(t *testing.T) {
+	_, ipnet, err := net.ParseCIDR("100.64.0.0/16")
+	require.NoError(t, err)
+
+	network := &types.Network{Identifier: "network1", Net: *ipnet, NetV6: types.NewNetworkV6("network1")}
+	peer := &nbpeer.Peer{IP: net.ParseIP("100.64.0.1"), Key: "peer-key", DNSLabel: "peer1"}
+	remotePeer := &nbpeer.Peer{ID: "peer2", IP: net.ParseIP("100.64.0.2"), Key: "peer2-key", DNSLabel: "peer2"}
+	networkMap := &types.NetworkMap{
+		Network: network,
+		Peers:   []*nbpeer.Peer{remotePeer},
+	}
+
+	response := toSyncResponse(context.Background(), nil, peer, nil, nil, networkMap, "netbird.cloud", nil, &DNSConfigCache{}, false)
+
+	assert.Equal(t, "100.64.0.1/16", response.PeerConfig.Address)
+	assert.Equal(t, network.PeerIPv6(peer.IP).String()+"/64", response.PeerConfig.AddressV6)
+	require.Len(t, response.RemotePeers, 1)
+	assert.Equal(t, []string{"100.64.0.2/32"}, response.RemotePeers[0].AllowedIps, "the remote peer didn't configure its IPv6 address")
+
+	remotePeer.Meta.IPv6Supported = true
+	response = toSyncResponse(context.Background(), nil, peer, nil, nil, networkMap, "netbird.cloud", nil, &DNSConfigCache{}, false)
+	assert.Equal(t, []string{"100.64.0.2/32", network.PeerIPv6(net.ParseIP("100.64.0.2")).String() + "/128"}, response.RemotePeers[0].AllowedIps)
+
+	network.NetV6 = net.IPNet{}
+	response = toSyncResponse(context.Background(), nil, peer, nil, nil, networkMap, "netbird.cloud", nil, &DNSConfigCache{}, false)
+	assert.Empty(t, response.PeerConfig.AddressV6)
+	assert.Equal(t, []string{"100.64.0.2/32"}, response.RemotePeers[0].AllowedIps)
+}
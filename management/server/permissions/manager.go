@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/netbirdio/netbird/management/server/roles"
 	"github.com/netbirdio/netbird/management/server/settings"
+	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/management/server/users"
 )
@@ -13,11 +16,37 @@ import (
 type Module string
 
 const (
-	Networks Module = "networks"
-	Peers    Module = "peers"
-	Groups   Module = "groups"
+	Networks      Module = "networks"
+	Peers         Module = "peers"
+	Groups        Module = "groups"
+	Policies      Module = "policies"
+	Routes        Module = "routes"
+	DNS           Module = "dns"
+	SetupKeys     Module = "setup_keys"
+	PostureChecks Module = "posture_checks"
+	Users         Module = "users"
+	Events        Module = "events"
 )
 
+// Modules lists all the modules permissions can be granted for
+var Modules = []Module{Networks, Peers, Groups, Policies, Routes, DNS, SetupKeys, PostureChecks, Users, Events}
+
+// IsValidModule returns true if the given module is known
+func IsValidModule(module string) bool {
+	return slices.Contains(Modules, Module(module))
+}
+
+// WritableModules lists the modules custom roles can grant write access to. The write operations of these modules
+// validate the group scope of the role against the groups of the modified objects, objects that aren't bound to
+// any group can only be modified with unscoped permissions. The write operations of the other modules are reserved
+// to users with admin power
+var WritableModules = []Module{Groups, Policies, Routes, DNS, SetupKeys, PostureChecks, Users, Events}
+
+// IsWritableModule returns true if custom roles can grant write access to the given module
+func IsWritableModule(module string) bool {
+	return slices.Contains(WritableModules, Module(module))
+}
+
 type Operation string
 
 const (
@@ -27,24 +56,33 @@ const (
 
 type Manager interface {
 	ValidateUserPermissions(ctx context.Context, accountID, userID string, module Module, operation Operation) (bool, error)
+	// ValidateUserPermissionsInGroups validates the user permissions for an object associated with the given groups.
+	// Custom roles scoped to specific groups only allow writes when all the given groups are within the scope
+	ValidateUserPermissionsInGroups(ctx context.Context, accountID, userID string, module Module, operation Operation, groupIDs []string) (bool, error)
 }
 
 type managerImpl struct {
 	userManager     users.Manager
 	settingsManager settings.Manager
+	rolesManager    roles.Manager
 }
 
 type managerMock struct {
 }
 
-func NewManager(userManager users.Manager, settingsManager settings.Manager) Manager {
+func NewManager(userManager users.Manager, settingsManager settings.Manager, rolesManager roles.Manager) Manager {
 	return &managerImpl{
 		userManager:     userManager,
 		settingsManager: settingsManager,
+		rolesManager:    rolesManager,
 	}
 }
 
 func (m *managerImpl) ValidateUserPermissions(ctx context.Context, accountID, userID string, module Module, operation Operation) (bool, error) {
+	return m.ValidateUserPermissionsInGroups(ctx, accountID, userID, module, operation, nil)
+}
+
+func (m *managerImpl) ValidateUserPermissionsInGroups(ctx context.Context, accountID, userID string, module Module, operation Operation, groupIDs []string) (bool, error) {
 	user, err := m.userManager.GetUser(ctx, userID)
 	if err != nil {
 		return false, err
@@ -55,14 +93,17 @@ func (m *managerImpl) ValidateUserPermissions(ctx context.Context, accountID, us
 	}
 
 	if user.AccountID != accountID {
-		return false, errors.New("user does not belong to account")
+		return false, status.NewUserNotPartOfAccountError()
 	}
 
 	switch user.Role {
 	case types.UserRoleAdmin, types.UserRoleOwner:
 		return true, nil
 	case types.UserRoleUser:
-		return m.validateRegularUserPermissions(ctx, accountID, userID, module, operation)
+		if user.IsServiceUser {
+			return operation == Read, nil
+		}
+		return m.validateRegularUserPermissions(ctx, accountID, user, module, operation, groupIDs)
 	case types.UserRoleBillingAdmin:
 		return false, nil
 	default:
@@ -70,8 +111,15 @@ func (m *managerImpl) ValidateUserPermissions(ctx context.Context, accountID, us
 	}
 }
 
-func (m *managerImpl) validateRegularUserPermissions(ctx context.Context, accountID, userID string, module Module, operation Operation) (bool, error) {
-	settings, err := m.settingsManager.GetSettings(ctx, accountID, userID)
+func (m *managerImpl) validateRegularUserPermissions(ctx context.Context, accountID string, user *types.User, module Module, operation Operation, groupIDs []string) (bool, error) {
+	if user.CustomRoleID != "" {
+		allowed, err := m.validateCustomRolePermissions(ctx, accountID, user.CustomRoleID, module, operation, groupIDs)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+
+	settings, err := m.settingsManager.GetSettings(ctx, accountID, user.Id)
 	if err != nil {
 		return false, fmt.Errorf("failed to get settings: %w", err)
 	}
@@ -90,6 +138,46 @@ func (m *managerImpl) validateRegularUserPermissions(ctx context.Context, accoun
 	return false, nil
 }
 
+// validateCustomRolePermissions checks the grants of the custom role. A role that no longer exists grants nothing
+func (m *managerImpl) validateCustomRolePermissions(ctx context.Context, accountID, roleID string, module Module, operation Operation, groupIDs []string) (bool, error) {
+	role, err := m.rolesManager.GetCustomRole(ctx, accountID, roleID)
+	if err != nil {
+		if sErr, ok := status.FromError(err); ok && sErr.Type() == status.NotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get custom role: %w", err)
+	}
+
+	permission, ok := role.GetPermission(string(module))
+	if !ok {
+		return false, nil
+	}
+
+	switch operation {
+	case Read:
+		// write access implies read access
+		return permission.Read || permission.Write, nil
+	case Write:
+		if !permission.Write {
+			return false, nil
+		}
+		if len(permission.Groups) == 0 {
+			return true, nil
+		}
+		if len(groupIDs) == 0 {
+			return false, nil
+		}
+		for _, groupID := range groupIDs {
+			if !slices.Contains(permission.Groups, groupID) {
+				return false, nil
+			}
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
 func NewManagerMock() Manager {
 	return &managerMock{}
 }
@@ -100,3 +188,7 @@ func (m *managerMock) ValidateUserPermissions(ctx context.Context, accountID, us
 	}
 	return false, nil
 }
+
+func (m *managerMock) ValidateUserPermissionsInGroups(ctx context.Context, accountID, userID string, module Module, operation Operation, groupIDs []string) (bool, error) {
+	return m.ValidateUserPermissions(ctx, accountID, userID, module, operation)
+}
@@ -0,0 +1,56 @@
+package permissions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/roles"
+	"github.com/netbirdio/netbird/management/server/settings"
+	"github.com/netbirdio/netbird/management/server/users"
+)
+
+func TestManager_ValidateUserPermissionsInGroups(t *testing.T) {
+	manager := NewManager(users.NewManagerMock(), settings.NewManagerMock(), roles.NewManagerMock())
+
+	tests := []struct {
+		name      string
+		userID    string
+		module    Module
+		operation Operation
+		groupIDs  []string
+		allowed   bool
+	}{
+		{name: "admin can write", userID: "adminUser", module: Policies, operation: Write, allowed: true},
+		{name: "owner can write", userID: "ownerUser", module: Users, operation: Write, allowed: true},
+		{name: "billing admin can't read", userID: "billingUser", module: Peers, operation: Read},
+		{name: "regular user can read peers", userID: "regularUser", module: Peers, operation: Read, allowed: true},
+		{name: "regular user can't read routes", userID: "regularUser", module: Routes, operation: Read},
+		{name: "regular user can't write peers", userID: "regularUser", module: Peers, operation: Write},
+		{name: "custom role read granted", userID: "routesUser", module: Groups, operation: Read, allowed: true},
+		{name: "custom role write implies read", userID: "routesUser", module: Routes, operation: Read, allowed: true},
+		{name: "custom role write within scope", userID: "routesUser", module: Routes, operation: Write, groupIDs: []string{"networkTeam"}, allowed: true},
+		{name: "custom role write outside of scope", userID: "routesUser", module: Routes, operation: Write, groupIDs: []string{"networkTeam", "other"}},
+		{name: "custom role scoped write without groups", userID: "routesUser", module: Routes, operation: Write},
+		{name: "custom role write not granted", userID: "routesUser", module: Groups, operation: Write, groupIDs: []string{"networkTeam"}},
+		{name: "custom role falls back to default permissions", userID: "routesUser", module: Peers, operation: Read, allowed: true},
+		{name: "custom role module not granted", userID: "routesUser", module: Policies, operation: Read},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := manager.ValidateUserPermissionsInGroups(context.Background(), "", tt.userID, tt.module, tt.operation, tt.groupIDs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, allowed)
+		})
+	}
+}
+
+func TestManager_ValidateUserPermissions_WrongAccount(t *testing.T) {
+	manager := NewManager(users.NewManagerMock(), settings.NewManagerMock(), roles.NewManagerMock())
+
+	_, err := manager.ValidateUserPermissions(context.Background(), "otherAccount", "adminUser", Peers, Read)
+	assert.Error(t, err)
+}
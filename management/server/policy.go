@@ -3,33 +3,28 @@ package server
 import (
 	"context"
 	_ "embed"
+	"slices"
+	"time"
 
 	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
 // GetPolicy from the store
 func (am *DefaultAccountManager) GetPolicy(ctx context.Context, accountID, policyID, userID string) (*types.Policy, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Policies, permissions.Read); err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
-	}
-
 	return am.Store.GetPolicyByID(ctx, store.LockingStrengthShare, accountID, policyID)
 }
 
@@ -38,20 +33,23 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
-		return nil, err
-	}
+	var isUpdate = policy.ID != ""
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
+	// a policy can't be moved out of the groups the user is allowed to manage
+	scopeGroupIDs := policyScopeGroups(policy)
+	if isUpdate {
+		oldPolicy, err := am.Store.GetPolicyByID(ctx, store.LockingStrengthShare, accountID, policy.ID)
+		if err != nil {
+			return nil, err
+		}
+		scopeGroupIDs = slices.Concat(scopeGroupIDs, policyScopeGroups(oldPolicy))
 	}
 
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
+	err := am.validateUserPermissions(ctx, accountID, userID, permissions.Policies, permissions.Write, scopeGroupIDs...)
+	if err != nil {
+		return nil, err
 	}
 
-	var isUpdate = policy.ID != ""
 	var updateAccountPeers bool
 	var action = activity.PolicyAdded
 
@@ -87,6 +85,8 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 		am.UpdateAccountPeers(ctx, accountID)
 	}
 
+	am.checkAndSchedulePolicyRuleTransitions(ctx, accountID)
+
 	return policy, nil
 }
 
@@ -95,28 +95,19 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	policy, err := am.Store.GetPolicyByID(ctx, store.LockingStrengthShare, accountID, policyID)
 	if err != nil {
 		return err
 	}
 
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return status.NewAdminPermissionError()
+	err = am.validateUserPermissions(ctx, accountID, userID, permissions.Policies, permissions.Write, policyScopeGroups(policy)...)
+	if err != nil {
+		return err
 	}
 
-	var policy *types.Policy
 	var updateAccountPeers bool
 
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
-		policy, err = transaction.GetPolicyByID(ctx, store.LockingStrengthUpdate, accountID, policyID)
-		if err != nil {
-			return err
-		}
-
 		updateAccountPeers, err = arePolicyChangesAffectPeers(ctx, transaction, accountID, policy, false)
 		if err != nil {
 			return err
@@ -138,25 +129,91 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 		am.UpdateAccountPeers(ctx, accountID)
 	}
 
+	am.checkAndSchedulePolicyRuleTransitions(ctx, accountID)
+
 	return nil
 }
 
 // ListPolicies from the store.
 func (am *DefaultAccountManager) ListPolicies(ctx context.Context, accountID, userID string) ([]*types.Policy, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Policies, permissions.Read); err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
+	return am.Store.GetAccountPolicies(ctx, store.LockingStrengthShare, accountID)
+}
+
+// policyRuleTransitionJob updates account peers when a scheduled policy rule becomes active or inactive
+// and returns the duration until the next transition of the account policies if found
+func (am *DefaultAccountManager) policyRuleTransitionJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		log.WithContext(ctx).Debugf("policy rule schedule transition for account %s, updating peers", accountID)
+		am.UpdateAccountPeers(ctx, accountID)
+
+		return am.getNextPolicyRuleTransition(ctx, accountID)
+	}
+}
+
+// checkAndSchedulePolicyRuleTransitions schedules an account peers update for the next time a scheduled policy rule
+// becomes active or inactive. The caller must hold the account write lock so concurrent policy changes can't leave
+// a job scheduled for a stale transition
+func (am *DefaultAccountManager) checkAndSchedulePolicyRuleTransitions(ctx context.Context, accountID string) {
+	am.policyRuleScheduler.Cancel(ctx, []string{accountID})
+	if nextRun, ok := am.getNextPolicyRuleTransition(ctx, accountID); ok {
+		am.policyRuleScheduler.Schedule(ctx, nextRun, accountID, am.policyRuleTransitionJob(ctx, accountID))
 	}
+}
 
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
+// schedulePolicyRuleTransitions schedules the policy rule transitions of all accounts with scheduled policy rules.
+// It is called once on startup, later the transitions are scheduled again on every policy change
+func (am *DefaultAccountManager) schedulePolicyRuleTransitions(ctx context.Context) {
+	accountIDs, err := am.Store.GetAccountIDsWithScheduledPolicyRules(ctx, store.LockingStrengthShare)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get accounts with scheduled policy rules: %v", err)
+		return
 	}
 
-	return am.Store.GetAccountPolicies(ctx, store.LockingStrengthShare, accountID)
+	for _, accountID := range accountIDs {
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		am.checkAndSchedulePolicyRuleTransitions(ctx, accountID)
+		unlock()
+	}
+}
+
+// getNextPolicyRuleTransition returns the duration until the earliest schedule transition of the account policies
+func (am *DefaultAccountManager) getNextPolicyRuleTransition(ctx context.Context, accountID string) (time.Duration, bool) {
+	policies, err := am.Store.GetAccountPolicies(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get policies for account %s: %v", accountID, err)
+		return peerSchedulerRetryInterval, true
+	}
+
+	now := time.Now()
+	var next time.Time
+	for _, policy := range policies {
+		transition, ok := policy.NextScheduleTransition(now)
+		if ok && (next.IsZero() || transition.Before(next)) {
+			next = transition
+		}
+	}
+
+	if next.IsZero() {
+		return 0, false
+	}
+
+	// the ticker requires a positive duration
+	return max(next.Sub(now), time.Second), true
+}
+
+// policyScopeGroups returns the groups the custom role scope is validated against. Rules referencing resources
+// directly aren't bound to groups, such policies can only be modified with unscoped permissions
+func policyScopeGroups(policy *types.Policy) []string {
+	for _, rule := range policy.Rules {
+		if rule.SourceResource.ID != "" || rule.DestinationResource.ID != "" {
+			return nil
+		}
+	}
+	return policy.RuleGroups()
 }
 
 // arePolicyChangesAffectPeers checks if changes to a policy will affect any associated peers.
@@ -207,6 +264,16 @@ func validatePolicy(ctx context.Context, transaction store.Store, accountID stri
 	}
 
 	for i, rule := range policy.Rules {
+		if rule.Schedule != nil {
+			if err = rule.Schedule.Validate(); err != nil {
+				return status.Errorf(status.InvalidArgument, "invalid schedule for rule %s: %v", rule.Name, err)
+			}
+		}
+
+		if err = validateSSHAuthorizations(ctx, transaction, accountID, rule, groups); err != nil {
+			return err
+		}
+
 		ruleCopy := rule.Copy()
 		if ruleCopy.ID == "" {
 			ruleCopy.ID = policy.ID // TODO: when policy can contain multiple rules, need refactor
@@ -225,6 +292,49 @@ func validatePolicy(ctx context.Context, transaction store.Store, accountID stri
 	return nil
 }
 
+// validateSSHAuthorizations validates the SSH authorizations of the rule and the groups and users they reference
+func validateSSHAuthorizations(ctx context.Context, transaction store.Store, accountID string, rule *types.PolicyRule, groups map[string]*types.Group) error {
+	if len(rule.SSHAuthorizations) == 0 {
+		return nil
+	}
+
+	if rule.Action != types.PolicyTrafficActionAccept {
+		return status.Errorf(status.InvalidArgument, "SSH authorizations of rule %s require the accept action", rule.Name)
+	}
+
+	var accountUsers map[string]struct{}
+	for _, authorization := range rule.SSHAuthorizations {
+		if err := authorization.Validate(); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid SSH authorization for rule %s: %v", rule.Name, err)
+		}
+
+		for _, groupID := range authorization.Groups {
+			if _, ok := groups[groupID]; !ok {
+				return status.Errorf(status.InvalidArgument, "SSH authorization group %s of rule %s not found", groupID, rule.Name)
+			}
+		}
+
+		if len(authorization.Users) != 0 && accountUsers == nil {
+			users, err := transaction.GetAccountUsers(ctx, store.LockingStrengthShare, accountID)
+			if err != nil {
+				return err
+			}
+			accountUsers = make(map[string]struct{}, len(users))
+			for _, user := range users {
+				accountUsers[user.Id] = struct{}{}
+			}
+		}
+
+		for _, userID := range authorization.Users {
+			if _, ok := accountUsers[userID]; !ok {
+				return status.Errorf(status.InvalidArgument, "SSH authorization user %s of rule %s not found", userID, rule.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
 // getValidPostureCheckIDs filters and returns only the valid posture check IDs from the provided list.
 func getValidPostureCheckIDs(postureChecks map[string]*posture.Checks, postureChecksIds []string) []string {
 	validIDs := make([]string, 0, len(postureChecksIds))
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"slices"
+
+	"github.com/rs/xid"
+	"golang.org/x/exp/maps"
+
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// ExplainPolicyAccess evaluates whether the source peer of the request can reach the destination peer or network
+// resource and returns the matching policy rules, failed posture checks and the final verdict.
+func (am *DefaultAccountManager) ExplainPolicyAccess(ctx context.Context, accountID, userID string, req *types.PolicyExplainRequest) (*types.PolicyExplanation, error) {
+	if err := am.validatePolicyReader(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	switch req.Protocol {
+	case types.PolicyRuleProtocolALL, types.PolicyRuleProtocolTCP, types.PolicyRuleProtocolUDP, types.PolicyRuleProtocolICMP:
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "unknown protocol type: %v", req.Protocol)
+	}
+
+	account, err := am.requestBuffer.GetAccountWithBackpressure(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	validatedPeers, err := am.integratedPeerValidator.GetValidatedPeers(accountID, maps.Values(account.Groups), maps.Values(account.Peers), account.Settings.Extra)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.ExplainConnection(ctx, req, validatedPeers)
+}
+
+// DryRunPolicy returns the peer pairs that would gain or lose access if the policy with the given ID was replaced by
+// the provided policy. A new policy is simulated when policyID is empty and a removal when policy is nil.
+// Nothing is persisted.
+func (am *DefaultAccountManager) DryRunPolicy(ctx context.Context, accountID, userID, policyID string, policy *types.Policy) (*types.PolicyAccessDiff, error) {
+	if err := am.validatePolicyReader(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	if policyID == "" && policy == nil {
+		return nil, status.Errorf(status.InvalidArgument, "policy ID or a proposed policy is required")
+	}
+
+	account, err := am.requestBuffer.GetAccountWithBackpressure(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if policyID != "" && !slices.ContainsFunc(account.Policies, func(p *types.Policy) bool { return p.ID == policyID }) {
+		return nil, status.NewPolicyNotFoundError(policyID)
+	}
+
+	if policy != nil {
+		policy = policy.Copy()
+		policy.ID = policyID
+		if policy.ID == "" {
+			policy.ID = xid.New().String()
+		}
+		policy.AccountID = accountID
+
+		for _, rule := range policy.Rules {
+			if rule.Schedule != nil {
+				if err = rule.Schedule.Validate(); err != nil {
+					return nil, status.Errorf(status.InvalidArgument, "invalid schedule for rule %s: %v", rule.Name, err)
+				}
+			}
+			rule.PolicyID = policy.ID
+			rule.Sources = getValidGroupIDs(account.Groups, rule.Sources)
+			rule.Destinations = getValidGroupIDs(account.Groups, rule.Destinations)
+		}
+	}
+
+	validatedPeers, err := am.integratedPeerValidator.GetValidatedPeers(accountID, maps.Values(account.Groups), maps.Values(account.Peers), account.Settings.Extra)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.SimulatePolicyChange(ctx, policyID, policy, validatedPeers), nil
+}
+
+// validatePolicyReader checks that the user belongs to the account and is allowed to view its policies
+func (am *DefaultAccountManager) validatePolicyReader(ctx context.Context, accountID, userID string) error {
+	return am.validateUserPermissions(ctx, accountID, userID, permissions.Policies, permissions.Read)
+}
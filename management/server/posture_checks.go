@@ -10,6 +10,7 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/store"
@@ -17,19 +18,10 @@ import (
 )
 
 func (am *DefaultAccountManager) GetPostureChecks(ctx context.Context, accountID, postureChecksID, userID string) (*posture.Checks, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.PostureChecks, permissions.Read); err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
-
-	if !user.HasAdminPower() {
-		return nil, status.NewAdminPermissionError()
-	}
-
 	return am.Store.GetPostureChecksByID(ctx, store.LockingStrengthShare, accountID, postureChecksID)
 }
 
@@ -38,21 +30,23 @@ func (am *DefaultAccountManager) SavePostureChecks(ctx context.Context, accountI
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
-		return nil, err
-	}
+	var isUpdate = postureChecks.ID != ""
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
+	var scopeGroupIDs []string
+	if isUpdate {
+		var err error
+		scopeGroupIDs, err = am.postureChecksScopeGroups(ctx, accountID, postureChecks.ID)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if !user.HasAdminPower() {
-		return nil, status.NewAdminPermissionError()
+	err := am.validateUserPermissions(ctx, accountID, userID, permissions.PostureChecks, permissions.Write, scopeGroupIDs...)
+	if err != nil {
+		return nil, err
 	}
 
 	var updateAccountPeers bool
-	var isUpdate = postureChecks.ID != ""
 	var action = activity.PostureCheckCreated
 
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
@@ -94,17 +88,14 @@ func (am *DefaultAccountManager) DeletePostureChecks(ctx context.Context, accoun
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	scopeGroupIDs, err := am.postureChecksScopeGroups(ctx, accountID, postureChecksID)
 	if err != nil {
 		return err
 	}
 
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
-	}
-
-	if !user.HasAdminPower() {
-		return status.NewAdminPermissionError()
+	err = am.validateUserPermissions(ctx, accountID, userID, permissions.PostureChecks, permissions.Write, scopeGroupIDs...)
+	if err != nil {
+		return err
 	}
 
 	var postureChecks *posture.Checks
@@ -134,19 +125,29 @@ func (am *DefaultAccountManager) DeletePostureChecks(ctx context.Context, accoun
 	return nil
 }
 
-// ListPostureChecks returns a list of posture checks.
-func (am *DefaultAccountManager) ListPostureChecks(ctx context.Context, accountID, userID string) ([]*posture.Checks, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+// postureChecksScopeGroups returns the groups the custom role scope is validated against: the rule groups of the
+// policies the posture check is applied by. Checks that aren't used by any policy aren't bound to groups, they can
+// only be modified with unscoped permissions
+func (am *DefaultAccountManager) postureChecksScopeGroups(ctx context.Context, accountID, postureChecksID string) ([]string, error) {
+	policies, err := am.Store.GetAccountPolicies(ctx, store.LockingStrengthShare, accountID)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
+	var groupIDs []string
+	for _, policy := range policies {
+		if slices.Contains(policy.SourcePostureChecks, postureChecksID) {
+			groupIDs = append(groupIDs, policy.RuleGroups()...)
+		}
 	}
 
-	if !user.HasAdminPower() {
-		return nil, status.NewAdminPermissionError()
+	return groupIDs, nil
+}
+
+// ListPostureChecks returns a list of posture checks.
+func (am *DefaultAccountManager) ListPostureChecks(ctx context.Context, accountID, userID string) ([]*posture.Checks, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.PostureChecks, permissions.Read); err != nil {
+		return nil, err
 	}
 
 	return am.Store.GetAccountPostureChecks(ctx, store.LockingStrengthShare, accountID)
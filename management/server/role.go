@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// GetCustomRole returns the custom role of the account with the given ID
+func (am *DefaultAccountManager) GetCustomRole(ctx context.Context, accountID, roleID, userID string) (*types.CustomRole, error) {
+	if err := am.validateCustomRoleAdmin(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetCustomRoleByID(ctx, store.LockingStrengthShare, accountID, roleID)
+}
+
+// ListCustomRoles returns the custom roles of the account
+func (am *DefaultAccountManager) ListCustomRoles(ctx context.Context, accountID, userID string) ([]*types.CustomRole, error) {
+	if err := am.validateCustomRoleAdmin(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetAccountCustomRoles(ctx, store.LockingStrengthShare, accountID)
+}
+
+// SaveCustomRole creates a new custom role when the role ID is empty or updates an existing one
+func (am *DefaultAccountManager) SaveCustomRole(ctx context.Context, accountID, userID string, role *types.CustomRole) (*types.CustomRole, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if err := am.validateCustomRoleAdmin(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	isUpdate := role.ID != ""
+	action := activity.CustomRoleCreated
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err := validateCustomRole(ctx, transaction, accountID, role); err != nil {
+			return err
+		}
+
+		if isUpdate {
+			if _, err := transaction.GetCustomRoleByID(ctx, store.LockingStrengthUpdate, accountID, role.ID); err != nil {
+				return err
+			}
+			action = activity.CustomRoleUpdated
+		} else {
+			role.ID = xid.New().String()
+		}
+
+		role.AccountID = accountID
+		return transaction.SaveCustomRole(ctx, store.LockingStrengthUpdate, role)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, role.ID, accountID, action, role.EventMeta())
+
+	return role, nil
+}
+
+// DeleteCustomRole deletes the custom role of the account if no user is assigned to it
+func (am *DefaultAccountManager) DeleteCustomRole(ctx context.Context, accountID, roleID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if err := am.validateCustomRoleAdmin(ctx, accountID, userID); err != nil {
+		return err
+	}
+
+	var role *types.CustomRole
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		role, err = transaction.GetCustomRoleByID(ctx, store.LockingStrengthUpdate, accountID, roleID)
+		if err != nil {
+			return err
+		}
+
+		users, err := transaction.GetAccountUsers(ctx, store.LockingStrengthShare, accountID)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			if user.CustomRoleID == roleID {
+				return status.Errorf(status.PreconditionFailed, "custom role has been assigned to user %s", user.Id)
+			}
+		}
+
+		return transaction.DeleteCustomRole(ctx, store.LockingStrengthUpdate, accountID, roleID)
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, role.ID, accountID, activity.CustomRoleDeleted, role.EventMeta())
+
+	return nil
+}
+
+// validateCustomRoleAdmin checks that the user belongs to the account and is allowed to manage custom roles.
+// Custom roles can only be managed by admins as they control the permissions of other users.
+func (am *DefaultAccountManager) validateCustomRoleAdmin(ctx context.Context, accountID, userID string) error {
+	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.AccountID != accountID {
+		return status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return status.NewAdminPermissionError()
+	}
+
+	return nil
+}
+
+// validateCustomRole validates the custom role name, modules and group scopes
+func validateCustomRole(ctx context.Context, transaction store.Store, accountID string, role *types.CustomRole) error {
+	if role.Name == "" {
+		return status.Errorf(status.InvalidArgument, "role name shouldn't be empty")
+	}
+
+	existingRoles, err := transaction.GetAccountCustomRoles(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range existingRoles {
+		if existing.Name == role.Name && existing.ID != role.ID {
+			return status.Errorf(status.InvalidArgument, "role with name %s already exists", role.Name)
+		}
+	}
+
+	var groupIDs []string
+	modules := make(map[string]struct{}, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		if !permissions.IsValidModule(permission.Module) {
+			return status.Errorf(status.InvalidArgument, "unknown module %s", permission.Module)
+		}
+
+		if (permission.Write || len(permission.Groups) > 0) && !permissions.IsWritableModule(permission.Module) {
+			return status.Errorf(status.InvalidArgument, "write access can't be granted for module %s", permission.Module)
+		}
+
+		if _, ok := modules[permission.Module]; ok {
+			return status.Errorf(status.InvalidArgument, "duplicate permission for module %s", permission.Module)
+		}
+		modules[permission.Module] = struct{}{}
+
+		groupIDs = append(groupIDs, permission.Groups...)
+	}
+
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthShare, accountID, groupIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, groupID := range groupIDs {
+		if _, ok := groups[groupID]; !ok {
+			return status.Errorf(status.InvalidArgument, "group %s doesn't exist", groupID)
+		}
+	}
+
+	return nil
+}
+
+// validateUserPermissions checks that the user is allowed to perform the operation on the module objects
+// associated with the given groups
+func (am *DefaultAccountManager) validateUserPermissions(ctx context.Context, accountID, userID string, module permissions.Module, operation permissions.Operation, groupIDs ...string) error {
+	allowed, err := am.permissionsManager.ValidateUserPermissionsInGroups(ctx, accountID, userID, module, operation, groupIDs)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.NewPermissionValidationError(err)
+	}
+
+	if !allowed {
+		return status.NewAdminPermissionError()
+	}
+
+	return nil
+}
+
+// validateUserCustomRole checks that the custom role assigned to the user exists and can be assigned to its role
+func validateUserCustomRole(ctx context.Context, transaction store.Store, accountID string, user *types.User) error {
+	if user.CustomRoleID == "" {
+		return nil
+	}
+
+	if user.Role != types.UserRoleUser || user.IsServiceUser {
+		return status.Errorf(status.InvalidArgument, "custom roles can only be assigned to regular users with the user role")
+	}
+
+	_, err := transaction.GetCustomRoleByID(ctx, store.LockingStrengthShare, accountID, user.CustomRoleID)
+	return err
+}
@@ -0,0 +1,314 @@
+package server
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/roles"
+	"github.com/netbirdio/netbird/management/server/settings"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/management/server/users"
+	"github.com/netbirdio/netbird/route"
+)
+
+func newTestPermissionsManager(s store.Store) permissions.Manager {
+	return permissions.NewManager(users.NewManager(s), settings.NewManager(s), roles.NewManager(s))
+}
+
+func initTestCustomRolesAccount(t *testing.T, am *DefaultAccountManager) *types.Account {
+	t.Helper()
+
+	account := newAccountWithId(context.Background(), "testingAccount", adminUserID, "example.com")
+	account.Users[regularUserID] = &types.User{
+		Id:        regularUserID,
+		AccountID: account.Id,
+		Role:      types.UserRoleUser,
+	}
+	account.Groups["networkTeam"] = &types.Group{ID: "networkTeam", AccountID: account.Id, Name: "Network team"}
+
+	require.NoError(t, am.Store.SaveAccount(context.Background(), account))
+
+	return account
+}
+
+func TestDefaultAccountManager_SaveCustomRole(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+	account := initTestCustomRolesAccount(t, am)
+
+	role := &types.CustomRole{
+		Name: "Network team",
+		Permissions: []types.RolePermission{
+			{Module: string(permissions.Routes), Read: true, Write: true, Groups: []string{"networkTeam"}},
+			{Module: string(permissions.Groups), Read: true},
+		},
+	}
+
+	t.Run("regular user can't manage roles", func(t *testing.T) {
+		_, err := am.SaveCustomRole(context.Background(), account.Id, regularUserID, role.Copy())
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid roles", func(t *testing.T) {
+		invalidModule := role.Copy()
+		invalidModule.Permissions = []types.RolePermission{{Module: "unknown", Read: true}}
+		_, err := am.SaveCustomRole(context.Background(), account.Id, adminUserID, invalidModule)
+		assert.Error(t, err)
+
+		unknownGroup := role.Copy()
+		unknownGroup.Permissions = []types.RolePermission{{Module: string(permissions.Routes), Write: true, Groups: []string{"unknown"}}}
+		_, err = am.SaveCustomRole(context.Background(), account.Id, adminUserID, unknownGroup)
+		assert.Error(t, err)
+
+		duplicateModule := role.Copy()
+		duplicateModule.Permissions = append(duplicateModule.Permissions, types.RolePermission{Module: string(permissions.Routes), Read: true})
+		_, err = am.SaveCustomRole(context.Background(), account.Id, adminUserID, duplicateModule)
+		assert.Error(t, err)
+	})
+
+	savedRole, err := am.SaveCustomRole(context.Background(), account.Id, adminUserID, role.Copy())
+	require.NoError(t, err)
+	require.NotEmpty(t, savedRole.ID)
+
+	t.Run("duplicate name", func(t *testing.T) {
+		_, err := am.SaveCustomRole(context.Background(), account.Id, adminUserID, role.Copy())
+		assert.Error(t, err)
+	})
+
+	t.Run("update role", func(t *testing.T) {
+		update := savedRole.Copy()
+		update.Description = "Manages the network team routes"
+		updated, err := am.SaveCustomRole(context.Background(), account.Id, adminUserID, update)
+		require.NoError(t, err)
+		assert.Equal(t, savedRole.ID, updated.ID)
+
+		listed, err := am.ListCustomRoles(context.Background(), account.Id, adminUserID)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, update.Description, listed[0].Description)
+	})
+}
+
+func TestDefaultAccountManager_CustomRolePermissions(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+	account := initTestCustomRolesAccount(t, am)
+
+	role, err := am.SaveCustomRole(context.Background(), account.Id, adminUserID, &types.CustomRole{
+		Name: "Network team",
+		Permissions: []types.RolePermission{
+			{Module: string(permissions.Routes), Read: true, Write: true, Groups: []string{"networkTeam"}},
+			{Module: string(permissions.Groups), Read: true},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = am.GetAllGroups(context.Background(), account.Id, regularUserID)
+	assert.Error(t, err, "regular user without a custom role shouldn't list groups")
+
+	regularUser := account.Users[regularUserID].Copy()
+	regularUser.CustomRoleID = role.ID
+	_, err = am.SaveUser(context.Background(), account.Id, adminUserID, regularUser)
+	require.NoError(t, err)
+
+	_, err = am.GetAllGroups(context.Background(), account.Id, regularUserID)
+	assert.NoError(t, err)
+
+	_, err = am.ListRoutes(context.Background(), account.Id, regularUserID)
+	assert.NoError(t, err)
+
+	_, err = am.ListPolicies(context.Background(), account.Id, regularUserID)
+	assert.Error(t, err)
+
+	err = am.SaveGroup(context.Background(), account.Id, regularUserID, &types.Group{ID: "networkTeam", Name: "Renamed"})
+	assert.Error(t, err)
+
+	t.Run("custom role can't be assigned to admins", func(t *testing.T) {
+		admin := account.Users[adminUserID].Copy()
+		admin.CustomRoleID = role.ID
+		_, err := am.SaveUser(context.Background(), account.Id, adminUserID, admin)
+		assert.Error(t, err)
+	})
+
+	t.Run("assigned role can't be deleted", func(t *testing.T) {
+		err := am.DeleteCustomRole(context.Background(), account.Id, role.ID, adminUserID)
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.PreconditionFailed, sErr.Type())
+	})
+
+	regularUser.CustomRoleID = ""
+	_, err = am.SaveUser(context.Background(), account.Id, adminUserID, regularUser)
+	require.NoError(t, err)
+
+	err = am.DeleteCustomRole(context.Background(), account.Id, role.ID, adminUserID)
+	require.NoError(t, err)
+
+	_, err = am.GetCustomRole(context.Background(), account.Id, role.ID, adminUserID)
+	assert.Error(t, err)
+}
+
+func TestDefaultAccountManager_ScopedCustomRole(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+	account := initTestCustomRolesAccount(t, am)
+
+	account.Groups["otherTeam"] = &types.Group{ID: "otherTeam", AccountID: account.Id, Name: "Other team"}
+	account.Users["teamUser"] = &types.User{Id: "teamUser", AccountID: account.Id, Role: types.UserRoleUser, AutoGroups: []string{"networkTeam"}}
+	account.Users["otherUser"] = &types.User{Id: "otherUser", AccountID: account.Id, Role: types.UserRoleUser, AutoGroups: []string{"otherTeam"}}
+	require.NoError(t, am.Store.SaveAccount(context.Background(), account))
+
+	_, err = am.SaveCustomRole(context.Background(), account.Id, adminUserID, &types.CustomRole{
+		Name:        "Networks",
+		Permissions: []types.RolePermission{{Module: string(permissions.Networks), Write: true}},
+	})
+	assert.Error(t, err, "write access must only be granted for modules validating the role scope")
+
+	role, err := am.SaveCustomRole(context.Background(), account.Id, adminUserID, &types.CustomRole{
+		Name: "Network team managers",
+		Permissions: []types.RolePermission{
+			{Module: string(permissions.Users), Read: true, Write: true, Groups: []string{"networkTeam"}},
+			{Module: string(permissions.Groups), Read: true, Write: true, Groups: []string{"networkTeam"}},
+			{Module: string(permissions.Routes), Read: true, Write: true, Groups: []string{"networkTeam"}},
+			{Module: string(permissions.Policies), Read: true, Write: true, Groups: []string{"networkTeam"}},
+			{Module: string(permissions.DNS), Read: true, Write: true, Groups: []string{"networkTeam"}},
+			{Module: string(permissions.SetupKeys), Read: true, Write: true, Groups: []string{"networkTeam"}},
+		},
+	})
+	require.NoError(t, err)
+
+	regularUser := account.Users[regularUserID].Copy()
+	regularUser.CustomRoleID = role.ID
+	_, err = am.SaveUser(context.Background(), account.Id, adminUserID, regularUser)
+	require.NoError(t, err)
+
+	// the account settings update saves the whole account, the custom roles must be kept
+	_, err = am.UpdateAccountSettings(context.Background(), account.Id, adminUserID, account.Settings.Copy())
+	require.NoError(t, err)
+	_, err = am.GetCustomRole(context.Background(), account.Id, role.ID, adminUserID)
+	require.NoError(t, err)
+
+	t.Run("user in scope", func(t *testing.T) {
+		update := account.Users["teamUser"].Copy()
+		update.Blocked = true
+		_, err := am.SaveUser(context.Background(), account.Id, regularUserID, update)
+		assert.NoError(t, err)
+	})
+
+	t.Run("user outside of scope", func(t *testing.T) {
+		update := account.Users["otherUser"].Copy()
+		update.AutoGroups = []string{"networkTeam"}
+		_, err := am.SaveUser(context.Background(), account.Id, regularUserID, update)
+		assert.Error(t, err)
+	})
+
+	t.Run("move user out of scope", func(t *testing.T) {
+		update := account.Users["teamUser"].Copy()
+		update.AutoGroups = []string{"networkTeam", "otherTeam"}
+		_, err := am.SaveUser(context.Background(), account.Id, regularUserID, update)
+		assert.Error(t, err)
+	})
+
+	t.Run("own auto groups", func(t *testing.T) {
+		update := regularUser.Copy()
+		update.AutoGroups = []string{"networkTeam"}
+		_, err := am.SaveUser(context.Background(), account.Id, regularUserID, update)
+		assert.Error(t, err)
+	})
+
+	t.Run("delete user outside of scope", func(t *testing.T) {
+		err := am.DeleteUser(context.Background(), account.Id, regularUserID, "otherUser")
+		assert.Error(t, err)
+	})
+
+	t.Run("groups", func(t *testing.T) {
+		err := am.SaveGroup(context.Background(), account.Id, regularUserID, &types.Group{ID: "networkTeam", Name: "Network team"})
+		assert.NoError(t, err)
+
+		err = am.SaveGroup(context.Background(), account.Id, regularUserID, &types.Group{ID: "otherTeam", Name: "Renamed"})
+		assert.Error(t, err)
+	})
+
+	t.Run("routes", func(t *testing.T) {
+		prefix := netip.MustParsePrefix("10.10.0.0/16")
+		_, err := am.CreateRoute(context.Background(), account.Id, prefix, route.IPv4Network, nil, "", []string{"networkTeam"}, "", "team", false, 9999, []string{"networkTeam"}, nil, true, regularUserID, false, nil)
+		assert.NoError(t, err)
+
+		_, err = am.CreateRoute(context.Background(), account.Id, prefix, route.IPv4Network, nil, "", []string{"otherTeam"}, "", "other", false, 9999, []string{"networkTeam"}, nil, true, regularUserID, false, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("policies", func(t *testing.T) {
+		newPolicy := func(destination string) *types.Policy {
+			return &types.Policy{
+				Name:    "team",
+				Enabled: true,
+				Rules: []*types.PolicyRule{{
+					Name:          "team",
+					Enabled:       true,
+					Action:        types.PolicyTrafficActionAccept,
+					Protocol:      types.PolicyRuleProtocolALL,
+					Bidirectional: true,
+					Sources:       []string{"networkTeam"},
+					Destinations:  []string{destination},
+				}},
+			}
+		}
+
+		policy, err := am.SavePolicy(context.Background(), account.Id, regularUserID, newPolicy("networkTeam"))
+		require.NoError(t, err)
+
+		_, err = am.SavePolicy(context.Background(), account.Id, regularUserID, newPolicy("otherTeam"))
+		assert.Error(t, err)
+
+		update := newPolicy("otherTeam")
+		update.ID = policy.ID
+		_, err = am.SavePolicy(context.Background(), account.Id, regularUserID, update)
+		assert.Error(t, err, "policy must not be moved out of scope")
+
+		err = am.DeletePolicy(context.Background(), account.Id, policy.ID, regularUserID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("nameservers", func(t *testing.T) {
+		nameServers := []nbdns.NameServer{{IP: netip.MustParseAddr("1.1.1.1"), NSType: nbdns.UDPNameServerType, Port: nbdns.DefaultDNSPort}}
+		nsGroup, err := am.CreateNameServerGroup(context.Background(), account.Id, "team", "", nameServers, []string{"networkTeam"}, true, nil, true, regularUserID, false)
+		require.NoError(t, err)
+
+		_, err = am.CreateNameServerGroup(context.Background(), account.Id, "other", "", nameServers, []string{"otherTeam"}, true, nil, true, regularUserID, false)
+		assert.Error(t, err)
+
+		update := nsGroup.Copy()
+		update.Groups = []string{"otherTeam"}
+		err = am.SaveNameServerGroup(context.Background(), account.Id, regularUserID, update)
+		assert.Error(t, err, "nameserver group must not be moved out of scope")
+
+		err = am.DeleteNameServerGroup(context.Background(), account.Id, nsGroup.ID, regularUserID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("setup keys", func(t *testing.T) {
+		key, err := am.CreateSetupKey(context.Background(), account.Id, "team", types.SetupKeyReusable, time.Hour, []string{"networkTeam"}, 0, regularUserID, false, false)
+		require.NoError(t, err)
+
+		_, err = am.CreateSetupKey(context.Background(), account.Id, "unscoped", types.SetupKeyReusable, time.Hour, nil, 0, regularUserID, false, false)
+		assert.Error(t, err, "setup keys without groups require unscoped permissions")
+
+		update := key.Copy()
+		update.AutoGroups = []string{"otherTeam"}
+		_, err = am.SaveSetupKey(context.Background(), account.Id, update, regularUserID)
+		assert.Error(t, err, "setup key must not be moved out of scope")
+
+		err = am.DeleteSetupKey(context.Background(), account.Id, regularUserID, key.Id)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,51 @@
+package roles
+
+import (
+	"context"
+
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+type Manager interface {
+	GetCustomRole(ctx context.Context, accountID, roleID string) (*types.CustomRole, error)
+}
+
+type managerImpl struct {
+	store store.Store
+}
+
+type managerMock struct {
+}
+
+func NewManager(store store.Store) Manager {
+	return &managerImpl{
+		store: store,
+	}
+}
+
+func (m *managerImpl) GetCustomRole(ctx context.Context, accountID, roleID string) (*types.CustomRole, error) {
+	return m.store.GetCustomRoleByID(ctx, store.LockingStrengthShare, accountID, roleID)
+}
+
+func NewManagerMock() Manager {
+	return &managerMock{}
+}
+
+func (m *managerMock) GetCustomRole(ctx context.Context, accountID, roleID string) (*types.CustomRole, error) {
+	switch roleID {
+	case "routesRole":
+		return &types.CustomRole{
+			ID:        roleID,
+			AccountID: accountID,
+			Name:      "Routes",
+			Permissions: []types.RolePermission{
+				{Module: "routes", Read: true, Write: true, Groups: []string{"networkTeam"}},
+				{Module: "groups", Read: true},
+			},
+		}, nil
+	default:
+		return nil, status.NewCustomRoleNotFoundError(roleID)
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/netip"
+	"slices"
 	"unicode/utf8"
 
 	"github.com/rs/xid"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
@@ -14,21 +16,17 @@ import (
 	"github.com/netbirdio/netbird/management/domain"
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/route"
 )
 
 // GetRoute gets a route object from account and route IDs
 func (am *DefaultAccountManager) GetRoute(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Routes, permissions.Read); err != nil {
 		return nil, err
 	}
 
-	if !user.IsAdminOrServiceUser() || user.AccountID != accountID {
-		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can view Network Routes")
-	}
-
 	return am.Store.GetRouteByID(ctx, store.LockingStrengthShare, string(routeID), accountID)
 }
 
@@ -115,10 +113,14 @@ func getRouteDescriptor(prefix netip.Prefix, domains domain.List) string {
 }
 
 // CreateRoute creates and saves a new route
-func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool) (*route.Route, error) {
+func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, healthCheck *route.HealthCheck) (*route.Route, error) {
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Routes, permissions.Write, slices.Concat(groups, peerGroupIDs)...); err != nil {
+		return nil, err
+	}
+
 	account, err := am.Store.GetAccount(ctx, accountID)
 	if err != nil {
 		return nil, err
@@ -150,6 +152,10 @@ func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID stri
 			peerID, peerGroupIDs)
 	}
 
+	if err = validateRouteHealthCheck(healthCheck, prefix, domains); err != nil {
+		return nil, err
+	}
+
 	var newRoute route.Route
 	newRoute.ID = route.ID(xid.New().String())
 
@@ -198,6 +204,7 @@ func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID stri
 	newRoute.Groups = groups
 	newRoute.KeepRoute = keepRoute
 	newRoute.AccessControlGroups = accessControlGroupIDs
+	newRoute.HealthCheck = healthCheck
 
 	if account.Routes == nil {
 		account.Routes = make(map[route.ID]*route.Route)
@@ -241,6 +248,16 @@ func (am *DefaultAccountManager) SaveRoute(ctx context.Context, accountID, userI
 		return err
 	}
 
+	// a route can't be moved out of the groups the user is allowed to manage
+	scopeGroupIDs := routeScopeGroups(routeToSave)
+	if oldRoute, ok := account.Routes[routeToSave.ID]; ok {
+		scopeGroupIDs = append(scopeGroupIDs, routeScopeGroups(oldRoute)...)
+	}
+
+	if err = am.validateUserPermissions(ctx, accountID, userID, permissions.Routes, permissions.Write, scopeGroupIDs...); err != nil {
+		return err
+	}
+
 	// Do not allow non-Linux peers
 	if peer := account.GetPeer(routeToSave.Peer); peer != nil {
 		if peer.Meta.GoOS != "linux" {
@@ -264,6 +281,10 @@ func (am *DefaultAccountManager) SaveRoute(ctx context.Context, accountID, userI
 		return status.Errorf(status.InvalidArgument, "peer with ID and peer groups should not be provided at the same time")
 	}
 
+	if err = validateRouteHealthCheck(routeToSave.HealthCheck, routeToSave.Network, routeToSave.Domains); err != nil {
+		return err
+	}
+
 	if len(routeToSave.PeerGroups) > 0 {
 		err = validateGroups(routeToSave.PeerGroups, account.Groups)
 		if err != nil {
@@ -319,6 +340,10 @@ func (am *DefaultAccountManager) DeleteRoute(ctx context.Context, accountID stri
 	if routy == nil {
 		return status.Errorf(status.NotFound, "route with ID %s doesn't exist", routeID)
 	}
+
+	if err = am.validateUserPermissions(ctx, accountID, userID, permissions.Routes, permissions.Write, routeScopeGroups(routy)...); err != nil {
+		return err
+	}
 	delete(account.Routes, routeID)
 
 	account.Network.IncSerial()
@@ -337,16 +362,29 @@ func (am *DefaultAccountManager) DeleteRoute(ctx context.Context, accountID stri
 
 // ListRoutes returns a list of routes from account
 func (am *DefaultAccountManager) ListRoutes(ctx context.Context, accountID, userID string) ([]*route.Route, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
+	if err := am.validateUserPermissions(ctx, accountID, userID, permissions.Routes, permissions.Read); err != nil {
 		return nil, err
 	}
 
-	if !user.IsAdminOrServiceUser() || user.AccountID != accountID {
-		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can view Network Routes")
+	return am.Store.GetAccountRoutes(ctx, store.LockingStrengthShare, accountID)
+}
+
+// validateRouteHealthCheck checks that the health check, if any, probes a target within the routed network
+func validateRouteHealthCheck(healthCheck *route.HealthCheck, prefix netip.Prefix, domains domain.List) error {
+	if healthCheck == nil {
+		return nil
 	}
 
-	return am.Store.GetAccountRoutes(ctx, store.LockingStrengthShare, accountID)
+	if len(domains) > 0 {
+		return status.Errorf(status.InvalidArgument, "health checks are not supported for domain routes")
+	}
+
+	return healthCheck.Validate(prefix)
+}
+
+// routeScopeGroups returns the distribution and peer groups of the route which group scoped permissions are checked against
+func routeScopeGroups(r *route.Route) []string {
+	return slices.Concat(r.Groups, r.PeerGroups)
 }
 
 func toProtocolRoute(route *route.Route) *proto.Route {
@@ -360,6 +398,20 @@ func toProtocolRoute(route *route.Route) *proto.Route {
 		Metric:      int64(route.Metric),
 		Masquerade:  route.Masquerade,
 		KeepRoute:   route.KeepRoute,
+		HealthCheck: toProtocolRouteHealthCheck(route.HealthCheck),
+	}
+}
+
+func toProtocolRouteHealthCheck(healthCheck *route.HealthCheck) *proto.RouteHealthCheck {
+	if healthCheck == nil {
+		return nil
+	}
+	return &proto.RouteHealthCheck{
+		Type:             string(healthCheck.Type),
+		Target:           healthCheck.Target,
+		Interval:         durationpb.New(healthCheck.GetInterval()),
+		Timeout:          durationpb.New(healthCheck.GetTimeout()),
+		FailureThreshold: int32(healthCheck.GetFailureThreshold()),
 	}
 }
 
@@ -160,6 +160,16 @@ func NewNameServerGroupNotFoundError(nsGroupID string) error {
 	return Errorf(NotFound, "nameserver group: %s not found", nsGroupID)
 }
 
+// NewDNSZoneNotFoundError creates a new Error with NotFound type for a missing DNS zone
+func NewDNSZoneNotFoundError(zoneID string) error {
+	return Errorf(NotFound, "dns zone: %s not found", zoneID)
+}
+
+// NewRouteNotFoundError creates a new Error with NotFound type for a missing route
+func NewRouteNotFoundError(routeID string) error {
+	return Errorf(NotFound, "route: %s not found", routeID)
+}
+
 // NewNetworkNotFoundError creates a new Error with NotFound type for a missing network.
 func NewNetworkNotFoundError(networkID string) error {
 	return Errorf(NotFound, "network: %s not found", networkID)
@@ -175,6 +185,16 @@ func NewNetworkResourceNotFoundError(resourceID string) error {
 	return Errorf(NotFound, "network resource: %s not found", resourceID)
 }
 
+// NewCustomRoleNotFoundError creates a new Error with NotFound type for a missing custom role.
+func NewCustomRoleNotFoundError(roleID string) error {
+	return Errorf(NotFound, "custom role: %s not found", roleID)
+}
+
+// NewEventSinkNotFoundError creates a new Error with NotFound type for a missing event sink.
+func NewEventSinkNotFoundError(sinkID string) error {
+	return Errorf(NotFound, "event sink: %s not found", sinkID)
+}
+
 // NewPermissionDeniedError creates a new Error with PermissionDenied type for a permission denied error.
 func NewPermissionDeniedError() error {
 	return Errorf(PermissionDenied, "permission denied")
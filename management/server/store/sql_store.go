@@ -96,7 +96,8 @@ func NewSqlStore(ctx context.Context, db *gorm.DB, storeEngine Engine, metrics t
 		&types.SetupKey{}, &nbpeer.Peer{}, &types.User{}, &types.PersonalAccessToken{}, &types.Group{},
 		&types.Account{}, &types.Policy{}, &types.PolicyRule{}, &route.Route{}, &nbdns.NameServerGroup{},
 		&installation{}, &account.ExtraSettings{}, &posture.Checks{}, &nbpeer.NetworkAddress{},
-		&networkTypes.Network{}, &routerTypes.NetworkRouter{}, &resourceTypes.NetworkResource{},
+		&networkTypes.Network{}, &routerTypes.NetworkRouter{}, &resourceTypes.NetworkResource{}, &types.CustomRole{},
+		&types.DNSZone{}, &types.EventSink{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("auto migrate: %w", err)
@@ -281,6 +282,16 @@ func (s *SqlStore) DeleteAccount(ctx context.Context, account *types.Account) er
 			return result.Error
 		}
 
+		result = tx.Delete(&types.CustomRole{}, "account_id = ?", account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&types.EventSink{}, "account_id = ?", account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
 		result = tx.Select(clause.Associations).Delete(account)
 		if result.Error != nil {
 			return result.Error
@@ -376,7 +387,7 @@ func (s *SqlStore) SavePeerStatus(ctx context.Context, lockStrength LockingStren
 
 	fieldsToUpdate := []string{
 		"peer_status_last_seen", "peer_status_connected",
-		"peer_status_login_expired", "peer_status_required_approval",
+		"peer_status_login_expired", "peer_status_requires_approval",
 	}
 	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&nbpeer.Peer{}).
 		Select(fieldsToUpdate).
@@ -856,6 +867,23 @@ func (s *SqlStore) GetTakenIPs(ctx context.Context, lockStrength LockingStrength
 	return ips, nil
 }
 
+// GetAccountIDsWithExpiringPendingPeers returns the IDs of the accounts with a peer approval timeout and at least one
+// peer pending approval.
+func (s *SqlStore) GetAccountIDsWithExpiringPendingPeers(ctx context.Context, lockStrength LockingStrength) ([]string, error) {
+	var accountIDs []string
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&types.Account{}).
+		Joins("JOIN peers ON peers.account_id = accounts.id").
+		Where("accounts.settings_peer_approval_timeout > 0 AND peers.peer_status_requires_approval = ?", true).
+		Distinct().
+		Pluck("accounts.id", &accountIDs)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get accounts with pending peers from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get accounts with pending peers from store")
+	}
+
+	return accountIDs, nil
+}
+
 func (s *SqlStore) GetPeerLabelsInAccount(ctx context.Context, lockStrength LockingStrength, accountID string) ([]string, error) {
 	var labels []string
 	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&nbpeer.Peer{}).
@@ -1594,6 +1622,22 @@ func (s *SqlStore) GetAccountPolicies(ctx context.Context, lockStrength LockingS
 	return policies, nil
 }
 
+// GetAccountIDsWithScheduledPolicyRules returns the IDs of the accounts with at least one scheduled policy rule.
+func (s *SqlStore) GetAccountIDsWithScheduledPolicyRules(ctx context.Context, lockStrength LockingStrength) ([]string, error) {
+	var accountIDs []string
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&types.Policy{}).
+		Joins("JOIN policy_rules ON policy_rules.policy_id = policies.id").
+		Where("policy_rules.schedule IS NOT NULL").
+		Distinct().
+		Pluck("policies.account_id", &accountIDs)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get accounts with scheduled policy rules from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get accounts with scheduled policy rules from store")
+	}
+
+	return accountIDs, nil
+}
+
 // GetPolicyByID retrieves a policy by its ID and account ID.
 func (s *SqlStore) GetPolicyByID(ctx context.Context, lockStrength LockingStrength, accountID, policyID string) (*types.Policy, error) {
 	var policy *types.Policy
@@ -1628,6 +1672,22 @@ func (s *SqlStore) SavePolicy(ctx context.Context, lockStrength LockingStrength,
 		log.WithContext(ctx).Errorf("failed to save policy to the store: %s", err)
 		return status.Errorf(status.Internal, "failed to save policy to store")
 	}
+
+	// saving the associations doesn't remove the rules that are no longer part of the policy
+	ruleIDs := make([]string, 0, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+
+	query := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Where("policy_id = ?", policy.ID)
+	if len(ruleIDs) > 0 {
+		query = query.Where("id NOT IN ?", ruleIDs)
+	}
+	if err := query.Delete(&types.PolicyRule{}).Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to delete stale policy rules from the store: %s", err)
+		return status.Errorf(status.Internal, "failed to save policy to store")
+	}
+
 	return nil
 }
 
@@ -1728,6 +1788,32 @@ func (s *SqlStore) GetRouteByID(ctx context.Context, lockStrength LockingStrengt
 	return getRecordByID[route.Route](s.db, lockStrength, routeID, accountID)
 }
 
+// SaveRoute saves a route to the database.
+func (s *SqlStore) SaveRoute(ctx context.Context, lockStrength LockingStrength, r *route.Route) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(r)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to save route to the store: %s", err)
+		return status.Errorf(status.Internal, "failed to save route to store")
+	}
+
+	return nil
+}
+
+// DeleteRoute deletes a route from the database.
+func (s *SqlStore) DeleteRoute(ctx context.Context, lockStrength LockingStrength, accountID, routeID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Delete(&route.Route{}, accountAndIDQueryCondition, accountID, routeID)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to delete route from the store: %s", err)
+		return status.Errorf(status.Internal, "failed to delete route from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewRouteNotFoundError(routeID)
+	}
+
+	return nil
+}
+
 // GetAccountSetupKeys retrieves setup keys for an account.
 func (s *SqlStore) GetAccountSetupKeys(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.SetupKey, error) {
 	var setupKeys []*types.SetupKey
@@ -1836,6 +1922,59 @@ func (s *SqlStore) DeleteNameServerGroup(ctx context.Context, lockStrength Locki
 	return nil
 }
 
+// GetAccountDNSZones retrieves the custom DNS zones of an account.
+func (s *SqlStore) GetAccountDNSZones(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.DNSZone, error) {
+	var zones []*types.DNSZone
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&zones, accountIDCondition, accountID)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get dns zones from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get dns zones from store")
+	}
+
+	return zones, nil
+}
+
+// GetDNSZoneByID retrieves a custom DNS zone by its ID and account ID.
+func (s *SqlStore) GetDNSZoneByID(ctx context.Context, lockStrength LockingStrength, accountID, zoneID string) (*types.DNSZone, error) {
+	var zone *types.DNSZone
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&zone, accountAndIDQueryCondition, accountID, zoneID)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.NewDNSZoneNotFoundError(zoneID)
+		}
+		log.WithContext(ctx).Errorf("failed to get dns zone from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get dns zone from store")
+	}
+
+	return zone, nil
+}
+
+// SaveDNSZone saves a custom DNS zone to the database.
+func (s *SqlStore) SaveDNSZone(ctx context.Context, lockStrength LockingStrength, zone *types.DNSZone) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(zone)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to save dns zone to the store: %s", err)
+		return status.Errorf(status.Internal, "failed to save dns zone to store")
+	}
+	return nil
+}
+
+// DeleteDNSZone deletes a custom DNS zone from the database.
+func (s *SqlStore) DeleteDNSZone(ctx context.Context, lockStrength LockingStrength, accountID, zoneID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Delete(&types.DNSZone{}, accountAndIDQueryCondition, accountID, zoneID)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to delete dns zone from the store: %s", err)
+		return status.Errorf(status.Internal, "failed to delete dns zone from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewDNSZoneNotFoundError(zoneID)
+	}
+
+	return nil
+}
+
 // getRecords retrieves records from the database based on the account ID.
 func getRecords[T any](db *gorm.DB, lockStrength LockingStrength, accountID string) ([]T, error) {
 	var record []T
@@ -2170,3 +2309,127 @@ func (s *SqlStore) DeletePAT(ctx context.Context, lockStrength LockingStrength,
 
 	return nil
 }
+
+// GetAccountCustomRoles retrieves the custom roles of an account.
+func (s *SqlStore) GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.CustomRole, error) {
+	var roles []*types.CustomRole
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&roles, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get custom roles from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get custom roles from store")
+	}
+
+	return roles, nil
+}
+
+// GetCustomRoleByID retrieves a custom role by its ID and account ID.
+func (s *SqlStore) GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*types.CustomRole, error) {
+	var role *types.CustomRole
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&role, accountAndIDQueryCondition, accountID, roleID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewCustomRoleNotFoundError(roleID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get custom role from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get custom role from store")
+	}
+
+	return role, nil
+}
+
+// SaveCustomRole saves a custom role to the database.
+func (s *SqlStore) SaveCustomRole(ctx context.Context, lockStrength LockingStrength, role *types.CustomRole) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(role)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save custom role to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save custom role to store")
+	}
+
+	return nil
+}
+
+// DeleteCustomRole deletes a custom role from the database.
+func (s *SqlStore) DeleteCustomRole(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&types.CustomRole{}, accountAndIDQueryCondition, accountID, roleID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete custom role from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete custom role from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewCustomRoleNotFoundError(roleID)
+	}
+
+	return nil
+}
+
+// GetAccountEventSinks retrieves the event sinks of an account.
+func (s *SqlStore) GetAccountEventSinks(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.EventSink, error) {
+	var sinks []*types.EventSink
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&sinks, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get event sinks from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get event sinks from store")
+	}
+
+	return sinks, nil
+}
+
+// GetAllEventSinks retrieves the event sinks of all accounts.
+func (s *SqlStore) GetAllEventSinks(ctx context.Context, lockStrength LockingStrength) ([]*types.EventSink, error) {
+	var sinks []*types.EventSink
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&sinks)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get all event sinks from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get all event sinks from store")
+	}
+
+	return sinks, nil
+}
+
+// GetEventSinkByID retrieves an event sink by its ID and account ID.
+func (s *SqlStore) GetEventSinkByID(ctx context.Context, lockStrength LockingStrength, accountID, sinkID string) (*types.EventSink, error) {
+	var eventSink *types.EventSink
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&eventSink, accountAndIDQueryCondition, accountID, sinkID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewEventSinkNotFoundError(sinkID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get event sink from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get event sink from store")
+	}
+
+	return eventSink, nil
+}
+
+// SaveEventSink saves an event sink to the database.
+func (s *SqlStore) SaveEventSink(ctx context.Context, lockStrength LockingStrength, eventSink *types.EventSink) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(eventSink)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save event sink to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save event sink to store")
+	}
+
+	return nil
+}
+
+// DeleteEventSink deletes an event sink from the database.
+func (s *SqlStore) DeleteEventSink(ctx context.Context, lockStrength LockingStrength, accountID, sinkID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&types.EventSink{}, accountAndIDQueryCondition, accountID, sinkID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete event sink from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete event sink from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewEventSinkNotFoundError(sinkID)
+	}
+
+	return nil
+}
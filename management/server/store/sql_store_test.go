@@ -1738,6 +1738,65 @@ func TestSqlStore_GetPolicyByID(t *testing.T) {
 	}
 }
 
+func TestSqlStore_GetAccountIDsWithScheduledPolicyRules(t *testing.T) {
+	store, cleanup, err := NewTestStoreFromSQL(context.Background(), "../testdata/store.sql", t.TempDir())
+	t.Cleanup(cleanup)
+	require.NoError(t, err)
+
+	accountIDs, err := store.GetAccountIDsWithScheduledPolicyRules(context.Background(), LockingStrengthShare)
+	require.NoError(t, err)
+	require.Empty(t, accountIDs)
+
+	accountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+	policyID := "cs1tnh0hhcjnqoiuebf0"
+
+	policy, err := store.GetPolicyByID(context.Background(), LockingStrengthShare, accountID, policyID)
+	require.NoError(t, err)
+	policy.Rules[0].Schedule = &types.PolicyRuleSchedule{TimeZone: "UTC"}
+	policy.Rules = append(policy.Rules, &types.PolicyRule{
+		ID:       "scheduled-rule",
+		PolicyID: policyID,
+		Enabled:  true,
+		Action:   types.PolicyTrafficActionAccept,
+		Schedule: &types.PolicyRuleSchedule{TimeZone: "UTC"},
+	})
+	require.NoError(t, store.SavePolicy(context.Background(), LockingStrengthUpdate, policy))
+
+	accountIDs, err = store.GetAccountIDsWithScheduledPolicyRules(context.Background(), LockingStrengthShare)
+	require.NoError(t, err)
+	require.Equal(t, []string{accountID}, accountIDs)
+}
+
+func TestSqlStore_GetAccountIDsWithExpiringPendingPeers(t *testing.T) {
+	store, cleanup, err := NewTestStoreFromSQL(context.Background(), "../testdata/store.sql", t.TempDir())
+	t.Cleanup(cleanup)
+	require.NoError(t, err)
+
+	accountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+	peerID := "ct286bi7qv930dsrrug0"
+
+	require.NoError(t, store.SavePeerStatus(context.Background(), LockingStrengthUpdate, accountID, peerID, nbpeer.PeerStatus{RequiresApproval: true}))
+
+	accountIDs, err := store.GetAccountIDsWithExpiringPendingPeers(context.Background(), LockingStrengthShare)
+	require.NoError(t, err)
+	require.Empty(t, accountIDs, "pending peers without an approval timeout don't expire")
+
+	account, err := store.GetAccount(context.Background(), accountID)
+	require.NoError(t, err)
+	account.Settings.PeerApprovalTimeout = time.Hour
+	require.NoError(t, store.SaveAccount(context.Background(), account))
+
+	accountIDs, err = store.GetAccountIDsWithExpiringPendingPeers(context.Background(), LockingStrengthShare)
+	require.NoError(t, err)
+	require.Equal(t, []string{accountID}, accountIDs)
+
+	require.NoError(t, store.SavePeerStatus(context.Background(), LockingStrengthUpdate, accountID, peerID, nbpeer.PeerStatus{}))
+
+	accountIDs, err = store.GetAccountIDsWithExpiringPendingPeers(context.Background(), LockingStrengthShare)
+	require.NoError(t, err)
+	require.Empty(t, accountIDs)
+}
+
 func TestSqlStore_CreatePolicy(t *testing.T) {
 	store, cleanup, err := NewTestStoreFromSQL(context.Background(), "../testdata/store.sql", t.TempDir())
 	t.Cleanup(cleanup)
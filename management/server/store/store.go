@@ -100,6 +100,8 @@ type Store interface {
 	DeleteGroups(ctx context.Context, strength LockingStrength, accountID string, groupIDs []string) error
 
 	GetAccountPolicies(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.Policy, error)
+	GetAccountIDsWithScheduledPolicyRules(ctx context.Context, lockStrength LockingStrength) ([]string, error)
+	GetAccountIDsWithExpiringPendingPeers(ctx context.Context, lockStrength LockingStrength) ([]string, error)
 	GetPolicyByID(ctx context.Context, lockStrength LockingStrength, accountID, policyID string) (*types.Policy, error)
 	CreatePolicy(ctx context.Context, lockStrength LockingStrength, policy *types.Policy) error
 	SavePolicy(ctx context.Context, lockStrength LockingStrength, policy *types.Policy) error
@@ -141,12 +143,19 @@ type Store interface {
 
 	GetAccountRoutes(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*route.Route, error)
 	GetRouteByID(ctx context.Context, lockStrength LockingStrength, routeID string, accountID string) (*route.Route, error)
+	SaveRoute(ctx context.Context, lockStrength LockingStrength, r *route.Route) error
+	DeleteRoute(ctx context.Context, lockStrength LockingStrength, accountID, routeID string) error
 
 	GetAccountNameServerGroups(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*dns.NameServerGroup, error)
 	GetNameServerGroupByID(ctx context.Context, lockStrength LockingStrength, nameServerGroupID string, accountID string) (*dns.NameServerGroup, error)
 	SaveNameServerGroup(ctx context.Context, lockStrength LockingStrength, nameServerGroup *dns.NameServerGroup) error
 	DeleteNameServerGroup(ctx context.Context, lockStrength LockingStrength, accountID, nameServerGroupID string) error
 
+	GetAccountDNSZones(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.DNSZone, error)
+	GetDNSZoneByID(ctx context.Context, lockStrength LockingStrength, accountID, zoneID string) (*types.DNSZone, error)
+	SaveDNSZone(ctx context.Context, lockStrength LockingStrength, zone *types.DNSZone) error
+	DeleteDNSZone(ctx context.Context, lockStrength LockingStrength, accountID, zoneID string) error
+
 	GetTakenIPs(ctx context.Context, lockStrength LockingStrength, accountId string) ([]net.IP, error)
 	IncrementNetworkSerial(ctx context.Context, lockStrength LockingStrength, accountId string) error
 	GetAccountNetwork(ctx context.Context, lockStrength LockingStrength, accountId string) (*types.Network, error)
@@ -185,6 +194,17 @@ type Store interface {
 	GetNetworkResourceByName(ctx context.Context, lockStrength LockingStrength, accountID, resourceName string) (*resourceTypes.NetworkResource, error)
 	SaveNetworkResource(ctx context.Context, lockStrength LockingStrength, resource *resourceTypes.NetworkResource) error
 	DeleteNetworkResource(ctx context.Context, lockStrength LockingStrength, accountID, resourceID string) error
+
+	GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.CustomRole, error)
+	GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*types.CustomRole, error)
+	SaveCustomRole(ctx context.Context, lockStrength LockingStrength, role *types.CustomRole) error
+	DeleteCustomRole(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) error
+
+	GetAccountEventSinks(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.EventSink, error)
+	GetAllEventSinks(ctx context.Context, lockStrength LockingStrength) ([]*types.EventSink, error)
+	GetEventSinkByID(ctx context.Context, lockStrength LockingStrength, accountID, sinkID string) (*types.EventSink, error)
+	SaveEventSink(ctx context.Context, lockStrength LockingStrength, eventSink *types.EventSink) error
+	DeleteEventSink(ctx context.Context, lockStrength LockingStrength, accountID, sinkID string) error
 }
 
 type Engine string
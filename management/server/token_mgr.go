@@ -12,6 +12,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/proto"
+	"github.com/netbirdio/netbird/management/server/settings"
 	auth "github.com/netbirdio/netbird/relay/auth/hmac"
 	authv2 "github.com/netbirdio/netbird/relay/auth/hmac/v2"
 )
@@ -21,8 +22,8 @@ const defaultDuration = 12 * time.Hour
 // SecretsManager used to manage TURN and relay secrets
 type SecretsManager interface {
 	GenerateTurnToken() (*Token, error)
-	GenerateRelayToken() (*Token, error)
-	SetupRefresh(ctx context.Context, peerKey string)
+	GenerateRelayToken(ctx context.Context, accountID string) (*Token, error)
+	SetupRefresh(ctx context.Context, accountID, peerKey string)
 	CancelRefresh(peerKey string)
 }
 
@@ -34,15 +35,17 @@ type TimeBasedAuthSecretsManager struct {
 	turnHmacToken  *auth.TimedHMAC
 	relayHmacToken *authv2.Generator
 	updateManager  *PeersUpdateManager
+	settings       settings.Manager
 	turnCancelMap  map[string]chan struct{}
 	relayCancelMap map[string]chan struct{}
 }
 
 type Token auth.Token
 
-func NewTimeBasedAuthSecretsManager(updateManager *PeersUpdateManager, turnCfg *TURNConfig, relayCfg *Relay) *TimeBasedAuthSecretsManager {
+func NewTimeBasedAuthSecretsManager(updateManager *PeersUpdateManager, turnCfg *TURNConfig, relayCfg *Relay, settingsManager settings.Manager) *TimeBasedAuthSecretsManager {
 	mgr := &TimeBasedAuthSecretsManager{
 		updateManager:  updateManager,
+		settings:       settingsManager,
 		turnCfg:        turnCfg,
 		relayCfg:       relayCfg,
 		turnCancelMap:  make(map[string]chan struct{}),
@@ -87,8 +90,9 @@ func (m *TimeBasedAuthSecretsManager) GenerateTurnToken() (*Token, error) {
 	return (*Token)(turnToken), nil
 }
 
-// GenerateRelayToken generates new time-based secret credentials for relay
-func (m *TimeBasedAuthSecretsManager) GenerateRelayToken() (*Token, error) {
+// GenerateRelayToken generates new time-based secret credentials for relay. When the account has relay limits, the
+// credentials carry a second token with the limits for the relay servers supporting them
+func (m *TimeBasedAuthSecretsManager) GenerateRelayToken(ctx context.Context, accountID string) (*Token, error) {
 	if m.relayHmacToken == nil {
 		return nil, fmt.Errorf("relay configuration is not set")
 	}
@@ -97,10 +101,43 @@ func (m *TimeBasedAuthSecretsManager) GenerateRelayToken() (*Token, error) {
 		return nil, fmt.Errorf("generate relay token: %s", err)
 	}
 
-	return &Token{
+	token := &Token{
 		Payload:   string(relayToken.Payload),
 		Signature: base64.StdEncoding.EncodeToString(relayToken.Signature),
-	}, nil
+	}
+
+	limits := m.relayLimits(ctx, accountID)
+	if limits.IsZero() {
+		return token, nil
+	}
+
+	limitsToken, err := m.relayHmacToken.GenerateTokenWithLimits(limits)
+	if err != nil {
+		return nil, fmt.Errorf("generate relay limits token: %s", err)
+	}
+	token.LimitsPayload = string(limitsToken.Payload)
+	token.LimitsSignature = base64.StdEncoding.EncodeToString(limitsToken.Signature)
+
+	return token, nil
+}
+
+// relayLimits returns the relay limits overridden by the account settings. The limits of the relay servers apply
+// when the settings can't be read
+func (m *TimeBasedAuthSecretsManager) relayLimits(ctx context.Context, accountID string) authv2.Limits {
+	if m.settings == nil || accountID == "" {
+		return authv2.Limits{}
+	}
+
+	accountSettings, err := m.settings.GetSettings(ctx, accountID, "")
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get the relay limits of account %s: %v", accountID, err)
+		return authv2.Limits{}
+	}
+
+	return authv2.Limits{
+		BytesPerSecond:    accountSettings.RelayBytesPerSecond,
+		MessagesPerSecond: accountSettings.RelayMessagesPerSecond,
+	}
 }
 
 func (m *TimeBasedAuthSecretsManager) cancelTURN(peerID string) {
@@ -126,7 +163,7 @@ func (m *TimeBasedAuthSecretsManager) CancelRefresh(peerID string) {
 }
 
 // SetupRefresh starts peer credentials refresh
-func (m *TimeBasedAuthSecretsManager) SetupRefresh(ctx context.Context, peerID string) {
+func (m *TimeBasedAuthSecretsManager) SetupRefresh(ctx context.Context, accountID, peerID string) {
 	m.mux.Lock()
 	defer m.mux.Unlock()
 
@@ -136,19 +173,19 @@ func (m *TimeBasedAuthSecretsManager) SetupRefresh(ctx context.Context, peerID s
 	if m.turnCfg != nil && m.turnCfg.TimeBasedCredentials {
 		turnCancel := make(chan struct{}, 1)
 		m.turnCancelMap[peerID] = turnCancel
-		go m.refreshTURNTokens(ctx, peerID, turnCancel)
+		go m.refreshTURNTokens(ctx, accountID, peerID, turnCancel)
 		log.WithContext(ctx).Debugf("starting TURN refresh for %s", peerID)
 	}
 
 	if m.relayCfg != nil {
 		relayCancel := make(chan struct{}, 1)
 		m.relayCancelMap[peerID] = relayCancel
-		go m.refreshRelayTokens(ctx, peerID, relayCancel)
+		go m.refreshRelayTokens(ctx, accountID, peerID, relayCancel)
 		log.WithContext(ctx).Debugf("starting relay refresh for %s", peerID)
 	}
 }
 
-func (m *TimeBasedAuthSecretsManager) refreshTURNTokens(ctx context.Context, peerID string, cancel chan struct{}) {
+func (m *TimeBasedAuthSecretsManager) refreshTURNTokens(ctx context.Context, accountID, peerID string, cancel chan struct{}) {
 	ticker := time.NewTicker(m.turnCfg.CredentialsTTL.Duration / 4 * 3)
 	defer ticker.Stop()
 
@@ -158,12 +195,12 @@ func (m *TimeBasedAuthSecretsManager) refreshTURNTokens(ctx context.Context, pee
 			log.WithContext(ctx).Debugf("stopping TURN refresh for %s", peerID)
 			return
 		case <-ticker.C:
-			m.pushNewTURNAndRelayTokens(ctx, peerID)
+			m.pushNewTURNAndRelayTokens(ctx, accountID, peerID)
 		}
 	}
 }
 
-func (m *TimeBasedAuthSecretsManager) refreshRelayTokens(ctx context.Context, peerID string, cancel chan struct{}) {
+func (m *TimeBasedAuthSecretsManager) refreshRelayTokens(ctx context.Context, accountID, peerID string, cancel chan struct{}) {
 	ticker := time.NewTicker(m.relayCfg.CredentialsTTL.Duration / 4 * 3)
 	defer ticker.Stop()
 
@@ -173,12 +210,12 @@ func (m *TimeBasedAuthSecretsManager) refreshRelayTokens(ctx context.Context, pe
 			log.WithContext(ctx).Debugf("stopping relay refresh for %s", peerID)
 			return
 		case <-ticker.C:
-			m.pushNewRelayTokens(ctx, peerID)
+			m.pushNewRelayTokens(ctx, accountID, peerID)
 		}
 	}
 }
 
-func (m *TimeBasedAuthSecretsManager) pushNewTURNAndRelayTokens(ctx context.Context, peerID string) {
+func (m *TimeBasedAuthSecretsManager) pushNewTURNAndRelayTokens(ctx context.Context, accountID, peerID string) {
 	turnToken, err := m.turnHmacToken.GenerateToken(sha1.New)
 	if err != nil {
 		log.Errorf("failed to generate token for peer '%s': %s", peerID, err)
@@ -206,12 +243,14 @@ func (m *TimeBasedAuthSecretsManager) pushNewTURNAndRelayTokens(ctx context.Cont
 
 	// workaround for the case when client is unable to handle turn and relay updates at different time
 	if m.relayCfg != nil {
-		token, err := m.GenerateRelayToken()
+		token, err := m.GenerateRelayToken(ctx, accountID)
 		if err == nil {
 			update.NetbirdConfig.Relay = &proto.RelayConfig{
-				Urls:           m.relayCfg.Addresses,
-				TokenPayload:   token.Payload,
-				TokenSignature: token.Signature,
+				Urls:                 m.relayCfg.Addresses,
+				TokenPayload:         token.Payload,
+				TokenSignature:       token.Signature,
+				LimitsTokenPayload:   token.LimitsPayload,
+				LimitsTokenSignature: token.LimitsSignature,
 			}
 		}
 	}
@@ -220,8 +259,8 @@ func (m *TimeBasedAuthSecretsManager) pushNewTURNAndRelayTokens(ctx context.Cont
 	m.updateManager.SendUpdate(ctx, peerID, &UpdateMessage{Update: update})
 }
 
-func (m *TimeBasedAuthSecretsManager) pushNewRelayTokens(ctx context.Context, peerID string) {
-	relayToken, err := m.relayHmacToken.GenerateToken()
+func (m *TimeBasedAuthSecretsManager) pushNewRelayTokens(ctx context.Context, accountID, peerID string) {
+	relayToken, err := m.GenerateRelayToken(ctx, accountID)
 	if err != nil {
 		log.Errorf("failed to generate relay token for peer '%s': %s", peerID, err)
 		return
@@ -230,9 +269,11 @@ func (m *TimeBasedAuthSecretsManager) pushNewRelayTokens(ctx context.Context, pe
 	update := &proto.SyncResponse{
 		NetbirdConfig: &proto.NetbirdConfig{
 			Relay: &proto.RelayConfig{
-				Urls:           m.relayCfg.Addresses,
-				TokenPayload:   string(relayToken.Payload),
-				TokenSignature: base64.StdEncoding.EncodeToString(relayToken.Signature),
+				Urls:                 m.relayCfg.Addresses,
+				TokenPayload:         relayToken.Payload,
+				TokenSignature:       relayToken.Signature,
+				LimitsTokenPayload:   relayToken.LimitsPayload,
+				LimitsTokenSignature: relayToken.LimitsSignature,
 			},
 			// omit Turns to avoid updates there
 		},
@@ -7,12 +7,16 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"hash"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/netbirdio/netbird/management/proto"
+	"github.com/netbirdio/netbird/management/server/settings"
+	"github.com/netbirdio/netbird/management/server/types"
+	authv2 "github.com/netbirdio/netbird/relay/auth/hmac/v2"
 	"github.com/netbirdio/netbird/util"
 )
 
@@ -39,7 +43,7 @@ func TestTimeBasedAuthSecretsManager_GenerateCredentials(t *testing.T) {
 		Secret:               secret,
 		Turns:                []*Host{TurnTestHost},
 		TimeBasedCredentials: true,
-	}, rc)
+	}, rc, settings.NewManagerMock())
 
 	turnCredentials, err := tested.GenerateTurnToken()
 	require.NoError(t, err)
@@ -53,7 +57,7 @@ func TestTimeBasedAuthSecretsManager_GenerateCredentials(t *testing.T) {
 
 	validateMAC(t, sha1.New, turnCredentials.Payload, turnCredentials.Signature, []byte(secret))
 
-	relayCredentials, err := tested.GenerateRelayToken()
+	relayCredentials, err := tested.GenerateRelayToken(context.Background(), "accountID")
 	require.NoError(t, err)
 
 	if relayCredentials.Payload == "" {
@@ -67,6 +71,49 @@ func TestTimeBasedAuthSecretsManager_GenerateCredentials(t *testing.T) {
 	validateMAC(t, sha256.New, relayCredentials.Payload, relayCredentials.Signature, hashedSecret[:])
 }
 
+type relayLimitsSettingsManager struct {
+	settings *types.Settings
+}
+
+func (m *relayLimitsSettingsManager) GetSettings(context.Context, string, string) (*types.Settings, error) {
+	return m.settings, nil
+}
+
+func TestTimeBasedAuthSecretsManager_GenerateRelayTokenWithLimits(t *testing.T) {
+	secret := "some_secret"
+	rc := &Relay{
+		Addresses:      []string{"localhost:0"},
+		CredentialsTTL: util.Duration{Duration: time.Hour},
+		Secret:         secret,
+	}
+	settingsManager := &relayLimitsSettingsManager{settings: &types.Settings{RelayBytesPerSecond: 1024, RelayMessagesPerSecond: 10}}
+	tested := NewTimeBasedAuthSecretsManager(NewPeersUpdateManager(nil), &TURNConfig{}, rc, settingsManager)
+
+	relayCredentials, err := tested.GenerateRelayToken(context.Background(), "accountID")
+	require.NoError(t, err)
+
+	hashedSecret := sha256.Sum256([]byte(secret))
+	validator := authv2.NewValidator(hashedSecret[:])
+	marshal := func(payload, encodedSignature string) []byte {
+		signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+		require.NoError(t, err)
+		token := &authv2.Token{AuthAlgo: authv2.AuthAlgoHMACSHA256, Signature: signature, Payload: []byte(payload)}
+		return token.Marshal()
+	}
+
+	// the plain token must stay a bare timestamp for the relay servers without limits support
+	_, err = strconv.ParseInt(relayCredentials.Payload, 10, 64)
+	require.NoError(t, err)
+	require.NoError(t, validator.Validate(marshal(relayCredentials.Payload, relayCredentials.Signature)))
+
+	limitsToken := marshal(relayCredentials.LimitsPayload, relayCredentials.LimitsSignature)
+	require.NoError(t, validator.Validate(limitsToken))
+
+	limits, err := authv2.LimitsFromToken(limitsToken)
+	require.NoError(t, err)
+	require.Equal(t, authv2.Limits{BytesPerSecond: 1024, MessagesPerSecond: 10}, limits)
+}
+
 func TestTimeBasedAuthSecretsManager_SetupRefresh(t *testing.T) {
 	ttl := util.Duration{Duration: 2 * time.Second}
 	secret := "some_secret"
@@ -84,12 +131,12 @@ func TestTimeBasedAuthSecretsManager_SetupRefresh(t *testing.T) {
 		Secret:               secret,
 		Turns:                []*Host{TurnTestHost},
 		TimeBasedCredentials: true,
-	}, rc)
+	}, rc, settings.NewManagerMock())
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	tested.SetupRefresh(ctx, peer)
+	tested.SetupRefresh(ctx, "accountID", peer)
 
 	if _, ok := tested.turnCancelMap[peer]; !ok {
 		t.Errorf("expecting peer to be present in the turn cancel map, got not present")
@@ -181,9 +228,9 @@ func TestTimeBasedAuthSecretsManager_CancelRefresh(t *testing.T) {
 		Secret:               secret,
 		Turns:                []*Host{TurnTestHost},
 		TimeBasedCredentials: true,
-	}, rc)
+	}, rc, settings.NewManagerMock())
 
-	tested.SetupRefresh(context.Background(), peer)
+	tested.SetupRefresh(context.Background(), "accountID", peer)
 	if _, ok := tested.turnCancelMap[peer]; !ok {
 		t.Errorf("expecting peer to be present in turn cancel map, got not present")
 	}
@@ -65,6 +65,7 @@ type Account struct {
 	RoutesG                []route.Route                     `json:"-" gorm:"foreignKey:AccountID;references:id"`
 	NameServerGroups       map[string]*nbdns.NameServerGroup `gorm:"-"`
 	NameServerGroupsG      []nbdns.NameServerGroup           `json:"-" gorm:"foreignKey:AccountID;references:id"`
+	DNSZones               []*DNSZone                        `gorm:"foreignKey:AccountID;references:id"`
 	DNSSettings            DNSSettings                       `gorm:"embedded;embeddedPrefix:dns_settings_"`
 	PostureChecks          []*posture.Checks                 `gorm:"foreignKey:AccountID;references:id"`
 	// Settings is a dictionary of Account settings
@@ -221,7 +222,7 @@ func (a *Account) GetGroup(groupID string) *Group {
 func (a *Account) GetPeerNetworkMap(
 	ctx context.Context,
 	peerID string,
-	peersCustomZone nbdns.CustomZone,
+	peersCustomZones []nbdns.CustomZone,
 	validatedPeersMap map[string]struct{},
 	resourcePolicies map[string][]*Policy,
 	routers map[string]map[string]*routerTypes.NetworkRouter,
@@ -272,21 +273,25 @@ func (a *Account) GetPeerNetworkMap(
 	if dnsManagementStatus {
 		var zones []nbdns.CustomZone
 
-		if peersCustomZone.Domain != "" {
-			zones = append(zones, peersCustomZone)
+		for _, zone := range peersCustomZones {
+			if zone.Domain != "" {
+				zones = append(zones, zone)
+			}
 		}
-		dnsUpdate.CustomZones = zones
+		dnsUpdate.CustomZones = append(zones, getPeerDNSZones(a, peerID)...)
 		dnsUpdate.NameServerGroups = getPeerNSGroups(a, peerID)
 	}
 
 	nm := &NetworkMap{
-		Peers:               peersToConnectIncludingRouters,
-		Network:             a.Network.Copy(),
-		Routes:              slices.Concat(networkResourcesRoutes, routesUpdate),
-		DNSConfig:           dnsUpdate,
-		OfflinePeers:        expiredPeers,
-		FirewallRules:       firewallRules,
-		RoutesFirewallRules: slices.Concat(networkResourcesFirewallRules, routesFirewallRules),
+		Peers:                peersToConnectIncludingRouters,
+		Network:              a.Network.Copy(),
+		Routes:               slices.Concat(networkResourcesRoutes, routesUpdate),
+		DNSConfig:            dnsUpdate,
+		OfflinePeers:         expiredPeers,
+		FirewallRules:        firewallRules,
+		RoutesFirewallRules:  slices.Concat(networkResourcesFirewallRules, routesFirewallRules),
+		SSHAuthorizedUsers:   a.GetPeerSSHAuthorizedUsers(ctx, peerID, validatedPeersMap),
+		ConnectionStrategies: a.GetPeerConnectionStrategies(peerID, slices.Concat(peersToConnectIncludingRouters, expiredPeers)),
 	}
 
 	if metrics != nil {
@@ -372,6 +377,26 @@ func getPeerNSGroups(account *Account, peerID string) []*nbdns.NameServerGroup {
 	return peerNSGroups
 }
 
+// getPeerDNSZones returns the enabled custom DNS zones distributed to the peer groups
+func getPeerDNSZones(account *Account, peerID string) []nbdns.CustomZone {
+	groupList := account.GetPeerGroups(peerID)
+
+	var zones []nbdns.CustomZone
+	for _, zone := range account.DNSZones {
+		if !zone.Enabled || len(zone.Records) == 0 {
+			continue
+		}
+		for _, gID := range zone.Groups {
+			if _, found := groupList[gID]; found {
+				zones = append(zones, zone.ToCustomZone())
+				break
+			}
+		}
+	}
+
+	return zones
+}
+
 // peerIsNameserver returns true if the peer is a nameserver for a nsGroup
 func peerIsNameserver(peer *nbpeer.Peer, nsGroup *nbdns.NameServerGroup) bool {
 	for _, ns := range nsGroup.NameServers {
@@ -427,6 +452,51 @@ func getUniqueHostLabel(name string, peerLabels LookupMap) string {
 	return ""
 }
 
+// GetPeersCustomZones returns the zone with the peer records and the reverse zone of the peer network
+func (a *Account) GetPeersCustomZones(ctx context.Context, dnsDomain string) []nbdns.CustomZone {
+	return []nbdns.CustomZone{a.GetPeersCustomZone(ctx, dnsDomain), a.GetPeersReverseZone(ctx, dnsDomain)}
+}
+
+// GetPeersReverseZone returns the in-addr.arpa zone of the peer network with a PTR record for every peer
+func (a *Account) GetPeersReverseZone(ctx context.Context, dnsDomain string) nbdns.CustomZone {
+	if dnsDomain == "" || a.Network == nil {
+		return nbdns.CustomZone{}
+	}
+
+	zoneName, err := nbdns.GetReverseZoneName(&a.Network.Net)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to generate reverse zone for account %s: %v", a.Id, err)
+		return nbdns.CustomZone{}
+	}
+
+	reverseZone := nbdns.CustomZone{
+		Domain:               zoneName,
+		Records:              make([]nbdns.SimpleRecord, 0, len(a.Peers)),
+		SearchDomainDisabled: true,
+	}
+
+	for _, peer := range a.Peers {
+		if peer.DNSLabel == "" || !a.Network.Net.Contains(peer.IP) {
+			continue
+		}
+
+		name, err := dns.ReverseAddr(peer.IP.String())
+		if err != nil {
+			continue
+		}
+
+		reverseZone.Records = append(reverseZone.Records, nbdns.SimpleRecord{
+			Name:  name,
+			Type:  int(dns.TypePTR),
+			Class: nbdns.DefaultClass,
+			TTL:   defaultTTL,
+			RData: dns.Fqdn(peer.DNSLabel + "." + dnsDomain),
+		})
+	}
+
+	return reverseZone
+}
+
 func (a *Account) GetPeersCustomZone(ctx context.Context, dnsDomain string) nbdns.CustomZone {
 	var merr *multierror.Error
 
@@ -449,22 +519,10 @@ func (a *Account) GetPeersCustomZone(ctx context.Context, dnsDomain string) nbdn
 			continue
 		}
 
-		sb.Grow(len(peer.DNSLabel) + len(domainSuffix))
-		sb.WriteString(peer.DNSLabel)
-		sb.WriteString(domainSuffix)
-
-		customZone.Records = append(customZone.Records, nbdns.SimpleRecord{
-			Name:  sb.String(),
-			Type:  int(dns.TypeA),
-			Class: nbdns.DefaultClass,
-			TTL:   defaultTTL,
-			RData: peer.IP.String(),
-		})
-		sb.Reset()
-
-		for _, extraLabel := range peer.ExtraDNSLabels {
-			sb.Grow(len(extraLabel) + len(domainSuffix))
-			sb.WriteString(extraLabel)
+		ipv6 := a.Network.RemotePeerIPv6(peer)
+		for _, label := range append([]string{peer.DNSLabel}, peer.ExtraDNSLabels...) {
+			sb.Grow(len(label) + len(domainSuffix))
+			sb.WriteString(label)
 			sb.WriteString(domainSuffix)
 
 			customZone.Records = append(customZone.Records, nbdns.SimpleRecord{
@@ -474,9 +532,18 @@ func (a *Account) GetPeersCustomZone(ctx context.Context, dnsDomain string) nbdn
 				TTL:   defaultTTL,
 				RData: peer.IP.String(),
 			})
+
+			if ipv6 != nil {
+				customZone.Records = append(customZone.Records, nbdns.SimpleRecord{
+					Name:  sb.String(),
+					Type:  int(dns.TypeAAAA),
+					Class: nbdns.DefaultClass,
+					TTL:   defaultTTL,
+					RData: ipv6.String(),
+				})
+			}
 			sb.Reset()
 		}
-
 	}
 
 	go func() {
@@ -809,6 +876,11 @@ func (a *Account) Copy() *Account {
 		nsGroups[id] = nsGroup.Copy()
 	}
 
+	dnsZones := []*DNSZone{}
+	for _, zone := range a.DNSZones {
+		dnsZones = append(dnsZones, zone.Copy())
+	}
+
 	dnsSettings := a.DNSSettings.Copy()
 
 	var settings *Settings
@@ -851,6 +923,7 @@ func (a *Account) Copy() *Account {
 		Policies:               policies,
 		Routes:                 routes,
 		NameServerGroups:       nsGroups,
+		DNSZones:               dnsZones,
 		DNSSettings:            dnsSettings,
 		PostureChecks:          postureChecks,
 		Settings:               settings,
@@ -947,13 +1020,14 @@ func (a *Account) UserGroupsRemoveFromPeers(userID string, groups ...string) map
 // This function returns the list of peers and firewall rules that are applicable to a given peer.
 func (a *Account) GetPeerConnectionResources(ctx context.Context, peerID string, validatedPeersMap map[string]struct{}) ([]*nbpeer.Peer, []*FirewallRule) {
 	generateResources, getAccumulatedResources := a.connResourcesGenerator(ctx)
+	now := time.Now()
 	for _, policy := range a.Policies {
 		if !policy.Enabled {
 			continue
 		}
 
 		for _, rule := range policy.Rules {
-			if !rule.Enabled {
+			if !rule.IsActive(now) {
 				continue
 			}
 
@@ -1011,33 +1085,44 @@ func (a *Account) connResourcesGenerator(ctx context.Context) (func(*PolicyRule,
 					peersExists[peer.ID] = struct{}{}
 				}
 
-				fr := FirewallRule{
-					PeerIP:    peer.IP.String(),
-					Direction: direction,
-					Action:    string(rule.Action),
-					Protocol:  string(rule.Protocol),
+				peerIPs := []string{peer.IP.String()}
+				ipv6 := a.Network.RemotePeerIPv6(peer)
+				if ipv6 != nil {
+					peerIPs = append(peerIPs, ipv6.String())
 				}
 
 				if isAll {
-					fr.PeerIP = "0.0.0.0"
-				}
-
-				ruleID := rule.ID + fr.PeerIP + strconv.Itoa(direction) +
-					fr.Protocol + fr.Action + strings.Join(rule.Ports, ",")
-				if _, ok := rulesExists[ruleID]; ok {
-					continue
-				}
-				rulesExists[ruleID] = struct{}{}
-
-				if len(rule.Ports) == 0 {
-					rules = append(rules, &fr)
-					continue
+					peerIPs = []string{"0.0.0.0"}
+					if ipv6 != nil {
+						peerIPs = append(peerIPs, "::")
+					}
 				}
 
-				for _, port := range rule.Ports {
-					pr := fr // clone rule and add set new port
-					pr.Port = port
-					rules = append(rules, &pr)
+				for _, peerIP := range peerIPs {
+					fr := FirewallRule{
+						PeerIP:    peerIP,
+						Direction: direction,
+						Action:    string(rule.Action),
+						Protocol:  string(rule.Protocol),
+					}
+
+					ruleID := rule.ID + fr.PeerIP + strconv.Itoa(direction) +
+						fr.Protocol + fr.Action + strings.Join(rule.Ports, ",")
+					if _, ok := rulesExists[ruleID]; ok {
+						continue
+					}
+					rulesExists[ruleID] = struct{}{}
+
+					if len(rule.Ports) == 0 {
+						rules = append(rules, &fr)
+						continue
+					}
+
+					for _, port := range rule.Ports {
+						pr := fr // clone rule and add set new port
+						pr.Port = port
+						rules = append(rules, &pr)
+					}
 				}
 			}
 		}, func() ([]*nbpeer.Peer, []*FirewallRule) {
@@ -1145,13 +1230,14 @@ func (a *Account) GetPeerRoutesFirewallRules(ctx context.Context, peerID string,
 
 func (a *Account) getRouteFirewallRules(ctx context.Context, peerID string, policies []*Policy, route *route.Route, validatedPeersMap map[string]struct{}, distributionPeers map[string]struct{}) []*RouteFirewallRule {
 	var fwRules []*RouteFirewallRule
+	now := time.Now()
 	for _, policy := range policies {
 		if !policy.Enabled {
 			continue
 		}
 
 		for _, rule := range policy.Rules {
-			if !rule.Enabled {
+			if !rule.IsActive(now) {
 				continue
 			}
 
@@ -1413,13 +1499,14 @@ func (a *Account) GetPoliciesForNetworkResource(resourceId string) []*Policy {
 
 	networkResourceGroups := a.getNetworkResourceGroups(resourceId)
 
+	now := time.Now()
 	for _, policy := range a.Policies {
 		if !policy.Enabled {
 			continue
 		}
 
 		for _, rule := range policy.Rules {
-			if !rule.Enabled {
+			if !rule.IsActive(now) {
 				continue
 			}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	nbdns "github.com/netbirdio/netbird/dns"
 	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
@@ -835,3 +836,31 @@ func Test_NetworksNetMapGenShouldExcludeOtherRouters(t *testing.T) {
 	assert.Len(t, networkResourcesRoutes, 1, "expected network resource route don't match")
 	assert.Len(t, sourcePeers, 2, "expected source peers don't match")
 }
+
+func Test_GetPeersCustomZoneWithIPv6(t *testing.T) {
+	network := &Network{Identifier: "network1", Net: net.IPNet{IP: net.ParseIP("100.64.0.0"), Mask: net.CIDRMask(16, 32)}}
+	account := &Account{
+		Network: network,
+		Peers: map[string]*nbpeer.Peer{
+			"peer1": {ID: "peer1", IP: net.ParseIP("100.64.0.1"), DNSLabel: "peer1", ExtraDNSLabels: []string{"web"}},
+		},
+	}
+
+	zone := account.GetPeersCustomZone(context.Background(), "netbird.cloud")
+	require.Len(t, zone.Records, 2)
+	for _, record := range zone.Records {
+		assert.Equal(t, 1, record.Type, "only A records are expected when IPv6 is disabled")
+	}
+
+	network.NetV6 = NewNetworkV6(network.Identifier)
+	ipv6 := network.PeerIPv6(net.ParseIP("100.64.0.1")).String()
+
+	zone = account.GetPeersCustomZone(context.Background(), "netbird.cloud")
+	require.Len(t, zone.Records, 2, "no AAAA records are expected for a peer that didn't configure its IPv6 address")
+
+	account.Peers["peer1"].Meta.IPv6Supported = true
+	zone = account.GetPeersCustomZone(context.Background(), "netbird.cloud")
+	require.Len(t, zone.Records, 4)
+	assert.Contains(t, zone.Records, nbdns.SimpleRecord{Name: "peer1.netbird.cloud", Type: 28, Class: nbdns.DefaultClass, TTL: defaultTTL, RData: ipv6})
+	assert.Contains(t, zone.Records, nbdns.SimpleRecord{Name: "web.netbird.cloud", Type: 28, Class: nbdns.DefaultClass, TTL: defaultTTL, RData: ipv6})
+}
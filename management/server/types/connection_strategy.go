@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+const (
+	// ConnectionStrategyDefault connects with ICE and the relay in parallel and prefers the direct connection
+	ConnectionStrategyDefault = "default"
+	// ConnectionStrategyRelayOnly connects through the relay only, for networks that block direct connections
+	ConnectionStrategyRelayOnly = "relay_only"
+	// ConnectionStrategyDirectOnly connects with ICE host and server reflexive candidates only and never relays
+	ConnectionStrategyDirectOnly = "direct_only"
+	// ConnectionStrategyPreferRelay connects through the relay and falls back to ICE when the relay is not available,
+	// for latency-insensitive peers
+	ConnectionStrategyPreferRelay = "prefer_relay"
+	// ConnectionStrategyLazy connects only when traffic to the peer shows up
+	ConnectionStrategyLazy = "lazy"
+)
+
+// connectionStrategyPrecedence orders the strategies from the most to the least restrictive.
+// The most restrictive strategy of the groups of both peers applies to a connection, so both ends agree on it
+var connectionStrategyPrecedence = []string{
+	ConnectionStrategyRelayOnly,
+	ConnectionStrategyDirectOnly,
+	ConnectionStrategyPreferRelay,
+	ConnectionStrategyLazy,
+}
+
+// ValidateConnectionStrategy checks that the strategy is empty or a known strategy
+func ValidateConnectionStrategy(strategy string) error {
+	switch strategy {
+	case "", ConnectionStrategyDefault, ConnectionStrategyRelayOnly, ConnectionStrategyDirectOnly, ConnectionStrategyPreferRelay, ConnectionStrategyLazy:
+		return nil
+	default:
+		return fmt.Errorf("invalid connection strategy %q", strategy)
+	}
+}
+
+// GetPeerConnectionStrategies returns the connection strategies of the peer to the remote peers, indexed by the
+// remote peer ID. Remote peers that use the default strategy with the peer are omitted
+func (a *Account) GetPeerConnectionStrategies(peerID string, remotePeers []*nbpeer.Peer) map[string]string {
+	// the rank of the most restrictive strategy of the groups of every peer
+	ranks := make(map[string]int)
+	for _, group := range a.Groups {
+		rank := slices.Index(connectionStrategyPrecedence, group.ConnectionStrategy)
+		if rank < 0 {
+			continue
+		}
+		for _, id := range group.Peers {
+			if current, ok := ranks[id]; !ok || rank < current {
+				ranks[id] = rank
+			}
+		}
+	}
+
+	if len(ranks) == 0 {
+		return nil
+	}
+
+	localRank, hasLocalStrategy := ranks[peerID]
+	strategies := make(map[string]string)
+	for _, remotePeer := range remotePeers {
+		rank, ok := ranks[remotePeer.ID]
+		if hasLocalStrategy && (!ok || localRank < rank) {
+			rank, ok = localRank, true
+		}
+		if ok {
+			strategies[remotePeer.ID] = connectionStrategyPrecedence[rank]
+		}
+	}
+	return strategies
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestAccount_GetPeerConnectionStrategies(t *testing.T) {
+	peers := map[string]*nbpeer.Peer{
+		"officePeer":  {ID: "officePeer"},
+		"lockedPeer":  {ID: "lockedPeer"},
+		"backupPeer":  {ID: "backupPeer"},
+		"defaultPeer": {ID: "defaultPeer"},
+	}
+	remotePeers := func(ids ...string) []*nbpeer.Peer {
+		var list []*nbpeer.Peer
+		for _, id := range ids {
+			list = append(list, peers[id])
+		}
+		return list
+	}
+
+	account := &Account{
+		Peers: peers,
+		Groups: map[string]*Group{
+			"all":     {ID: "all", Name: "All", Peers: []string{"officePeer", "lockedPeer", "backupPeer", "defaultPeer"}},
+			"office":  {ID: "office", Name: "office", Peers: []string{"officePeer"}, ConnectionStrategy: ConnectionStrategyLazy},
+			"locked":  {ID: "locked", Name: "locked", Peers: []string{"lockedPeer"}, ConnectionStrategy: ConnectionStrategyRelayOnly},
+			"backup":  {ID: "backup", Name: "backup", Peers: []string{"backupPeer", "lockedPeer"}, ConnectionStrategy: ConnectionStrategyPreferRelay},
+			"default": {ID: "default", Name: "default", Peers: []string{"defaultPeer"}, ConnectionStrategy: ConnectionStrategyDefault},
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		"lockedPeer":  ConnectionStrategyRelayOnly,
+		"backupPeer":  ConnectionStrategyPreferRelay,
+		"defaultPeer": ConnectionStrategyLazy,
+	}, account.GetPeerConnectionStrategies("officePeer", remotePeers("lockedPeer", "backupPeer", "defaultPeer")),
+		"the most restrictive strategy of both peers should apply")
+
+	assert.Equal(t, map[string]string{
+		"officePeer":  ConnectionStrategyRelayOnly,
+		"backupPeer":  ConnectionStrategyRelayOnly,
+		"defaultPeer": ConnectionStrategyRelayOnly,
+	}, account.GetPeerConnectionStrategies("lockedPeer", remotePeers("officePeer", "backupPeer", "defaultPeer")),
+		"the strategies should be symmetric")
+
+	assert.Equal(t, map[string]string{
+		"officePeer": ConnectionStrategyLazy,
+		"lockedPeer": ConnectionStrategyRelayOnly,
+	}, account.GetPeerConnectionStrategies("defaultPeer", remotePeers("officePeer", "lockedPeer")),
+		"the explicit default strategy should not override other strategies")
+
+	account.Groups = map[string]*Group{"all": account.Groups["all"]}
+	assert.Nil(t, account.GetPeerConnectionStrategies("officePeer", remotePeers("lockedPeer")))
+}
+
+func TestValidateConnectionStrategy(t *testing.T) {
+	for _, strategy := range []string{"", ConnectionStrategyDefault, ConnectionStrategyRelayOnly, ConnectionStrategyDirectOnly, ConnectionStrategyPreferRelay, ConnectionStrategyLazy} {
+		assert.NoError(t, ValidateConnectionStrategy(strategy), strategy)
+	}
+	assert.Error(t, ValidateConnectionStrategy("turn_only"))
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/rs/xid"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// DNSZoneRecordTypes are the record types that can be added to a custom DNS zone
+var DNSZoneRecordTypes = map[string]uint16{
+	"A":     dns.TypeA,
+	"AAAA":  dns.TypeAAAA,
+	"CNAME": dns.TypeCNAME,
+	"TXT":   dns.TypeTXT,
+	"SRV":   dns.TypeSRV,
+	"MX":    dns.TypeMX,
+	"PTR":   dns.TypePTR,
+}
+
+// DNSZone is an account-defined DNS zone resolved by the peers of the distribution groups
+type DNSZone struct {
+	// ID of the zone
+	ID string `gorm:"primaryKey"`
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `gorm:"index"`
+	// Name is the domain of the zone, e.g. internal.example.com
+	Name        string
+	Description string
+	Enabled     bool
+	// SearchDomainsEnabled indicates whether the zone is added to the search domains of the peers
+	SearchDomainsEnabled bool
+	// Groups are the distribution groups of the zone
+	Groups []string `gorm:"serializer:json"`
+	// Records of the zone, each record name is the zone domain or a subdomain of it
+	Records []nbdns.SimpleRecord `gorm:"serializer:json"`
+}
+
+// NewDNSZone creates a new DNS zone with a generated ID
+func NewDNSZone(accountID string) *DNSZone {
+	return &DNSZone{
+		ID:        xid.New().String(),
+		AccountID: accountID,
+	}
+}
+
+// Copy returns a copy of the zone
+func (z *DNSZone) Copy() *DNSZone {
+	zone := &DNSZone{
+		ID:                   z.ID,
+		AccountID:            z.AccountID,
+		Name:                 z.Name,
+		Description:          z.Description,
+		Enabled:              z.Enabled,
+		SearchDomainsEnabled: z.SearchDomainsEnabled,
+		Groups:               make([]string, len(z.Groups)),
+		Records:              make([]nbdns.SimpleRecord, len(z.Records)),
+	}
+	copy(zone.Groups, z.Groups)
+	copy(zone.Records, z.Records)
+	return zone
+}
+
+// EventMeta returns activity event meta related to the zone
+func (z *DNSZone) EventMeta() map[string]any {
+	return map[string]any{"name": z.Name}
+}
+
+// ToCustomZone converts the zone to the custom zone sent to the peers
+func (z *DNSZone) ToCustomZone() nbdns.CustomZone {
+	zone := nbdns.CustomZone{
+		Domain:               dns.Fqdn(z.Name),
+		Records:              make([]nbdns.SimpleRecord, len(z.Records)),
+		SearchDomainDisabled: !z.SearchDomainsEnabled,
+	}
+	copy(zone.Records, z.Records)
+	return zone
+}
+
+// FromAPIRequest fills the zone from the API request, record names and types are normalized
+func (z *DNSZone) FromAPIRequest(req *api.DNSZoneRequest) {
+	z.Name = strings.ToLower(strings.TrimSuffix(req.Name, "."))
+	z.Description = req.Description
+	z.Enabled = req.Enabled
+	z.SearchDomainsEnabled = req.SearchDomainsEnabled
+	z.Groups = req.Groups
+
+	z.Records = make([]nbdns.SimpleRecord, 0, len(req.Records))
+	for _, record := range req.Records {
+		z.Records = append(z.Records, nbdns.SimpleRecord{
+			Name:  dns.Fqdn(strings.ToLower(record.Name)),
+			Type:  int(DNSZoneRecordTypes[strings.ToUpper(string(record.Type))]),
+			Class: nbdns.DefaultClass,
+			TTL:   record.Ttl,
+			RData: strings.TrimSpace(record.Content),
+		})
+	}
+}
+
+// ToAPIResponse converts the zone to the API response
+func (z *DNSZone) ToAPIResponse() *api.DNSZone {
+	records := make([]api.DNSRecord, 0, len(z.Records))
+	for _, record := range z.Records {
+		records = append(records, api.DNSRecord{
+			Name:    strings.TrimSuffix(record.Name, "."),
+			Type:    api.DNSRecordType(dns.Type(record.Type).String()),
+			Ttl:     record.TTL,
+			Content: record.RData,
+		})
+	}
+
+	return &api.DNSZone{
+		Id:                   z.ID,
+		Name:                 z.Name,
+		Description:          z.Description,
+		Enabled:              z.Enabled,
+		SearchDomainsEnabled: z.SearchDomainsEnabled,
+		Groups:               z.Groups,
+		Records:              records,
+	}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/activity/sink"
+)
+
+// EventSink is an account managed destination the activity events of the account are streamed to
+type EventSink struct {
+	// ID of the sink
+	ID string `gorm:"primaryKey"`
+	// AccountID is a reference to the Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+	// Name of the sink
+	Name string
+	// Enabled indicates whether the events are streamed to the sink
+	Enabled bool
+	// Type of the destination. Accounts can only use the syslog and webhook destinations, file destinations are
+	// reserved to the management configuration as they write to the management server disk
+	Type sink.Type
+	// Activities limits the sink to the events with the given activity codes. Events of all activities are
+	// forwarded when empty
+	Activities []string `gorm:"serializer:json"`
+
+	Syslog  *sink.SyslogConfig  `gorm:"serializer:json"`
+	Webhook *sink.WebhookConfig `gorm:"serializer:json"`
+}
+
+// Copy returns a copy of the event sink
+func (s *EventSink) Copy() *EventSink {
+	c := &EventSink{
+		ID:         s.ID,
+		AccountID:  s.AccountID,
+		Name:       s.Name,
+		Enabled:    s.Enabled,
+		Type:       s.Type,
+		Activities: slices.Clone(s.Activities),
+	}
+	if s.Syslog != nil {
+		syslog := *s.Syslog
+		c.Syslog = &syslog
+	}
+	if s.Webhook != nil {
+		webhook := *s.Webhook
+		webhook.Headers = make(map[string]string, len(s.Webhook.Headers))
+		for k, v := range s.Webhook.Headers {
+			webhook.Headers[k] = v
+		}
+		c.Webhook = &webhook
+	}
+	return c
+}
+
+// Validate checks the sink type, destination and activity codes
+func (s *EventSink) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("sink name shouldn't be empty")
+	}
+
+	if s.Type != sink.TypeSyslog && s.Type != sink.TypeWebhook {
+		return fmt.Errorf("unsupported sink type %q", s.Type)
+	}
+
+	for _, code := range s.Activities {
+		if _, ok := activity.ActivityFromStringCode(code); !ok {
+			return fmt.Errorf("unknown activity code %q", code)
+		}
+	}
+
+	return s.DestinationConfig().Validate()
+}
+
+// DestinationConfig returns the streaming configuration of the sink limited to the events of its account.
+// The sink ID is used as the destination name as it identifies the queue of the sink
+func (s *EventSink) DestinationConfig() *sink.DestinationConfig {
+	return &sink.DestinationConfig{
+		Name:       s.ID,
+		Type:       s.Type,
+		AccountIDs: []string{s.AccountID},
+		Activities: slices.Clone(s.Activities),
+		Syslog:     s.Syslog,
+		Webhook:    s.Webhook,
+	}
+}
+
+// EventMeta returns activity event meta related to the sink
+func (s *EventSink) EventMeta() map[string]any {
+	return map[string]any{"name": s.Name, "type": string(s.Type)}
+}
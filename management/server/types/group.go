@@ -31,6 +31,14 @@ type Group struct {
 	// Resources contains a list of resources in that group
 	Resources []Resource `gorm:"serializer:json"`
 
+	// ConnectionStrategy defines how the peers of the group connect to other peers (enum of ConnectionStrategy* values).
+	// Empty uses the default strategy
+	ConnectionStrategy string
+
+	// Rule is an expression over peer attributes that defines the members of a dynamic group.
+	// Peers of a dynamic group are kept in sync with the rule and can't be changed manually
+	Rule string
+
 	IntegrationReference integration_reference.IntegrationReference `gorm:"embedded;embeddedPrefix:integration_ref_"`
 }
 
@@ -51,6 +59,8 @@ func (g *Group) Copy() *Group {
 		Peers:                make([]string, len(g.Peers)),
 		Resources:            make([]Resource, len(g.Resources)),
 		IntegrationReference: g.IntegrationReference,
+		ConnectionStrategy:   g.ConnectionStrategy,
+		Rule:                 g.Rule,
 	}
 	copy(group.Peers, g.Peers)
 	copy(group.Resources, g.Resources)
@@ -62,6 +72,11 @@ func (g *Group) HasPeers() bool {
 	return len(g.Peers) > 0
 }
 
+// IsDynamic checks if the group members are defined by a rule.
+func (g *Group) IsDynamic() bool {
+	return g.Rule != ""
+}
+
 // IsGroupAll checks if the group is a default "All" group.
 func (g *Group) IsGroupAll() bool {
 	return g.Name == "All"
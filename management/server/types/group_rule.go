@@ -0,0 +1,427 @@
+package types
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+// groupRuleAttributes maps the attribute names usable in a dynamic group rule to the peer values they refer to
+var groupRuleAttributes = map[string]func(peer *nbpeer.Peer) string{
+	"os":             func(p *nbpeer.Peer) string { return p.Meta.GoOS },
+	"os_version":     func(p *nbpeer.Peer) string { return p.Meta.OSVersion },
+	"kernel_version": func(p *nbpeer.Peer) string { return p.Meta.KernelVersion },
+	"version":        func(p *nbpeer.Peer) string { return p.Meta.WtVersion },
+	"hostname":       func(p *nbpeer.Peer) string { return p.Meta.Hostname },
+	"country":        func(p *nbpeer.Peer) string { return p.Location.CountryCode },
+	"city":           func(p *nbpeer.Peer) string { return p.Location.CityName },
+	"user":           func(p *nbpeer.Peer) string { return p.UserID },
+	"ephemeral":      func(p *nbpeer.Peer) string { return strconv.FormatBool(p.Ephemeral) },
+	"product":        func(p *nbpeer.Peer) string { return p.Meta.SystemProductName },
+	"manufacturer":   func(p *nbpeer.Peer) string { return p.Meta.SystemManufacturer },
+	"cloud":          func(p *nbpeer.Peer) string { return p.Meta.Environment.Cloud },
+	"platform":       func(p *nbpeer.Peer) string { return p.Meta.Environment.Platform },
+}
+
+// groupRuleVersionAttributes are the attributes that support ordering comparisons
+var groupRuleVersionAttributes = map[string]bool{
+	"os_version":     true,
+	"kernel_version": true,
+	"version":        true,
+}
+
+// GroupRule is a parsed dynamic group rule.
+//
+// A rule is a boolean expression over peer attributes, e.g.:
+//
+//	os == linux && cloud == "Amazon Web Services" && !ephemeral
+//	hostname =~ "web-*" || country in [DE, NL]
+//	version >= 0.30.0
+//
+// Supported operators are == and != (case-insensitive), =~ and !~ (glob match), in [...] and,
+// for version attributes, <, <=, > and >=. Expressions can be combined with &&, || and ! and grouped with parentheses.
+// A bare attribute name is true when the attribute equals "true".
+type GroupRule struct {
+	expr ruleExpr
+}
+
+// ParseGroupRule parses and validates a dynamic group rule
+func ParseGroupRule(rule string) (*GroupRule, error) {
+	tokens, err := tokenizeGroupRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ruleParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.peek().value, p.peek().pos)
+	}
+
+	return &GroupRule{expr: expr}, nil
+}
+
+// Match returns true if the peer satisfies the rule
+func (r *GroupRule) Match(peer *nbpeer.Peer) bool {
+	return r.expr.eval(peer)
+}
+
+type ruleExpr interface {
+	eval(peer *nbpeer.Peer) bool
+}
+
+type ruleAnd struct{ left, right ruleExpr }
+
+func (e ruleAnd) eval(peer *nbpeer.Peer) bool { return e.left.eval(peer) && e.right.eval(peer) }
+
+type ruleOr struct{ left, right ruleExpr }
+
+func (e ruleOr) eval(peer *nbpeer.Peer) bool { return e.left.eval(peer) || e.right.eval(peer) }
+
+type ruleNot struct{ expr ruleExpr }
+
+func (e ruleNot) eval(peer *nbpeer.Peer) bool { return !e.expr.eval(peer) }
+
+type ruleComparison struct {
+	attribute string
+	operator  string
+	values    []string
+}
+
+func (e ruleComparison) eval(peer *nbpeer.Peer) bool {
+	actual := groupRuleAttributes[e.attribute](peer)
+
+	switch e.operator {
+	case "==":
+		return strings.EqualFold(actual, e.values[0])
+	case "!=":
+		return !strings.EqualFold(actual, e.values[0])
+	case "=~":
+		return globMatch(e.values[0], actual)
+	case "!~":
+		return !globMatch(e.values[0], actual)
+	case "in":
+		for _, value := range e.values {
+			if strings.EqualFold(actual, value) {
+				return true
+			}
+		}
+		return false
+	default:
+		return compareVersions(actual, e.operator, e.values[0])
+	}
+}
+
+func globMatch(pattern, value string) bool {
+	matched, err := path.Match(strings.ToLower(pattern), strings.ToLower(value))
+	return err == nil && matched
+}
+
+// compareVersions compares the peer version with the rule version. Peers reporting an unparsable version never match.
+func compareVersions(actual, operator, expected string) bool {
+	actualVersion, err := version.NewVersion(actual)
+	if err != nil {
+		return false
+	}
+	expectedVersion, err := version.NewVersion(expected)
+	if err != nil {
+		return false
+	}
+
+	switch operator {
+	case "<":
+		return actualVersion.LessThan(expectedVersion)
+	case "<=":
+		return actualVersion.LessThanOrEqual(expectedVersion)
+	case ">":
+		return actualVersion.GreaterThan(expectedVersion)
+	case ">=":
+		return actualVersion.GreaterThanOrEqual(expectedVersion)
+	}
+	return false
+}
+
+type ruleTokenKind int
+
+const (
+	ruleTokenWord ruleTokenKind = iota
+	ruleTokenString
+	ruleTokenOperator
+)
+
+type ruleToken struct {
+	kind  ruleTokenKind
+	value string
+	pos   int
+}
+
+var groupRuleOperators = []string{"&&", "||", "==", "!=", "=~", "!~", "<=", ">=", "<", ">", "!", "(", ")", "[", "]", ","}
+
+func isRuleWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.IndexByte("_.-*?:/", c) >= 0
+}
+
+func tokenizeGroupRule(rule string) ([]ruleToken, error) {
+	var tokens []ruleToken
+
+	for i := 0; i < len(rule); {
+		c := rule[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(rule) && rule[end] != '"' {
+				if rule[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(rule) {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+			value, err := strconv.Unquote(rule[i : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at position %d: %w", i, err)
+			}
+			tokens = append(tokens, ruleToken{kind: ruleTokenString, value: value, pos: i})
+			i = end + 1
+		case isRuleWordChar(c):
+			start := i
+			for i < len(rule) && isRuleWordChar(rule[i]) {
+				i++
+			}
+			tokens = append(tokens, ruleToken{kind: ruleTokenWord, value: rule[start:i], pos: start})
+		default:
+			matched := false
+			for _, op := range groupRuleOperators {
+				if strings.HasPrefix(rule[i:], op) {
+					tokens = append(tokens, ruleToken{kind: ruleTokenOperator, value: op, pos: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+			}
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("rule is empty")
+	}
+
+	return tokens, nil
+}
+
+type ruleParser struct {
+	tokens []ruleToken
+	pos    int
+}
+
+func (p *ruleParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *ruleParser) peek() ruleToken {
+	if p.done() {
+		return ruleToken{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *ruleParser) accept(operator string) bool {
+	if t := p.peek(); !p.done() && t.kind == ruleTokenOperator && t.value == operator {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *ruleParser) expect(operator string) error {
+	if p.accept(operator) {
+		return nil
+	}
+	if p.done() {
+		return fmt.Errorf("expected %q at the end of the rule", operator)
+	}
+	return fmt.Errorf("expected %q at position %d, got %q", operator, p.peek().pos, p.peek().value)
+}
+
+func (p *ruleParser) parseOr() (ruleExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = ruleOr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *ruleParser) parseAnd() (ruleExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = ruleAnd{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *ruleParser) parseUnary() (ruleExpr, error) {
+	if p.accept("!") {
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return ruleNot{expr: expr}, nil
+	}
+
+	if p.accept("(") {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *ruleParser) parseComparison() (ruleExpr, error) {
+	if p.done() {
+		return nil, fmt.Errorf("expected attribute at the end of the rule")
+	}
+
+	t := p.tokens[p.pos]
+	if t.kind != ruleTokenWord {
+		return nil, fmt.Errorf("expected attribute at position %d, got %q", t.pos, t.value)
+	}
+	attribute := strings.ToLower(t.value)
+	if _, ok := groupRuleAttributes[attribute]; !ok {
+		return nil, fmt.Errorf("unknown attribute %q at position %d", t.value, t.pos)
+	}
+	p.pos++
+
+	op := p.peek()
+	if p.done() || op.kind == ruleTokenOperator && (op.value == "&&" || op.value == "||" || op.value == ")") {
+		// a bare attribute is a shorthand for attribute == true
+		return ruleComparison{attribute: attribute, operator: "==", values: []string{"true"}}, nil
+	}
+
+	if op.kind == ruleTokenWord && strings.EqualFold(op.value, "in") {
+		p.pos++
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return ruleComparison{attribute: attribute, operator: "in", values: values}, nil
+	}
+
+	if op.kind != ruleTokenOperator {
+		return nil, fmt.Errorf("expected operator at position %d, got %q", op.pos, op.value)
+	}
+
+	switch op.value {
+	case "==", "!=", "=~", "!~":
+	case "<", "<=", ">", ">=":
+		if !groupRuleVersionAttributes[attribute] {
+			return nil, fmt.Errorf("operator %q is not supported for attribute %q", op.value, attribute)
+		}
+	default:
+		return nil, fmt.Errorf("expected operator at position %d, got %q", op.pos, op.value)
+	}
+	p.pos++
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.value {
+	case "=~", "!~":
+		if _, err := path.Match(value, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", value, err)
+		}
+	case "<", "<=", ">", ">=":
+		if _, err := version.NewVersion(value); err != nil {
+			return nil, fmt.Errorf("invalid version %q: %w", value, err)
+		}
+	}
+
+	return ruleComparison{attribute: attribute, operator: op.value, values: []string{value}}, nil
+}
+
+func (p *ruleParser) parseList() ([]string, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		if p.accept("]") {
+			return values, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *ruleParser) parseValue() (string, error) {
+	if p.done() {
+		return "", fmt.Errorf("expected value at the end of the rule")
+	}
+
+	t := p.tokens[p.pos]
+	if t.kind == ruleTokenOperator {
+		return "", fmt.Errorf("expected value at position %d, got %q", t.pos, t.value)
+	}
+	p.pos++
+
+	return t.value, nil
+}
+
+// ApplyRule replaces the peers of a dynamic group with the given peers that match its rule
+func (g *Group) ApplyRule(peers []*nbpeer.Peer) error {
+	rule, err := ParseGroupRule(g.Rule)
+	if err != nil {
+		return err
+	}
+
+	g.Peers = make([]string, 0)
+	for _, peer := range peers {
+		if rule.Match(peer) {
+			g.Peers = append(g.Peers, peer.ID)
+		}
+	}
+	return nil
+}
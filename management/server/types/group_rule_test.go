@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestGroupRule_Match(t *testing.T) {
+	peer := &nbpeer.Peer{
+		ID:        "peer1",
+		UserID:    "user1",
+		Ephemeral: true,
+		Meta: nbpeer.PeerSystemMeta{
+			Hostname:          "web-01.example.com",
+			GoOS:              "linux",
+			KernelVersion:     "6.1.0",
+			WtVersion:         "0.36.5",
+			SystemProductName: "t3.medium",
+			Environment:       nbpeer.Environment{Cloud: "Amazon Web Services", Platform: "EC2"},
+		},
+		Location: nbpeer.Location{CountryCode: "DE", CityName: "Berlin"},
+	}
+
+	tests := []struct {
+		rule  string
+		match bool
+	}{
+		{rule: "os == linux", match: true},
+		{rule: "os == Linux", match: true},
+		{rule: "os != linux", match: false},
+		{rule: `cloud == "amazon web services" && platform == ec2`, match: true},
+		{rule: `hostname =~ "web-*"`, match: true},
+		{rule: `hostname !~ "web-*"`, match: false},
+		{rule: "hostname =~ db-*", match: false},
+		{rule: "country in [NL, de]", match: true},
+		{rule: "country in [NL, FR]", match: false},
+		{rule: "city == Berlin && user == user1", match: true},
+		{rule: "ephemeral", match: true},
+		{rule: "!ephemeral", match: false},
+		{rule: "ephemeral == false", match: false},
+		{rule: "version >= 0.36.0", match: true},
+		{rule: "version < 0.36.0", match: false},
+		{rule: "kernel_version > 5.15", match: true},
+		{rule: "os_version >= 10", match: false},
+		{rule: "product == t3.medium", match: true},
+		{rule: "manufacturer == \"\"", match: true},
+		{rule: "os == windows || country == DE", match: true},
+		{rule: "os == windows || country == DE && city == Munich", match: false},
+		{rule: "(os == windows || country == DE) && !(city == Munich)", match: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.rule, func(t *testing.T) {
+			rule, err := ParseGroupRule(tc.rule)
+			require.NoError(t, err)
+			assert.Equal(t, tc.match, rule.Match(peer))
+		})
+	}
+}
+
+func TestParseGroupRule_Invalid(t *testing.T) {
+	rules := []string{
+		"",
+		"   ",
+		"color == blue",
+		"os ==",
+		"os == linux &&",
+		"(os == linux",
+		"os == linux)",
+		"hostname > web",
+		"version >= latest",
+		"hostname =~ \"web-[\"",
+		"country in [DE",
+		"country in DE",
+		"os == \"linux",
+		"os # linux",
+		"os linux",
+	}
+
+	for _, rule := range rules {
+		_, err := ParseGroupRule(rule)
+		assert.Error(t, err, "rule %q should be invalid", rule)
+	}
+}
+
+func TestGroup_ApplyRule(t *testing.T) {
+	peers := []*nbpeer.Peer{
+		{ID: "linuxPeer", Meta: nbpeer.PeerSystemMeta{GoOS: "linux"}},
+		{ID: "windowsPeer", Meta: nbpeer.PeerSystemMeta{GoOS: "windows"}},
+	}
+
+	group := &Group{ID: "group1", Peers: []string{"windowsPeer"}, Rule: "os == linux"}
+	require.NoError(t, group.ApplyRule(peers))
+	assert.Equal(t, []string{"linuxPeer"}, group.Peers)
+
+	group.Rule = "os =="
+	assert.Error(t, group.ApplyRule(peers))
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"math/rand"
 	"net"
 	"sync"
@@ -23,6 +24,11 @@ const (
 
 	// AllowedIPsFormat generates Wireguard AllowedIPs format (e.g. 100.64.30.1/32)
 	AllowedIPsFormat = "%s/32"
+
+	// NetSizeV6 is the size of the IPv6 unique local prefix of a network, e.g. fd12:3456:789a::/64
+	NetSizeV6 = 64
+	// AllowedIPsFormatV6 generates Wireguard AllowedIPs format for IPv6 addresses (e.g. fd12:3456:789a::6440:1e01/128)
+	AllowedIPsFormatV6 = "%s/128"
 )
 
 type NetworkMap struct {
@@ -33,6 +39,12 @@ type NetworkMap struct {
 	OfflinePeers        []*nbpeer.Peer
 	FirewallRules       []*FirewallRule
 	RoutesFirewallRules []*RouteFirewallRule
+	// SSHAuthorizedUsers are the local users the remote peers may log in as with the SSH server of the peer,
+	// indexed by the remote peer ID. Nil if the SSH server doesn't restrict the local users
+	SSHAuthorizedUsers map[string][]string
+	// ConnectionStrategies are the connection strategies of the peer to the remote peers, indexed by the remote peer ID.
+	// Remote peers missing from the map use the default strategy
+	ConnectionStrategies map[string]string
 }
 
 type Network struct {
@@ -42,6 +54,8 @@ type Network struct {
 	// Serial is an ID that increments by 1 when any change to the network happened (e.g. new peer has been added).
 	// Used to synchronize state to the client apps.
 	Serial uint64
+	// NetV6 is the IPv6 unique local prefix of the network. Empty if IPv6 overlay addressing is disabled
+	NetV6 net.IPNet `gorm:"serializer:json"`
 
 	Mu sync.Mutex `json:"-" gorm:"-"`
 }
@@ -82,11 +96,54 @@ func (n *Network) Copy() *Network {
 	return &Network{
 		Identifier: n.Identifier,
 		Net:        n.Net,
+		NetV6:      n.NetV6,
 		Dns:        n.Dns,
 		Serial:     n.Serial,
 	}
 }
 
+// NewNetworkV6 returns the IPv6 unique local /64 prefix of the network with the given identifier.
+// The global ID of the prefix (RFC 4193) is derived from the identifier so the prefix stays the same
+// when IPv6 is disabled and enabled again.
+func NewNetworkV6(identifier string) net.IPNet {
+	sum := sha256.Sum256([]byte(identifier))
+
+	ip := make(net.IP, net.IPv6len)
+	ip[0] = 0xfd
+	copy(ip[1:6], sum[:5])
+
+	return net.IPNet{IP: ip, Mask: net.CIDRMask(NetSizeV6, 8*net.IPv6len)}
+}
+
+// HasIPv6 checks if IPv6 overlay addressing is enabled for the network
+func (n *Network) HasIPv6() bool {
+	return n != nil && len(n.NetV6.IP) == net.IPv6len
+}
+
+// PeerIPv6 returns the IPv6 address of the peer with the given IPv4 address or nil if IPv6 is disabled.
+// The IPv4 address is embedded in the interface identifier, which makes the IPv6 address unique in the network
+// and stable for the peer lifetime.
+func (n *Network) PeerIPv6(peerIP net.IP) net.IP {
+	ipv4 := peerIP.To4()
+	if !n.HasIPv6() || ipv4 == nil {
+		return nil
+	}
+
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, n.NetV6.IP.Mask(n.NetV6.Mask))
+	copy(ip[12:], ipv4)
+	return ip
+}
+
+// RemotePeerIPv6 returns the IPv6 address the other peers reach the peer on or nil if IPv6 is disabled or the peer
+// didn't configure its IPv6 address, e.g. because its firewall can't filter IPv6 traffic
+func (n *Network) RemotePeerIPv6(peer *nbpeer.Peer) net.IP {
+	if !peer.Meta.IPv6Supported {
+		return nil
+	}
+	return n.PeerIPv6(peer.IP)
+}
+
 // AllocatePeerIP pics an available IP from an net.IPNet.
 // This method considers already taken IPs and reuses IPs if there are gaps in takenIps
 // E.g. if ipNet=100.30.0.0/16 and takenIps=[100.30.0.1, 100.30.0.4] then the result would be 100.30.0.2 or 100.30.0.3
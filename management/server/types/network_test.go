@@ -49,3 +49,33 @@ func TestGenerateIPs(t *testing.T) {
 		t.Errorf("expected last ip to be: 100.64.0.253, got %s", ips[len(ips)-1].String())
 	}
 }
+
+func TestNewNetworkV6(t *testing.T) {
+	prefix := NewNetworkV6("network1")
+
+	ula := net.IPNet{IP: net.ParseIP("fd00::"), Mask: net.CIDRMask(8, 128)}
+	assert.True(t, ula.Contains(prefix.IP), "prefix should be a unique local address")
+	ones, bits := prefix.Mask.Size()
+	assert.Equal(t, NetSizeV6, ones)
+	assert.Equal(t, 128, bits)
+
+	assert.Equal(t, prefix, NewNetworkV6("network1"), "prefix should be stable for the network")
+	assert.NotEqual(t, prefix, NewNetworkV6("network2"))
+}
+
+func TestNetwork_PeerIPv6(t *testing.T) {
+	network := &Network{Identifier: "network1", Net: net.IPNet{IP: net.ParseIP("100.64.0.0"), Mask: net.CIDRMask(16, 32)}}
+	assert.False(t, network.HasIPv6())
+	assert.Nil(t, network.PeerIPv6(net.ParseIP("100.64.30.1")), "IPv6 is disabled")
+
+	network.NetV6 = NewNetworkV6(network.Identifier)
+	assert.True(t, network.HasIPv6())
+
+	ip := network.PeerIPv6(net.ParseIP("100.64.30.1"))
+	assert.True(t, network.NetV6.Contains(ip))
+	assert.Equal(t, net.IP{100, 64, 30, 1}, ip[12:].To4())
+	assert.NotEqual(t, ip, network.PeerIPv6(net.ParseIP("100.64.30.2")))
+
+	var nilNetwork *Network
+	assert.Nil(t, nilNetwork.PeerIPv6(net.ParseIP("100.64.30.1")))
+}
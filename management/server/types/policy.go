@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 const (
 	// PolicyTrafficActionAccept indicates that the traffic is accepted
 	PolicyTrafficActionAccept = PolicyTrafficActionType("accept")
@@ -84,6 +86,27 @@ func (p *Policy) Copy() *Policy {
 	return c
 }
 
+// NextScheduleTransition returns the earliest time after now at which one of the enabled scheduled rules
+// of the policy becomes active or inactive
+func (p *Policy) NextScheduleTransition(now time.Time) (time.Time, bool) {
+	var next time.Time
+	if !p.Enabled {
+		return next, false
+	}
+
+	for _, rule := range p.Rules {
+		if !rule.Enabled || rule.Schedule == nil {
+			continue
+		}
+		transition, ok := rule.Schedule.NextTransition(now)
+		if ok && (next.IsZero() || transition.Before(next)) {
+			next = transition
+		}
+	}
+
+	return next, !next.IsZero()
+}
+
 // EventMeta returns activity event meta related to this policy
 func (p *Policy) EventMeta() map[string]any {
 	return map[string]any{"name": p.Name}
@@ -104,12 +127,15 @@ func (p *Policy) UpgradeAndFix() {
 }
 
 // RuleGroups returns a list of all groups referenced in the policy's rules,
-// including sources and destinations.
+// including sources, destinations and SSH authorizations.
 func (p *Policy) RuleGroups() []string {
 	groups := make([]string, 0)
 	for _, rule := range p.Rules {
 		groups = append(groups, rule.Sources...)
 		groups = append(groups, rule.Destinations...)
+		for _, authorization := range rule.SSHAuthorizations {
+			groups = append(groups, authorization.Groups...)
+		}
 	}
 
 	return groups
@@ -0,0 +1,412 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// PolicyExplainRequest describes a connection to evaluate against the account access control
+type PolicyExplainRequest struct {
+	// SourcePeerID is the ID of the peer initiating the connection
+	SourcePeerID string
+
+	// DestinationPeerID is the ID of the peer receiving the connection. Either it or DestinationResourceID is set
+	DestinationPeerID string
+
+	// DestinationResourceID is the ID of the network resource receiving the connection
+	DestinationResourceID string
+
+	// Protocol of the connection
+	Protocol PolicyRuleProtocolType
+
+	// Port of the connection. It is ignored for the ALL and ICMP protocols
+	Port uint16
+}
+
+// PolicyRuleMatch is a policy rule that applies to an evaluated connection
+type PolicyRuleMatch struct {
+	PolicyID   string
+	PolicyName string
+	RuleID     string
+	RuleName   string
+	Action     PolicyTrafficActionType
+
+	// FailedPostureChecks are IDs of the policy posture checks the rule source peer doesn't pass
+	FailedPostureChecks []string
+}
+
+// PolicyExplanation is the result of a connection evaluation
+type PolicyExplanation struct {
+	// Allowed is the final verdict for the connection
+	Allowed bool
+
+	// Reason describes why the connection is allowed or denied
+	Reason string
+
+	// MatchedRules are the active rules matching the connection peers, protocol and port
+	MatchedRules []PolicyRuleMatch
+
+	// FailedPostureChecks are IDs of all posture checks failed by the peers of the matched rules
+	FailedPostureChecks []string
+
+	// RouteFirewallRules are the rules the routing peers of a destination resource apply to the source peer
+	RouteFirewallRules []*RouteFirewallRule
+}
+
+// PeerAccessPair is a pair of peers allowed to connect to each other. PeerID is always lower than RemotePeerID
+type PeerAccessPair struct {
+	PeerID       string
+	RemotePeerID string
+}
+
+// PolicyAccessDiff lists the peer pairs that gain or lose access with a policy change
+type PolicyAccessDiff struct {
+	Gained []PeerAccessPair
+	Lost   []PeerAccessPair
+}
+
+// ExplainConnection evaluates the connection described by the request against the account policies, posture checks
+// and network routers the same way the peers network maps are generated.
+func (a *Account) ExplainConnection(ctx context.Context, req *PolicyExplainRequest, validatedPeersMap map[string]struct{}) (*PolicyExplanation, error) {
+	source := a.GetPeer(req.SourcePeerID)
+	if source == nil {
+		return nil, status.Errorf(status.NotFound, "source peer %s not found", req.SourcePeerID)
+	}
+
+	explanation := &PolicyExplanation{}
+	var destination *nbpeer.Peer
+	switch {
+	case req.DestinationPeerID != "":
+		destination = a.GetPeer(req.DestinationPeerID)
+		if destination == nil {
+			return nil, status.Errorf(status.NotFound, "destination peer %s not found", req.DestinationPeerID)
+		}
+		explanation.MatchedRules = a.matchPeerRules(ctx, req, source, destination)
+	case req.DestinationResourceID != "":
+		resource := a.getNetworkResource(req.DestinationResourceID)
+		if resource == nil {
+			return nil, status.Errorf(status.NotFound, "destination resource %s not found", req.DestinationResourceID)
+		}
+		if !resource.Enabled {
+			explanation.Reason = fmt.Sprintf("network resource %s is disabled", resource.Name)
+			return explanation, nil
+		}
+		explanation.MatchedRules = a.matchResourceRules(ctx, req, source)
+		explanation.RouteFirewallRules = a.getResourceFirewallRulesForPeer(ctx, resource.ID, resource.NetworkID, source, validatedPeersMap)
+		if len(a.GetResourceRoutersMap()[resource.NetworkID]) == 0 {
+			explanation.Reason = fmt.Sprintf("network of resource %s has no enabled routing peers", resource.Name)
+			return explanation, nil
+		}
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "destination peer or resource is required")
+	}
+
+	for _, match := range explanation.MatchedRules {
+		for _, check := range match.FailedPostureChecks {
+			if !slices.Contains(explanation.FailedPostureChecks, check) {
+				explanation.FailedPostureChecks = append(explanation.FailedPostureChecks, check)
+			}
+		}
+	}
+
+	for _, peer := range []*nbpeer.Peer{source, destination} {
+		if peer == nil {
+			continue
+		}
+		if _, ok := validatedPeersMap[peer.ID]; !ok {
+			explanation.Reason = fmt.Sprintf("peer %s is not approved", peer.Name)
+			return explanation, nil
+		}
+		if expired, _ := peer.LoginExpired(a.Settings.PeerLoginExpiration); a.Settings.PeerLoginExpirationEnabled && expired {
+			explanation.Reason = fmt.Sprintf("login of peer %s has expired", peer.Name)
+			return explanation, nil
+		}
+	}
+
+	explanation.Allowed, explanation.Reason = getExplanationVerdict(explanation.MatchedRules)
+
+	return explanation, nil
+}
+
+// getExplanationVerdict decides on the connection based on the matched rules. Drop rules take precedence over accept
+// rules the same way as in the peer firewall.
+func getExplanationVerdict(matches []PolicyRuleMatch) (bool, string) {
+	var accept *PolicyRuleMatch
+	failedPostureChecks := false
+	for i, match := range matches {
+		if len(match.FailedPostureChecks) > 0 {
+			failedPostureChecks = true
+			continue
+		}
+		if match.Action == PolicyTrafficActionDrop {
+			return false, fmt.Sprintf("denied by rule %s of policy %s", match.RuleName, match.PolicyName)
+		}
+		if accept == nil {
+			accept = &matches[i]
+		}
+	}
+
+	if accept != nil {
+		return true, fmt.Sprintf("allowed by rule %s of policy %s", accept.RuleName, accept.PolicyName)
+	}
+
+	if failedPostureChecks {
+		return false, "matching rules are not applied because of failed posture checks"
+	}
+
+	return false, "no policy rule allows the connection"
+}
+
+// matchPeerRules returns the active rules that allow or deny traffic from the source to the destination peer
+func (a *Account) matchPeerRules(ctx context.Context, req *PolicyExplainRequest, source, destination *nbpeer.Peer) []PolicyRuleMatch {
+	var matches []PolicyRuleMatch
+	now := time.Now()
+	for _, policy := range a.Policies {
+		if !policy.Enabled {
+			continue
+		}
+
+		for _, rule := range policy.Rules {
+			if !rule.IsActive(now) || !rule.matchesTraffic(req.Protocol, req.Port) {
+				continue
+			}
+
+			var ruleSource *nbpeer.Peer
+			switch {
+			case a.isPeerInGroups(source.ID, rule.Sources) && a.isPeerInGroups(destination.ID, rule.Destinations):
+				ruleSource = source
+			case rule.Bidirectional && a.isPeerInGroups(destination.ID, rule.Sources) && a.isPeerInGroups(source.ID, rule.Destinations):
+				ruleSource = destination
+			default:
+				continue
+			}
+
+			matches = append(matches, PolicyRuleMatch{
+				PolicyID:            policy.ID,
+				PolicyName:          policy.Name,
+				RuleID:              rule.ID,
+				RuleName:            rule.Name,
+				Action:              rule.Action,
+				FailedPostureChecks: a.getFailedPostureChecks(ctx, policy.SourcePostureChecks, ruleSource),
+			})
+		}
+	}
+
+	return matches
+}
+
+// matchResourceRules returns the active rules that allow or deny traffic from the source peer to the network resource
+func (a *Account) matchResourceRules(ctx context.Context, req *PolicyExplainRequest, source *nbpeer.Peer) []PolicyRuleMatch {
+	var matches []PolicyRuleMatch
+	now := time.Now()
+	resourceGroups := a.getNetworkResourceGroups(req.DestinationResourceID)
+	for _, policy := range a.GetPoliciesForNetworkResource(req.DestinationResourceID) {
+		for _, rule := range policy.Rules {
+			if !rule.IsActive(now) || !rule.matchesTraffic(req.Protocol, req.Port) {
+				continue
+			}
+
+			if !a.isPeerInGroups(source.ID, rule.Sources) {
+				continue
+			}
+
+			if rule.DestinationResource.ID != req.DestinationResourceID && !slices.ContainsFunc(resourceGroups, func(group *Group) bool {
+				return slices.Contains(rule.Destinations, group.ID)
+			}) {
+				continue
+			}
+
+			matches = append(matches, PolicyRuleMatch{
+				PolicyID:            policy.ID,
+				PolicyName:          policy.Name,
+				RuleID:              rule.ID,
+				RuleName:            rule.Name,
+				Action:              rule.Action,
+				FailedPostureChecks: a.getFailedPostureChecks(ctx, policy.SourcePostureChecks, source),
+			})
+		}
+	}
+
+	return matches
+}
+
+// getResourceFirewallRulesForPeer returns the route firewall rules of the resource routing peers that match the peer IP
+func (a *Account) getResourceFirewallRulesForPeer(ctx context.Context, resourceID, networkID string, peer *nbpeer.Peer, validatedPeersMap map[string]struct{}) []*RouteFirewallRule {
+	peerIP, ok := netip.AddrFromSlice(peer.IP)
+	if !ok {
+		return nil
+	}
+
+	resourcePolicies := a.GetResourcePoliciesMap()
+	resource := a.getNetworkResource(resourceID)
+
+	var rules []*RouteFirewallRule
+	for routerPeerID, router := range a.GetResourceRoutersMap()[networkID] {
+		routerPeer := a.GetPeer(routerPeerID)
+		if routerPeer == nil {
+			continue
+		}
+
+		routes := a.getNetworkResourcesRoutes(resource, routerPeerID, router, resourcePolicies)
+		for _, rule := range a.GetPeerNetworkResourceFirewallRules(ctx, routerPeer, validatedPeersMap, routes, resourcePolicies) {
+			for _, sourceRange := range rule.SourceRanges {
+				prefix, err := netip.ParsePrefix(sourceRange)
+				if err != nil {
+					log.WithContext(ctx).Debugf("failed to parse route firewall rule source range %s: %v", sourceRange, err)
+					continue
+				}
+				if prefix.Contains(peerIP.Unmap()) {
+					rules = append(rules, rule)
+					break
+				}
+			}
+		}
+	}
+
+	return rules
+}
+
+// getFailedPostureChecks returns the IDs of the posture checks the peer doesn't pass
+func (a *Account) getFailedPostureChecks(ctx context.Context, postureChecksIDs []string, peer *nbpeer.Peer) []string {
+	var failed []string
+	for _, postureChecksID := range postureChecksIDs {
+		postureChecks := a.GetPostureChecks(postureChecksID)
+		if postureChecks == nil {
+			continue
+		}
+
+		for _, check := range postureChecks.GetChecks() {
+			isValid, err := check.Check(ctx, *peer)
+			if err != nil {
+				log.WithContext(ctx).Debugf("an error occurred check %s: on peer: %s :%s", check.Name(), peer.ID, err.Error())
+			}
+			if !isValid {
+				failed = append(failed, postureChecksID)
+				break
+			}
+		}
+	}
+
+	return failed
+}
+
+func (a *Account) isPeerInGroups(peerID string, groupIDs []string) bool {
+	for _, groupID := range groupIDs {
+		group := a.GetGroup(groupID)
+		if group != nil && slices.Contains(group.Peers, peerID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Account) getNetworkResource(resourceID string) *resourceTypes.NetworkResource {
+	for _, resource := range a.NetworkResources {
+		if resource.ID == resourceID {
+			return resource
+		}
+	}
+	return nil
+}
+
+// matchesTraffic checks if the rule protocol and ports cover the given protocol and port
+func (pm *PolicyRule) matchesTraffic(protocol PolicyRuleProtocolType, port uint16) bool {
+	if pm.Protocol != PolicyRuleProtocolALL && pm.Protocol != protocol {
+		return false
+	}
+
+	if protocol == PolicyRuleProtocolALL || protocol == PolicyRuleProtocolICMP {
+		return true
+	}
+
+	if len(pm.Ports) == 0 && len(pm.PortRanges) == 0 {
+		return true
+	}
+
+	if slices.Contains(pm.Ports, strconv.Itoa(int(port))) {
+		return true
+	}
+
+	for _, portRange := range pm.PortRanges {
+		if port >= portRange.Start && port <= portRange.End {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetPeerAccessPairs returns all pairs of validated peers the account policies allow to connect to each other
+func (a *Account) GetPeerAccessPairs(ctx context.Context, validatedPeersMap map[string]struct{}) map[PeerAccessPair]struct{} {
+	pairs := make(map[PeerAccessPair]struct{})
+	for peerID := range validatedPeersMap {
+		if _, ok := a.Peers[peerID]; !ok {
+			continue
+		}
+
+		aclPeers, _ := a.GetPeerConnectionResources(ctx, peerID, validatedPeersMap)
+		for _, remotePeer := range aclPeers {
+			pairs[newPeerAccessPair(peerID, remotePeer.ID)] = struct{}{}
+		}
+	}
+
+	return pairs
+}
+
+// SimulatePolicyChange returns the peer pairs that would gain or lose access if the policy with the given ID was
+// replaced by the provided one. A nil policy simulates the removal of the policy.
+func (a *Account) SimulatePolicyChange(ctx context.Context, policyID string, policy *Policy, validatedPeersMap map[string]struct{}) *PolicyAccessDiff {
+	before := a.GetPeerAccessPairs(ctx, validatedPeersMap)
+
+	simulated := a.Copy()
+	simulated.Policies = slices.DeleteFunc(simulated.Policies, func(p *Policy) bool {
+		return p.ID == policyID
+	})
+	if policy != nil {
+		simulated.Policies = append(simulated.Policies, policy.Copy())
+	}
+	after := simulated.GetPeerAccessPairs(ctx, validatedPeersMap)
+
+	diff := &PolicyAccessDiff{}
+	for pair := range after {
+		if _, ok := before[pair]; !ok {
+			diff.Gained = append(diff.Gained, pair)
+		}
+	}
+	for pair := range before {
+		if _, ok := after[pair]; !ok {
+			diff.Lost = append(diff.Lost, pair)
+		}
+	}
+	sortPeerAccessPairs(diff.Gained)
+	sortPeerAccessPairs(diff.Lost)
+
+	return diff
+}
+
+func newPeerAccessPair(peerID, remotePeerID string) PeerAccessPair {
+	if peerID > remotePeerID {
+		peerID, remotePeerID = remotePeerID, peerID
+	}
+	return PeerAccessPair{PeerID: peerID, RemotePeerID: remotePeerID}
+}
+
+func sortPeerAccessPairs(pairs []PeerAccessPair) {
+	slices.SortFunc(pairs, func(a, b PeerAccessPair) int {
+		if c := strings.Compare(a.PeerID, b.PeerID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.RemotePeerID, b.RemotePeerID)
+	})
+}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func setupExplainTestAccount() *Account {
+	return &Account{
+		Id: "accountID",
+		Peers: map[string]*nbpeer.Peer{
+			"peerA": {ID: "peerA", Name: "peer-a", IP: net.IP{100, 64, 0, 1}, Status: &nbpeer.PeerStatus{}},
+			"peerB": {ID: "peerB", Name: "peer-b", IP: net.IP{100, 64, 0, 2}, Status: &nbpeer.PeerStatus{}},
+			"peerC": {ID: "peerC", Name: "peer-c", IP: net.IP{100, 64, 0, 3}, Status: &nbpeer.PeerStatus{}},
+		},
+		Groups: map[string]*Group{
+			"groupA": {ID: "groupA", Name: "A", Peers: []string{"peerA"}},
+			"groupB": {ID: "groupB", Name: "B", Peers: []string{"peerB"}},
+			"groupC": {ID: "groupC", Name: "C", Peers: []string{"peerC"}},
+		},
+		Policies: []*Policy{
+			{
+				ID:      "ssh",
+				Name:    "SSH",
+				Enabled: true,
+				Rules: []*PolicyRule{
+					{
+						ID:           "ssh",
+						Name:         "SSH",
+						Enabled:      true,
+						Action:       PolicyTrafficActionAccept,
+						Protocol:     PolicyRuleProtocolTCP,
+						Ports:        []string{"22"},
+						Sources:      []string{"groupA"},
+						Destinations: []string{"groupB"},
+					},
+				},
+			},
+		},
+		Network:  &Network{},
+		Settings: &Settings{},
+	}
+}
+
+func TestAccount_ExplainConnection(t *testing.T) {
+	account := setupExplainTestAccount()
+	validatedPeers := map[string]struct{}{"peerA": {}, "peerB": {}, "peerC": {}}
+
+	tests := []struct {
+		name     string
+		req      PolicyExplainRequest
+		allowed  bool
+		matches  int
+		notFound bool
+	}{
+		{
+			name:    "allowed by port rule",
+			req:     PolicyExplainRequest{SourcePeerID: "peerA", DestinationPeerID: "peerB", Protocol: PolicyRuleProtocolTCP, Port: 22},
+			allowed: true,
+			matches: 1,
+		},
+		{
+			name: "rule is not bidirectional",
+			req:  PolicyExplainRequest{SourcePeerID: "peerB", DestinationPeerID: "peerA", Protocol: PolicyRuleProtocolTCP, Port: 22},
+		},
+		{
+			name: "port is not covered",
+			req:  PolicyExplainRequest{SourcePeerID: "peerA", DestinationPeerID: "peerB", Protocol: PolicyRuleProtocolTCP, Port: 443},
+		},
+		{
+			name: "protocol is not covered",
+			req:  PolicyExplainRequest{SourcePeerID: "peerA", DestinationPeerID: "peerB", Protocol: PolicyRuleProtocolUDP, Port: 22},
+		},
+		{
+			name: "no policy between peers",
+			req:  PolicyExplainRequest{SourcePeerID: "peerA", DestinationPeerID: "peerC", Protocol: PolicyRuleProtocolTCP, Port: 22},
+		},
+		{
+			name:     "unknown peer",
+			req:      PolicyExplainRequest{SourcePeerID: "peerA", DestinationPeerID: "peerX", Protocol: PolicyRuleProtocolTCP, Port: 22},
+			notFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			explanation, err := account.ExplainConnection(context.Background(), &tt.req, validatedPeers)
+			if tt.notFound {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, explanation.Allowed, explanation.Reason)
+			assert.Len(t, explanation.MatchedRules, tt.matches)
+		})
+	}
+
+	t.Run("not approved peer", func(t *testing.T) {
+		req := &PolicyExplainRequest{SourcePeerID: "peerA", DestinationPeerID: "peerB", Protocol: PolicyRuleProtocolTCP, Port: 22}
+		explanation, err := account.ExplainConnection(context.Background(), req, map[string]struct{}{"peerA": {}})
+		require.NoError(t, err)
+		assert.False(t, explanation.Allowed)
+		assert.Len(t, explanation.MatchedRules, 1)
+	})
+
+	t.Run("drop rule takes precedence", func(t *testing.T) {
+		dropAccount := account.Copy()
+		dropAccount.Policies = append(dropAccount.Policies, &Policy{
+			ID:      "drop",
+			Name:    "Drop",
+			Enabled: true,
+			Rules: []*PolicyRule{
+				{
+					ID:            "drop",
+					Name:          "Drop",
+					Enabled:       true,
+					Action:        PolicyTrafficActionDrop,
+					Protocol:      PolicyRuleProtocolALL,
+					Bidirectional: true,
+					Sources:       []string{"groupA"},
+					Destinations:  []string{"groupB"},
+				},
+			},
+		})
+		req := &PolicyExplainRequest{SourcePeerID: "peerA", DestinationPeerID: "peerB", Protocol: PolicyRuleProtocolTCP, Port: 22}
+		explanation, err := dropAccount.ExplainConnection(context.Background(), req, validatedPeers)
+		require.NoError(t, err)
+		assert.False(t, explanation.Allowed)
+		assert.Len(t, explanation.MatchedRules, 2)
+	})
+}
+
+func TestAccount_SimulatePolicyChange(t *testing.T) {
+	account := setupExplainTestAccount()
+	validatedPeers := map[string]struct{}{"peerA": {}, "peerB": {}, "peerC": {}}
+
+	diff := account.SimulatePolicyChange(context.Background(), "ssh", nil, validatedPeers)
+	assert.Empty(t, diff.Gained)
+	assert.Equal(t, []PeerAccessPair{{PeerID: "peerA", RemotePeerID: "peerB"}}, diff.Lost)
+
+	replacement := account.Policies[0].Copy()
+	replacement.Rules[0].Destinations = []string{"groupC"}
+	diff = account.SimulatePolicyChange(context.Background(), "ssh", replacement, validatedPeers)
+	assert.Equal(t, []PeerAccessPair{{PeerID: "peerA", RemotePeerID: "peerC"}}, diff.Gained)
+	assert.Equal(t, []PeerAccessPair{{PeerID: "peerA", RemotePeerID: "peerB"}}, diff.Lost)
+
+	// the account itself is not modified by the simulation
+	assert.Len(t, account.Policies, 1)
+	assert.Equal(t, []string{"groupB"}, account.Policies[0].Rules[0].Destinations)
+}
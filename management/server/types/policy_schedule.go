@@ -0,0 +1,220 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// scheduleLookaheadDays is how far ahead recurring windows are expanded when searching for the next transition.
+// A week plus one day covers every weekly window including the ones that wrap over midnight.
+const scheduleLookaheadDays = 8
+
+// PolicyRuleTimeWindow is a recurring weekly window in which a policy rule is active
+type PolicyRuleTimeWindow struct {
+	// Days of the week the window starts on. An empty list means every day
+	Days []time.Weekday
+
+	// Start time of the window in the HH:MM format
+	Start string
+
+	// End time of the window in the HH:MM format. If End is not after Start the window ends on the next day
+	End string
+}
+
+// PolicyRuleSchedule restricts a policy rule to recurring time windows and an optional absolute validity period
+type PolicyRuleSchedule struct {
+	// TimeZone is the IANA name of the time zone the windows are evaluated in. UTC is used when empty
+	TimeZone string
+
+	// Windows when the rule is active. The rule is active for the whole validity period when empty
+	Windows []PolicyRuleTimeWindow
+
+	// StartsAt is an optional time before which the rule is inactive
+	StartsAt *time.Time
+
+	// EndsAt is an optional time from which the rule is inactive
+	EndsAt *time.Time
+}
+
+// Copy returns a copy of the schedule
+func (s *PolicyRuleSchedule) Copy() *PolicyRuleSchedule {
+	if s == nil {
+		return nil
+	}
+
+	c := &PolicyRuleSchedule{
+		TimeZone: s.TimeZone,
+		Windows:  make([]PolicyRuleTimeWindow, len(s.Windows)),
+	}
+	for i, w := range s.Windows {
+		c.Windows[i] = PolicyRuleTimeWindow{
+			Days:  make([]time.Weekday, len(w.Days)),
+			Start: w.Start,
+			End:   w.End,
+		}
+		copy(c.Windows[i].Days, w.Days)
+	}
+	if s.StartsAt != nil {
+		startsAt := *s.StartsAt
+		c.StartsAt = &startsAt
+	}
+	if s.EndsAt != nil {
+		endsAt := *s.EndsAt
+		c.EndsAt = &endsAt
+	}
+	return c
+}
+
+// Validate checks that the schedule time zone, windows and validity period are well-formed
+func (s *PolicyRuleSchedule) Validate() error {
+	if _, err := time.LoadLocation(s.TimeZone); err != nil {
+		return fmt.Errorf("invalid time zone %q", s.TimeZone)
+	}
+
+	if s.StartsAt != nil && s.EndsAt != nil && !s.EndsAt.After(*s.StartsAt) {
+		return fmt.Errorf("schedule end time must be after its start time")
+	}
+
+	for _, w := range s.Windows {
+		if _, err := parseMinuteOfDay(w.Start); err != nil {
+			return fmt.Errorf("invalid window start time %q: %w", w.Start, err)
+		}
+		if _, err := parseMinuteOfDay(w.End); err != nil {
+			return fmt.Errorf("invalid window end time %q: %w", w.End, err)
+		}
+		for _, day := range w.Days {
+			if day < time.Sunday || day > time.Saturday {
+				return fmt.Errorf("invalid window day %d", day)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsActive reports whether the schedule allows the rule to be applied at the given time
+func (s *PolicyRuleSchedule) IsActive(now time.Time) bool {
+	if s.StartsAt != nil && now.Before(*s.StartsAt) {
+		return false
+	}
+
+	if s.EndsAt != nil && !now.Before(*s.EndsAt) {
+		return false
+	}
+
+	if len(s.Windows) == 0 {
+		return true
+	}
+
+	local := now.In(s.location())
+	for _, w := range s.Windows {
+		if w.contains(local) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextTransition returns the first time after now at which the schedule changes its active state.
+// The second return value is false if the state will not change anymore.
+func (s *PolicyRuleSchedule) NextTransition(now time.Time) (time.Time, bool) {
+	active := s.IsActive(now)
+
+	var next time.Time
+	for _, candidate := range s.transitionCandidates(now) {
+		if !candidate.After(now) || (!next.IsZero() && !candidate.Before(next)) {
+			continue
+		}
+		if s.IsActive(candidate) != active {
+			next = candidate
+		}
+	}
+
+	return next, !next.IsZero()
+}
+
+// transitionCandidates returns the validity period bounds and every window boundary in the lookahead period
+func (s *PolicyRuleSchedule) transitionCandidates(now time.Time) []time.Time {
+	var candidates []time.Time
+	if s.StartsAt != nil {
+		candidates = append(candidates, *s.StartsAt)
+	}
+	if s.EndsAt != nil {
+		candidates = append(candidates, *s.EndsAt)
+	}
+
+	loc := s.location()
+	local := now.In(loc)
+	for _, w := range s.Windows {
+		start, _ := parseMinuteOfDay(w.Start)
+		end, _ := parseMinuteOfDay(w.End)
+		// start from the day before to catch the end of a window that wraps over midnight
+		for i := -1; i < scheduleLookaheadDays; i++ {
+			day := local.Day() + i
+			if !w.startsOn(time.Date(local.Year(), local.Month(), day, 0, 0, 0, 0, loc).Weekday()) {
+				continue
+			}
+			// build the boundaries from the wall clock, adding durations to midnight is off by an hour on DST changes
+			candidates = append(candidates, time.Date(local.Year(), local.Month(), day, start/60, start%60, 0, 0, loc))
+			if end <= start {
+				day++
+			}
+			candidates = append(candidates, time.Date(local.Year(), local.Month(), day, end/60, end%60, 0, 0, loc))
+		}
+	}
+
+	return candidates
+}
+
+func (s *PolicyRuleSchedule) location() *time.Location {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// contains checks if the given local time falls into the window
+func (w PolicyRuleTimeWindow) contains(local time.Time) bool {
+	start, err := parseMinuteOfDay(w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseMinuteOfDay(w.End)
+	if err != nil {
+		return false
+	}
+
+	minute := local.Hour()*60 + local.Minute()
+	if start < end {
+		return w.startsOn(local.Weekday()) && minute >= start && minute < end
+	}
+
+	// the window wraps over midnight
+	if minute >= start {
+		return w.startsOn(local.Weekday())
+	}
+	return minute < end && w.startsOn((local.Weekday()+6)%7)
+}
+
+func (w PolicyRuleTimeWindow) startsOn(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// parseMinuteOfDay parses HH:MM into the number of minutes since midnight
+func parseMinuteOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
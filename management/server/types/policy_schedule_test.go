@@ -0,0 +1,135 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyRuleSchedule_IsActive(t *testing.T) {
+	// 2025-01-06 is a Monday
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2025, 1, 6, hour, minute, 0, 0, time.UTC)
+	}
+	startsAt := monday(0, 0)
+	endsAt := monday(12, 0)
+
+	tests := []struct {
+		name     string
+		schedule PolicyRuleSchedule
+		now      time.Time
+		expected bool
+	}{
+		{
+			name: "inside working hours",
+			schedule: PolicyRuleSchedule{
+				Windows: []PolicyRuleTimeWindow{{Days: []time.Weekday{time.Monday}, Start: "09:00", End: "17:00"}},
+			},
+			now:      monday(10, 0),
+			expected: true,
+		},
+		{
+			name: "window end is exclusive",
+			schedule: PolicyRuleSchedule{
+				Windows: []PolicyRuleTimeWindow{{Days: []time.Weekday{time.Monday}, Start: "09:00", End: "17:00"}},
+			},
+			now:      monday(17, 0),
+			expected: false,
+		},
+		{
+			name: "different day",
+			schedule: PolicyRuleSchedule{
+				Windows: []PolicyRuleTimeWindow{{Days: []time.Weekday{time.Tuesday}, Start: "09:00", End: "17:00"}},
+			},
+			now:      monday(10, 0),
+			expected: false,
+		},
+		{
+			name: "overnight window started on the previous day",
+			schedule: PolicyRuleSchedule{
+				Windows: []PolicyRuleTimeWindow{{Days: []time.Weekday{time.Sunday}, Start: "22:00", End: "06:00"}},
+			},
+			now:      monday(5, 0),
+			expected: true,
+		},
+		{
+			name: "window in another time zone",
+			schedule: PolicyRuleSchedule{
+				TimeZone: "America/New_York",
+				Windows:  []PolicyRuleTimeWindow{{Start: "09:00", End: "17:00"}},
+			},
+			now:      monday(10, 0),
+			expected: false,
+		},
+		{
+			name:     "after the validity period",
+			schedule: PolicyRuleSchedule{StartsAt: &startsAt, EndsAt: &endsAt},
+			now:      monday(12, 0),
+			expected: false,
+		},
+		{
+			name:     "within the validity period",
+			schedule: PolicyRuleSchedule{StartsAt: &startsAt, EndsAt: &endsAt},
+			now:      monday(11, 59),
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.schedule.Validate())
+			assert.Equal(t, tt.expected, tt.schedule.IsActive(tt.now))
+		})
+	}
+}
+
+func TestPolicyRuleSchedule_NextTransition(t *testing.T) {
+	schedule := PolicyRuleSchedule{
+		Windows: []PolicyRuleTimeWindow{
+			{Days: []time.Weekday{time.Monday}, Start: "09:00", End: "12:00"},
+			{Days: []time.Weekday{time.Monday}, Start: "11:00", End: "17:00"},
+		},
+	}
+
+	// Monday 08:00, the window opens at 09:00
+	next, ok := schedule.NextTransition(time.Date(2025, 1, 6, 8, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC), next)
+
+	// overlapping windows close at 17:00
+	next, ok = schedule.NextTransition(time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2025, 1, 6, 17, 0, 0, 0, time.UTC), next)
+
+	// after the window the next one opens a week later
+	next, ok = schedule.NextTransition(time.Date(2025, 1, 6, 18, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC), next)
+
+	// the window opens at 09:00 wall clock time on the day the clocks are set forward
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	dst := PolicyRuleSchedule{
+		TimeZone: "Europe/Berlin",
+		Windows:  []PolicyRuleTimeWindow{{Days: []time.Weekday{time.Sunday}, Start: "09:00", End: "17:00"}},
+	}
+	next, ok = dst.NextTransition(time.Date(2025, 3, 30, 1, 0, 0, 0, berlin))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 30, 9, 0, 0, 0, berlin), next.In(berlin))
+
+	endsAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	expired := PolicyRuleSchedule{EndsAt: &endsAt}
+	_, ok = expired.NextTransition(time.Date(2025, 1, 6, 8, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestPolicyRuleSchedule_Validate(t *testing.T) {
+	assert.Error(t, (&PolicyRuleSchedule{TimeZone: "Mars/Olympus"}).Validate())
+	assert.Error(t, (&PolicyRuleSchedule{Windows: []PolicyRuleTimeWindow{{Start: "25:00", End: "10:00"}}}).Validate())
+	assert.Error(t, (&PolicyRuleSchedule{Windows: []PolicyRuleTimeWindow{{Days: []time.Weekday{7}, Start: "09:00", End: "10:00"}}}).Validate())
+
+	now := time.Now()
+	assert.Error(t, (&PolicyRuleSchedule{StartsAt: &now, EndsAt: &now}).Validate())
+}
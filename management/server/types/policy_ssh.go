@@ -0,0 +1,157 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// SSHLocalUserPlaceholder is a local user of an SSH authorization that maps the NetBird user owning the source peer
+// to their own local OS user, see User.SSHUsername
+const SSHLocalUserPlaceholder = "${user}"
+
+// maxSSHUsernameLength is the longest user name accepted by the common Linux tools
+const maxSSHUsernameLength = 32
+
+// SSHAuthorization binds source peers of a policy rule to the local OS users they may log in as
+// with the NetBird SSH server of the rule destination peers
+type SSHAuthorization struct {
+	// Groups limits the authorization to the source peers in any of the groups
+	Groups []string
+
+	// Users limits the authorization to the source peers owned by any of the NetBird users.
+	// The authorization applies to all source peers of the rule when both Groups and Users are empty
+	Users []string
+
+	// LocalUsers are the local OS users the peers may log in as. SSHLocalUserPlaceholder maps the owner of
+	// the source peer to their own local OS user
+	LocalUsers []string
+}
+
+// Copy returns a copy of the SSH authorization
+func (s SSHAuthorization) Copy() SSHAuthorization {
+	return SSHAuthorization{
+		Groups:     slices.Clone(s.Groups),
+		Users:      slices.Clone(s.Users),
+		LocalUsers: slices.Clone(s.LocalUsers),
+	}
+}
+
+// Validate checks that the authorization grants at least one valid local user
+func (s SSHAuthorization) Validate() error {
+	if len(s.LocalUsers) == 0 {
+		return fmt.Errorf("at least one local user is required")
+	}
+
+	for _, localUser := range s.LocalUsers {
+		invalidRune := func(r rune) bool {
+			return unicode.IsSpace(r) || unicode.IsControl(r) || r == ':' || r == '/'
+		}
+		if localUser == "" || strings.ContainsFunc(localUser, invalidRune) {
+			return fmt.Errorf("invalid local user %q", localUser)
+		}
+	}
+
+	return nil
+}
+
+// GetPeerSSHAuthorizedUsers returns the local OS users the remote peers may log in as with the SSH server of the peer,
+// indexed by the remote peer ID. It returns nil if no active rule with SSH authorizations has the peer
+// as a destination, in which case the SSH server doesn't restrict the local users
+func (a *Account) GetPeerSSHAuthorizedUsers(ctx context.Context, peerID string, validatedPeersMap map[string]struct{}) map[string][]string {
+	var authorizedUsers map[string][]string
+	now := time.Now()
+	for _, policy := range a.Policies {
+		if !policy.Enabled {
+			continue
+		}
+
+		for _, rule := range policy.Rules {
+			if len(rule.SSHAuthorizations) == 0 || rule.Action != PolicyTrafficActionAccept || !rule.IsActive(now) {
+				continue
+			}
+
+			_, peerInDestinations := a.getAllPeersFromGroups(ctx, rule.Destinations, peerID, nil, validatedPeersMap)
+			if !peerInDestinations {
+				continue
+			}
+
+			if authorizedUsers == nil {
+				authorizedUsers = make(map[string][]string)
+			}
+
+			sourcePeers, _ := a.getAllPeersFromGroups(ctx, rule.Sources, peerID, policy.SourcePostureChecks, validatedPeersMap)
+			for _, authorization := range rule.SSHAuthorizations {
+				groupPeers := make(map[string]struct{})
+				for _, id := range a.getUniquePeerIDsFromGroupsIDs(ctx, authorization.Groups) {
+					groupPeers[id] = struct{}{}
+				}
+
+				for _, source := range sourcePeers {
+					_, inGroups := groupPeers[source.ID]
+					matchAll := len(authorization.Groups) == 0 && len(authorization.Users) == 0
+					if matchAll || inGroups || (source.UserID != "" && slices.Contains(authorization.Users, source.UserID)) {
+						authorizedUsers[source.ID] = append(authorizedUsers[source.ID], a.sshLocalUsers(authorization, source.UserID)...)
+					}
+				}
+			}
+		}
+	}
+
+	for id, localUsers := range authorizedUsers {
+		slices.Sort(localUsers)
+		authorizedUsers[id] = slices.Compact(localUsers)
+	}
+
+	return authorizedUsers
+}
+
+// sshLocalUsers returns the local users of the authorization with the placeholder replaced by the local OS user of
+// the peer owner. The placeholder is dropped for peers without an owner or owners without a known user name
+func (a *Account) sshLocalUsers(authorization SSHAuthorization, ownerID string) []string {
+	if !slices.Contains(authorization.LocalUsers, SSHLocalUserPlaceholder) {
+		return authorization.LocalUsers
+	}
+
+	localUsers := make([]string, 0, len(authorization.LocalUsers))
+	for _, localUser := range authorization.LocalUsers {
+		if localUser != SSHLocalUserPlaceholder {
+			localUsers = append(localUsers, localUser)
+			continue
+		}
+		if owner, ok := a.Users[ownerID]; ok && owner.SSHUsername != "" {
+			localUsers = append(localUsers, owner.SSHUsername)
+		}
+	}
+	return localUsers
+}
+
+// SSHUsernameFromUserData derives the local OS user name of a NetBird user from the local part of their email or,
+// without an email, from their name. Only lowercase letters, digits, dots, underscores and dashes are kept, as
+// portable user names are limited to them. It returns an empty string when no valid user name can be derived
+func SSHUsernameFromUserData(email, name string) string {
+	source := name
+	if localPart, _, ok := strings.Cut(email, "@"); ok && localPart != "" {
+		source = localPart
+	}
+
+	var username strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(source)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			username.WriteRune(r)
+		case unicode.IsSpace(r):
+			username.WriteRune('.')
+		}
+	}
+
+	// user names can't start with a dash or a dot
+	result := strings.TrimLeft(username.String(), "-.")
+	if len(result) > maxSSHUsernameLength {
+		result = result[:maxSSHUsernameLength]
+	}
+	return result
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func setupSSHTestAccount() *Account {
+	return &Account{
+		Id: "accountID",
+		Peers: map[string]*nbpeer.Peer{
+			"opsPeer":    {ID: "opsPeer", UserID: "alice", IP: net.IP{100, 64, 0, 1}, Status: &nbpeer.PeerStatus{}},
+			"devPeer":    {ID: "devPeer", UserID: "bob", IP: net.IP{100, 64, 0, 2}, Status: &nbpeer.PeerStatus{}},
+			"serverPeer": {ID: "serverPeer", IP: net.IP{100, 64, 0, 3}, Status: &nbpeer.PeerStatus{}},
+		},
+		Groups: map[string]*Group{
+			"ops":     {ID: "ops", Name: "ops", Peers: []string{"opsPeer"}},
+			"devs":    {ID: "devs", Name: "devs", Peers: []string{"devPeer"}},
+			"servers": {ID: "servers", Name: "servers", Peers: []string{"serverPeer"}},
+		},
+		Policies: []*Policy{
+			{
+				ID:      "ssh",
+				Name:    "SSH",
+				Enabled: true,
+				Rules: []*PolicyRule{
+					{
+						ID:            "ssh",
+						Name:          "SSH",
+						Enabled:       true,
+						Action:        PolicyTrafficActionAccept,
+						Protocol:      PolicyRuleProtocolALL,
+						Bidirectional: true,
+						Sources:       []string{"ops", "devs"},
+						Destinations:  []string{"servers"},
+						SSHAuthorizations: []SSHAuthorization{
+							{Groups: []string{"ops"}, LocalUsers: []string{"root"}},
+							{Users: []string{"alice"}, LocalUsers: []string{"alice"}},
+							{Users: []string{"bob"}, LocalUsers: []string{"bob"}},
+						},
+					},
+				},
+			},
+		},
+		Network:  &Network{},
+		Settings: &Settings{},
+	}
+}
+
+func TestAccount_GetPeerSSHAuthorizedUsers(t *testing.T) {
+	validatedPeers := map[string]struct{}{"opsPeer": {}, "devPeer": {}, "serverPeer": {}}
+
+	t.Run("groups and owners are mapped to local users", func(t *testing.T) {
+		account := setupSSHTestAccount()
+		users := account.GetPeerSSHAuthorizedUsers(context.Background(), "serverPeer", validatedPeers)
+		assert.Equal(t, map[string][]string{
+			"opsPeer": {"alice", "root"},
+			"devPeer": {"bob"},
+		}, users)
+	})
+
+	t.Run("authorizations apply only to destination peers", func(t *testing.T) {
+		account := setupSSHTestAccount()
+		users := account.GetPeerSSHAuthorizedUsers(context.Background(), "opsPeer", validatedPeers)
+		assert.Nil(t, users, "bidirectional rules shouldn't restrict the SSH server of the sources")
+	})
+
+	t.Run("authorization without groups and users applies to all sources", func(t *testing.T) {
+		account := setupSSHTestAccount()
+		account.Policies[0].Rules[0].SSHAuthorizations = []SSHAuthorization{{LocalUsers: []string{"ubuntu"}}}
+		users := account.GetPeerSSHAuthorizedUsers(context.Background(), "serverPeer", validatedPeers)
+		assert.Equal(t, map[string][]string{
+			"opsPeer": {"ubuntu"},
+			"devPeer": {"ubuntu"},
+		}, users)
+	})
+
+	t.Run("sources not matching any authorization get no local users", func(t *testing.T) {
+		account := setupSSHTestAccount()
+		account.Policies[0].Rules[0].SSHAuthorizations = []SSHAuthorization{{Groups: []string{"ops"}, LocalUsers: []string{"root"}}}
+		users := account.GetPeerSSHAuthorizedUsers(context.Background(), "serverPeer", validatedPeers)
+		assert.NotNil(t, users)
+		assert.Equal(t, []string{"root"}, users["opsPeer"])
+		assert.Empty(t, users["devPeer"])
+	})
+
+	t.Run("disabled rules don't restrict the local users", func(t *testing.T) {
+		account := setupSSHTestAccount()
+		account.Policies[0].Rules[0].Enabled = false
+		users := account.GetPeerSSHAuthorizedUsers(context.Background(), "serverPeer", validatedPeers)
+		assert.Nil(t, users)
+	})
+
+	t.Run("placeholder maps owners to their own local users", func(t *testing.T) {
+		account := setupSSHTestAccount()
+		account.Users = map[string]*User{
+			"alice": {Id: "alice", SSHUsername: "alice.smith"},
+			"bob":   {Id: "bob"},
+		}
+		account.Policies[0].Rules[0].SSHAuthorizations = []SSHAuthorization{{LocalUsers: []string{SSHLocalUserPlaceholder, "ubuntu"}}}
+		users := account.GetPeerSSHAuthorizedUsers(context.Background(), "serverPeer", validatedPeers)
+		assert.Equal(t, map[string][]string{
+			"opsPeer": {"alice.smith", "ubuntu"},
+			"devPeer": {"ubuntu"},
+		}, users, "owners without a user name shouldn't get the placeholder")
+	})
+}
+
+func TestSSHUsernameFromUserData(t *testing.T) {
+	tests := []struct {
+		email    string
+		name     string
+		expected string
+	}{
+		{email: "Alice.Smith@example.com", name: "Alice", expected: "alice.smith"},
+		{email: "", name: "Bob Jones", expected: "bob.jones"},
+		{email: "-admin+ops@example.com", expected: "adminops"},
+		{email: "@example.com", name: "carol", expected: "carol"},
+		{email: "dave_o'neil@example.com", expected: "dave_oneil"},
+		{email: "", name: "Ünïcödé", expected: "ncd"},
+		{email: "", name: "", expected: ""},
+		{email: "a-very-long-user-name-exceeding-the-limit@example.com", expected: "a-very-long-user-name-exceeding-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SSHUsernameFromUserData(tt.email, tt.name))
+		})
+	}
+}
+
+func TestSSHAuthorization_Validate(t *testing.T) {
+	assert.NoError(t, SSHAuthorization{LocalUsers: []string{"root", `DOMAIN\user`}}.Validate())
+	assert.NoError(t, SSHAuthorization{LocalUsers: []string{SSHLocalUserPlaceholder}}.Validate())
+	assert.Error(t, SSHAuthorization{Groups: []string{"ops"}}.Validate(), "local users are required")
+	assert.Error(t, SSHAuthorization{LocalUsers: []string{""}}.Validate())
+	assert.Error(t, SSHAuthorization{LocalUsers: []string{"root user"}}.Validate())
+	assert.Error(t, SSHAuthorization{LocalUsers: []string{"root:x"}}.Validate())
+}
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // PolicyUpdateOperationType operation type
 type PolicyUpdateOperationType int
 
@@ -61,6 +63,13 @@ type PolicyRule struct {
 
 	// PortRanges a list of port ranges.
 	PortRanges []RulePortRange `gorm:"serializer:json"`
+
+	// Schedule optionally restricts the rule to time windows. The rule is always applied when it is nil
+	Schedule *PolicyRuleSchedule `gorm:"serializer:json"`
+
+	// SSHAuthorizations bind the source peers to the local OS users they may log in as with the NetBird SSH server
+	// of the destination peers. Destination peers with SSH authorizations deny logins that none of them allows
+	SSHAuthorizations []SSHAuthorization `gorm:"serializer:json"`
 }
 
 // Copy returns a copy of a policy rule
@@ -80,10 +89,23 @@ func (pm *PolicyRule) Copy() *PolicyRule {
 		Protocol:            pm.Protocol,
 		Ports:               make([]string, len(pm.Ports)),
 		PortRanges:          make([]RulePortRange, len(pm.PortRanges)),
+		Schedule:            pm.Schedule.Copy(),
+		SSHAuthorizations:   make([]SSHAuthorization, len(pm.SSHAuthorizations)),
 	}
 	copy(rule.Destinations, pm.Destinations)
 	copy(rule.Sources, pm.Sources)
 	copy(rule.Ports, pm.Ports)
 	copy(rule.PortRanges, pm.PortRanges)
+	for i, authorization := range pm.SSHAuthorizations {
+		rule.SSHAuthorizations[i] = authorization.Copy()
+	}
 	return rule
 }
+
+// IsActive returns true if the rule is enabled and its schedule, if any, allows it at the given time
+func (pm *PolicyRule) IsActive(now time.Time) bool {
+	if !pm.Enabled {
+		return false
+	}
+	return pm.Schedule == nil || pm.Schedule.IsActive(now)
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"slices"
+)
+
+// CustomRole is an account defined role granting regular users access to a subset of the management modules
+type CustomRole struct {
+	// ID of the role
+	ID string `gorm:"primaryKey"`
+	// AccountID is a reference to the Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+	// Name of the role
+	Name string
+	// Description of the role
+	Description string
+	// Permissions granted by the role, one per module
+	Permissions []RolePermission `gorm:"serializer:json"`
+}
+
+// RolePermission grants access to a single management module
+type RolePermission struct {
+	// Module the permission applies to, e.g. routes or policies
+	Module string
+	// Read allows viewing the module objects
+	Read bool
+	// Write allows creating, updating and deleting the module objects
+	Write bool
+	// Groups optionally limits write access to objects associated with the given group IDs only
+	Groups []string
+}
+
+// Copy returns a copy of the custom role
+func (r *CustomRole) Copy() *CustomRole {
+	permissions := make([]RolePermission, len(r.Permissions))
+	for i, p := range r.Permissions {
+		permissions[i] = RolePermission{
+			Module: p.Module,
+			Read:   p.Read,
+			Write:  p.Write,
+			Groups: slices.Clone(p.Groups),
+		}
+	}
+
+	return &CustomRole{
+		ID:          r.ID,
+		AccountID:   r.AccountID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: permissions,
+	}
+}
+
+// GetPermission returns the permission of the role for the given module
+func (r *CustomRole) GetPermission(module string) (RolePermission, bool) {
+	for _, p := range r.Permissions {
+		if p.Module == module {
+			return p, true
+		}
+	}
+	return RolePermission{}, false
+}
+
+// EventMeta returns activity event meta related to the custom role
+func (r *CustomRole) EventMeta() map[string]any {
+	return map[string]any{"name": r.Name}
+}
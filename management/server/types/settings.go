@@ -1,6 +1,7 @@
 package types
 
 import (
+	"slices"
 	"time"
 
 	"github.com/netbirdio/netbird/management/server/account"
@@ -41,6 +42,31 @@ type Settings struct {
 	// RoutingPeerDNSResolutionEnabled enabled the DNS resolution on the routing peers
 	RoutingPeerDNSResolutionEnabled bool
 
+	// IPv6Enabled assigns an IPv6 overlay address to the peers in addition to their IPv4 address
+	IPv6Enabled bool `gorm:"column:ipv6_enabled"`
+
+	// PeerApprovalRequired puts newly registered peers into a pending state until an administrator approves them.
+	// Pending peers get an empty network map and are excluded from the network maps of the other peers
+	PeerApprovalRequired bool
+
+	// PeerApprovalSetupKeys limits the approval to the peers registered with the given setup key IDs
+	PeerApprovalSetupKeys []string `gorm:"serializer:json"`
+
+	// PeerApprovalUsers limits the approval to the peers registered by the given user IDs.
+	// All new peers require approval when neither setup keys nor users are set
+	PeerApprovalUsers []string `gorm:"serializer:json"`
+
+	// PeerApprovalTimeout is the period after which a pending peer is rejected. Pending peers don't expire when zero
+	PeerApprovalTimeout time.Duration
+
+	// RelayBytesPerSecond limits the bandwidth of each peer of the account on the relay servers. It can't lift the
+	// per-peer limit of the relay servers, which applies alone when zero
+	RelayBytesPerSecond int64
+
+	// RelayMessagesPerSecond limits the message rate of each peer of the account on the relay servers. It can't lift
+	// the per-peer limit of the relay servers, which applies alone when zero
+	RelayMessagesPerSecond int64
+
 	// Extra is a dictionary of Account settings
 	Extra *account.ExtraSettings `gorm:"embedded;embeddedPrefix:extra_"`
 }
@@ -60,9 +86,33 @@ func (s *Settings) Copy() *Settings {
 		PeerInactivityExpiration:        s.PeerInactivityExpiration,
 
 		RoutingPeerDNSResolutionEnabled: s.RoutingPeerDNSResolutionEnabled,
+		IPv6Enabled:                     s.IPv6Enabled,
+
+		PeerApprovalRequired:  s.PeerApprovalRequired,
+		PeerApprovalSetupKeys: slices.Clone(s.PeerApprovalSetupKeys),
+		PeerApprovalUsers:     slices.Clone(s.PeerApprovalUsers),
+		PeerApprovalTimeout:   s.PeerApprovalTimeout,
+
+		RelayBytesPerSecond:    s.RelayBytesPerSecond,
+		RelayMessagesPerSecond: s.RelayMessagesPerSecond,
 	}
 	if s.Extra != nil {
 		settings.Extra = s.Extra.Copy()
 	}
 	return settings
 }
+
+// PeerRequiresApproval returns true if a new peer registered with the setup key or by the user has to be approved
+// by an administrator
+func (s *Settings) PeerRequiresApproval(setupKeyID, userID string) bool {
+	if !s.PeerApprovalRequired {
+		return false
+	}
+
+	if len(s.PeerApprovalSetupKeys) == 0 && len(s.PeerApprovalUsers) == 0 {
+		return true
+	}
+
+	return (setupKeyID != "" && slices.Contains(s.PeerApprovalSetupKeys, setupKeyID)) ||
+		(userID != "" && slices.Contains(s.PeerApprovalUsers, userID))
+}
@@ -51,6 +51,7 @@ type UserInfo struct {
 	Email                string                                     `json:"email"`
 	Name                 string                                     `json:"name"`
 	Role                 string                                     `json:"role"`
+	CustomRoleID         string                                     `json:"custom_role_id"`
 	AutoGroups           []string                                   `json:"auto_groups"`
 	Status               string                                     `json:"-"`
 	IsServiceUser        bool                                       `json:"is_service_user"`
@@ -92,6 +93,12 @@ type User struct {
 	Issued string `gorm:"default:api"`
 
 	IntegrationReference integration_reference.IntegrationReference `gorm:"embedded;embeddedPrefix:integration_ref_"`
+
+	// CustomRoleID is the ID of the account custom role granting additional permissions to a regular user
+	CustomRoleID string
+	// SSHUsername is the local OS user name derived from the IdP email or name of the user. It replaces
+	// SSHLocalUserPlaceholder in the SSH authorizations of the policies
+	SSHUsername string
 }
 
 // IsBlocked returns true if the user is blocked, false otherwise
@@ -147,6 +154,7 @@ func (u *User) ToUserInfo(userData *idp.UserData, settings *Settings) (*UserInfo
 			Email:         "",
 			Name:          u.ServiceUserName,
 			Role:          string(u.Role),
+			CustomRoleID:  u.CustomRoleID,
 			AutoGroups:    u.AutoGroups,
 			Status:        string(UserStatusActive),
 			IsServiceUser: u.IsServiceUser,
@@ -172,6 +180,7 @@ func (u *User) ToUserInfo(userData *idp.UserData, settings *Settings) (*UserInfo
 		Email:         userData.Email,
 		Name:          userData.Name,
 		Role:          string(u.Role),
+		CustomRoleID:  u.CustomRoleID,
 		AutoGroups:    autoGroups,
 		Status:        string(userStatus),
 		IsServiceUser: u.IsServiceUser,
@@ -196,6 +205,7 @@ func (u *User) Copy() *User {
 		Id:                   u.Id,
 		AccountID:            u.AccountID,
 		Role:                 u.Role,
+		CustomRoleID:         u.CustomRoleID,
 		AutoGroups:           autoGroups,
 		IsServiceUser:        u.IsServiceUser,
 		NonDeletable:         u.NonDeletable,
@@ -206,6 +216,7 @@ func (u *User) Copy() *User {
 		CreatedAt:            u.CreatedAt,
 		Issued:               u.Issued,
 		IntegrationReference: u.IntegrationReference,
+		SSHUsername:          u.SSHUsername,
 	}
 }
 
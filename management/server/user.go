@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	nbContext "github.com/netbirdio/netbird/management/server/context"
 	"github.com/netbirdio/netbird/management/server/idp"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
@@ -97,6 +99,14 @@ func (am *DefaultAccountManager) inviteNewUser(ctx context.Context, accountID, u
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
+	if err = am.validateUserPermissions(ctx, accountID, userID, permissions.Users, permissions.Write, invite.AutoGroups...); err != nil {
+		return nil, err
+	}
+
+	if !initiatorUser.HasAdminPower() && types.StrRoleToUserRole(invite.Role) != types.UserRoleUser {
+		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can invite users with the %s role", invite.Role)
+	}
+
 	inviterID := userID
 	if initiatorUser.IsServiceUser {
 		createdBy, err := am.Store.GetAccountCreatedBy(ctx, store.LockingStrengthShare, accountID)
@@ -232,19 +242,28 @@ func (am *DefaultAccountManager) DeleteUser(ctx context.Context, accountID, init
 		return status.NewUserNotPartOfAccountError()
 	}
 
-	if !initiatorUser.HasAdminPower() {
-		return status.NewAdminPermissionError()
-	}
-
 	targetUser, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, targetUserID)
 	if err != nil {
 		return err
 	}
 
+	if targetUser.AccountID != accountID {
+		return status.NewUserNotPartOfAccountError()
+	}
+
+	if err = am.validateUserPermissions(ctx, accountID, initiatorUserID, permissions.Users, permissions.Write, targetUser.AutoGroups...); err != nil {
+		return err
+	}
+
 	if targetUser.Role == types.UserRoleOwner {
 		return status.NewOwnerDeletePermissionError()
 	}
 
+	// users managing other users through a custom role can't delete privileged or service users
+	if !initiatorUser.HasAdminPower() && (targetUser.HasAdminPower() || targetUser.IsServiceUser) {
+		return status.NewAdminPermissionError()
+	}
+
 	// disable deleting integration user if the initiator is not admin service user
 	if targetUser.Issued == types.UserIssuedIntegration && !initiatorUser.IsServiceUser {
 		return status.Errorf(status.PermissionDenied, "only integration service user can delete this user")
@@ -294,6 +313,19 @@ func (am *DefaultAccountManager) InviteUser(ctx context.Context, accountID strin
 		return status.NewUserNotPartOfAccountError()
 	}
 
+	targetUser, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, targetUserID)
+	if err != nil {
+		return err
+	}
+
+	if targetUser.AccountID != accountID {
+		return status.NewUserNotPartOfAccountError()
+	}
+
+	if err = am.validateUserPermissions(ctx, accountID, initiatorUserID, permissions.Users, permissions.Write, targetUser.AutoGroups...); err != nil {
+		return err
+	}
+
 	// check if the user is already registered with this ID
 	user, err := am.lookupUserInCache(ctx, targetUserID, accountID)
 	if err != nil {
@@ -446,7 +478,7 @@ func (am *DefaultAccountManager) SaveUser(ctx context.Context, accountID, initia
 }
 
 // SaveOrAddUser updates the given user. If addIfNotExists is set to true it will add user when no exist
-// Only User.AutoGroups, User.Role, and User.Blocked fields are allowed to be updated for now.
+// Only User.AutoGroups, User.Role, User.CustomRoleID and User.Blocked fields are allowed to be updated for now.
 func (am *DefaultAccountManager) SaveOrAddUser(ctx context.Context, accountID, initiatorUserID string, update *types.User, addIfNotExists bool) (*types.UserInfo, error) {
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
@@ -480,10 +512,14 @@ func (am *DefaultAccountManager) SaveOrAddUsers(ctx context.Context, accountID,
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if !initiatorUser.HasAdminPower() || initiatorUser.IsBlocked() {
+	if initiatorUser.IsBlocked() {
 		return nil, status.NewAdminPermissionError()
 	}
 
+	if err = am.validateUsersUpdatePermissions(ctx, accountID, initiatorUserID, updates); err != nil {
+		return nil, err
+	}
+
 	settings, err := am.Store.GetAccountSettings(ctx, store.LockingStrengthShare, accountID)
 	if err != nil {
 		return nil, err
@@ -566,6 +602,37 @@ func (am *DefaultAccountManager) SaveOrAddUsers(ctx context.Context, accountID,
 	return updatedUsersInfo, nil
 }
 
+// validateUsersUpdatePermissions checks that the initiator is allowed to update the users. The permission is scoped to
+// both the current and the new auto groups of every user, so users managing users through a group scoped custom role
+// can neither update users outside of their scope nor move users to groups outside of it
+func (am *DefaultAccountManager) validateUsersUpdatePermissions(ctx context.Context, accountID, initiatorUserID string, updates []*types.User) error {
+	if len(updates) == 0 {
+		return am.validateUserPermissions(ctx, accountID, initiatorUserID, permissions.Users, permissions.Write)
+	}
+
+	for _, update := range updates {
+		if update == nil {
+			return status.Errorf(status.InvalidArgument, "provided user update is nil")
+		}
+
+		scopeGroups := slices.Clone(update.AutoGroups)
+		oldUser, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, update.Id)
+		if err != nil {
+			if sErr, ok := status.FromError(err); !ok || sErr.Type() != status.NotFound {
+				return err
+			}
+		} else if oldUser.AccountID == accountID {
+			scopeGroups = append(scopeGroups, oldUser.AutoGroups...)
+		}
+
+		if err = am.validateUserPermissions(ctx, accountID, initiatorUserID, permissions.Users, permissions.Write, scopeGroups...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // prepareUserUpdateEvents prepares a list user update events based on the changes between the old and new user data.
 func (am *DefaultAccountManager) prepareUserUpdateEvents(ctx context.Context, accountID string, initiatorUserID string, oldUser, newUser *types.User, transferredOwnerRole bool) []func() {
 	var eventsToStore []func()
@@ -593,6 +660,12 @@ func (am *DefaultAccountManager) prepareUserUpdateEvents(ctx context.Context, ac
 		})
 	}
 
+	if oldUser.CustomRoleID != newUser.CustomRoleID {
+		eventsToStore = append(eventsToStore, func() {
+			am.StoreEvent(ctx, initiatorUserID, oldUser.Id, accountID, activity.UserCustomRoleUpdated, map[string]any{"custom_role_id": newUser.CustomRoleID})
+		})
+	}
+
 	return eventsToStore
 }
 
@@ -618,10 +691,15 @@ func (am *DefaultAccountManager) processUserUpdate(ctx context.Context, transact
 	updatedUser.Role = update.Role
 	updatedUser.Blocked = update.Blocked
 	updatedUser.AutoGroups = update.AutoGroups
+	updatedUser.CustomRoleID = update.CustomRoleID
 	// these two fields can't be set via API, only via direct call to the method
 	updatedUser.Issued = update.Issued
 	updatedUser.IntegrationReference = update.IntegrationReference
 
+	if err = validateUserCustomRole(ctx, transaction, initiatorUser.AccountID, updatedUser); err != nil {
+		return false, nil, nil, nil, err
+	}
+
 	transferredOwnerRole, err := handleOwnerRoleTransfer(ctx, transaction, initiatorUser, update)
 	if err != nil {
 		return false, nil, nil, nil, err
@@ -705,6 +783,18 @@ func (am *DefaultAccountManager) getUserInfo(ctx context.Context, user *types.Us
 
 // validateUserUpdate validates the update operation for a user.
 func validateUserUpdate(groupsMap map[string]*types.Group, initiatorUser, oldUser, update *types.User) error {
+	if !initiatorUser.HasAdminPower() {
+		if oldUser.HasAdminPower() || oldUser.IsServiceUser {
+			return status.Errorf(status.PermissionDenied, "only users with admin power can update admins and service users")
+		}
+		if update.Role != oldUser.Role || update.CustomRoleID != oldUser.CustomRoleID {
+			return status.Errorf(status.PermissionDenied, "only users with admin power can change user roles")
+		}
+		// the auto groups are propagated to the user peers, changing them would grant the user access to other groups
+		if initiatorUser.Id == update.Id && (len(util.Difference(oldUser.AutoGroups, update.AutoGroups)) > 0 || len(util.Difference(update.AutoGroups, oldUser.AutoGroups)) > 0) {
+			return status.Errorf(status.PermissionDenied, "only users with admin power can change their own auto groups")
+		}
+	}
 	if initiatorUser.HasAdminPower() && initiatorUser.Id == update.Id && oldUser.Blocked != update.Blocked {
 		return status.Errorf(status.PermissionDenied, "admins can't block or unblock themselves")
 	}
@@ -807,6 +897,16 @@ func (am *DefaultAccountManager) BuildUserInfosForAccount(ctx context.Context, a
 		return nil, err
 	}
 
+	// regular users can only see themselves unless a custom role allows them to view users
+	viewOwnUserOnly := initiatorUser.IsRegularUser()
+	if viewOwnUserOnly {
+		allowed, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, initiatorUserID, permissions.Users, permissions.Read)
+		if err != nil {
+			return nil, status.NewPermissionValidationError(err)
+		}
+		viewOwnUserOnly = !allowed
+	}
+
 	if !isNil(am.idpManager) {
 		users := make(map[string]userLoggedInOnce, len(accountUsers))
 		usersFromIntegration := make([]*idp.UserData, 0)
@@ -845,7 +945,7 @@ func (am *DefaultAccountManager) BuildUserInfosForAccount(ctx context.Context, a
 	// in case of self-hosted, or IDP doesn't return anything, we will return the locally stored userInfo
 	if len(queriedUsers) == 0 {
 		for _, accountUser := range accountUsers {
-			if initiatorUser.IsRegularUser() && initiatorUser.Id != accountUser.Id {
+			if viewOwnUserOnly && initiatorUser.Id != accountUser.Id {
 				// if user is not an admin then show only current user and do not show other users
 				continue
 			}
@@ -861,7 +961,7 @@ func (am *DefaultAccountManager) BuildUserInfosForAccount(ctx context.Context, a
 	}
 
 	for _, localUser := range accountUsers {
-		if initiatorUser.IsRegularUser() && initiatorUser.Id != localUser.Id {
+		if viewOwnUserOnly && initiatorUser.Id != localUser.Id {
 			// if user is not an admin then show only current user and do not show other users
 			continue
 		}
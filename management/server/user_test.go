@@ -61,8 +61,9 @@ func TestUser_CreatePAT_ForSameUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      s,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              s,
+		permissionsManager: newTestPermissionsManager(s),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	pat, err := am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockExpiresIn)
@@ -109,8 +110,9 @@ func TestUser_CreatePAT_ForDifferentUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockTargetUserId, mockTokenName, mockExpiresIn)
@@ -135,8 +137,9 @@ func TestUser_CreatePAT_ForServiceUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	pat, err := am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockTargetUserId, mockTokenName, mockExpiresIn)
@@ -162,8 +165,9 @@ func TestUser_CreatePAT_WithWrongExpiration(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockWrongExpiresIn)
@@ -185,8 +189,9 @@ func TestUser_CreatePAT_WithEmptyName(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockEmptyTokenName, mockExpiresIn)
@@ -216,8 +221,9 @@ func TestUser_DeletePAT(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	err = am.DeletePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenID1)
@@ -257,8 +263,9 @@ func TestUser_GetPAT(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	pat, err := am.GetPAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenID1)
@@ -298,8 +305,9 @@ func TestUser_GetAllPATs(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	pats, err := am.GetAllPATs(context.Background(), mockAccountID, mockUserID, mockUserID)
@@ -338,6 +346,8 @@ func TestUser_Copy(t *testing.T) {
 			ID:              0,
 			IntegrationType: "test",
 		},
+		CustomRoleID: "customRoleId",
+		SSHUsername:  "sshUsername",
 	}
 
 	err := validateStruct(user)
@@ -350,6 +360,23 @@ func TestUser_Copy(t *testing.T) {
 	assert.True(t, cmp.Equal(user, *copiedUser))
 }
 
+func TestDefaultAccountManager_UpdateUsersSSHUsername(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	account := newAccountWithId(context.Background(), mockAccountID, mockUserID, "")
+	require.NoError(t, am.Store.SaveAccount(context.Background(), account))
+
+	am.updateUsersSSHUsername(context.Background(), mockAccountID, []*idp.UserData{
+		{ID: mockUserID, Email: "Jane.Doe@example.com", Name: "Jane"},
+		{ID: "unknownUser", Email: "unknown@example.com"},
+	})
+
+	user, err := am.Store.GetUserByUserID(context.Background(), store.LockingStrengthShare, mockUserID)
+	require.NoError(t, err)
+	assert.Equal(t, "jane.doe", user.SSHUsername)
+}
+
 // based on https://medium.com/@anajankow/fast-check-if-all-struct-fields-are-set-in-golang-bba1917213d2
 func validateStruct(s interface{}) (err error) {
 
@@ -392,8 +419,9 @@ func TestUser_CreateServiceUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	user, err := am.createServiceUser(context.Background(), mockAccountID, mockUserID, mockRole, mockServiceUserName, false, []string{"group1", "group2"})
@@ -437,8 +465,9 @@ func TestUser_CreateUser_ServiceUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	user, err := am.CreateUser(context.Background(), mockAccountID, mockUserID, &types.UserInfo{
@@ -483,8 +512,9 @@ func TestUser_CreateUser_RegularUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	_, err = am.CreateUser(context.Background(), mockAccountID, mockUserID, &types.UserInfo{
@@ -512,9 +542,10 @@ func TestUser_InviteNewUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:        store,
-		eventStore:   &activity.InMemoryEventStore{},
-		cacheLoading: map[string]chan struct{}{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
+		cacheLoading:       map[string]chan struct{}{},
 	}
 
 	goCacheClient := gocache.New(CacheExpirationMax, 30*time.Minute)
@@ -617,8 +648,9 @@ func TestUser_DeleteUser_ServiceUser(t *testing.T) {
 			}
 
 			am := DefaultAccountManager{
-				Store:      store,
-				eventStore: &activity.InMemoryEventStore{},
+				Store:              store,
+				permissionsManager: newTestPermissionsManager(store),
+				eventStore:         &activity.InMemoryEventStore{},
 			}
 
 			err = am.DeleteUser(context.Background(), mockAccountID, mockUserID, mockServiceUserID)
@@ -653,8 +685,9 @@ func TestUser_DeleteUser_SelfDelete(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	err = am.DeleteUser(context.Background(), mockAccountID, mockUserID, mockUserID)
@@ -706,6 +739,7 @@ func TestUser_DeleteUser_regularUser(t *testing.T) {
 
 	am := DefaultAccountManager{
 		Store:                   store,
+		permissionsManager:      newTestPermissionsManager(store),
 		eventStore:              &activity.InMemoryEventStore{},
 		integratedPeerValidator: MocIntegratedValidator{},
 	}
@@ -814,6 +848,7 @@ func TestUser_DeleteUser_RegularUsers(t *testing.T) {
 
 	am := DefaultAccountManager{
 		Store:                   store,
+		permissionsManager:      newTestPermissionsManager(store),
 		eventStore:              &activity.InMemoryEventStore{},
 		integratedPeerValidator: MocIntegratedValidator{},
 	}
@@ -922,8 +957,9 @@ func TestDefaultAccountManager_GetUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	claims := nbcontext.UserAuth{
@@ -958,8 +994,9 @@ func TestDefaultAccountManager_ListUsers(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	users, err := am.ListUsers(context.Background(), mockAccountID)
@@ -1045,8 +1082,9 @@ func TestDefaultAccountManager_ListUsers_DashboardPermissions(t *testing.T) {
 			}
 
 			am := DefaultAccountManager{
-				Store:      store,
-				eventStore: &activity.InMemoryEventStore{},
+				Store:              store,
+				permissionsManager: newTestPermissionsManager(store),
+				eventStore:         &activity.InMemoryEventStore{},
 			}
 
 			users, err := am.ListUsers(context.Background(), mockAccountID)
@@ -1088,10 +1126,11 @@ func TestDefaultAccountManager_ExternalCache(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:        store,
-		eventStore:   &activity.InMemoryEventStore{},
-		idpManager:   &idp.GoogleWorkspaceManager{}, // empty manager
-		cacheLoading: map[string]chan struct{}{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
+		idpManager:         &idp.GoogleWorkspaceManager{}, // empty manager
+		cacheLoading:       map[string]chan struct{}{},
 		cacheManager: cache.New[[]*idp.UserData](
 			cacheStore.NewGoCache(gocache.New(CacheExpirationMax, 30*time.Minute)),
 		),
@@ -1151,8 +1190,9 @@ func TestUser_GetUsersFromAccount_ForAdmin(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	users, err := am.GetUsersFromAccount(context.Background(), mockAccountID, mockUserID)
@@ -1183,8 +1223,9 @@ func TestUser_GetUsersFromAccount_ForUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		permissionsManager: newTestPermissionsManager(store),
+		eventStore:         &activity.InMemoryEventStore{},
 	}
 
 	users, err := am.GetUsersFromAccount(context.Background(), mockAccountID, mockServiceUserID)
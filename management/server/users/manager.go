@@ -43,6 +43,8 @@ func (m *managerMock) GetUser(ctx context.Context, userID string) (*types.User,
 		return &types.User{Id: userID, Role: types.UserRoleOwner}, nil
 	case "billingUser":
 		return &types.User{Id: userID, Role: types.UserRoleBillingAdmin}, nil
+	case "routesUser":
+		return &types.User{Id: userID, Role: types.UserRoleUser, CustomRoleID: "routesRole"}, nil
 	default:
 		return nil, errors.New("user not found")
 	}
@@ -11,8 +11,9 @@ import (
 // TokenStore is a simple in-memory store for token
 // With this can update the token in thread safe way
 type TokenStore struct {
-	mu    sync.Mutex
-	token []byte
+	mu          sync.Mutex
+	token       []byte
+	limitsToken []byte
 }
 
 func (a *TokenStore) UpdateToken(token *Token) error {
@@ -22,18 +23,21 @@ func (a *TokenStore) UpdateToken(token *Token) error {
 		return nil
 	}
 
-	sig, err := base64.StdEncoding.DecodeString(token.Signature)
+	tok, err := marshalV2Token(token.Payload, token.Signature)
 	if err != nil {
-		return fmt.Errorf("decode signature: %w", err)
+		return err
 	}
 
-	tok := v2.Token{
-		AuthAlgo:  v2.AuthAlgoHMACSHA256,
-		Signature: sig,
-		Payload:   []byte(token.Payload),
+	var limitsTok []byte
+	if token.LimitsPayload != "" {
+		limitsTok, err = marshalV2Token(token.LimitsPayload, token.LimitsSignature)
+		if err != nil {
+			return fmt.Errorf("limits token: %w", err)
+		}
 	}
 
-	a.token = tok.Marshal()
+	a.token = tok
+	a.limitsToken = limitsTok
 	return nil
 }
 
@@ -42,3 +46,29 @@ func (a *TokenStore) TokenBinary() []byte {
 	defer a.mu.Unlock()
 	return a.token
 }
+
+// LimitsTokenBinary returns the token carrying the rate limits of the peer. It must only be sent to the relay
+// servers accepting the limits, older servers reject it. Without limits it returns the plain token
+func (a *TokenStore) LimitsTokenBinary() []byte {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.limitsToken == nil {
+		return a.token
+	}
+	return a.limitsToken
+}
+
+func marshalV2Token(payload, signature string) ([]byte, error) {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, fmt.Errorf("decode signature: %w", err)
+	}
+
+	tok := v2.Token{
+		AuthAlgo:  v2.AuthAlgoHMACSHA256,
+		Signature: sig,
+		Payload:   []byte(payload),
+	}
+
+	return tok.Marshal(), nil
+}
@@ -16,6 +16,10 @@ import (
 type Token struct {
 	Payload   string
 	Signature string
+	// LimitsPayload and LimitsSignature are the optional relay token carrying the rate limits of the peer. The
+	// plain token stays valid for the relay servers that don't accept the limits
+	LimitsPayload   string
+	LimitsSignature string
 }
 
 func unmarshalToken(payload []byte) (Token, error) {
@@ -1,6 +1,7 @@
 package hmac
 
 import (
+	"bytes"
 	"crypto/sha1"
 	"crypto/sha256"
 	"encoding/base64"
@@ -103,3 +104,31 @@ func TestInvalidPayload(t *testing.T) {
 		t.Fatalf("expected invalid token due to invalid payload")
 	}
 }
+
+func TestTokenStore_LimitsToken(t *testing.T) {
+	signature := base64.StdEncoding.EncodeToString([]byte("signature"))
+
+	store := &TokenStore{}
+	if err := store.UpdateToken(&Token{Payload: "1700000000", Signature: signature}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !bytes.Equal(store.TokenBinary(), store.LimitsTokenBinary()) {
+		t.Fatalf("expected the plain token without limits")
+	}
+
+	err := store.UpdateToken(&Token{
+		Payload:         "1700000000",
+		Signature:       signature,
+		LimitsPayload:   "1700000000;bps=1024",
+		LimitsSignature: signature,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !bytes.HasSuffix(store.TokenBinary(), []byte("1700000000")) {
+		t.Fatalf("expected the plain token to carry the bare timestamp")
+	}
+	if !bytes.HasSuffix(store.LimitsTokenBinary(), []byte("1700000000;bps=1024")) {
+		t.Fatalf("expected the limits token")
+	}
+}
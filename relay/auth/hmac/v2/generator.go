@@ -29,9 +29,15 @@ func NewGenerator(algo AuthAlgo, secret []byte, timeToLive time.Duration) (*Gene
 }
 
 func (g *Generator) GenerateToken() (*Token, error) {
+	return g.GenerateTokenWithLimits(Limits{})
+}
+
+// GenerateTokenWithLimits generates a token that tightens the per-peer rate limits of the relay server. Relay servers
+// without limits support reject these tokens, the plain token of GenerateToken must be sent to them
+func (g *Generator) GenerateTokenWithLimits(limits Limits) (*Token, error) {
 	expirationTime := time.Now().Add(g.timeToLive).Unix()
 
-	payload := []byte(strconv.FormatInt(expirationTime, 10))
+	payload := []byte(limits.marshal(strconv.FormatInt(expirationTime, 10)))
 
 	h := hmac.New(g.algo, g.secret)
 	h.Write(payload)
@@ -108,3 +108,43 @@ func TestInvalidPayload(t *testing.T) {
 		t.Fatalf("expected invalid token due to invalid payload")
 	}
 }
+
+func TestLimits(t *testing.T) {
+	secret := "supersecret"
+	timeToLive := 1 * time.Hour
+	g, err := NewGenerator(AuthAlgoHMACSHA256, []byte(secret), timeToLive)
+	if err != nil {
+		t.Fatalf("failed to create generator: %v", err)
+	}
+
+	token, err := g.GenerateTokenWithLimits(Limits{BytesPerSecond: 1024, MessagesPerSecond: 10})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	v := NewValidator([]byte(secret))
+	if err := v.Validate(token.Marshal()); err != nil {
+		t.Fatalf("expected valid token: %s", err)
+	}
+
+	limits, err := LimitsFromToken(token.Marshal())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if limits.BytesPerSecond != 1024 || limits.MessagesPerSecond != 10 {
+		t.Fatalf("unexpected limits: %+v", limits)
+	}
+
+	token, err = g.GenerateToken()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	limits, err = LimitsFromToken(token.Marshal())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !limits.IsZero() {
+		t.Fatalf("expected no limits, got %+v", limits)
+	}
+}
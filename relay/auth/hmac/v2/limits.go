@@ -0,0 +1,81 @@
+package v2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	payloadSeparator       = ";"
+	limitBytesPerSecond    = "bps"
+	limitMessagesPerSecond = "mps"
+	limitKeyValueSeparator = "="
+)
+
+// Limits are the rate limits of a peer carried in the token. They can only tighten the per-peer limits of the relay
+// server, zero values keep the server defaults.
+type Limits struct {
+	BytesPerSecond    int64
+	MessagesPerSecond int64
+}
+
+// IsZero returns true if the token does not carry any limit
+func (l Limits) IsZero() bool {
+	return l.BytesPerSecond == 0 && l.MessagesPerSecond == 0
+}
+
+// marshal appends the limits to the expiration time of the payload. Without limits the payload is the bare timestamp
+// older relay servers expect.
+func (l Limits) marshal(expiration string) string {
+	payload := expiration
+	if l.BytesPerSecond > 0 {
+		payload += payloadSeparator + limitBytesPerSecond + limitKeyValueSeparator + strconv.FormatInt(l.BytesPerSecond, 10)
+	}
+	if l.MessagesPerSecond > 0 {
+		payload += payloadSeparator + limitMessagesPerSecond + limitKeyValueSeparator + strconv.FormatInt(l.MessagesPerSecond, 10)
+	}
+	return payload
+}
+
+// splitPayload splits the payload into the expiration time and the optional limit fields
+func splitPayload(payload []byte) (string, []string) {
+	fields := strings.Split(string(payload), payloadSeparator)
+	return fields[0], fields[1:]
+}
+
+// LimitsFromToken extracts the rate limits of the marshalled token. The token must be validated before.
+// Unknown fields are ignored to let newer management servers add fields.
+func LimitsFromToken(data []byte) (Limits, error) {
+	token, err := UnmarshalToken(data)
+	if err != nil {
+		return Limits{}, fmt.Errorf("unmarshal token: %w", err)
+	}
+
+	var limits Limits
+	_, fields := splitPayload(token.Payload)
+	for _, field := range fields {
+		key, value, ok := strings.Cut(field, limitKeyValueSeparator)
+		if !ok {
+			return Limits{}, fmt.Errorf("invalid payload field: %s", field)
+		}
+
+		var target *int64
+		switch key {
+		case limitBytesPerSecond:
+			target = &limits.BytesPerSecond
+		case limitMessagesPerSecond:
+			target = &limits.MessagesPerSecond
+		default:
+			continue
+		}
+
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || v < 0 {
+			return Limits{}, fmt.Errorf("invalid %s limit: %s", key, value)
+		}
+		*target = v
+	}
+
+	return limits, nil
+}
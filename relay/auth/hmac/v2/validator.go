@@ -46,7 +46,8 @@ func (v *Validator) Validate(data any) error {
 		return errors.New("invalid signature")
 	}
 
-	timestamp, err := strconv.ParseInt(string(token.Payload), 10, 64)
+	expiration, _ := splitPayload(token.Payload)
+	timestamp, err := strconv.ParseInt(expiration, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid payload: %w", err)
 	}
@@ -20,6 +20,8 @@ import (
 const (
 	bufferSize            = 8820
 	serverResponseTimeout = 8 * time.Second
+	// throttleLogGap is the time without throttle messages after that a new throttle message is logged again
+	throttleLogGap = 5 * time.Second
 )
 
 var (
@@ -118,6 +120,12 @@ func (cc *connContainer) close() {
 	}
 }
 
+// tokenLimitsConn is implemented by the connections that negotiate whether the relay server accepts the rate limits
+// in the auth token
+type tokenLimitsConn interface {
+	TokenLimitsSupported() bool
+}
+
 // Client is a client for the relay server. It is responsible for establishing a connection to the relay server and
 // managing connections to other peers. All exported functions are safe to call concurrently. After close the connection,
 // the client can be reused by calling Connect again. When the client is closed, all connections are closed too.
@@ -142,6 +150,9 @@ type Client struct {
 
 	onDisconnectListener func(string)
 	listenerMutex        sync.Mutex
+
+	throttledUntil time.Time
+	muThrottled    sync.Mutex
 }
 
 // NewClient creates a new client for the relay server. The client is not connected to the server until the Connect
@@ -243,6 +254,14 @@ func (c *Client) HasConns() bool {
 	return len(c.conns) > 0
 }
 
+// ThrottledUntil returns the time until the relay server drops the messages of the client because of its rate
+// limits. The time is in the past if the client is not throttled.
+func (c *Client) ThrottledUntil() time.Time {
+	c.muThrottled.Lock()
+	defer c.muThrottled.Unlock()
+	return c.throttledUntil
+}
+
 func (c *Client) Ready() bool {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -274,7 +293,12 @@ func (c *Client) connect() error {
 }
 
 func (c *Client) handShake() error {
-	msg, err := messages.MarshalAuthMsg(c.hashedID, c.authTokenStore.TokenBinary())
+	token := c.authTokenStore.TokenBinary()
+	if conn, ok := c.relayConn.(tokenLimitsConn); ok && conn.TokenLimitsSupported() {
+		token = c.authTokenStore.LimitsTokenBinary()
+	}
+
+	msg, err := messages.MarshalAuthMsg(c.hashedID, token)
 	if err != nil {
 		c.log.Errorf("failed to marshal auth message: %s", err)
 		return err
@@ -386,11 +410,32 @@ func (c *Client) handleMsg(msgType messages.MsgType, buf []byte, bufPtr *[]byte,
 		c.log.Debugf("relay connection close by server")
 		c.bufPool.Put(bufPtr)
 		return false
+	case messages.MsgTypeThrottle:
+		c.handleThrottleMsg(buf)
+		c.bufPool.Put(bufPtr)
 	}
 
 	return true
 }
 
+func (c *Client) handleThrottleMsg(buf []byte) {
+	reason, retryAfter, err := messages.UnmarshalThrottleMsg(buf)
+	if err != nil {
+		c.log.Errorf("failed to parse throttle message: %s", err)
+		return
+	}
+
+	now := time.Now()
+	c.muThrottled.Lock()
+	// log once per throttled period, the server repeats the message at most every second while the client exceeds the
+	// limit
+	if now.After(c.throttledUntil.Add(throttleLogGap)) {
+		c.log.Warnf("relay server drops messages by %s, retry after %s", reason, retryAfter)
+	}
+	c.throttledUntil = now.Add(retryAfter)
+	c.muThrottled.Unlock()
+}
+
 func (c *Client) handleHealthCheck(hc *healthcheck.Receiver, internallyStoppedFlag *internalStopFlag) {
 	msg := messages.MarshalHealthcheck()
 	_, wErr := c.relayConn.Write(msg)
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/netbirdio/netbird/relay/server"
+)
+
+func startClusterServer(t *testing.T, listenAddr string, members ...string) *server.Server {
+	t.Helper()
+
+	srv, err := server.NewServer(otel.Meter(""), "rel://"+listenAddr, false, av)
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+
+	err = srv.JoinCluster(server.ClusterConfig{
+		ListenAddress: "127.0.0.1:0",
+		Members:       members,
+		Secret:        "cluster-secret",
+	})
+	if err != nil {
+		t.Fatalf("failed to join cluster: %s", err)
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		if err := srv.Listen(server.ListenerConfig{Address: listenAddr}); err != nil {
+			errChan <- err
+		}
+	}()
+	t.Cleanup(func() {
+		if err := srv.Shutdown(context.Background()); err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	})
+
+	if err := waitForServerToStart(errChan); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+	return srv
+}
+
+// readMessages reads the messages of the connection into the returned channel
+func readMessages(conn interface{ Read([]byte) (int, error) }) <-chan string {
+	msgs := make(chan string, 10)
+	go func() {
+		defer close(msgs)
+		buf := make([]byte, 65535)
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				return
+			}
+			msgs <- string(buf[:n])
+		}
+	}()
+	return msgs
+}
+
+// sendUntilReceived writes the payload until the other side receives it, the peer presence is shared asynchronously
+func sendUntilReceived(t *testing.T, conn interface{ Write([]byte) (int, error) }, msgs <-chan string, payload string) {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		if _, err := conn.Write([]byte(payload)); err != nil {
+			t.Fatalf("failed to write to channel: %s", err)
+		}
+		select {
+		case msg := <-msgs:
+			if msg != payload {
+				t.Fatalf("expected %s, got %s", payload, msg)
+			}
+			return
+		case <-time.After(100 * time.Millisecond):
+		case <-timeout:
+			t.Fatalf("message %q not received", payload)
+		}
+	}
+}
+
+func TestClusterForwarding(t *testing.T) {
+	ctx := context.Background()
+
+	srv1 := startClusterServer(t, "127.0.0.1:1241")
+	srv2 := startClusterServer(t, "127.0.0.1:1242", srv1.ClusterAddress())
+	startClusterServer(t, "127.0.0.1:1243", srv1.ClusterAddress(), srv2.ClusterAddress())
+
+	clientAlice := NewClient(ctx, "rel://127.0.0.1:1241", hmacTokenStore, "alice")
+	if err := clientAlice.Connect(); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer clientAlice.Close()
+
+	clientBob := NewClient(ctx, "rel://127.0.0.1:1242", hmacTokenStore, "bob")
+	if err := clientBob.Connect(); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer clientBob.Close()
+
+	clientCarol := NewClient(ctx, "rel://127.0.0.1:1243", hmacTokenStore, "carol")
+	if err := clientCarol.Connect(); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer clientCarol.Close()
+
+	connAliceToBob, err := clientAlice.OpenConn("bob")
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+	connBobToAlice, err := clientBob.OpenConn("alice")
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+	connAliceToCarol, err := clientAlice.OpenConn("carol")
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+	connCarolToAlice, err := clientCarol.OpenConn("alice")
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+
+	aliceFromBob := readMessages(connAliceToBob)
+	bobFromAlice := readMessages(connBobToAlice)
+	aliceFromCarol := readMessages(connAliceToCarol)
+	carolFromAlice := readMessages(connCarolToAlice)
+
+	sendUntilReceived(t, connAliceToBob, bobFromAlice, "hello bob, I am alice")
+	sendUntilReceived(t, connBobToAlice, aliceFromBob, "hello alice, I am bob")
+	// carol's instance linked itself to alice's instance
+	sendUntilReceived(t, connAliceToCarol, carolFromAlice, "hello carol, I am alice")
+	sendUntilReceived(t, connCarolToAlice, aliceFromCarol, "hello alice, I am carol")
+
+	// bob moves to alice's instance, the presence on his old instance is withdrawn
+	if err := clientBob.Close(); err != nil {
+		t.Fatalf("failed to close Bob client: %s", err)
+	}
+	clientBob = NewClient(ctx, "rel://127.0.0.1:1241", hmacTokenStore, "bob")
+	if err := clientBob.Connect(); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer clientBob.Close()
+
+	connBobToAlice, err = clientBob.OpenConn("alice")
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+	sendUntilReceived(t, connBobToAlice, aliceFromBob, "hello alice, I moved")
+}
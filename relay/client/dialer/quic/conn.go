@@ -11,6 +11,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	netErr "github.com/netbirdio/netbird/relay/client/dialer/net"
+	quictls "github.com/netbirdio/netbird/relay/tls"
 )
 
 const (
@@ -88,6 +89,11 @@ func (c *Conn) Close() error {
 	return c.session.CloseWithError(0, "normal closure")
 }
 
+// TokenLimitsSupported returns true if the relay server accepts the rate limits in the auth token
+func (c *Conn) TokenLimitsSupported() bool {
+	return quictls.TokenLimitsSupported(c.session.ConnectionState().TLS)
+}
+
 func (c *Conn) remoteCloseErrHandling(err error) error {
 	var appErr *quic.ApplicationError
 	if errors.As(err, &appErr) && appErr.ErrorCode == 0x0 {
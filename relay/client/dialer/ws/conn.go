@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/coder/websocket"
+
+	"github.com/netbirdio/netbird/relay/server/listener/ws"
 )
 
 type Conn struct {
@@ -65,3 +67,8 @@ func (c *Conn) SetDeadline(t time.Time) error {
 func (c *Conn) Close() error {
 	return c.Conn.CloseNow()
 }
+
+// TokenLimitsSupported returns true if the relay server accepts the rate limits in the auth token
+func (c *Conn) TokenLimitsSupported() bool {
+	return c.Conn.Subprotocol() == ws.SubprotocolTokenLimits
+}
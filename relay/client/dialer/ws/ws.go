@@ -33,7 +33,8 @@ func (d Dialer) Dial(ctx context.Context, address string) (net.Conn, error) {
 	}
 
 	opts := &websocket.DialOptions{
-		HTTPClient: httpClientNbDialer(),
+		HTTPClient:   httpClientNbDialer(),
+		Subprotocols: []string{ws.SubprotocolTokenLimits},
 	}
 
 	parsedURL, err := url.Parse(wsURL)
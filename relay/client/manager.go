@@ -208,6 +208,18 @@ func (m *Manager) RelayInstanceAddress() (string, error) {
 	return m.relayClient.ServerInstanceURL()
 }
 
+// ThrottledUntil returns the time until the permanent relay server drops the messages of the client because of its
+// rate limits. The time is zero if the client is not connected to the relay server.
+func (m *Manager) ThrottledUntil() time.Time {
+	m.relayClientMu.Lock()
+	defer m.relayClientMu.Unlock()
+
+	if m.relayClient == nil {
+		return time.Time{}
+	}
+	return m.relayClient.ThrottledUntil()
+}
+
 // ServerURLs returns the addresses of the relay servers.
 func (m *Manager) ServerURLs() []string {
 	return m.serverPicker.ServerURLs.Load().([]string)
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/netbirdio/netbird/relay/server"
+)
+
+func TestThrottle(t *testing.T) {
+	ctx := context.Background()
+	listenAddr := "127.0.0.1:1244"
+
+	srv, err := server.NewServer(otel.Meter(""), "rel://"+listenAddr, false, av)
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+	srv.SetLimits(server.LimitsConfig{Peer: server.Limits{MessagesPerSecond: 2}})
+
+	errChan := make(chan error, 1)
+	go func() {
+		if err := srv.Listen(server.ListenerConfig{Address: listenAddr}); err != nil {
+			errChan <- err
+		}
+	}()
+	defer func() {
+		if err := srv.Shutdown(ctx); err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	}()
+
+	if err := waitForServerToStart(errChan); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+
+	clientAlice := NewClient(ctx, "rel://"+listenAddr, hmacTokenStore, "alice")
+	if err := clientAlice.Connect(); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer clientAlice.Close()
+
+	clientBob := NewClient(ctx, "rel://"+listenAddr, hmacTokenStore, "bob")
+	if err := clientBob.Connect(); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer clientBob.Close()
+
+	connAliceToBob, err := clientAlice.OpenConn("bob")
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+	connBobToAlice, err := clientBob.OpenConn("alice")
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+	bobFromAlice := readMessages(connBobToAlice)
+
+	const sent = 10
+	for i := 0; i < sent; i++ {
+		if _, err := connAliceToBob.Write([]byte("hello bob")); err != nil {
+			t.Fatalf("failed to write to channel: %s", err)
+		}
+	}
+
+	received := 0
+	timeout := time.After(500 * time.Millisecond)
+loop:
+	for {
+		select {
+		case <-bobFromAlice:
+			received++
+		case <-timeout:
+			break loop
+		}
+	}
+
+	if received == 0 || received >= sent {
+		t.Fatalf("expected some of the %d messages to be dropped, received %d", sent, received)
+	}
+
+	if !clientAlice.ThrottledUntil().After(time.Now().Add(-time.Second)) {
+		t.Fatalf("expected alice to be notified about the throttling")
+	}
+	if !clientBob.ThrottledUntil().IsZero() {
+		t.Fatalf("expected bob not to be throttled")
+	}
+}
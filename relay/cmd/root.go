@@ -40,6 +40,15 @@ type Config struct {
 	AuthSecret            string
 	LogLevel              string
 	LogFile               string
+	// ClusterListenAddress enables clustering, the other instances of the cluster link to this address
+	ClusterListenAddress string
+	ClusterMembers       []string
+	ClusterSecret        string
+	// rate limits of the transport messages, zero values are unlimited
+	PeerBytesPerSecond      int64
+	PeerMessagesPerSecond   int64
+	GlobalBytesPerSecond    int64
+	GlobalMessagesPerSecond int64
 }
 
 func (c Config) Validate() error {
@@ -49,6 +58,12 @@ func (c Config) Validate() error {
 	if c.AuthSecret == "" {
 		return fmt.Errorf("auth secret is required")
 	}
+	if c.ClusterListenAddress != "" && c.ClusterSecret == "" {
+		return fmt.Errorf("cluster secret is required when clustering is enabled")
+	}
+	if c.PeerBytesPerSecond < 0 || c.PeerMessagesPerSecond < 0 || c.GlobalBytesPerSecond < 0 || c.GlobalMessagesPerSecond < 0 {
+		return fmt.Errorf("rate limits must not be negative")
+	}
 	return nil
 }
 
@@ -87,6 +102,13 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&cobraConfig.AuthSecret, "auth-secret", "s", "", "auth secret")
 	rootCmd.PersistentFlags().StringVar(&cobraConfig.LogLevel, "log-level", "info", "log level")
 	rootCmd.PersistentFlags().StringVar(&cobraConfig.LogFile, "log-file", "console", "log file")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.ClusterListenAddress, "cluster-listen-address", "", "address to accept the links of the other relay instances of the cluster on, e.g. 10.0.0.1:33090. Enables clustering, all instances of the cluster should use the same exposed address")
+	rootCmd.PersistentFlags().StringSliceVar(&cobraConfig.ClusterMembers, "cluster-members", nil, "cluster listen addresses of the other relay instances of the cluster")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.ClusterSecret, "cluster-secret", "", "secret shared by the relay instances of the cluster")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.PeerBytesPerSecond, "peer-bytes-per-second", 0, "limits the bytes per second a peer can send, the relay token of the peer can only lower it. 0 is unlimited")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.PeerMessagesPerSecond, "peer-messages-per-second", 0, "limits the messages per second a peer can send, the relay token of the peer can only lower it. 0 is unlimited")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.GlobalBytesPerSecond, "global-bytes-per-second", 0, "limits the bytes per second all peers can send together. 0 is unlimited")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.GlobalMessagesPerSecond, "global-messages-per-second", 0, "limits the messages per second all peers can send together. 0 is unlimited")
 
 	setFlagsFromEnvVars(rootCmd)
 }
@@ -147,6 +169,30 @@ func execute(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create relay server: %v", err)
 	}
 	log.Infof("server will be available on: %s", srv.InstanceURL())
+
+	if cobraConfig.ClusterListenAddress != "" {
+		err := srv.JoinCluster(server.ClusterConfig{
+			ListenAddress: cobraConfig.ClusterListenAddress,
+			Members:       cobraConfig.ClusterMembers,
+			Secret:        cobraConfig.ClusterSecret,
+		})
+		if err != nil {
+			log.Debugf("failed to join relay cluster: %v", err)
+			return fmt.Errorf("failed to join relay cluster: %v", err)
+		}
+	}
+
+	srv.SetLimits(server.LimitsConfig{
+		Peer: server.Limits{
+			BytesPerSecond:    cobraConfig.PeerBytesPerSecond,
+			MessagesPerSecond: cobraConfig.PeerMessagesPerSecond,
+		},
+		Global: server.Limits{
+			BytesPerSecond:    cobraConfig.GlobalBytesPerSecond,
+			MessagesPerSecond: cobraConfig.GlobalMessagesPerSecond,
+		},
+	})
+
 	go func() {
 		if err := srv.Listen(srvListenerCfg); err != nil {
 			log.Fatalf("failed to bind server: %s", err)
@@ -2,8 +2,10 @@ package messages
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"time"
 )
 
 const (
@@ -11,6 +13,9 @@ const (
 	MaxHandshakeRespSize = 8192
 
 	CurrentProtocolVersion = 1
+	// ThrottleProtocolVersion is the version of the throttle message. The existing messages keep using the
+	// CurrentProtocolVersion, so older clients skip the throttle message as an unsupported one.
+	ThrottleProtocolVersion = 2
 
 	MsgTypeUnknown MsgType = 0
 	// Deprecated: Use MsgTypeAuth instead.
@@ -22,6 +27,7 @@ const (
 	MsgTypeHealthCheck   MsgType = 5
 	MsgTypeAuth                  = 6
 	MsgTypeAuthResponse          = 7
+	MsgTypeThrottle      MsgType = 8
 
 	// base size of the message
 	sizeOfVersionByte = 1
@@ -43,8 +49,34 @@ const (
 	headerSizeTransport      = IDSize
 	offsetTransportID        = sizeOfProtoHeader
 	headerTotalSizeTransport = sizeOfProtoHeader + headerSizeTransport
+
+	// throttle
+	sizeOfThrottleReason    = 1
+	sizeOfThrottleRetry     = 4
+	offsetThrottleReason    = sizeOfProtoHeader
+	offsetThrottleRetry     = offsetThrottleReason + sizeOfThrottleReason
+	headerTotalSizeThrottle = sizeOfProtoHeader + sizeOfThrottleReason + sizeOfThrottleRetry
+)
+
+// ThrottleReason tells which limit of the server dropped the messages of the client
+type ThrottleReason byte
+
+const (
+	ThrottleReasonPeer   ThrottleReason = 1
+	ThrottleReasonGlobal ThrottleReason = 2
 )
 
+func (r ThrottleReason) String() string {
+	switch r {
+	case ThrottleReasonPeer:
+		return "peer limit"
+	case ThrottleReasonGlobal:
+		return "global limit"
+	default:
+		return "unknown limit"
+	}
+}
+
 var (
 	ErrInvalidMessageLength = errors.New("invalid message length")
 	ErrUnsupportedVersion   = errors.New("unsupported version")
@@ -72,6 +104,8 @@ func (m MsgType) String() string {
 		return "close"
 	case MsgTypeHealthCheck:
 		return "health check"
+	case MsgTypeThrottle:
+		return "throttle"
 	default:
 		return "unknown"
 	}
@@ -83,7 +117,7 @@ func ValidateVersion(msg []byte) (int, error) {
 		return 0, ErrInvalidMessageLength
 	}
 	version := int(msg[0])
-	if version != CurrentProtocolVersion {
+	if version < CurrentProtocolVersion || version > ThrottleProtocolVersion {
 		return 0, fmt.Errorf("%d: %w", version, ErrUnsupportedVersion)
 	}
 	return version, nil
@@ -122,7 +156,8 @@ func DetermineServerMessageType(msg []byte) (MsgType, error) {
 		MsgTypeAuthResponse,
 		MsgTypeTransport,
 		MsgTypeClose,
-		MsgTypeHealthCheck:
+		MsgTypeHealthCheck,
+		MsgTypeThrottle:
 		return msgType, nil
 	default:
 		return MsgTypeUnknown, fmt.Errorf("invalid msg type %d", msgType)
@@ -316,3 +351,24 @@ func UpdateTransportMsg(msg []byte, peerID []byte) error {
 func MarshalHealthcheck() []byte {
 	return healthCheckMsg
 }
+
+// MarshalThrottleMsg creates a throttle message.
+// The server sends the throttle message when it drops transport messages of the client because of a rate limit. The
+// message contains the limit that was exceeded and a hint when the client can send again.
+func MarshalThrottleMsg(reason ThrottleReason, retryAfter time.Duration) []byte {
+	msg := make([]byte, headerTotalSizeThrottle)
+	msg[0] = byte(ThrottleProtocolVersion)
+	msg[1] = byte(MsgTypeThrottle)
+	msg[offsetThrottleReason] = byte(reason)
+	binary.BigEndian.PutUint32(msg[offsetThrottleRetry:], uint32(retryAfter.Milliseconds()))
+	return msg
+}
+
+// UnmarshalThrottleMsg extracts the exceeded limit and the retry hint from the throttle message.
+func UnmarshalThrottleMsg(msg []byte) (ThrottleReason, time.Duration, error) {
+	if len(msg) < headerTotalSizeThrottle {
+		return 0, 0, ErrInvalidMessageLength
+	}
+	retryAfter := time.Duration(binary.BigEndian.Uint32(msg[offsetThrottleRetry:])) * time.Millisecond
+	return ThrottleReason(msg[offsetThrottleReason]), retryAfter, nil
+}
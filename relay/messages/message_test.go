@@ -2,6 +2,7 @@ package messages
 
 import (
 	"testing"
+	"time"
 )
 
 func TestMarshalHelloMsg(t *testing.T) {
@@ -136,3 +137,56 @@ func TestMarshalHealthcheck(t *testing.T) {
 		t.Errorf("expected %d, got %d", MsgTypeHealthCheck, msgType)
 	}
 }
+
+func TestMarshalThrottleMsg(t *testing.T) {
+	msg := MarshalThrottleMsg(ThrottleReasonGlobal, 1500*time.Millisecond)
+
+	version, err := ValidateVersion(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	if version != ThrottleProtocolVersion {
+		t.Errorf("expected %d, got %d", ThrottleProtocolVersion, version)
+	}
+
+	msgType, err := DetermineServerMessageType(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	if msgType != MsgTypeThrottle {
+		t.Errorf("expected %d, got %d", MsgTypeThrottle, msgType)
+	}
+
+	if _, err := DetermineClientMessageType(msg); err == nil {
+		t.Errorf("expected error, the client must not send throttle messages")
+	}
+
+	reason, retryAfter, err := UnmarshalThrottleMsg(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	if reason != ThrottleReasonGlobal {
+		t.Errorf("expected %s, got %s", ThrottleReasonGlobal, reason)
+	}
+
+	if retryAfter != 1500*time.Millisecond {
+		t.Errorf("expected %s, got %s", 1500*time.Millisecond, retryAfter)
+	}
+}
+
+func TestValidateVersion(t *testing.T) {
+	for _, version := range []byte{CurrentProtocolVersion, ThrottleProtocolVersion} {
+		if _, err := ValidateVersion([]byte{version, byte(MsgTypeTransport)}); err != nil {
+			t.Errorf("version %d: %v", version, err)
+		}
+	}
+
+	for _, version := range []byte{0, ThrottleProtocolVersion + 1} {
+		if _, err := ValidateVersion([]byte{version, byte(MsgTypeTransport)}); err == nil {
+			t.Errorf("expected error for version %d", version)
+		}
+	}
+}
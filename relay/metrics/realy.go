@@ -6,6 +6,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
 
@@ -21,6 +22,9 @@ type Metrics struct {
 	AuthenticationTime metric.Float64Histogram
 	PeerStoreTime      metric.Float64Histogram
 
+	throttledMessages metric.Int64Counter
+	throttledBytes    metric.Int64Counter
+
 	peers            metric.Int64UpDownCounter
 	peerActivityChan chan string
 	peerLastActive   map[string]time.Time
@@ -80,12 +84,28 @@ func NewMetrics(ctx context.Context, meter metric.Meter) (*Metrics, error) {
 		return nil, err
 	}
 
+	throttledMessages, err := meter.Int64Counter("relay_throttled_messages_total",
+		metric.WithDescription("Total number of transport messages dropped by the rate limits"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	throttledBytes, err := meter.Int64Counter("relay_throttled_bytes_total",
+		metric.WithDescription("Total number of bytes dropped by the rate limits"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	m := &Metrics{
 		Meter:              meter,
 		TransferBytesSent:  bytesSent,
 		TransferBytesRecv:  bytesRecv,
 		AuthenticationTime: authTime,
 		PeerStoreTime:      peerStoreTime,
+		throttledMessages:  throttledMessages,
+		throttledBytes:     throttledBytes,
 		peers:              peers,
 
 		ctx:              ctx,
@@ -129,6 +149,13 @@ func (m *Metrics) RecordPeerStoreTime(duration time.Duration) {
 	m.PeerStoreTime.Record(m.ctx, float64(duration.Nanoseconds())/1e6)
 }
 
+// RecordThrottled counts a transport message dropped by the given rate limit scope (peer or global)
+func (m *Metrics) RecordThrottled(scope string, bytes int) {
+	attrs := metric.WithAttributes(attribute.String("scope", scope))
+	m.throttledMessages.Add(m.ctx, 1, attrs)
+	m.throttledBytes.Add(m.ctx, int64(bytes), attrs)
+}
+
 // PeerDisconnected decrements the number of connected peers and decrements number of idle or active connections
 func (m *Metrics) PeerDisconnected(id string) {
 	m.peers.Add(m.ctx, -1)
@@ -0,0 +1,560 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/relay/messages"
+)
+
+const (
+	clusterProtocolVersion = 1
+	clusterNodeIDSize      = 16
+	clusterNonceSize       = 32
+
+	// clusterFrameHeaderSize is the size of the frame type and the payload length
+	clusterFrameHeaderSize = 5
+	// clusterMaxFrameSize is large enough for a forwarded transport message and a presence batch
+	clusterMaxFrameSize = messages.IDSize + bufferSize
+	// clusterPresenceBatchSize is the number of peer IDs sent in one presence frame
+	clusterPresenceBatchSize = clusterMaxFrameSize / messages.IDSize
+
+	clusterHandshakeTimeout = 10 * time.Second
+	clusterRedialInterval   = 5 * time.Second
+	clusterWriteTimeout     = 5 * time.Second
+	// clusterControlQueueSize is the number of presence frames queued for a link before it is considered stuck
+	clusterControlQueueSize = 1024
+)
+
+var clusterMagic = []byte("NBRC")
+
+type clusterFrameType byte
+
+const (
+	// clusterFrameAnnounce lists peers connected to the sending instance
+	clusterFrameAnnounce clusterFrameType = 1
+	// clusterFrameWithdraw lists peers disconnected from the sending instance
+	clusterFrameWithdraw clusterFrameType = 2
+	// clusterFrameTransport carries the ID of the destination peer followed by a transport message
+	clusterFrameTransport clusterFrameType = 3
+)
+
+// ClusterConfig configures the links between the relay instances of a cluster.
+// The instances share the presence of their peers and forward the transport messages of the peers connected to
+// another instance, so a peer needs a single relay connection to reach every peer of the cluster. The instances of a
+// cluster should use the same exposed address, so the peers report the same relay address to each other.
+// The links are authenticated with the shared secret but not encrypted, the transport messages are encrypted by
+// WireGuard. Run them on a private network.
+type ClusterConfig struct {
+	// ListenAddress is the address the instance accepts the links of the other instances on
+	ListenAddress string
+	// Members are the link addresses of the other instances. An instance dials every member, two instances are
+	// linked when either of them lists the other
+	Members []string
+	// Secret authenticates the instances of the cluster
+	Secret string
+}
+
+// cluster links the relay instance with the other instances of the cluster
+type cluster struct {
+	nodeID   []byte
+	secret   []byte
+	store    *Store
+	listener net.Listener
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+
+	// presenceMu orders the changes of the local store with the presence frames sent to the links
+	presenceMu sync.Mutex
+	links      map[*clusterLink]struct{}
+
+	// remotePeers are the links of the instances the peers are connected to, indexed by peer ID
+	remotePeers   map[string]map[*clusterLink]struct{}
+	remotePeersMu sync.RWMutex
+}
+
+func newCluster(cfg ClusterConfig, store *Store) (*cluster, error) {
+	if cfg.Secret == "" {
+		return nil, errors.New("cluster secret is required")
+	}
+
+	nodeID := make([]byte, clusterNodeIDSize)
+	if _, err := rand.Read(nodeID); err != nil {
+		return nil, fmt.Errorf("generate node id: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", cfg.ListenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("listen on cluster address: %w", err)
+	}
+
+	secret := sha256.Sum256([]byte(cfg.Secret))
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &cluster{
+		nodeID:      nodeID,
+		secret:      secret[:],
+		store:       store,
+		listener:    listener,
+		ctx:         ctx,
+		cancel:      cancel,
+		links:       make(map[*clusterLink]struct{}),
+		remotePeers: make(map[string]map[*clusterLink]struct{}),
+	}
+
+	log.Infof("cluster link listening on %s", listener.Addr())
+	c.wg.Add(1)
+	go c.acceptLinks()
+
+	for _, member := range cfg.Members {
+		c.wg.Add(1)
+		go c.dialMember(member)
+	}
+
+	return c, nil
+}
+
+// addr returns the address the instance accepts the links on
+func (c *cluster) addr() net.Addr {
+	return c.listener.Addr()
+}
+
+// close closes the links and stops accepting and dialing new ones
+func (c *cluster) close() {
+	c.cancel()
+	if err := c.listener.Close(); err != nil {
+		log.Debugf("failed to close cluster listener: %s", err)
+	}
+
+	c.presenceMu.Lock()
+	for link := range c.links {
+		link.close()
+	}
+	c.presenceMu.Unlock()
+
+	c.wg.Wait()
+}
+
+// addPeer adds the peer to the store and announces it to the other instances
+func (c *cluster) addPeer(peer *Peer) {
+	c.presenceMu.Lock()
+	defer c.presenceMu.Unlock()
+
+	c.store.AddPeer(peer)
+	for link := range c.links {
+		link.sendControl(clusterFrameAnnounce, peer.idB)
+	}
+}
+
+// deletePeer deletes the peer from the store and withdraws it from the other instances unless it has been replaced
+func (c *cluster) deletePeer(peer *Peer) {
+	c.presenceMu.Lock()
+	defer c.presenceMu.Unlock()
+
+	if !c.store.DeletePeer(peer) {
+		return
+	}
+	for link := range c.links {
+		link.sendControl(clusterFrameWithdraw, peer.idB)
+	}
+}
+
+// forward sends the transport message to the instance the peer is connected to.
+// It returns false if the peer is not connected to any other instance
+func (c *cluster) forward(peerID []byte, msg []byte) (bool, error) {
+	c.remotePeersMu.RLock()
+	var link *clusterLink
+	for l := range c.remotePeers[messages.HashIDToString(peerID)] {
+		link = l
+		break
+	}
+	c.remotePeersMu.RUnlock()
+
+	if link == nil {
+		return false, nil
+	}
+	return true, link.writeFrame(clusterFrameTransport, peerID, msg)
+}
+
+func (c *cluster) acceptLinks() {
+	defer c.wg.Done()
+
+	for {
+		conn, err := c.listener.Accept()
+		if err != nil {
+			if c.ctx.Err() == nil {
+				log.Errorf("failed to accept cluster link: %s", err)
+			}
+			return
+		}
+
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+
+			remoteNodeID, err := c.handshakeAccept(conn)
+			if err != nil {
+				log.Warnf("cluster link from %s rejected: %s", conn.RemoteAddr(), err)
+				_ = conn.Close()
+				return
+			}
+			if bytes.Equal(remoteNodeID, c.nodeID) {
+				// this instance is in its own member list
+				_ = conn.Close()
+				return
+			}
+			c.serveLink(conn, remoteNodeID)
+		}()
+	}
+}
+
+// dialMember keeps a link to the member open until the cluster is closed
+func (c *cluster) dialMember(address string) {
+	defer c.wg.Done()
+
+	dialer := net.Dialer{Timeout: clusterHandshakeTimeout}
+	for {
+		conn, err := dialer.DialContext(c.ctx, "tcp", address)
+		if err == nil {
+			var remoteNodeID []byte
+			remoteNodeID, err = c.handshakeDial(conn)
+			switch {
+			case err == nil && bytes.Equal(remoteNodeID, c.nodeID):
+				log.Debugf("cluster member %s is this instance, not linking", address)
+				_ = conn.Close()
+				return
+			case err == nil:
+				log.Infof("cluster link to %s established", address)
+				c.serveLink(conn, remoteNodeID)
+				log.Infof("cluster link to %s closed", address)
+			default:
+				_ = conn.Close()
+			}
+		}
+		if err != nil && c.ctx.Err() == nil {
+			log.Warnf("failed to link cluster member %s: %s", address, err)
+		}
+
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(clusterRedialInterval):
+		}
+	}
+}
+
+// handshakeDial authenticates the dialed instance and authenticates this instance to it.
+// It returns the node ID of the dialed instance
+func (c *cluster) handshakeDial(conn net.Conn) ([]byte, error) {
+	if err := conn.SetDeadline(time.Now().Add(clusterHandshakeTimeout)); err != nil {
+		return nil, err
+	}
+
+	localNonce := make([]byte, clusterNonceSize)
+	if _, err := rand.Read(localNonce); err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(c.marshalHello(localNonce, nil)); err != nil {
+		return nil, fmt.Errorf("send hello: %w", err)
+	}
+
+	remoteNonce, remoteNodeID, remoteMAC, err := c.readHello(conn, true)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(remoteMAC, c.mac("accept", localNonce, remoteNonce, remoteNodeID)) {
+		return nil, errors.New("invalid cluster secret")
+	}
+
+	if _, err := conn.Write(c.mac("dial", remoteNonce, localNonce, c.nodeID)); err != nil {
+		return nil, fmt.Errorf("send authentication: %w", err)
+	}
+
+	return remoteNodeID, conn.SetDeadline(time.Time{})
+}
+
+// handshakeAccept authenticates the dialing instance and authenticates this instance to it.
+// It returns the node ID of the dialing instance
+func (c *cluster) handshakeAccept(conn net.Conn) ([]byte, error) {
+	if err := conn.SetDeadline(time.Now().Add(clusterHandshakeTimeout)); err != nil {
+		return nil, err
+	}
+
+	remoteNonce, remoteNodeID, _, err := c.readHello(conn, false)
+	if err != nil {
+		return nil, err
+	}
+
+	localNonce := make([]byte, clusterNonceSize)
+	if _, err := rand.Read(localNonce); err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(c.marshalHello(localNonce, c.mac("accept", remoteNonce, localNonce, c.nodeID))); err != nil {
+		return nil, fmt.Errorf("send hello: %w", err)
+	}
+
+	remoteMAC := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(conn, remoteMAC); err != nil {
+		return nil, fmt.Errorf("read authentication: %w", err)
+	}
+	if !hmac.Equal(remoteMAC, c.mac("dial", localNonce, remoteNonce, remoteNodeID)) {
+		return nil, errors.New("invalid cluster secret")
+	}
+
+	return remoteNodeID, conn.SetDeadline(time.Time{})
+}
+
+// marshalHello creates the hello message with the nonce, the node ID and the optional authentication code
+func (c *cluster) marshalHello(nonce, mac []byte) []byte {
+	msg := make([]byte, 0, len(clusterMagic)+1+clusterNonceSize+clusterNodeIDSize+len(mac))
+	msg = append(msg, clusterMagic...)
+	msg = append(msg, clusterProtocolVersion)
+	msg = append(msg, nonce...)
+	msg = append(msg, c.nodeID...)
+	return append(msg, mac...)
+}
+
+func (c *cluster) readHello(conn net.Conn, withMAC bool) (nonce, nodeID, mac []byte, err error) {
+	size := len(clusterMagic) + 1 + clusterNonceSize + clusterNodeIDSize
+	if withMAC {
+		size += sha256.Size
+	}
+
+	msg := make([]byte, size)
+	if _, err := io.ReadFull(conn, msg); err != nil {
+		return nil, nil, nil, fmt.Errorf("read hello: %w", err)
+	}
+	if !bytes.Equal(msg[:len(clusterMagic)], clusterMagic) {
+		return nil, nil, nil, errors.New("not a cluster link")
+	}
+	msg = msg[len(clusterMagic):]
+	if msg[0] != clusterProtocolVersion {
+		return nil, nil, nil, fmt.Errorf("unsupported cluster protocol version %d", msg[0])
+	}
+	msg = msg[1:]
+
+	nonce, msg = msg[:clusterNonceSize], msg[clusterNonceSize:]
+	nodeID, mac = msg[:clusterNodeIDSize], msg[clusterNodeIDSize:]
+	return nonce, nodeID, mac, nil
+}
+
+// mac authenticates the handshake role and the nonces of both sides and the node ID of the sender with the secret
+func (c *cluster) mac(role string, receiverNonce, senderNonce, senderNodeID []byte) []byte {
+	h := hmac.New(sha256.New, c.secret)
+	h.Write([]byte(role))
+	h.Write(receiverNonce)
+	h.Write(senderNonce)
+	h.Write(senderNodeID)
+	return h.Sum(nil)
+}
+
+// serveLink shares the presence of the peers with the linked instance and handles its frames until the link closes
+func (c *cluster) serveLink(conn net.Conn, remoteNodeID []byte) {
+	link := newClusterLink(conn, remoteNodeID)
+
+	c.presenceMu.Lock()
+	if c.ctx.Err() != nil {
+		c.presenceMu.Unlock()
+		link.close()
+		return
+	}
+	peers := c.store.Peers()
+	for i := 0; i < len(peers); i += clusterPresenceBatchSize {
+		batch := make([]byte, 0, clusterPresenceBatchSize*messages.IDSize)
+		for _, peer := range peers[i:min(i+clusterPresenceBatchSize, len(peers))] {
+			batch = append(batch, peer.idB...)
+		}
+		link.sendControl(clusterFrameAnnounce, batch)
+	}
+	c.links[link] = struct{}{}
+	c.presenceMu.Unlock()
+
+	go link.writeControl()
+	err := c.readLink(link)
+	if err != nil && c.ctx.Err() == nil {
+		link.log.Warnf("cluster link failed: %s", err)
+	}
+
+	c.presenceMu.Lock()
+	delete(c.links, link)
+	c.presenceMu.Unlock()
+	link.close()
+	c.removeLinkPeers(link)
+}
+
+func (c *cluster) readLink(link *clusterLink) error {
+	header := make([]byte, clusterFrameHeaderSize)
+	payload := make([]byte, clusterMaxFrameSize)
+	for {
+		if _, err := io.ReadFull(link.conn, header); err != nil {
+			return err
+		}
+		frameType := clusterFrameType(header[0])
+		size := binary.BigEndian.Uint32(header[1:])
+		if size > clusterMaxFrameSize {
+			return fmt.Errorf("frame too large: %d", size)
+		}
+		if _, err := io.ReadFull(link.conn, payload[:size]); err != nil {
+			return err
+		}
+
+		if err := c.handleFrame(link, frameType, payload[:size]); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *cluster) handleFrame(link *clusterLink, frameType clusterFrameType, payload []byte) error {
+	switch frameType {
+	case clusterFrameAnnounce, clusterFrameWithdraw:
+		if len(payload)%messages.IDSize != 0 {
+			return fmt.Errorf("invalid presence frame size: %d", len(payload))
+		}
+		c.remotePeersMu.Lock()
+		for i := 0; i < len(payload); i += messages.IDSize {
+			peerID := messages.HashIDToString(payload[i : i+messages.IDSize])
+			if frameType == clusterFrameAnnounce {
+				if c.remotePeers[peerID] == nil {
+					c.remotePeers[peerID] = make(map[*clusterLink]struct{})
+				}
+				c.remotePeers[peerID][link] = struct{}{}
+				continue
+			}
+			delete(c.remotePeers[peerID], link)
+			if len(c.remotePeers[peerID]) == 0 {
+				delete(c.remotePeers, peerID)
+			}
+		}
+		c.remotePeersMu.Unlock()
+	case clusterFrameTransport:
+		if len(payload) < messages.IDSize {
+			return fmt.Errorf("invalid transport frame size: %d", len(payload))
+		}
+		peerID := messages.HashIDToString(payload[:messages.IDSize])
+		dp, ok := c.store.Peer(peerID)
+		if !ok {
+			link.log.Debugf("forwarded peer not found: %s", peerID)
+			return nil
+		}
+		if _, err := dp.Write(payload[messages.IDSize:]); err != nil {
+			dp.log.Errorf("failed to write forwarded transport message: %s", err)
+		}
+	default:
+		link.log.Warnf("received unexpected cluster frame type: %d", frameType)
+	}
+	return nil
+}
+
+// removeLinkPeers forgets the peers announced by the closed link
+func (c *cluster) removeLinkPeers(link *clusterLink) {
+	c.remotePeersMu.Lock()
+	defer c.remotePeersMu.Unlock()
+
+	for peerID, links := range c.remotePeers {
+		delete(links, link)
+		if len(links) == 0 {
+			delete(c.remotePeers, peerID)
+		}
+	}
+}
+
+// clusterLink is an authenticated connection with another instance of the cluster
+type clusterLink struct {
+	conn    net.Conn
+	log     *log.Entry
+	writeMu sync.Mutex
+	// control queues the presence frames, so a slow link doesn't hold up the peers connecting to this instance
+	control   chan []byte
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newClusterLink(conn net.Conn, remoteNodeID []byte) *clusterLink {
+	return &clusterLink{
+		conn:    conn,
+		log:     log.WithField("cluster_node", fmt.Sprintf("%x", remoteNodeID)),
+		control: make(chan []byte, clusterControlQueueSize),
+		closed:  make(chan struct{}),
+	}
+}
+
+// sendControl queues a presence frame. The link is closed if the queue is full, the presence is shared again when the
+// link is reestablished
+func (l *clusterLink) sendControl(frameType clusterFrameType, peerIDs []byte) {
+	frame := marshalClusterFrame(frameType, peerIDs)
+	select {
+	case l.control <- frame:
+	default:
+		l.log.Warnf("cluster link is stuck, closing")
+		l.close()
+	}
+}
+
+func (l *clusterLink) writeControl() {
+	for {
+		select {
+		case <-l.closed:
+			return
+		case frame := <-l.control:
+			if err := l.write(frame); err != nil {
+				l.log.Debugf("failed to write presence frame: %s", err)
+				return
+			}
+		}
+	}
+}
+
+func (l *clusterLink) writeFrame(frameType clusterFrameType, parts ...[]byte) error {
+	return l.write(marshalClusterFrame(frameType, parts...))
+}
+
+func (l *clusterLink) write(frame []byte) error {
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+
+	if err := l.conn.SetWriteDeadline(time.Now().Add(clusterWriteTimeout)); err != nil {
+		return err
+	}
+	if _, err := l.conn.Write(frame); err != nil {
+		// a partially written frame breaks the framing of the link
+		l.close()
+		return err
+	}
+	return nil
+}
+
+func (l *clusterLink) close() {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		if err := l.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			l.log.Debugf("failed to close cluster link: %s", err)
+		}
+	})
+}
+
+func marshalClusterFrame(frameType clusterFrameType, parts ...[]byte) []byte {
+	size := 0
+	for _, part := range parts {
+		size += len(part)
+	}
+
+	frame := make([]byte, clusterFrameHeaderSize, clusterFrameHeaderSize+size)
+	frame[0] = byte(frameType)
+	binary.BigEndian.PutUint32(frame[1:], uint32(size))
+	for _, part := range parts {
+		frame = append(frame, part...)
+	}
+	return frame
+}
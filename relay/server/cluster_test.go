@@ -0,0 +1,65 @@
+package server
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (c *cluster) linkCount() int {
+	c.presenceMu.Lock()
+	defer c.presenceMu.Unlock()
+	return len(c.links)
+}
+
+func TestCluster_Handshake(t *testing.T) {
+	c1, err := newCluster(ClusterConfig{ListenAddress: "127.0.0.1:0", Secret: "secret"}, NewStore())
+	require.NoError(t, err)
+	t.Cleanup(c1.close)
+
+	conn, err := net.Dial("tcp", c1.addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	c2, err := newCluster(ClusterConfig{ListenAddress: "127.0.0.1:0", Secret: "secret"}, NewStore())
+	require.NoError(t, err)
+	t.Cleanup(c2.close)
+
+	remoteNodeID, err := c2.handshakeDial(conn)
+	require.NoError(t, err)
+	assert.Equal(t, c1.nodeID, remoteNodeID)
+	assert.Eventually(t, func() bool { return c1.linkCount() == 1 }, time.Second, 10*time.Millisecond)
+
+	wrongSecret, err := newCluster(ClusterConfig{ListenAddress: "127.0.0.1:0", Secret: "wrong"}, NewStore())
+	require.NoError(t, err)
+	t.Cleanup(wrongSecret.close)
+
+	conn, err = net.Dial("tcp", c1.addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = wrongSecret.handshakeDial(conn)
+	assert.Error(t, err, "instance with another secret should not be linked")
+	assert.Equal(t, 1, c1.linkCount())
+}
+
+func TestCluster_IgnoresItselfAsMember(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	c, err := newCluster(ClusterConfig{ListenAddress: addr, Members: []string{addr}, Secret: "secret"}, NewStore())
+	require.NoError(t, err)
+	t.Cleanup(c.close)
+
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, 0, c.linkCount())
+}
+
+func TestNewCluster_RequiresSecret(t *testing.T) {
+	_, err := newCluster(ClusterConfig{ListenAddress: "127.0.0.1:0"}, NewStore())
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/relay/auth"
+	authv2 "github.com/netbirdio/netbird/relay/auth/hmac/v2"
 	"github.com/netbirdio/netbird/relay/messages"
 	//nolint:staticcheck
 	"github.com/netbirdio/netbird/relay/messages/address"
@@ -59,6 +60,8 @@ type handshake struct {
 
 	handshakeMethodAuth bool
 	peerID              string
+	// limits are the rate limits carried in the auth token of the peer
+	limits authv2.Limits
 }
 
 func (h *handshake) handshakeReceive() ([]byte, error) {
@@ -151,5 +154,11 @@ func (h *handshake) handleAuthMsg(buf []byte) ([]byte, string, error) {
 		return nil, "", fmt.Errorf("validate %s (%s): %w", peerID, h.conn.RemoteAddr(), err)
 	}
 
+	limits, err := authv2.LimitsFromToken(authPayload)
+	if err != nil {
+		log.Warnf("ignore rate limits in the token of %s (%s): %s", peerID, h.conn.RemoteAddr(), err)
+	}
+	h.limits = limits
+
 	return rawPeerID, peerID, nil
 }
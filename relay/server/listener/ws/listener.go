@@ -15,6 +15,10 @@ import (
 // URLPath is the path for the websocket connection.
 const URLPath = "/relay"
 
+// SubprotocolTokenLimits is negotiated by the relay servers that accept the rate limits in the auth token. Older
+// servers don't negotiate any subprotocol, the clients send them the token without limits
+const SubprotocolTokenLimits = "netbird-relay-token-limits"
+
 type Listener struct {
 	// Address is the address to listen on.
 	Address string
@@ -64,7 +68,9 @@ func (l *Listener) Shutdown(ctx context.Context) error {
 
 func (l *Listener) onAccept(w http.ResponseWriter, r *http.Request) {
 	connRemoteAddr := remoteAddr(r)
-	wsConn, err := websocket.Accept(w, r, nil)
+	wsConn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+		Subprotocols: []string{SubprotocolTokenLimits},
+	})
 	if err != nil {
 		log.Errorf("failed to accept ws connection from %s: %s", connRemoteAddr, err)
 		return
@@ -29,6 +29,12 @@ type Peer struct {
 	conn    net.Conn
 	connMu  sync.RWMutex
 	store   *Store
+	// cluster forwards the transport messages to the peers connected to other relay instances, nil without clustering
+	cluster *cluster
+	// limiter and globalLimiter rate limit the transport messages, nil without limits
+	limiter            *limiter
+	globalLimiter      *limiter
+	lastThrottleNotify time.Time
 }
 
 // NewPeer creates a new Peer instance and prepare custom logging
@@ -101,6 +107,9 @@ func (p *Peer) handleMsgType(ctx context.Context, msgType messages.MsgType, hc *
 	case messages.MsgTypeTransport:
 		p.metrics.TransferBytesRecv.Add(ctx, int64(n))
 		p.metrics.PeerActivity(p.String())
+		if !p.allowTransport(n) {
+			return
+		}
 		p.handleTransportMsg(msg)
 	case messages.MsgTypeClose:
 		p.log.Infof("peer exited gracefully")
@@ -200,7 +209,7 @@ func (p *Peer) handleTransportMsg(msg []byte) {
 	stringPeerID := messages.HashIDToString(peerID)
 	dp, ok := p.store.Peer(stringPeerID)
 	if !ok {
-		p.log.Debugf("peer not found: %s", stringPeerID)
+		p.forwardTransportMsg(peerID, stringPeerID, msg)
 		return
 	}
 
@@ -217,3 +226,30 @@ func (p *Peer) handleTransportMsg(msg []byte) {
 	}
 	p.metrics.TransferBytesSent.Add(context.Background(), int64(n))
 }
+
+// forwardTransportMsg sends the transport message to the relay instance of the cluster the peer is connected to
+func (p *Peer) forwardTransportMsg(peerID []byte, stringPeerID string, msg []byte) {
+	if p.cluster == nil {
+		p.log.Debugf("peer not found: %s", stringPeerID)
+		return
+	}
+
+	// the destination ID is replaced by the source ID, keep a copy for the receiving instance
+	dstID := make([]byte, len(peerID))
+	copy(dstID, peerID)
+	if err := messages.UpdateTransportMsg(msg, p.idB); err != nil {
+		p.log.Errorf("failed to update transport message: %s", err)
+		return
+	}
+
+	found, err := p.cluster.forward(dstID, msg)
+	if !found {
+		p.log.Debugf("peer not found in the cluster: %s", stringPeerID)
+		return
+	}
+	if err != nil {
+		p.log.Errorf("failed to forward transport message to: %s", stringPeerID)
+		return
+	}
+	p.metrics.TransferBytesSent.Add(context.Background(), int64(len(msg)))
+}
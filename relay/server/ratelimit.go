@@ -0,0 +1,159 @@
+package server
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+
+	authv2 "github.com/netbirdio/netbird/relay/auth/hmac/v2"
+	"github.com/netbirdio/netbird/relay/messages"
+)
+
+const (
+	// throttleNotifyInterval is the minimum time between two throttle messages to the same peer
+	throttleNotifyInterval = time.Second
+
+	throttleScopePeer   = "peer"
+	throttleScopeGlobal = "global"
+)
+
+// Limits are token bucket limits of the transport messages, zero values are unlimited
+type Limits struct {
+	BytesPerSecond    int64
+	MessagesPerSecond int64
+}
+
+// LimitsConfig configures the rate limits of the transport messages the peers send
+type LimitsConfig struct {
+	// Peer limits every peer on its own, the auth token of the peer can only tighten it
+	Peer Limits
+	// Global limits all the peers of the relay instance together
+	Global Limits
+}
+
+// peerLimits returns the limits of a peer with the limits of its auth token applied. The token limits are set by the
+// account admins, so they can tighten the limits of the relay operator but never lift them
+func (c LimitsConfig) peerLimits(tokenLimits authv2.Limits) Limits {
+	return Limits{
+		BytesPerSecond:    tighterLimit(c.Peer.BytesPerSecond, tokenLimits.BytesPerSecond),
+		MessagesPerSecond: tighterLimit(c.Peer.MessagesPerSecond, tokenLimits.MessagesPerSecond),
+	}
+}
+
+// tighterLimit returns the smaller of the two limits, zero values are unlimited
+func tighterLimit(limit, tokenLimit int64) int64 {
+	if tokenLimit <= 0 {
+		return limit
+	}
+	if limit <= 0 {
+		return tokenLimit
+	}
+	return min(limit, tokenLimit)
+}
+
+type limiter struct {
+	bytes    *rate.Limiter
+	messages *rate.Limiter
+}
+
+// newLimiter returns nil if the limits are not set, a nil limiter allows every message
+func newLimiter(limits Limits) *limiter {
+	if limits.BytesPerSecond <= 0 && limits.MessagesPerSecond <= 0 {
+		return nil
+	}
+
+	l := &limiter{}
+	if limits.BytesPerSecond > 0 {
+		// the burst must fit the largest message, otherwise it would never pass
+		burst := max(int(limits.BytesPerSecond), bufferSize)
+		l.bytes = rate.NewLimiter(rate.Limit(limits.BytesPerSecond), burst)
+	}
+	if limits.MessagesPerSecond > 0 {
+		l.messages = rate.NewLimiter(rate.Limit(limits.MessagesPerSecond), int(limits.MessagesPerSecond))
+	}
+	return l
+}
+
+// reserve takes the tokens of a message of n bytes. If a limit is exceeded it takes no tokens and returns how long
+// the sender should wait. The returned function gives back the taken tokens.
+func (l *limiter) reserve(now time.Time, n int) (func(), time.Duration, bool) {
+	if l == nil {
+		return func() {}, 0, true
+	}
+
+	bytesReservation, retryAfter, ok := reserveTokens(l.bytes, now, n)
+	if !ok {
+		return nil, retryAfter, false
+	}
+
+	messagesReservation, retryAfter, ok := reserveTokens(l.messages, now, 1)
+	if !ok {
+		cancelReservation(bytesReservation, now)
+		return nil, retryAfter, false
+	}
+
+	return func() {
+		cancelReservation(bytesReservation, now)
+		cancelReservation(messagesReservation, now)
+	}, 0, true
+}
+
+func reserveTokens(l *rate.Limiter, now time.Time, tokens int) (*rate.Reservation, time.Duration, bool) {
+	if l == nil {
+		return nil, 0, true
+	}
+
+	r := l.ReserveN(now, tokens)
+	if !r.OK() {
+		return nil, throttleNotifyInterval, false
+	}
+
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return nil, delay, false
+	}
+	return r, 0, true
+}
+
+func cancelReservation(r *rate.Reservation, now time.Time) {
+	if r != nil {
+		r.CancelAt(now)
+	}
+}
+
+// allowTransport applies the per-peer and the global rate limits to a transport message of n bytes. The limited
+// messages are dropped and the peer is notified with a throttle message.
+func (p *Peer) allowTransport(n int) bool {
+	now := time.Now()
+
+	cancelPeer, retryAfter, ok := p.limiter.reserve(now, n)
+	if !ok {
+		p.throttled(now, messages.ThrottleReasonPeer, retryAfter, n)
+		return false
+	}
+
+	if _, retryAfter, ok = p.globalLimiter.reserve(now, n); !ok {
+		cancelPeer()
+		p.throttled(now, messages.ThrottleReasonGlobal, retryAfter, n)
+		return false
+	}
+	return true
+}
+
+func (p *Peer) throttled(now time.Time, reason messages.ThrottleReason, retryAfter time.Duration, n int) {
+	scope := throttleScopePeer
+	if reason == messages.ThrottleReasonGlobal {
+		scope = throttleScopeGlobal
+	}
+	p.metrics.RecordThrottled(scope, n)
+
+	if now.Sub(p.lastThrottleNotify) < throttleNotifyInterval {
+		return
+	}
+	p.lastThrottleNotify = now
+
+	p.log.Debugf("throttling transport messages by %s, retry after %s", reason, retryAfter)
+	if _, err := p.Write(messages.MarshalThrottleMsg(reason, retryAfter)); err != nil {
+		p.log.Errorf("failed to send throttle message: %s", err)
+	}
+}
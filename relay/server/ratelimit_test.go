@@ -0,0 +1,58 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	authv2 "github.com/netbirdio/netbird/relay/auth/hmac/v2"
+)
+
+func TestLimiter_Reserve(t *testing.T) {
+	var unlimited *limiter
+	_, _, ok := unlimited.reserve(time.Now(), bufferSize)
+	assert.True(t, ok, "nil limiter should allow every message")
+	assert.Nil(t, newLimiter(Limits{}))
+
+	l := newLimiter(Limits{BytesPerSecond: 100, MessagesPerSecond: 2})
+	now := time.Now()
+
+	_, _, ok = l.reserve(now, bufferSize)
+	assert.True(t, ok, "the burst should fit the largest message")
+
+	_, retryAfter, ok := l.reserve(now, 1)
+	assert.False(t, ok, "the bytes should be exceeded")
+	assert.Greater(t, retryAfter, time.Duration(0))
+
+	// the exceeded messages limit must give back the bytes
+	l = newLimiter(Limits{BytesPerSecond: bufferSize, MessagesPerSecond: 1})
+	_, _, ok = l.reserve(now, 100)
+	assert.True(t, ok)
+	_, _, ok = l.reserve(now, 100)
+	assert.False(t, ok)
+	assert.Equal(t, float64(bufferSize-100), l.bytes.TokensAt(now))
+}
+
+func TestLimiter_Cancel(t *testing.T) {
+	l := newLimiter(Limits{MessagesPerSecond: 1})
+	now := time.Now()
+
+	cancel, _, ok := l.reserve(now, 1)
+	assert.True(t, ok)
+	cancel()
+
+	_, _, ok = l.reserve(now, 1)
+	assert.True(t, ok, "canceled reservation should give back the tokens")
+}
+
+func TestLimitsConfig_PeerLimits(t *testing.T) {
+	cfg := LimitsConfig{Peer: Limits{BytesPerSecond: 1000, MessagesPerSecond: 10}}
+
+	assert.Equal(t, cfg.Peer, cfg.peerLimits(authv2.Limits{}))
+	assert.Equal(t, Limits{BytesPerSecond: 500, MessagesPerSecond: 10}, cfg.peerLimits(authv2.Limits{BytesPerSecond: 500}))
+	assert.Equal(t, cfg.Peer, cfg.peerLimits(authv2.Limits{BytesPerSecond: 5000, MessagesPerSecond: 100}), "token must not lift the operator limits")
+
+	unlimited := LimitsConfig{}
+	assert.Equal(t, Limits{BytesPerSecond: 5000}, unlimited.peerLimits(authv2.Limits{BytesPerSecond: 5000}))
+}
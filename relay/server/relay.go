@@ -23,9 +23,12 @@ type Relay struct {
 	metricsCancel context.CancelFunc
 	validator     auth.Validator
 
-	store       *Store
-	instanceURL string
-	preparedMsg *preparedMsg
+	store         *Store
+	cluster       *cluster
+	limits        LimitsConfig
+	globalLimiter *limiter
+	instanceURL   string
+	preparedMsg   *preparedMsg
 
 	closed  bool
 	closeMu sync.RWMutex
@@ -126,14 +129,17 @@ func (r *Relay) Accept(conn net.Conn) {
 	}
 
 	peer := NewPeer(r.metrics, peerID, conn, r.store)
+	peer.cluster = r.cluster
+	peer.limiter = newLimiter(r.limits.peerLimits(h.limits))
+	peer.globalLimiter = r.globalLimiter
 	peer.log.Infof("peer connected from: %s", conn.RemoteAddr())
 	storeTime := time.Now()
-	r.store.AddPeer(peer)
+	r.addPeer(peer)
 	r.metrics.RecordPeerStoreTime(time.Since(storeTime))
 	r.metrics.PeerConnected(peer.String())
 	go func() {
 		peer.Work()
-		r.store.DeletePeer(peer)
+		r.deletePeer(peer)
 		peer.log.Debugf("relay connection closed")
 		r.metrics.PeerDisconnected(peer.String())
 	}()
@@ -162,10 +168,67 @@ func (r *Relay) Shutdown(ctx context.Context) {
 		}(peer)
 	}
 	wg.Wait()
+	if r.cluster != nil {
+		r.cluster.close()
+	}
 	r.metricsCancel()
 	r.closed = true
 }
 
+// JoinCluster links the relay with the other instances of the cluster. It must be called before accepting peers.
+func (r *Relay) JoinCluster(cfg ClusterConfig) error {
+	r.closeMu.Lock()
+	defer r.closeMu.Unlock()
+
+	if r.cluster != nil {
+		return fmt.Errorf("already joined a cluster")
+	}
+
+	c, err := newCluster(cfg, r.store)
+	if err != nil {
+		return err
+	}
+	r.cluster = c
+	return nil
+}
+
+// SetLimits configures the rate limits of the transport messages. It must be called before accepting peers.
+func (r *Relay) SetLimits(cfg LimitsConfig) {
+	r.closeMu.Lock()
+	defer r.closeMu.Unlock()
+
+	r.limits = cfg
+	r.globalLimiter = newLimiter(cfg.Global)
+}
+
+// ClusterAddress returns the address the relay accepts the links of the other cluster instances on, empty without
+// clustering
+func (r *Relay) ClusterAddress() string {
+	r.closeMu.RLock()
+	defer r.closeMu.RUnlock()
+
+	if r.cluster == nil {
+		return ""
+	}
+	return r.cluster.addr().String()
+}
+
+func (r *Relay) addPeer(peer *Peer) {
+	if r.cluster != nil {
+		r.cluster.addPeer(peer)
+		return
+	}
+	r.store.AddPeer(peer)
+}
+
+func (r *Relay) deletePeer(peer *Peer) {
+	if r.cluster != nil {
+		r.cluster.deletePeer(peer)
+		return
+	}
+	r.store.DeletePeer(peer)
+}
+
 // InstanceURL returns the instance URL of the relay server
 func (r *Relay) InstanceURL() string {
 	return r.instanceURL
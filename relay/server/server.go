@@ -89,6 +89,21 @@ func (r *Server) Listen(cfg ListenerConfig) error {
 	return nberrors.FormatErrorOrNil(multiErr)
 }
 
+// JoinCluster links the relay server with the other instances of the cluster. It must be called before Listen.
+func (r *Server) JoinCluster(cfg ClusterConfig) error {
+	return r.relay.JoinCluster(cfg)
+}
+
+// SetLimits configures the per-peer and global rate limits of the transport messages. It must be called before Listen.
+func (r *Server) SetLimits(cfg LimitsConfig) {
+	r.relay.SetLimits(cfg)
+}
+
+// ClusterAddress returns the address the relay server accepts the links of the other cluster instances on
+func (r *Server) ClusterAddress() string {
+	return r.relay.ClusterAddress()
+}
+
 // Shutdown stops the relay server. If there are active connections, they will be closed gracefully. In case of a context,
 // the connections will be forcefully closed.
 func (r *Server) Shutdown(ctx context.Context) error {
@@ -1,3 +1,17 @@
 package tls
 
+import "crypto/tls"
+
 const nbalpn = "nb-quic"
+
+// nbalpnTokenLimits is negotiated by the relay servers that accept the rate limits in the auth token. Older servers
+// only know nbalpn, the clients send them the token without limits
+const nbalpnTokenLimits = "nb-quic-token-limits"
+
+// nextProtos lists the ALPNs in the order of preference, the servers pick the first one the client supports
+var nextProtos = []string{nbalpnTokenLimits, nbalpn}
+
+// TokenLimitsSupported returns true if the relay server of the connection accepts the rate limits in the auth token
+func TokenLimitsSupported(state tls.ConnectionState) bool {
+	return state.NegotiatedProtocol == nbalpnTokenLimits
+}
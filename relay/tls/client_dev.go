@@ -19,8 +19,8 @@ func ClientQUICTLSConfig() *tls.Config {
 	}
 
 	return &tls.Config{
-		InsecureSkipVerify: true,             // Debug mode allows insecure connections
-		NextProtos:         []string{nbalpn}, // Ensure this matches the server's ALPN
+		InsecureSkipVerify: true,       // Debug mode allows insecure connections
+		NextProtos:         nextProtos, // Ensure this matches the server's ALPN
 		RootCAs:            certPool,
 	}
 }
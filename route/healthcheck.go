@@ -0,0 +1,150 @@
+package route
+
+import (
+	"net/netip"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	// DefaultHealthCheckInterval is the probe interval used when none is configured
+	DefaultHealthCheckInterval = 10 * time.Second
+	// DefaultHealthCheckTimeout is the probe timeout used when none is configured
+	DefaultHealthCheckTimeout = 3 * time.Second
+	// DefaultHealthCheckFailureThreshold is the number of consecutive failed probes after which a routing peer is considered unhealthy
+	DefaultHealthCheckFailureThreshold = 3
+
+	// MinHealthCheckInterval is the lowest accepted probe interval
+	MinHealthCheckInterval = time.Second
+	// MaxHealthCheckFailureThreshold is the highest accepted failure threshold
+	MaxHealthCheckFailureThreshold = 100
+)
+
+// HealthCheckType defines the protocol used to probe a target behind a routing peer
+type HealthCheckType string
+
+const (
+	// HealthCheckICMP sends ICMP echo requests to the target IP
+	HealthCheckICMP HealthCheckType = "icmp"
+	// HealthCheckTCP opens a TCP connection to the target IP and port
+	HealthCheckTCP HealthCheckType = "tcp"
+	// HealthCheckHTTP sends a GET request to the target URL and expects a status code below 400
+	HealthCheckHTTP HealthCheckType = "http"
+)
+
+// HealthCheck describes an active probe the clients run through every routing peer of a route.
+// A routing peer whose probes fail FailureThreshold times in a row is not selected while a healthy one is available.
+type HealthCheck struct {
+	Type HealthCheckType
+	// Target is an IP address for ICMP, an IP:port for TCP and a http(s) URL with an IP host for HTTP.
+	// The IP must be within the routed network.
+	Target           string
+	Interval         time.Duration
+	Timeout          time.Duration
+	FailureThreshold int
+}
+
+// Copy copies a health check object
+func (h *HealthCheck) Copy() *HealthCheck {
+	if h == nil {
+		return nil
+	}
+	hc := *h
+	return &hc
+}
+
+// Equal compares one health check with the other
+func (h *HealthCheck) Equal(other *HealthCheck) bool {
+	if h == nil || other == nil {
+		return h == other
+	}
+	return *h == *other
+}
+
+// GetInterval returns the probe interval or the default one if unset
+func (h *HealthCheck) GetInterval() time.Duration {
+	if h.Interval <= 0 {
+		return DefaultHealthCheckInterval
+	}
+	return h.Interval
+}
+
+// GetTimeout returns the probe timeout or the default one if unset
+func (h *HealthCheck) GetTimeout() time.Duration {
+	if h.Timeout <= 0 {
+		return DefaultHealthCheckTimeout
+	}
+	return h.Timeout
+}
+
+// GetFailureThreshold returns the failure threshold or the default one if unset
+func (h *HealthCheck) GetFailureThreshold() int {
+	if h.FailureThreshold <= 0 {
+		return DefaultHealthCheckFailureThreshold
+	}
+	return h.FailureThreshold
+}
+
+// TargetAddr returns the IP address probed by the health check
+func (h *HealthCheck) TargetAddr() (netip.Addr, error) {
+	switch h.Type {
+	case HealthCheckICMP:
+		addr, err := netip.ParseAddr(h.Target)
+		if err != nil {
+			return netip.Addr{}, status.Errorf(status.InvalidArgument, "invalid health check target %s, expected an IP address", h.Target)
+		}
+		return addr.Unmap(), nil
+	case HealthCheckTCP:
+		addrPort, err := netip.ParseAddrPort(h.Target)
+		if err != nil || addrPort.Port() == 0 {
+			return netip.Addr{}, status.Errorf(status.InvalidArgument, "invalid health check target %s, expected an IP address and port", h.Target)
+		}
+		return addrPort.Addr().Unmap(), nil
+	case HealthCheckHTTP:
+		u, err := url.Parse(h.Target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return netip.Addr{}, status.Errorf(status.InvalidArgument, "invalid health check target %s, expected a http or https URL", h.Target)
+		}
+		addr, err := netip.ParseAddr(u.Hostname())
+		if err != nil {
+			return netip.Addr{}, status.Errorf(status.InvalidArgument, "invalid health check target %s, the URL host must be an IP address", h.Target)
+		}
+		if port := u.Port(); port != "" {
+			if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+				return netip.Addr{}, status.Errorf(status.InvalidArgument, "invalid health check target %s, invalid port", h.Target)
+			}
+		}
+		return addr.Unmap(), nil
+	default:
+		return netip.Addr{}, status.Errorf(status.InvalidArgument, "invalid health check type %q", h.Type)
+	}
+}
+
+// Validate checks the health check settings and, if the network is valid, that the target lies within it
+func (h *HealthCheck) Validate(network netip.Prefix) error {
+	addr, err := h.TargetAddr()
+	if err != nil {
+		return err
+	}
+
+	if network.IsValid() && !network.Contains(addr) {
+		return status.Errorf(status.InvalidArgument, "health check target %s is not within the network %s", addr, network)
+	}
+
+	if h.Interval < 0 || (h.Interval > 0 && h.Interval < MinHealthCheckInterval) {
+		return status.Errorf(status.InvalidArgument, "health check interval must be at least %s", MinHealthCheckInterval)
+	}
+
+	if h.Timeout < 0 || (h.Timeout > 0 && h.Timeout > h.GetInterval()) {
+		return status.Errorf(status.InvalidArgument, "health check timeout must not exceed the interval")
+	}
+
+	if h.FailureThreshold < 0 || h.FailureThreshold > MaxHealthCheckFailureThreshold {
+		return status.Errorf(status.InvalidArgument, "health check failure threshold must be between 1 and %d", MaxHealthCheckFailureThreshold)
+	}
+
+	return nil
+}
@@ -103,6 +103,8 @@ type Route struct {
 	Enabled             bool
 	Groups              []string `gorm:"serializer:json"`
 	AccessControlGroups []string `gorm:"serializer:json"`
+	// HealthCheck is an optional probe used to select a healthy routing peer
+	HealthCheck *HealthCheck `gorm:"serializer:json"`
 }
 
 // EventMeta returns activity event meta related to the route
@@ -128,6 +130,7 @@ func (r *Route) Copy() *Route {
 		Enabled:             r.Enabled,
 		Groups:              slices.Clone(r.Groups),
 		AccessControlGroups: slices.Clone(r.AccessControlGroups),
+		HealthCheck:         r.HealthCheck.Copy(),
 	}
 	return route
 }
@@ -154,7 +157,8 @@ func (r *Route) IsEqual(other *Route) bool {
 		other.Enabled == r.Enabled &&
 		slices.Equal(r.Groups, other.Groups) &&
 		slices.Equal(r.PeerGroups, other.PeerGroups) &&
-		slices.Equal(r.AccessControlGroups, other.AccessControlGroups)
+		slices.Equal(r.AccessControlGroups, other.AccessControlGroups) &&
+		r.HealthCheck.Equal(other.HealthCheck)
 }
 
 // IsDynamic returns if the route is dynamic, i.e. has domains
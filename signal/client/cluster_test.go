@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc"
+
+	"github.com/netbirdio/netbird/signal/peer"
+	sigProto "github.com/netbirdio/netbird/signal/proto"
+	"github.com/netbirdio/netbird/signal/server"
+)
+
+var _ = Describe("GrpcClient with several Signal instances", func() {
+
+	Context("sharing a memory bus", func() {
+		It("should exchange messages between peers of different instances", func() {
+			bus := peer.NewMemoryBus()
+			addrA, stopA := startSignalInstance(bus.Backend())
+			defer stopA()
+			addrB, stopB := startSignalInstance(bus.Backend())
+			defer stopB()
+
+			exchangeAcrossInstances(addrA, addrB)
+		})
+	})
+
+	Context("linked by the cluster backend", func() {
+		It("should exchange messages between peers of different instances", func() {
+			first := newClusterBackend()
+			second := newClusterBackend(first.Addr().String())
+			third := newClusterBackend(first.Addr().String(), second.Addr().String())
+
+			addrA, stopFirst := startSignalInstance(first)
+			defer stopFirst()
+			_, stopSecond := startSignalInstance(second)
+			defer stopSecond()
+			addrB, stopThird := startSignalInstance(third)
+			defer stopThird()
+
+			exchangeAcrossInstances(addrA, addrB)
+		})
+	})
+})
+
+func newClusterBackend(members ...string) *peer.ClusterBackend {
+	backend, err := peer.NewClusterBackend(peer.ClusterConfig{
+		ListenAddress: "127.0.0.1:0",
+		Members:       members,
+		Secret:        "cluster-secret",
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return backend
+}
+
+// startSignalInstance starts a Signal server with the backend and returns its address
+func startSignalInstance(backend peer.Backend) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	s := grpc.NewServer()
+	srv, err := server.NewServerWithBackend(context.Background(), otel.Meter(""), backend)
+	Expect(err).NotTo(HaveOccurred())
+	sigProto.RegisterSignalExchangeServer(s, srv)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			log.Errorf("failed to serve: %v", err)
+		}
+	}()
+
+	return lis.Addr().String(), func() {
+		s.Stop()
+		Expect(srv.Close()).To(Succeed())
+	}
+}
+
+// exchangeAcrossInstances connects a peer to each instance and sends a message between them. The presence of the
+// peers is shared asynchronously, so the message is sent until it is received.
+func exchangeAcrossInstances(addrA, addrB string) {
+	keyA, _ := wgtypes.GenerateKey()
+	clientA := createSignalClient(addrA, keyA)
+	defer clientA.Close()
+	receivedOnA := receivePayloads(clientA)
+
+	keyB, _ := wgtypes.GenerateKey()
+	clientB := createSignalClient(addrB, keyB)
+	defer clientB.Close()
+	receivedOnB := receivePayloads(clientB)
+
+	sendUntilReceived(clientA, keyA, keyB, receivedOnB, "ping")
+	sendUntilReceived(clientB, keyB, keyA, receivedOnA, "pong")
+}
+
+func receivePayloads(client *GrpcClient) <-chan string {
+	payloads := make(chan string, 10)
+	go func() {
+		err := client.Receive(context.Background(), func(msg *sigProto.Message) error {
+			payloads <- msg.GetBody().GetPayload()
+			return nil
+		})
+		if err != nil {
+			return
+		}
+	}()
+	client.WaitStreamConnected()
+	return payloads
+}
+
+func sendUntilReceived(client *GrpcClient, from, to wgtypes.Key, received <-chan string, payload string) {
+	timeout := time.After(5 * time.Second)
+	for {
+		err := client.Send(&sigProto.Message{
+			Key:       from.PublicKey().String(),
+			RemoteKey: to.PublicKey().String(),
+			Body:      &sigProto.Body{Payload: payload},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		select {
+		case msg := <-received:
+			Expect(msg).To(Equal(payload))
+			return
+		case <-time.After(100 * time.Millisecond):
+		case <-timeout:
+			Fail("message " + payload + " not received")
+		}
+	}
+}
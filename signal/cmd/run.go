@@ -17,6 +17,7 @@ import (
 	"golang.org/x/crypto/acme/autocert"
 
 	"github.com/netbirdio/netbird/signal/metrics"
+	"github.com/netbirdio/netbird/signal/peer"
 
 	"github.com/netbirdio/netbird/encryption"
 	"github.com/netbirdio/netbird/signal/proto"
@@ -39,6 +40,9 @@ var (
 	defaultSignalSSLDir     string
 	signalCertFile          string
 	signalCertKey           string
+	clusterListenAddress    string
+	clusterMembers          []string
+	clusterSecret           string
 
 	signalKaep = grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
 		MinTime:             5 * time.Second,
@@ -106,7 +110,12 @@ var (
 				}
 			}()
 
-			srv, err := server.NewServer(cmd.Context(), metricsServer.Meter)
+			backend, err := newPeerBackend()
+			if err != nil {
+				return err
+			}
+
+			srv, err := server.NewServerWithBackend(cmd.Context(), metricsServer.Meter, backend)
 			if err != nil {
 				return fmt.Errorf("creating signal server: %v", err)
 			}
@@ -160,6 +169,10 @@ var (
 				log.Infof("stopped gRPC backward compatibility server")
 			}
 
+			if err := srv.Close(); err != nil {
+				log.Errorf("Failed to stop peer backend: %v", err)
+			}
+
 			ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
 			defer cancel()
 			if err := metricsServer.Shutdown(ctx); err != nil {
@@ -174,6 +187,24 @@ var (
 	}
 )
 
+// newPeerBackend returns the backend sharing the peers between the signal instances. Without cluster configuration the
+// server runs as a single instance.
+func newPeerBackend() (peer.Backend, error) {
+	if clusterListenAddress == "" {
+		return peer.NewMemoryBus().Backend(), nil
+	}
+
+	backend, err := peer.NewClusterBackend(peer.ClusterConfig{
+		ListenAddress: clusterListenAddress,
+		Members:       clusterMembers,
+		Secret:        clusterSecret,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating signal cluster backend: %v", err)
+	}
+	return backend, nil
+}
+
 func startPprof() {
 	go func() {
 		log.Debugf("Starting pprof server on 127.0.0.1:6060")
@@ -303,4 +334,7 @@ func init() {
 	runCmd.Flags().StringVar(&signalLetsencryptDomain, "letsencrypt-domain", "", "a domain to issue Let's Encrypt certificate for. Enables TLS using Let's Encrypt. Will fetch and renew certificate, and run the server with TLS")
 	runCmd.Flags().StringVar(&signalCertFile, "cert-file", "", "Location of your SSL certificate. Can be used when you have an existing certificate and don't want a new certificate be generated automatically. If letsencrypt-domain is specified this property has no effect")
 	runCmd.Flags().StringVar(&signalCertKey, "cert-key", "", "Location of your SSL certificate private key. Can be used when you have an existing certificate and don't want a new certificate be generated automatically. If letsencrypt-domain is specified this property has no effect")
+	runCmd.Flags().StringVar(&clusterListenAddress, "cluster-listen-address", "", "address to accept the links of the other signal instances on, e.g. 10.0.0.1:10001. Enables running several signal instances behind a load balancer. The links are not encrypted, use a private network")
+	runCmd.Flags().StringSliceVar(&clusterMembers, "cluster-members", nil, "cluster listen addresses of the other signal instances")
+	runCmd.Flags().StringVar(&clusterSecret, "cluster-secret", "", "secret shared by the signal instances of the cluster")
 }
@@ -20,6 +20,7 @@ type AppMetrics struct {
 	MessagesForwarded      metric.Int64Counter
 	MessageForwardFailures metric.Int64Counter
 	MessageForwardLatency  metric.Float64Histogram
+	MessagesRouted         metric.Int64Counter
 
 	MessageSize metric.Int64Histogram
 }
@@ -99,6 +100,13 @@ func NewAppMetrics(meter metric.Meter) (*AppMetrics, error) {
 		return nil, err
 	}
 
+	messagesRouted, err := meter.Int64Counter("messages_routed_total",
+		metric.WithDescription("Total number of messages routed to peers connected to other signal instances"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	messageSize, err := meter.Int64Histogram(
 		"message.size.bytes",
 		metric.WithUnit("bytes"),
@@ -124,6 +132,7 @@ func NewAppMetrics(meter metric.Meter) (*AppMetrics, error) {
 		MessagesForwarded:      messagesForwarded,
 		MessageForwardFailures: messageForwardFailures,
 		MessageForwardLatency:  messageForwardLatency,
+		MessagesRouted:         messagesRouted,
 
 		MessageSize: messageSize,
 	}, nil
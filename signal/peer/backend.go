@@ -0,0 +1,119 @@
+package peer
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+// ErrPeerNotFound is returned by a Backend if no other instance holds the stream of the destination peer
+var ErrPeerNotFound = errors.New("peer not found on other instances")
+
+// MessageHandler delivers a message to a peer connected to the local instance
+type MessageHandler func(ctx context.Context, msg *proto.EncryptedMessage)
+
+// Backend shares the presence of the peers between the instances of the Signal service and routes the messages to the
+// instance that holds the stream of the destination peer. The Registry keeps the streams of the local peers.
+type Backend interface {
+	// Start starts the backend. The handler receives the messages other instances route to the local peers.
+	Start(handler MessageHandler) error
+	// Announce shares that the peer is connected to the local instance
+	Announce(peerID string)
+	// Withdraw shares that the peer is not connected to the local instance anymore
+	Withdraw(peerID string)
+	// Route sends the message to the instance of the destination peer. It returns ErrPeerNotFound if no other instance
+	// holds the peer.
+	Route(ctx context.Context, msg *proto.EncryptedMessage) error
+	// Close withdraws the local peers and stops the backend
+	Close() error
+}
+
+// MemoryBus connects the Signal servers running in the same process. A server with its own bus is a single instance
+// deployment.
+type MemoryBus struct {
+	mu    sync.RWMutex
+	peers map[string]*MemoryBackend
+}
+
+// NewMemoryBus creates an empty MemoryBus
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{
+		peers: make(map[string]*MemoryBackend),
+	}
+}
+
+// Backend creates a new instance on the bus
+func (b *MemoryBus) Backend() *MemoryBackend {
+	return &MemoryBackend{
+		bus:   b,
+		local: make(map[string]struct{}),
+	}
+}
+
+// MemoryBackend is a Backend of an instance of a MemoryBus
+type MemoryBackend struct {
+	bus     *MemoryBus
+	handler MessageHandler
+	// local is guarded by the mutex of the bus
+	local map[string]struct{}
+}
+
+// Start sets the handler of the routed messages
+func (m *MemoryBackend) Start(handler MessageHandler) error {
+	m.bus.mu.Lock()
+	defer m.bus.mu.Unlock()
+	m.handler = handler
+	return nil
+}
+
+// Announce registers the peer on the bus, the latest announcement of a peer wins
+func (m *MemoryBackend) Announce(peerID string) {
+	m.bus.mu.Lock()
+	defer m.bus.mu.Unlock()
+	m.bus.peers[peerID] = m
+	m.local[peerID] = struct{}{}
+}
+
+// Withdraw removes the peer from the bus unless another instance announced it since
+func (m *MemoryBackend) Withdraw(peerID string) {
+	m.bus.mu.Lock()
+	defer m.bus.mu.Unlock()
+	m.withdraw(peerID)
+}
+
+func (m *MemoryBackend) withdraw(peerID string) {
+	delete(m.local, peerID)
+	if m.bus.peers[peerID] == m {
+		delete(m.bus.peers, peerID)
+	}
+}
+
+// Route delivers the message with the handler of the instance of the destination peer
+func (m *MemoryBackend) Route(ctx context.Context, msg *proto.EncryptedMessage) error {
+	m.bus.mu.RLock()
+	dst, ok := m.bus.peers[msg.RemoteKey]
+	var handler MessageHandler
+	if ok {
+		handler = dst.handler
+	}
+	m.bus.mu.RUnlock()
+
+	if !ok || dst == m || handler == nil {
+		return ErrPeerNotFound
+	}
+	handler(ctx, msg)
+	return nil
+}
+
+// Close withdraws the peers of the instance from the bus
+func (m *MemoryBackend) Close() error {
+	m.bus.mu.Lock()
+	defer m.bus.mu.Unlock()
+	for peerID := range m.local {
+		m.withdraw(peerID)
+	}
+	m.handler = nil
+	return nil
+}
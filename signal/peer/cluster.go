@@ -0,0 +1,587 @@
+package peer
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+const (
+	// DefaultPresenceTTL is the time the presence of the peers of an instance is kept without renewal
+	DefaultPresenceTTL = 30 * time.Second
+
+	clusterNonceSize        = 32
+	clusterHandshakeTimeout = 10 * time.Second
+
+	clusterLinkRetryInterval = 5 * time.Second
+	clusterLinkQueueSize     = 1024
+	clusterCloseTimeout      = 2 * time.Second
+	// clusterSnapshotChunkSize limits the peers of a presence message to stay below the gRPC message size limit
+	clusterSnapshotChunkSize = 1000
+)
+
+var errClusterClosed = errors.New("cluster backend closed")
+
+// ClusterConfig configures the links between the instances of a horizontally scaled Signal service
+type ClusterConfig struct {
+	// ListenAddress is the address the other instances link to, e.g. 10.0.0.1:10001
+	ListenAddress string
+	// Members are the listen addresses of the other instances. The list may contain the address of this instance.
+	Members []string
+	// Secret authenticates the instances of the cluster
+	Secret string
+	// PresenceTTL is the time the presence of the peers of an instance is kept without renewal, DefaultPresenceTTL if
+	// not set
+	PresenceTTL time.Duration
+}
+
+// ClusterBackend is a Backend that links the instances of the Signal service over gRPC. Every instance shares the
+// presence of its peers with the linked instances and the messages are routed to the instance of the destination
+// peer. The instances authenticate each other with a challenge on the shared secret when they link, but the links are
+// not encrypted, the cluster listener must be reachable only in a private network.
+type ClusterBackend struct {
+	proto.UnimplementedSignalClusterServer
+
+	cfg        ClusterConfig
+	instanceID string
+	listener   net.Listener
+	grpcServer *grpc.Server
+	presence   *presenceTable
+	handler    MessageHandler
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// mu guards the local peers and the links, it orders the snapshots of new links with the presence updates
+	mu      sync.Mutex
+	local   map[string]struct{}
+	links   map[*clusterLink]struct{}
+	routes  map[string]*clusterLink
+	closing bool
+}
+
+// clusterStream is implemented by the client and the server side of a link stream
+type clusterStream interface {
+	Send(*proto.ClusterMessage) error
+	Recv() (*proto.ClusterMessage, error)
+}
+
+type clusterLink struct {
+	instanceID string
+	queue      chan *proto.ClusterMessage
+	cancel     context.CancelFunc
+	done       chan struct{}
+}
+
+// enqueue sends the message asynchronously. A congested link is closed, its presence is synced again on reconnect.
+func (l *clusterLink) enqueue(msg *proto.ClusterMessage) bool {
+	select {
+	case l.queue <- msg:
+		return true
+	default:
+		log.Warnf("cluster link to instance %s is congested, closing it", l.instanceID)
+		l.cancel()
+		return false
+	}
+}
+
+// NewClusterBackend creates a ClusterBackend listening on the configured address
+func NewClusterBackend(cfg ClusterConfig) (*ClusterBackend, error) {
+	if cfg.Secret == "" {
+		return nil, errors.New("cluster secret is required")
+	}
+	if cfg.PresenceTTL <= 0 {
+		cfg.PresenceTTL = DefaultPresenceTTL
+	}
+
+	listener, err := net.Listen("tcp", cfg.ListenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("listen on %s: %w", cfg.ListenAddress, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &ClusterBackend{
+		cfg:        cfg,
+		instanceID: xid.New().String(),
+		listener:   listener,
+		presence:   newPresenceTable(),
+		ctx:        ctx,
+		cancel:     cancel,
+		local:      make(map[string]struct{}),
+		links:      make(map[*clusterLink]struct{}),
+		routes:     make(map[string]*clusterLink),
+	}
+	c.grpcServer = grpc.NewServer(grpc.KeepaliveParams(keepalive.ServerParameters{
+		Time:    10 * time.Second,
+		Timeout: 5 * time.Second,
+	}))
+	proto.RegisterSignalClusterServer(c.grpcServer, c)
+	return c, nil
+}
+
+// Addr returns the address the instance accepts the links of the other instances on
+func (c *ClusterBackend) Addr() net.Addr {
+	return c.listener.Addr()
+}
+
+// Start accepts the links of the other instances and links to the members of the cluster
+func (c *ClusterBackend) Start(handler MessageHandler) error {
+	c.handler = handler
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		if err := c.grpcServer.Serve(c.listener); err != nil {
+			log.Errorf("failed to serve signal cluster links: %v", err)
+		}
+	}()
+
+	for _, member := range c.cfg.Members {
+		c.wg.Add(1)
+		go c.linkMember(member)
+	}
+
+	c.wg.Add(1)
+	go c.renewPresence()
+
+	log.Infof("signal cluster instance %s accepts links on %s", c.instanceID, c.listener.Addr())
+	return nil
+}
+
+// Announce shares the presence of the peer with the linked instances
+func (c *ClusterBackend) Announce(peerID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.local[peerID] = struct{}{}
+	c.broadcast(&proto.ClusterPresence{Announced: []string{peerID}})
+}
+
+// Withdraw shares with the linked instances that the peer disconnected
+func (c *ClusterBackend) Withdraw(peerID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.local, peerID)
+	c.broadcast(&proto.ClusterPresence{Withdrawn: []string{peerID}})
+}
+
+// Route sends the message over the link to the instance of the destination peer
+func (c *ClusterBackend) Route(_ context.Context, msg *proto.EncryptedMessage) error {
+	instanceID, ok := c.presence.lookup(msg.RemoteKey, time.Now())
+	if !ok {
+		return ErrPeerNotFound
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closing {
+		return errClusterClosed
+	}
+
+	link, ok := c.routes[instanceID]
+	if !ok {
+		return fmt.Errorf("no link to instance %s", instanceID)
+	}
+
+	if !link.enqueue(&proto.ClusterMessage{Payload: &proto.ClusterMessage_Message{Message: msg}}) {
+		return fmt.Errorf("link to instance %s is congested", instanceID)
+	}
+	return nil
+}
+
+// Close withdraws the local peers from the linked instances and closes the links
+func (c *ClusterBackend) Close() error {
+	c.mu.Lock()
+	if c.closing {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closing = true
+	var pending []*clusterLink
+	for link := range c.links {
+		if link.enqueue(c.presenceMessage(&proto.ClusterPresence{Full: true})) {
+			close(link.queue)
+			pending = append(pending, link)
+		}
+	}
+	c.mu.Unlock()
+
+	timeout := time.After(clusterCloseTimeout)
+	for _, link := range pending {
+		select {
+		case <-link.done:
+		case <-timeout:
+		}
+	}
+
+	c.cancel()
+	c.grpcServer.Stop()
+	c.wg.Wait()
+	return nil
+}
+
+// Link accepts the link of another instance
+func (c *ClusterBackend) Link(stream proto.SignalCluster_LinkServer) error {
+	remoteID, err := c.handshakeAccept(stream)
+	if err != nil {
+		return err
+	}
+
+	// the instance is in its own member list
+	if remoteID == c.instanceID {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	stop := context.AfterFunc(c.ctx, cancel)
+	defer stop()
+
+	log.Infof("signal cluster instance %s linked", remoteID)
+	return c.serveLink(ctx, stream, remoteID)
+}
+
+func (c *ClusterBackend) linkMember(addr string) {
+	defer c.wg.Done()
+
+	for {
+		self, err := c.dialMember(addr)
+		if self {
+			log.Debugf("ignore signal cluster member %s, it is this instance", addr)
+			return
+		}
+		if err != nil && c.ctx.Err() == nil {
+			log.Warnf("signal cluster link to %s failed: %v", addr, err)
+		}
+
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(clusterLinkRetryInterval):
+		}
+	}
+}
+
+func (c *ClusterBackend) dialMember(addr string) (bool, error) {
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    10 * time.Second,
+			Timeout: 5 * time.Second,
+		}),
+	)
+	if err != nil {
+		return false, fmt.Errorf("create client: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Debugf("failed to close signal cluster connection to %s: %v", addr, err)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+
+	stream, err := proto.NewSignalClusterClient(conn).Link(ctx)
+	if err != nil {
+		return false, fmt.Errorf("open link: %w", err)
+	}
+
+	remoteID, err := c.handshakeDial(stream)
+	if err != nil {
+		return false, err
+	}
+	if remoteID == c.instanceID {
+		return true, nil
+	}
+
+	log.Infof("linked to signal cluster instance %s on %s", remoteID, addr)
+	return false, c.serveLink(ctx, stream, remoteID)
+}
+
+// serveLink exchanges the presence and the messages with the remote instance until the link fails
+func (c *ClusterBackend) serveLink(ctx context.Context, stream clusterStream, remoteID string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	link := &clusterLink{
+		instanceID: remoteID,
+		queue:      make(chan *proto.ClusterMessage, clusterLinkQueueSize),
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+	if !c.addLink(link) {
+		cancel()
+		return errClusterClosed
+	}
+	defer c.removeLink(link)
+
+	go c.sendLoop(ctx, stream, link)
+	defer func() {
+		cancel()
+		<-link.done
+	}()
+
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- c.recvLoop(stream, remoteID)
+	}()
+
+	select {
+	case err := <-recvErr:
+		return err
+	case <-link.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *ClusterBackend) sendLoop(ctx context.Context, stream clusterStream, link *clusterLink) {
+	defer close(link.done)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-link.queue:
+			if !ok {
+				return
+			}
+			if err := stream.Send(msg); err != nil {
+				log.Debugf("failed to send to signal cluster instance %s: %v", link.instanceID, err)
+				link.cancel()
+				return
+			}
+		}
+	}
+}
+
+func (c *ClusterBackend) recvLoop(stream clusterStream, remoteID string) error {
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch payload := msg.GetPayload().(type) {
+		case *proto.ClusterMessage_Presence:
+			c.presence.update(remoteID, payload.Presence, time.Now())
+		case *proto.ClusterMessage_Message:
+			c.handler(c.ctx, payload.Message)
+		}
+	}
+}
+
+// addLink registers the link and queues the snapshot of the local peers
+func (c *ClusterBackend) addLink(link *clusterLink) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closing {
+		return false
+	}
+
+	c.links[link] = struct{}{}
+	c.routes[link.instanceID] = link
+
+	snapshot := make([]string, 0, len(c.local))
+	for peerID := range c.local {
+		snapshot = append(snapshot, peerID)
+	}
+
+	// the first chunk replaces the peers known from a previous link
+	full := true
+	for len(snapshot) > 0 || full {
+		n := min(len(snapshot), clusterSnapshotChunkSize)
+		presence := &proto.ClusterPresence{Full: full, Announced: snapshot[:n]}
+		if !link.enqueue(c.presenceMessage(presence)) {
+			break
+		}
+		snapshot = snapshot[n:]
+		full = false
+	}
+	return true
+}
+
+func (c *ClusterBackend) removeLink(link *clusterLink) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.links, link)
+	if c.routes[link.instanceID] != link {
+		return
+	}
+
+	delete(c.routes, link.instanceID)
+	// the instance can be linked in both directions
+	for other := range c.links {
+		if other.instanceID == link.instanceID {
+			c.routes[other.instanceID] = other
+			return
+		}
+	}
+}
+
+// broadcast queues the presence message on all links, it must be called with the lock held
+func (c *ClusterBackend) broadcast(presence *proto.ClusterPresence) {
+	if c.closing {
+		return
+	}
+
+	msg := c.presenceMessage(presence)
+	for link := range c.links {
+		link.enqueue(msg)
+	}
+}
+
+func (c *ClusterBackend) presenceMessage(presence *proto.ClusterPresence) *proto.ClusterMessage {
+	presence.TtlSeconds = uint32(c.cfg.PresenceTTL.Seconds())
+	return &proto.ClusterMessage{Payload: &proto.ClusterMessage_Presence{Presence: presence}}
+}
+
+// renewPresence sends heartbeats to the linked instances and expires the presence of the silent ones
+func (c *ClusterBackend) renewPresence() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.cfg.PresenceTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.mu.Lock()
+			c.broadcast(&proto.ClusterPresence{})
+			c.mu.Unlock()
+
+			for _, instanceID := range c.presence.expire(time.Now()) {
+				log.Infof("presence of signal cluster instance %s expired", instanceID)
+			}
+		}
+	}
+}
+
+// handshakeDial authenticates the called instance and authenticates this instance to it.
+// It returns the instance ID of the called instance
+func (c *ClusterBackend) handshakeDial(stream clusterStream) (string, error) {
+	localNonce := make([]byte, clusterNonceSize)
+	if _, err := rand.Read(localNonce); err != nil {
+		return "", err
+	}
+	if err := sendHandshake(stream, &proto.ClusterHandshake{InstanceID: c.instanceID, Nonce: localNonce}); err != nil {
+		return "", fmt.Errorf("send hello: %w", err)
+	}
+
+	hello, err := recvHandshake(stream)
+	if err != nil {
+		return "", fmt.Errorf("read hello: %w", err)
+	}
+	remoteID := hello.GetInstanceID()
+	if remoteID == "" || len(hello.GetNonce()) != clusterNonceSize {
+		return "", errors.New("invalid cluster hello")
+	}
+	if !hmac.Equal(hello.GetMac(), c.mac("accept", localNonce, hello.GetNonce(), remoteID)) {
+		return "", errors.New("invalid cluster secret")
+	}
+
+	auth := &proto.ClusterHandshake{Mac: c.mac("dial", hello.GetNonce(), localNonce, c.instanceID)}
+	if err := sendHandshake(stream, auth); err != nil {
+		return "", fmt.Errorf("send authentication: %w", err)
+	}
+	return remoteID, nil
+}
+
+// handshakeAccept authenticates the dialing instance and authenticates this instance to it.
+// It returns the instance ID of the dialing instance
+func (c *ClusterBackend) handshakeAccept(stream clusterStream) (string, error) {
+	hello, err := recvHandshake(stream)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "read hello: %v", err)
+	}
+	remoteID := hello.GetInstanceID()
+	if remoteID == "" || len(hello.GetNonce()) != clusterNonceSize {
+		return "", status.Error(codes.InvalidArgument, "invalid cluster hello")
+	}
+
+	localNonce := make([]byte, clusterNonceSize)
+	if _, err := rand.Read(localNonce); err != nil {
+		return "", status.Errorf(codes.Internal, "generate nonce: %v", err)
+	}
+	reply := &proto.ClusterHandshake{
+		InstanceID: c.instanceID,
+		Nonce:      localNonce,
+		Mac:        c.mac("accept", hello.GetNonce(), localNonce, c.instanceID),
+	}
+	if err := sendHandshake(stream, reply); err != nil {
+		return "", err
+	}
+
+	auth, err := recvHandshake(stream)
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "read authentication: %v", err)
+	}
+	if !hmac.Equal(auth.GetMac(), c.mac("dial", localNonce, hello.GetNonce(), remoteID)) {
+		return "", status.Error(codes.Unauthenticated, "invalid cluster secret")
+	}
+	return remoteID, nil
+}
+
+// mac authenticates the handshake role and the nonces of both sides and the instance ID of the sender with the secret
+func (c *ClusterBackend) mac(role string, receiverNonce, senderNonce []byte, senderID string) []byte {
+	h := hmac.New(sha256.New, []byte(c.cfg.Secret))
+	h.Write([]byte(role))
+	h.Write(receiverNonce)
+	h.Write(senderNonce)
+	h.Write([]byte(senderID))
+	return h.Sum(nil)
+}
+
+func sendHandshake(stream clusterStream, handshake *proto.ClusterHandshake) error {
+	return stream.Send(&proto.ClusterMessage{Payload: &proto.ClusterMessage_Handshake{Handshake: handshake}})
+}
+
+// recvHandshake reads the next handshake message. The caller must close the stream when the handshake times out.
+func recvHandshake(stream clusterStream) (*proto.ClusterHandshake, error) {
+	type result struct {
+		msg *proto.ClusterMessage
+		err error
+	}
+	received := make(chan result, 1)
+	go func() {
+		msg, err := stream.Recv()
+		received <- result{msg: msg, err: err}
+	}()
+
+	select {
+	case r := <-received:
+		if r.err != nil {
+			return nil, r.err
+		}
+		handshake := r.msg.GetHandshake()
+		if handshake == nil {
+			return nil, errors.New("expected a handshake message")
+		}
+		return handshake, nil
+	case <-time.After(clusterHandshakeTimeout):
+		return nil, errors.New("handshake timed out")
+	}
+}
@@ -0,0 +1,141 @@
+package peer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+func startClusterBackend(t *testing.T, secret string, handler MessageHandler, members ...string) *ClusterBackend {
+	t.Helper()
+
+	c, err := NewClusterBackend(ClusterConfig{ListenAddress: "127.0.0.1:0", Members: members, Secret: secret})
+	require.NoError(t, err)
+	require.NoError(t, c.Start(handler))
+	t.Cleanup(func() {
+		_ = c.Close()
+	})
+	return c
+}
+
+func TestClusterBackend_Route(t *testing.T) {
+	received := make(chan *proto.EncryptedMessage, 1)
+	first := startClusterBackend(t, "secret", func(context.Context, *proto.EncryptedMessage) {})
+	second := startClusterBackend(t, "secret", func(_ context.Context, msg *proto.EncryptedMessage) {
+		received <- msg
+	}, first.Addr().String())
+
+	second.Announce("peer")
+
+	msg := &proto.EncryptedMessage{Key: "other", RemoteKey: "peer"}
+	require.Eventually(t, func() bool {
+		return first.Route(context.Background(), msg) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	select {
+	case routed := <-received:
+		assert.Equal(t, "peer", routed.RemoteKey)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message not routed")
+	}
+
+	second.Withdraw("peer")
+	assert.Eventually(t, func() bool {
+		return first.Route(context.Background(), msg) == ErrPeerNotFound
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestClusterBackend_CloseWithdrawsPeers(t *testing.T) {
+	first := startClusterBackend(t, "secret", func(context.Context, *proto.EncryptedMessage) {})
+	second := startClusterBackend(t, "secret", func(context.Context, *proto.EncryptedMessage) {}, first.Addr().String())
+
+	second.Announce("peer")
+	require.Eventually(t, func() bool {
+		_, ok := first.presence.lookup("peer", time.Now())
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, second.Close())
+	assert.Eventually(t, func() bool {
+		_, ok := first.presence.lookup("peer", time.Now())
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestClusterBackend_RejectsWrongSecret(t *testing.T) {
+	first := startClusterBackend(t, "secret", func(context.Context, *proto.EncryptedMessage) {})
+	other := startClusterBackend(t, "wrong", func(context.Context, *proto.EncryptedMessage) {}, first.Addr().String())
+
+	other.Announce("peer")
+	time.Sleep(500 * time.Millisecond)
+
+	_, ok := first.presence.lookup("peer", time.Now())
+	assert.False(t, ok, "instance with another secret should not be linked")
+}
+
+func TestClusterBackend_RejectsReplayedHandshake(t *testing.T) {
+	first := startClusterBackend(t, "secret", func(context.Context, *proto.EncryptedMessage) {})
+	dialer, err := NewClusterBackend(ClusterConfig{ListenAddress: "127.0.0.1:0", Secret: "secret"})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = dialer.Close()
+	})
+
+	conn, err := grpc.NewClient(first.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// a recorded authentication doesn't match the nonce of a new handshake
+	stream, err := proto.NewSignalClusterClient(conn).Link(ctx)
+	require.NoError(t, err)
+	nonce := make([]byte, clusterNonceSize)
+	require.NoError(t, sendHandshake(stream, &proto.ClusterHandshake{InstanceID: dialer.instanceID, Nonce: nonce}))
+	hello, err := recvHandshake(stream)
+	require.NoError(t, err)
+	recordedNonce := make([]byte, clusterNonceSize)
+	recordedNonce[0] = 1
+	require.NotEqual(t, recordedNonce, hello.GetNonce())
+	require.NoError(t, sendHandshake(stream, &proto.ClusterHandshake{Mac: dialer.mac("dial", recordedNonce, nonce, dialer.instanceID)}))
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	stream, err = proto.NewSignalClusterClient(conn).Link(ctx)
+	require.NoError(t, err)
+	remoteID, err := dialer.handshakeDial(stream)
+	require.NoError(t, err)
+	assert.Equal(t, first.instanceID, remoteID)
+}
+
+func TestClusterBackend_IgnoresItselfAsMember(t *testing.T) {
+	c, err := NewClusterBackend(ClusterConfig{ListenAddress: "127.0.0.1:0", Secret: "secret"})
+	require.NoError(t, err)
+	c.cfg.Members = []string{c.Addr().String()}
+	require.NoError(t, c.Start(func(context.Context, *proto.EncryptedMessage) {}))
+	defer func() {
+		_ = c.Close()
+	}()
+
+	time.Sleep(500 * time.Millisecond)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	assert.Empty(t, c.links)
+}
+
+func TestNewClusterBackend_RequiresSecret(t *testing.T) {
+	_, err := NewClusterBackend(ClusterConfig{ListenAddress: "127.0.0.1:0"})
+	assert.Error(t, err)
+}
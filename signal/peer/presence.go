@@ -0,0 +1,106 @@
+package peer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+// presenceTable tracks the peers connected to the other instances of the cluster. The presence of all peers of an
+// instance expires if the instance does not renew it in time, e.g. if it crashed or lost its links.
+type presenceTable struct {
+	mu sync.Mutex
+	// instances holds the peers per instance ID
+	instances map[string]*instancePresence
+	// peers holds the instance ID per peer ID, the latest announcement of a peer wins
+	peers map[string]string
+}
+
+type instancePresence struct {
+	peers     map[string]struct{}
+	expiresAt time.Time
+}
+
+func newPresenceTable() *presenceTable {
+	return &presenceTable{
+		instances: make(map[string]*instancePresence),
+		peers:     make(map[string]string),
+	}
+}
+
+// update applies the presence message of an instance and renews the presence of its peers
+func (t *presenceTable) update(instanceID string, presence *proto.ClusterPresence, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	instance, ok := t.instances[instanceID]
+	if !ok {
+		instance = &instancePresence{peers: make(map[string]struct{})}
+		t.instances[instanceID] = instance
+	}
+
+	if presence.GetFull() {
+		for peerID := range instance.peers {
+			t.removePeer(instanceID, instance, peerID)
+		}
+	}
+
+	for _, peerID := range presence.GetAnnounced() {
+		instance.peers[peerID] = struct{}{}
+		t.peers[peerID] = instanceID
+	}
+
+	for _, peerID := range presence.GetWithdrawn() {
+		t.removePeer(instanceID, instance, peerID)
+	}
+
+	ttl := time.Duration(presence.GetTtlSeconds()) * time.Second
+	if ttl <= 0 {
+		ttl = DefaultPresenceTTL
+	}
+	instance.expiresAt = now.Add(ttl)
+}
+
+func (t *presenceTable) removePeer(instanceID string, instance *instancePresence, peerID string) {
+	delete(instance.peers, peerID)
+	if t.peers[peerID] == instanceID {
+		delete(t.peers, peerID)
+	}
+}
+
+// lookup returns the instance the peer is connected to
+func (t *presenceTable) lookup(peerID string, now time.Time) (string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	instanceID, ok := t.peers[peerID]
+	if !ok {
+		return "", false
+	}
+
+	instance, ok := t.instances[instanceID]
+	if !ok || now.After(instance.expiresAt) {
+		return "", false
+	}
+	return instanceID, true
+}
+
+// expire removes the peers of the instances that did not renew their presence
+func (t *presenceTable) expire(now time.Time) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var expired []string
+	for instanceID, instance := range t.instances {
+		if now.Before(instance.expiresAt) {
+			continue
+		}
+		for peerID := range instance.peers {
+			t.removePeer(instanceID, instance, peerID)
+		}
+		delete(t.instances, instanceID)
+		expired = append(expired, instanceID)
+	}
+	return expired
+}
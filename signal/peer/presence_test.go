@@ -0,0 +1,63 @@
+package peer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+func TestPresenceTable_Update(t *testing.T) {
+	table := newPresenceTable()
+	now := time.Now()
+
+	table.update("instance1", &proto.ClusterPresence{Announced: []string{"peer1", "peer2"}, TtlSeconds: 30}, now)
+	instanceID, ok := table.lookup("peer1", now)
+	assert.True(t, ok)
+	assert.Equal(t, "instance1", instanceID)
+
+	table.update("instance1", &proto.ClusterPresence{Withdrawn: []string{"peer1"}, TtlSeconds: 30}, now)
+	_, ok = table.lookup("peer1", now)
+	assert.False(t, ok, "withdrawn peer should not be found")
+
+	table.update("instance1", &proto.ClusterPresence{Full: true, Announced: []string{"peer3"}, TtlSeconds: 30}, now)
+	_, ok = table.lookup("peer2", now)
+	assert.False(t, ok, "full presence should replace the previous peers")
+	_, ok = table.lookup("peer3", now)
+	assert.True(t, ok)
+}
+
+func TestPresenceTable_PeerMovedToAnotherInstance(t *testing.T) {
+	table := newPresenceTable()
+	now := time.Now()
+
+	table.update("instance1", &proto.ClusterPresence{Announced: []string{"peer"}, TtlSeconds: 30}, now)
+	table.update("instance2", &proto.ClusterPresence{Announced: []string{"peer"}, TtlSeconds: 30}, now)
+	// the old instance notices the disconnection later
+	table.update("instance1", &proto.ClusterPresence{Withdrawn: []string{"peer"}, TtlSeconds: 30}, now)
+
+	instanceID, ok := table.lookup("peer", now)
+	assert.True(t, ok)
+	assert.Equal(t, "instance2", instanceID)
+}
+
+func TestPresenceTable_Expire(t *testing.T) {
+	table := newPresenceTable()
+	now := time.Now()
+
+	table.update("instance1", &proto.ClusterPresence{Announced: []string{"peer1"}, TtlSeconds: 10}, now)
+	table.update("instance2", &proto.ClusterPresence{Announced: []string{"peer2"}, TtlSeconds: 30}, now)
+
+	later := now.Add(20 * time.Second)
+	_, ok := table.lookup("peer1", later)
+	assert.False(t, ok, "presence should expire without renewal")
+
+	// a heartbeat renews all peers of the instance
+	table.update("instance2", &proto.ClusterPresence{TtlSeconds: 30}, later)
+
+	assert.Equal(t, []string{"instance1"}, table.expire(now.Add(40*time.Second)))
+	_, ok = table.lookup("peer2", now.Add(40*time.Second))
+	assert.True(t, ok)
+}
@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SignalClusterClient is the client API for SignalCluster service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SignalClusterClient interface {
+	// Link streams the presence of the peers connected to the calling instance and the messages routed to the peers of
+	// the called instance
+	Link(ctx context.Context, opts ...grpc.CallOption) (SignalCluster_LinkClient, error)
+}
+
+type signalClusterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSignalClusterClient(cc grpc.ClientConnInterface) SignalClusterClient {
+	return &signalClusterClient{cc}
+}
+
+func (c *signalClusterClient) Link(ctx context.Context, opts ...grpc.CallOption) (SignalCluster_LinkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SignalCluster_ServiceDesc.Streams[0], "/signalexchange.SignalCluster/Link", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &signalClusterLinkClient{stream}
+	return x, nil
+}
+
+type SignalCluster_LinkClient interface {
+	Send(*ClusterMessage) error
+	Recv() (*ClusterMessage, error)
+	grpc.ClientStream
+}
+
+type signalClusterLinkClient struct {
+	grpc.ClientStream
+}
+
+func (x *signalClusterLinkClient) Send(m *ClusterMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *signalClusterLinkClient) Recv() (*ClusterMessage, error) {
+	m := new(ClusterMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SignalClusterServer is the server API for SignalCluster service.
+// All implementations must embed UnimplementedSignalClusterServer
+// for forward compatibility
+type SignalClusterServer interface {
+	// Link streams the presence of the peers connected to the calling instance and the messages routed to the peers of
+	// the called instance
+	Link(SignalCluster_LinkServer) error
+	mustEmbedUnimplementedSignalClusterServer()
+}
+
+// UnimplementedSignalClusterServer must be embedded to have forward compatible implementations.
+type UnimplementedSignalClusterServer struct {
+}
+
+func (UnimplementedSignalClusterServer) Link(SignalCluster_LinkServer) error {
+	return status.Errorf(codes.Unimplemented, "method Link not implemented")
+}
+func (UnimplementedSignalClusterServer) mustEmbedUnimplementedSignalClusterServer() {}
+
+// UnsafeSignalClusterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SignalClusterServer will
+// result in compilation errors.
+type UnsafeSignalClusterServer interface {
+	mustEmbedUnimplementedSignalClusterServer()
+}
+
+func RegisterSignalClusterServer(s grpc.ServiceRegistrar, srv SignalClusterServer) {
+	s.RegisterService(&SignalCluster_ServiceDesc, srv)
+}
+
+func _SignalCluster_Link_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SignalClusterServer).Link(&signalClusterLinkServer{stream})
+}
+
+type SignalCluster_LinkServer interface {
+	Send(*ClusterMessage) error
+	Recv() (*ClusterMessage, error)
+	grpc.ServerStream
+}
+
+type signalClusterLinkServer struct {
+	grpc.ServerStream
+}
+
+func (x *signalClusterLinkServer) Send(m *ClusterMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *signalClusterLinkServer) Recv() (*ClusterMessage, error) {
+	m := new(ClusterMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SignalCluster_ServiceDesc is the grpc.ServiceDesc for SignalCluster service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SignalCluster_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "signalexchange.SignalCluster",
+	HandlerType: (*SignalClusterServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Link",
+			Handler:       _SignalCluster_Link_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "cluster.proto",
+}
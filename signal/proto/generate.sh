@@ -14,4 +14,5 @@ cd "$script_path"
 go install google.golang.org/protobuf/cmd/protoc-gen-go@v1.26
 go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.1
 protoc -I ./ ./signalexchange.proto --go_out=../ --go-grpc_out=../
+protoc -I ./ ./cluster.proto --go_out=../ --go-grpc_out=../
 cd "$old_pwd"
\ No newline at end of file
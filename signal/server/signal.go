@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -25,6 +26,7 @@ const (
 	labelTypeError         = "error"
 	labelTypeNotConnected  = "not_connected"
 	labelTypeNotRegistered = "not_registered"
+	labelTypeRouteError    = "route_error"
 	labelTypeStream        = "stream"
 	labelTypeMessage       = "message"
 
@@ -44,10 +46,17 @@ type Server struct {
 	proto.UnimplementedSignalExchangeServer
 	dispatcher *dispatcher.Dispatcher
 	metrics    *metrics.AppMetrics
+	// backend routes the messages to the peers connected to other instances of the Signal service
+	backend peer.Backend
 }
 
-// NewServer creates a new Signal server
+// NewServer creates a new Signal server running as a single instance
 func NewServer(ctx context.Context, meter metric.Meter) (*Server, error) {
+	return NewServerWithBackend(ctx, meter, peer.NewMemoryBus().Backend())
+}
+
+// NewServerWithBackend creates a new Signal server that shares its peers with the other instances of the backend
+func NewServerWithBackend(ctx context.Context, meter metric.Meter, backend peer.Backend) (*Server, error) {
 	appMetrics, err := metrics.NewAppMetrics(meter)
 	if err != nil {
 		return nil, fmt.Errorf("creating app metrics: %v", err)
@@ -62,11 +71,21 @@ func NewServer(ctx context.Context, meter metric.Meter) (*Server, error) {
 		dispatcher: d,
 		registry:   peer.NewRegistry(appMetrics),
 		metrics:    appMetrics,
+		backend:    backend,
+	}
+
+	if err := backend.Start(s.forwardMessageToPeer); err != nil {
+		return nil, fmt.Errorf("starting peer backend: %v", err)
 	}
 
 	return s, nil
 }
 
+// Close withdraws the peers of the server from the backend and stops it
+func (s *Server) Close() error {
+	return s.backend.Close()
+}
+
 // Send forwards a message to the signal peer
 func (s *Server) Send(ctx context.Context, msg *proto.EncryptedMessage) (*proto.EncryptedMessage, error) {
 	log.Debugf("received a new message to send from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
@@ -76,6 +95,10 @@ func (s *Server) Send(ctx context.Context, msg *proto.EncryptedMessage) (*proto.
 		return &proto.EncryptedMessage{}, nil
 	}
 
+	if s.routeMessage(ctx, msg) {
+		return &proto.EncryptedMessage{}, nil
+	}
+
 	return s.dispatcher.SendMessage(ctx, msg)
 }
 
@@ -114,6 +137,10 @@ func (s *Server) ConnectStream(stream proto.SignalExchange_ConnectStreamServer)
 
 			log.Debugf("Received a response from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
 
+			if _, found := s.registry.Get(msg.RemoteKey); !found && s.routeMessage(stream.Context(), msg) {
+				continue
+			}
+
 			_, err = s.dispatcher.SendMessage(stream.Context(), msg)
 			if err != nil {
 				log.Debugf("error while sending message from peer [%s] to peer [%s] %v", msg.Key, msg.RemoteKey, err)
@@ -138,16 +165,37 @@ func (s *Server) RegisterPeer(stream proto.SignalExchange_ConnectStreamServer) (
 
 	p := peer.NewPeer(id[0], stream)
 	s.registry.Register(p)
+	s.backend.Announce(p.Id)
 	s.dispatcher.ListenForMessages(stream.Context(), p.Id, s.forwardMessageToPeer)
 	return p, nil
 }
 
 func (s *Server) DeregisterPeer(p *peer.Peer) {
 	log.Debugf("peer disconnected [%s] [streamID %d] ", p.Id, p.StreamID)
-	s.registry.Deregister(p)
+	if s.registry.Deregister(p) {
+		s.backend.Withdraw(p.Id)
+	}
 	s.metrics.PeerConnectionDuration.Record(p.Stream.Context(), int64(time.Since(p.RegisteredAt).Seconds()))
 }
 
+// routeMessage sends the message to the instance of the Signal service the destination peer is connected to. It
+// returns false if no other instance holds the peer.
+func (s *Server) routeMessage(ctx context.Context, msg *proto.EncryptedMessage) bool {
+	err := s.backend.Route(ctx, msg)
+	if errors.Is(err, peer.ErrPeerNotFound) {
+		return false
+	}
+
+	if err != nil {
+		log.Warnf("error while routing message from peer [%s] to peer [%s] %v", msg.Key, msg.RemoteKey, err)
+		s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeRouteError)))
+		return true
+	}
+
+	s.metrics.MessagesRouted.Add(ctx, 1)
+	return true
+}
+
 func (s *Server) forwardMessageToPeer(ctx context.Context, msg *proto.EncryptedMessage) {
 	log.Debugf("forwarding a new message from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
 	getRegistrationStart := time.Now()